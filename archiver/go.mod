@@ -13,3 +13,5 @@ require (
 	google.golang.org/grpc v1.38.0
 	google.golang.org/protobuf v1.27.0
 )
+
+replace github.com/horahoradev/horahora/storage => ../storage
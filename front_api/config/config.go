@@ -18,6 +18,10 @@ type Config struct {
 	VideoServiceGRPCAddress     string `env:"VideoServiceGRPCAddress,required"`
 	SchedulerServiceGRPCAddress string `env:"SchedulerServiceGRPCAddress,required"`
 
+	// TrustedProxies are the CIDR ranges of reverse proxies whose X-Forwarded-For header is believed. The client
+	// address is used directly when none are given, since anyone can send the header.
+	TrustedProxies []string `env:"TrustedProxies" envSeparator:","`
+
 	VideoClient     videoproto.VideoServiceClient
 	UserClient      userproto.UserServiceClient
 	SchedulerClient schedulerproto.SchedulerClient
@@ -11,6 +11,7 @@ require (
 	github.com/labstack/echo/v4 v4.3.0
 	github.com/labstack/gommon v0.3.0
 	google.golang.org/grpc v1.38.0
+	gopkg.in/square/go-jose.v2 v2.5.1 // indirect
 )
 
 replace github.com/horahoradev/horahora/scheduler => ../scheduler
@@ -18,3 +19,5 @@ replace github.com/horahoradev/horahora/scheduler => ../scheduler
 replace github.com/horahoradev/horahora/user_service => ../user_service
 
 replace github.com/horahoradev/horahora/video_service => ../video_service
+
+replace github.com/horahoradev/horahora/storage => ../storage
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"net"
+
 	"github.com/SEAPUNK/horahora/front_api/config"
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
 	routes "github.com/SEAPUNK/horahora/front_api/routes"
@@ -18,9 +20,27 @@ func main() {
 
 	e := echo.New()
 
+	// Login throttling and view counting go by client IP, so it mustn't come from headers that clients can forge
+	e.IPExtractor = echo.ExtractIPDirect()
+	if len(cfg.TrustedProxies) > 0 {
+		var trustOptions []echo.TrustOption
+		for _, cidr := range cfg.TrustedProxies {
+			_, ipRange, err := net.ParseCIDR(cidr)
+			if err != nil {
+				log.Fatalf("Could not parse trusted proxy range %s. Err: %s", cidr, err)
+			}
+
+			trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+		}
+
+		// Only the given ranges are trusted, not every private address
+		trustOptions = append(trustOptions, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(trustOptions...)
+	}
+
 	e.Use(middleware.Logger())
 
-	grpcAuth := custommiddleware.NewGRPCAuth(cfg)
+	grpcAuth := custommiddleware.NewGRPCAuth(cfg, routes.APITokenScopes)
 	e.Use(grpcAuth.GRPCAuth)
 
 	routes.SetupRoutes(e, cfg)
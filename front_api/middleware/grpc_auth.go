@@ -3,78 +3,290 @@ package middleware
 import (
 	"context"
 	"encoding/base64"
-	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/SEAPUNK/horahora/front_api/config"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
 
 type JWTGRPCAuthenticator struct {
-	config *config.Config
+	config      *config.Config
+	verifier    *verifier.Verifier
+	tokenScopes map[string]permissions.Scope
 }
 
-func NewGRPCAuth(config *config.Config) *JWTGRPCAuthenticator {
+// NewGRPCAuth authenticates requests with the session cookies, or an Authorization: Bearer header holding an access
+// token or API token. tokenScopes are the scopes which API tokens need for routes, keyed by method and path, e.g.
+// "POST /upload". Tokens need the read scope for other GET routes, and can't call any other routes.
+func NewGRPCAuth(config *config.Config, tokenScopes map[string]permissions.Scope) *JWTGRPCAuthenticator {
 	return &JWTGRPCAuthenticator{
-		config: config,
+		config:      config,
+		verifier:    verifier.New(verifier.GRPCKeySource(config.UserClient)),
+		tokenScopes: tokenScopes,
 	}
 }
 
 const UserIDKey = "userid"
-const UserRankKey = "userrank"
+const PermissionsKey = "permissions"
+const SessionIDKey = "sessionid"
+const AccessTokenKey = "accesstoken"
+
+const (
+	JWTCookie          = "jwt"
+	RefreshTokenCookie = "refresh_token"
+	// Matches the user service's refresh token lifetime, which is extended on every refresh
+	refreshCookieLifetime = 30 * 24 * time.Hour
+)
 
 func (j *JWTGRPCAuthenticator) GRPCAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		// TODO: maybe add validation on the number of cookies
-
-		if len(c.Cookies()) < 1 {
-			return next(c)
+		if token, ok := bearerToken(c); ok {
+			return j.bearerAuth(c, token, next)
 		}
 
-		jwt := c.Cookies()[0].Value
-
-		jwtDecoded, err := base64.StdEncoding.DecodeString(jwt)
+		uid, sessionID, err := j.authenticateCookie(c)
 		if err != nil {
-			log.Errorf("Failed to decode jwt. Err: %s", err)
-			return next(c)
-		}
-
-		uid, err := j.authenticate(string(jwtDecoded))
-		if err != nil {
-			log.Errorf("Error while authenticating: %s", err)
-			return next(c)
+			// The access token may just have expired, in which case the refresh token gets a new one
+			uid, sessionID, err = j.refresh(c)
+			if err != nil {
+				if err != http.ErrNoCookie {
+					log.Errorf("Error while authenticating: %s", err)
+				}
+				return next(c)
+			}
 		}
 
 		c.Set(UserIDKey, uid)
+		c.Set(SessionIDKey, sessionID)
 
 		// TODO: add other stuff in here like username, profile picture location, etc
 		// If user is authenticated, get other metadata
-		resp, err := j.config.UserClient.GetUserFromID(context.Background(), &userproto.GetUserFromIDRequest{UserID: uid})
+		resp, err := j.config.UserClient.GetPermissions(context.Background(), &userproto.GetPermissionsRequest{UserID: uid})
 		if err != nil {
-			log.Errorf("Could not retrieve authenticated users metadata. Err: %s", err)
+			log.Errorf("Could not retrieve authenticated users permissions. Err: %s", err)
 			return next(c)
 		}
 
-		c.Set(UserRankKey, int32(resp.Rank))
+		c.Set(PermissionsKey, resp.Permissions)
 		return next(c)
 	}
 }
 
-func (j *JWTGRPCAuthenticator) authenticate(jwt string) (int64, error) {
-	jwtValidationRequest := &userproto.ValidateJWTRequest{
-		Jwt: jwt,
+// bearerToken returns the token from the request's Authorization header, if it has one
+func bearerToken(c echo.Context) (string, bool) {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", false
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")), true
+}
+
+// bearerAuth authenticates the request with a bearer token. Unlike cookies, a bad token fails the request rather than
+// carrying on logged out, since scripts would rather know.
+func (j *JWTGRPCAuthenticator) bearerAuth(c echo.Context, token string, next echo.HandlerFunc) error {
+	var uid, sessionID int64
+	var scopes []string
+	var err error
+
+	isAPIToken := strings.HasPrefix(token, permissions.APITokenPrefix)
+	if isAPIToken {
+		var resp *userproto.APITokenAuthentication
+		resp, err = j.config.UserClient.AuthenticateAPIToken(context.Background(), &userproto.AuthenticateAPITokenRequest{Token: token})
+		if err == nil {
+			uid, scopes = resp.UserID, resp.Scopes
+			c.Set(AccessTokenKey, resp.Jwt)
+		}
+	} else {
+		uid, sessionID, err = j.authenticateAs(c, token)
+	}
+
+	if err != nil {
+		log.Errorf("Error while authenticating bearer token: %s", err)
+		return c.String(http.StatusUnauthorized, "bearer token is invalid or expired")
+	}
+
+	if isAPIToken {
+		scope, ok := j.requiredScope(c)
+		switch {
+		case !ok:
+			return c.String(http.StatusForbidden, "API tokens can't be used for this")
+		case !hasScope(scopes, scope):
+			return c.String(http.StatusForbidden, fmt.Sprintf("API token needs the %s scope", scope))
+		}
+	}
+
+	c.Set(UserIDKey, uid)
+	c.Set(SessionIDKey, sessionID)
+
+	resp, err := j.config.UserClient.GetPermissions(context.Background(), &userproto.GetPermissionsRequest{UserID: uid})
+	if err != nil {
+		log.Errorf("Could not retrieve authenticated users permissions. Err: %s", err)
+		return next(c)
+	}
+
+	// Tokens only get the permissions of their scopes
+	granted := resp.Permissions
+	if isAPIToken {
+		granted = permissions.ScopedPermissions(granted, scopes)
+	}
+
+	c.Set(PermissionsKey, granted)
+	return next(c)
+}
+
+// requiredScope returns the scope which API tokens need for the route, or false if they can't call it
+func (j *JWTGRPCAuthenticator) requiredScope(c echo.Context) (permissions.Scope, bool) {
+	method := c.Request().Method
+	if scope, ok := j.tokenScopes[method+" "+c.Path()]; ok {
+		return scope, true
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return permissions.ScopeRead, true
+	}
+
+	return "", false
+}
+
+func hasScope(scopes []string, scope permissions.Scope) bool {
+	for _, s := range scopes {
+		if s == string(scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasPermission returns true if the current user has the permission. It's only used to decide what to show, since the
+// other services check permissions themselves.
+func HasPermission(c echo.Context, p permissions.Permission) bool {
+	granted, _ := c.Get(PermissionsKey).([]string)
+	for _, g := range granted {
+		if g == string(p) {
+			return true
+		}
 	}
 
-	// TODO: maybe add timeout
-	validationResp, err := j.config.UserClient.ValidateJWT(context.Background(), jwtValidationRequest)
+	return false
+}
+
+func (j *JWTGRPCAuthenticator) authenticateCookie(c echo.Context) (int64, int64, error) {
+	cookie, err := c.Cookie(JWTCookie)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
-	if !validationResp.IsValid {
-		return 0, errors.New("invalid jwt")
+	jwtDecoded, err := base64.StdEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return validationResp.Uid, nil
+	return j.authenticateAs(c, string(jwtDecoded))
+}
+
+// refresh exchanges the refresh token cookie for new tokens, and replaces both cookies
+func (j *JWTGRPCAuthenticator) refresh(c echo.Context) (int64, int64, error) {
+	cookie, err := c.Cookie(RefreshTokenCookie)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resp, err := j.config.UserClient.RefreshToken(context.Background(), &userproto.RefreshTokenRequest{
+		RefreshToken: cookie.Value,
+	})
+	if err != nil {
+		// The refresh token is no good, so stop sending it
+		ClearSessionCookies(c)
+		return 0, 0, err
+	}
+
+	SetSessionCookies(c, resp.Jwt, resp.RefreshToken, resp.Expiry)
+	return j.authenticateAs(c, resp.Jwt)
+}
+
+// authenticate verifies the token locally with the user service's published keys. Revoked sessions aren't noticed
+// until their access token expires, which saves a round trip to the user service on every request.
+func (j *JWTGRPCAuthenticator) authenticate(jwt string) (int64, int64, error) {
+	claims, err := j.verifier.Verify(jwt)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return claims.UID, claims.SessionID, nil
+}
+
+// authenticateAs authenticates the token, and keeps it to send with the request's calls to the other services
+func (j *JWTGRPCAuthenticator) authenticateAs(c echo.Context, jwt string) (int64, int64, error) {
+	uid, sessionID, err := j.authenticate(jwt)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	c.Set(AccessTokenKey, jwt)
+	return uid, sessionID, nil
+}
+
+// GRPCContext returns a context which sends the current user's access token with calls, so that the other services can
+// verify who's calling. Calls which need a permission are refused without one.
+func GRPCContext(c echo.Context) context.Context {
+	return withAccessToken(context.Background(), c)
+}
+
+// GRPCRequestContext is like GRPCContext, but is cancelled when the request ends, for requests which keep calling for
+// as long as the client is connected
+func GRPCRequestContext(c echo.Context) context.Context {
+	return withAccessToken(c.Request().Context(), c)
+}
+
+func withAccessToken(ctx context.Context, c echo.Context) context.Context {
+	token, ok := c.Get(AccessTokenKey).(string)
+	if !ok {
+		return ctx
+	}
+
+	return permissions.WithAccessToken(ctx, token)
+}
+
+// SetSessionCookies stores the access token until it expires, and the refresh token for as long as it can be used
+func SetSessionCookies(c echo.Context, jwt, refreshToken string, expiry int64) {
+	cookie := new(http.Cookie)
+	cookie.Name = JWTCookie
+	cookie.Path = "/"
+	cookie.Value = base64.StdEncoding.EncodeToString([]byte(jwt))
+	cookie.Expires = time.Unix(expiry, 0)
+	// cookie.SameSite = http.SameSiteStrictMode
+	//cookie.Secure = true // set this later
+	c.SetCookie(cookie)
+
+	if refreshToken == "" {
+		return
+	}
+
+	refreshCookie := new(http.Cookie)
+	refreshCookie.Name = RefreshTokenCookie
+	refreshCookie.Path = "/"
+	refreshCookie.Value = refreshToken
+	refreshCookie.Expires = time.Now().Add(refreshCookieLifetime)
+	refreshCookie.HttpOnly = true
+	c.SetCookie(refreshCookie)
+}
+
+func ClearSessionCookies(c echo.Context) {
+	for _, name := range []string{JWTCookie, RefreshTokenCookie} {
+		cookie := new(http.Cookie)
+		cookie.Name = name
+		cookie.Path = "/"
+		cookie.Value = ""
+		cookie.MaxAge = -1
+		c.SetCookie(cookie)
+	}
 }
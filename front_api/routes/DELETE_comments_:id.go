@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleDeleteComment(c echo.Context) error {
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.v.DeleteComment(custommiddleware.GRPCContext(c), &videoproto.CommentDeletion{
+		UserId:    userID,
+		CommentId: commentID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleRemoveProfileImage removes the current user's avatar or banner
+func (r RouteHandler) handleRemoveProfileImage(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	image, ok := profileImageParam(c)
+	if !ok {
+		return c.String(http.StatusNotFound, "image must be avatar or banner")
+	}
+
+	// An empty upload removes the image
+	user, err := r.u.UploadProfileImage(custommiddleware.GRPCContext(c), &userproto.ProfileImageUpload{
+		UserID: userID,
+		Image:  image,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newEditableProfileData(user))
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleUnfollowTag(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.v.UnfollowTag(custommiddleware.GRPCContext(c), &videoproto.TagFollow{
+		UserID: userID,
+		Tag:    c.Param("tag"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
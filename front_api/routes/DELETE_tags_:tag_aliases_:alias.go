@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleRemoveTagAlias(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	info, err := r.v.RemoveTagAlias(custommiddleware.GRPCContext(c), &videoproto.TagAliasChange{
+		UserID: userID,
+		Tag:    c.Param("tag"),
+		Alias:  c.Param("alias"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleRemoveTagImplication(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	info, err := r.v.RemoveTagImplication(custommiddleware.GRPCContext(c), &videoproto.TagImplicationChange{
+		UserID:     userID,
+		Tag:        c.Param("tag"),
+		ImpliedTag: c.Param("impliedTag"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
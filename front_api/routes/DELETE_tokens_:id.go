@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleRevokeAPIToken revokes one of the current user's API tokens
+func (r RouteHandler) handleRevokeAPIToken(c echo.Context) error {
+	tokenID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.u.RevokeAPIToken(custommiddleware.GRPCContext(c), &userproto.RevokeAPITokenRequest{
+		UserID:  userID,
+		TokenID: tokenID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleUnfollow(c echo.Context) error {
+	followeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	counts, err := r.u.UnfollowUser(custommiddleware.GRPCContext(c), &userproto.FollowRequest{
+		FollowerID: userID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newFollowData(counts))
+}
@@ -0,0 +1,72 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newAdminUserData(user *userproto.UserResponse) AdminUserData {
+	return AdminUserData{
+		UserID:        user.UserID,
+		Username:      user.Username,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		Rank:          user.Rank.String(),
+		Banned:        user.Banned,
+		BannedUntil:   user.BannedUntil,
+		BanReason:     user.BanReason,
+	}
+}
+
+// getAdminUsers searches users by name or email. rank may be given more than once, and banned=true and local=true
+// narrow the results down further. The user service checks that the user has the user.manage permission.
+func (r RouteHandler) getAdminUsers(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	req := userproto.ListUsersRequest{
+		ActorID:    userID,
+		Query:      c.QueryParam("q"),
+		BannedOnly: c.QueryParam("banned") == "true",
+		LocalOnly:  c.QueryParam("local") == "true",
+		PageNumber: pageNumber,
+	}
+
+	for _, name := range c.QueryParams()["rank"] {
+		rank, ok := userproto.UserRank_value[name]
+		if !ok {
+			return c.String(http.StatusBadRequest, "rank must be regular, trusted or admin")
+		}
+		req.Ranks = append(req.Ranks, userproto.UserRank(rank))
+	}
+
+	resp, err := r.u.ListUsers(custommiddleware.GRPCContext(c), &req)
+	if err != nil {
+		return err
+	}
+
+	users := make([]AdminUserData, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		users = append(users, newAdminUserData(user))
+	}
+
+	data := AdminUserListData{
+		Users:         users,
+		NumberOfUsers: resp.NumberOfUsers,
+		CurrentPage:   pageNumber,
+	}
+	addUserProfileInfo(c, &data.L, r.u)
+
+	return c.JSON(http.StatusOK, &data)
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getClaim returns one of the current user's claims
+func (r RouteHandler) getClaim(c echo.Context) error {
+	claimID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	claim, err := r.u.GetClaim(custommiddleware.GRPCContext(c), &userproto.GetClaimRequest{
+		ClaimID:    claimID,
+		ClaimantID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newClaimData(claim))
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -30,29 +31,103 @@ func (r RouteHandler) getComments(c echo.Context) error {
 		log.Error("Could not assert userid to int64 for getComments")
 	}
 
-	resp, err := r.v.GetCommentsForVideo(context.Background(), &videoproto.CommentRequest{VideoID: videoIDInt, CurrUserID: UserIDInt})
+	sortBy := videoproto.CommentSort_top
+	if c.QueryParam("sort") == "new" {
+		sortBy = videoproto.CommentSort_newest
+	}
+
+	// parent and page are optional, root comments and page 1 by default. archived=1 means parent is an archived comment
+	archived := c.QueryParam("archived") == "1"
+	parentID, _ := strconv.ParseInt(c.QueryParam("parent"), 10, 64)
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.GetCommentsForVideo(custommiddleware.GRPCContext(c), &videoproto.CommentRequest{
+		VideoID:    videoIDInt,
+		CurrUserID: UserIDInt,
+		SortBy:     sortBy,
+		ParentID:   parentID,
+		PageNumber: pageNumber,
+		Archived:   archived,
+	})
 	if err != nil {
 		return err
 	}
 
-	commentList := make([]CommentData, 0)
+	var authorIDs []int64
+	for _, comment := range resp.Comments {
+		if comment.AuthorId != 0 {
+			authorIDs = append(authorIDs, comment.AuthorId)
+		}
+	}
+	avatars := r.getAvatarURLs(authorIDs)
 
+	commentList := make([]CommentData, 0)
 	for _, comment := range resp.Comments {
-		commentData := CommentData{
-			ID:                 comment.CommentId,
-			CreationDate:       comment.CreationDate,
-			Content:            comment.Content,
-			Username:           comment.AuthorUsername,
-			ProfileImage:       comment.AuthorProfileImageUrl,
-			VoteScore:          comment.VoteScore,
-			CurrUserHasUpvoted: comment.CurrentUserHasUpvoted,
+		commentData := newCommentData(comment)
+		if avatar, ok := avatars[comment.AuthorId]; ok {
+			commentData.ProfileImage = avatar
 		}
-		if comment.ParentId != 0 {
-			commentData.ParentID = comment.ParentId
+		commentList = append(commentList, commentData)
+	}
+
+	archivedList := make([]CommentData, 0)
+	for _, comment := range resp.ArchivedComments {
+		archivedList = append(archivedList, newCommentData(comment))
+	}
+
+	return c.JSON(http.StatusOK, &CommentListData{
+		Comments:                 commentList,
+		NumberOfComments:         resp.NumberOfComments,
+		ArchivedComments:         archivedList,
+		NumberOfArchivedComments: resp.NumberOfArchivedComments,
+		CurrentPage:              pageNumber,
+	})
+}
+
+// getAvatarURLs returns the avatars of those users who have uploaded one. Comments are still worth showing if this
+// fails, so errors are only logged.
+func (r RouteHandler) getAvatarURLs(userIDs []int64) map[int64]string {
+	avatars := make(map[int64]string)
+	if len(userIDs) == 0 {
+		return avatars
+	}
+
+	resp, err := r.u.GetUsersByIDs(context.Background(), &userproto.GetUsersByIDsRequest{UserIDs: userIDs})
+	if err != nil {
+		log.Errorf("Could not fetch comment authors. Err: %s", err)
+		return avatars
+	}
+
+	for _, user := range resp.Users {
+		if user.AvatarURL != "" {
+			avatars[user.UserID] = user.AvatarURL
 		}
+	}
 
-		commentList = append(commentList, commentData)
+	return avatars
+}
+
+func newCommentData(comment *videoproto.Comment) CommentData {
+	commentData := CommentData{
+		ID:                 comment.CommentId,
+		CreationDate:       comment.CreationDate,
+		Content:            comment.Content,
+		Username:           comment.AuthorUsername,
+		ProfileImage:       comment.AuthorProfileImageUrl,
+		VoteScore:          comment.VoteScore,
+		CurrUserHasUpvoted: comment.CurrentUserHasUpvoted,
+		CurrUserVote:       comment.CurrentUserVote,
+		Deleted:            comment.Deleted,
+		EditedDate:         comment.EditedDate,
+		ReplyCount:         comment.ReplyCount,
+		Archived:           comment.Archived,
+	}
+	if comment.ParentId != 0 {
+		commentData.ParentID = comment.ParentId
 	}
 
-	return c.JSON(http.StatusOK, &commentList)
+	return commentData
 }
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Playback positions are in milliseconds, and the range is [from, to)
+func (r RouteHandler) getDanmaku(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	fromMs, err := strconv.ParseInt(c.QueryParam("from"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	toMs, err := strconv.ParseInt(c.QueryParam("to"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.v.GetDanmaku(context.Background(), &videoproto.DanmakuRequest{
+		VideoID: videoID,
+		FromMs:  fromMs,
+		ToMs:    toMs,
+	})
+	if err != nil {
+		return err
+	}
+
+	danmakuList := make([]DanmakuData, 0, len(resp.Danmaku))
+	for _, d := range resp.Danmaku {
+		danmakuList = append(danmakuList, DanmakuData{
+			ID:         d.DanmakuID,
+			PositionMs: d.PositionMs,
+			Content:    d.Content,
+			Position:   d.Position.String(),
+			Color:      d.Color,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &danmakuList)
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getFeed returns recent videos from followed users and tags, newest first. Pages are fetched with the cursor query
+// parameter rather than a page number.
+func (r RouteHandler) getFeed(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	feed, err := r.v.GetFeed(custommiddleware.GRPCContext(c), &videoproto.FeedRequest{
+		UserID: userID,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+
+	data := FeedData{
+		Videos:     []Video{},
+		NextCursor: feed.NextCursor,
+	}
+
+	addUserProfileInfo(c, &data.L, r.u)
+	for _, video := range feed.Videos {
+		data.Videos = append(data.Videos, Video{
+			Title:        video.VideoTitle,
+			VideoID:      video.VideoID,
+			Views:        video.Views,
+			AuthorName:   video.AuthorName,
+			ThumbnailLoc: video.ThumbnailLoc,
+			Rating:       video.Rating,
+		})
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
@@ -1,7 +1,6 @@
 package routes
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +8,7 @@ import (
 	"strconv"
 
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -27,17 +27,9 @@ func (h *RouteHandler) getHome(c echo.Context) error {
 		return err
 	}
 
-	rank, ok := c.Get(custommiddleware.UserRankKey).(int32)
-	if !ok {
-		log.Error("Failed to assert user rank to an int (this should not happen)")
-	}
-
-	// doesn't matter if it fails, 0 is a fine default rank
-	showUnapproved := false
-	if rank > 0 {
-		// privileged user, can show unapproved videos
-		showUnapproved = true
-	}
+	// Privileged users can see unapproved videos
+	showUnapproved := custommiddleware.HasPermission(c, permissions.VideoApprove)
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 
 	orderByVal, err := url.QueryUnescape(c.QueryParam("category"))
 	if err != nil {
@@ -74,9 +66,10 @@ func (h *RouteHandler) getHome(c echo.Context) error {
 		ContainsTag:    tag,
 		PageNumber:     pageNumberInt,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := h.v.GetVideoList(context.TODO(), &req)
+	videoList, err := h.v.GetVideoList(custommiddleware.GRPCContext(c), &req)
 	if err != nil {
 		log.Errorf("Could not retrieve video list. Err: %s", err)
 		return errors.New("Could not retrieve video list")
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newNotificationData(n *videoproto.Notification) NotificationData {
+	return NotificationData{
+		NotificationID: n.Id,
+		Kind:           n.Kind,
+		Message:        n.Message,
+		VideoID:        n.VideoID,
+		CommentID:      n.CommentID,
+		CreationDate:   n.CreationDate,
+		IsRead:         n.IsRead,
+	}
+}
+
+// getNotifications returns a page of the current user's notifications, newest first. Only unread notifications are
+// returned if unread=1.
+func (r RouteHandler) getNotifications(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.ListNotifications(custommiddleware.GRPCContext(c), &videoproto.ListNotificationsRequest{
+		UserID:     userID,
+		PageNumber: pageNumber,
+		UnreadOnly: c.QueryParam("unread") == "1",
+	})
+	if err != nil {
+		return err
+	}
+
+	notifications := make([]NotificationData, 0, len(resp.Notifications))
+	for _, n := range resp.Notifications {
+		notifications = append(notifications, newNotificationData(n))
+	}
+
+	return c.JSON(http.StatusOK, &NotificationListData{
+		Notifications:         notifications,
+		NumberOfNotifications: resp.NumberOfItems,
+		CurrentPage:           pageNumber,
+	})
+}
@@ -0,0 +1,104 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+const notificationPollInterval = 5 * time.Second
+
+// streamNotifications sends new notifications to the client as server-sent events. The video service has no way to
+// push notifications to us, so they're polled on the client's behalf. Each event's ID is the notification ID, so
+// clients that reconnect with Last-Event-ID pick up where they left off.
+func (r RouteHandler) streamNotifications(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := custommiddleware.GRPCRequestContext(c)
+
+	lastID, err := strconv.ParseInt(c.Request().Header.Get("Last-Event-ID"), 10, 64)
+	if err != nil {
+		// Only notifications created from now on
+		resp, err := r.v.ListNotifications(ctx, &videoproto.ListNotificationsRequest{UserID: userID, PageNumber: 1})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Notifications) > 0 {
+			lastID = resp.Notifications[0].Id
+		}
+	}
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		lastID, err = r.sendNewNotifications(ctx, w, userID, lastID)
+		if err != nil {
+			// Headers have already been sent, so the error can't be returned to the client
+			c.Logger().Errorf("Could not stream notifications for user %d. Err: %s", userID, err)
+			return nil
+		}
+		w.Flush()
+	}
+}
+
+// sendNewNotifications writes an event for each notification after lastID, or a comment to keep the connection open
+// if there are none. It returns the ID of the last notification sent.
+func (r RouteHandler) sendNewNotifications(ctx context.Context, w *echo.Response, userID, lastID int64) (int64, error) {
+	resp, err := r.v.ListNotifications(ctx, &videoproto.ListNotificationsRequest{UserID: userID, AfterID: lastID})
+	if err != nil {
+		return lastID, err
+	}
+
+	if len(resp.Notifications) == 0 {
+		_, err = fmt.Fprint(w, ": keepalive\n\n")
+		return lastID, err
+	}
+
+	notifications := resp.Notifications
+	if lastID == 0 {
+		// An AfterID of 0 is the same as not setting it, which lists the first page newest first, so put it in the
+		// same order as later polls. Otherwise lastID would end up as the oldest, and the rest would be sent again.
+		for i, j := 0, len(notifications)-1; i < j; i, j = i+1, j-1 {
+			notifications[i], notifications[j] = notifications[j], notifications[i]
+		}
+	}
+
+	for _, n := range notifications {
+		data, err := json.Marshal(newNotificationData(n))
+		if err != nil {
+			return lastID, err
+		}
+
+		if _, err = fmt.Fprintf(w, "id: %d\nevent: notification\ndata: %s\n\n", n.Id, data); err != nil {
+			return lastID, err
+		}
+		lastID = n.Id
+	}
+
+	return lastID, nil
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) getUnreadNotificationCount(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.v.GetUnreadNotificationCount(custommiddleware.GRPCContext(c), &videoproto.UnreadNotificationsRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &UnreadNotificationCountData{Count: resp.Count})
+}
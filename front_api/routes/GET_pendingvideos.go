@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getPendingVideos returns the moderation queue. The video service checks that the user is trusted.
+func (r RouteHandler) getPendingVideos(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.GetPendingVideos(custommiddleware.GRPCContext(c), &videoproto.PendingVideosRequest{
+		UserID:     userID,
+		PageNumber: pageNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	videos := make([]PendingVideoData, 0, len(resp.Videos))
+	for _, video := range resp.Videos {
+		videos = append(videos, PendingVideoData{
+			VideoID:          video.VideoID,
+			Title:            video.Title,
+			AuthorID:         video.AuthorID,
+			AuthorName:       video.AuthorName,
+			UploadDate:       video.UploadDate,
+			Approvals:        video.Approvals,
+			Rejections:       video.Rejections,
+			RejectionReasons: video.RejectionReasons,
+			CurrUserVoted:    video.CurrentUserVoted,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &PendingVideosData{
+		Videos:         videos,
+		NumberOfVideos: resp.NumberOfVideos,
+		CurrentPage:    pageNumber,
+	})
+}
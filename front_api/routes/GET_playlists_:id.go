@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+func (r RouteHandler) getPlaylist(c echo.Context) error {
+	playlistID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// Logged out users can view public and unlisted playlists
+	userID, _ := c.Get(custommiddleware.UserIDKey).(int64)
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.GetPlaylist(custommiddleware.GRPCContext(c), &videoproto.GetPlaylistRequest{
+		PlaylistID: playlistID,
+		UserID:     userID,
+		PageNumber: pageNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	pageRange, err := getPageRange(int(resp.Playlist.NumberOfVideos), int(pageNumber))
+	if err != nil {
+		log.Errorf("failed to calculate page range. Err: %s", err)
+		pageRange = []int{1}
+	}
+
+	data := PlaylistPageData{
+		Playlist: newPlaylistData(resp.Playlist),
+		PaginationData: PaginationData{
+			Pages:                pageRange,
+			PathsAndQueryStrings: generateQueryParams(pageRange, c),
+			CurrentPage:          int(pageNumber),
+		},
+		Videos: []Video{},
+	}
+
+	addUserProfileInfo(c, &data.L, r.u)
+	for _, video := range resp.Videos {
+		data.Videos = append(data.Videos, Video{
+			Title:        video.VideoTitle,
+			VideoID:      video.VideoID,
+			Views:        video.Views,
+			AuthorName:   video.AuthorName,
+			ThumbnailLoc: video.ThumbnailLoc,
+			Rating:       video.Rating,
+		})
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
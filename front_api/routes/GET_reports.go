@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getReports returns the moderation queue. The video service checks that the user is trusted.
+func (r RouteHandler) getReports(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.ListReports(custommiddleware.GRPCContext(c), &videoproto.ListReportsRequest{
+		UserID:     userID,
+		PageNumber: pageNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	items := make([]ReportData, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		reasons := make([]string, 0, len(item.Reasons))
+		for _, reason := range item.Reasons {
+			reasons = append(reasons, reason.String())
+		}
+
+		items = append(items, ReportData{
+			ContentType:   contentTypeNames[item.ContentType],
+			ContentID:     item.ContentID,
+			VideoID:       item.VideoID,
+			Preview:       item.Preview,
+			AuthorID:      item.AuthorID,
+			AuthorName:    item.AuthorName,
+			ReportCount:   item.ReportCount,
+			FirstReported: item.FirstReported,
+			Reasons:       reasons,
+			Details:       item.Details,
+		})
+	}
+
+	data := ReportListData{
+		Reports:         items,
+		NumberOfReports: resp.NumberOfItems,
+		CurrentPage:     pageNumber,
+	}
+	addUserProfileInfo(c, &data.L, r.u)
+
+	return c.JSON(http.StatusOK, &data)
+}
@@ -1,13 +1,13 @@
 package routes
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -30,16 +30,9 @@ func (v RouteHandler) getTag(c echo.Context) error {
 		pageNumberInt = num
 	}
 
-	rank, ok := c.Get(custommiddleware.UserRankKey).(int32)
-	if !ok {
-		log.Error("Failed to assert user rank to an int (this should not happen)")
-	}
-	// doesn't matter if it fails, 0 is a fine default rank
-	showUnapproved := false
-	if rank > 0 {
-		// privileged user, can show unapproved videos
-		showUnapproved = true
-	}
+	// Privileged users can see unapproved videos
+	showUnapproved := custommiddleware.HasPermission(c, permissions.VideoApprove)
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 
 	videoQueryConfig := videoproto.VideoQueryConfig{
 		OrderBy:        videoproto.OrderCategory_upload_date,
@@ -47,9 +40,10 @@ func (v RouteHandler) getTag(c echo.Context) error {
 		PageNumber:     pageNumberInt,
 		ContainsTag:    tag,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := v.v.GetVideoList(context.TODO(), &videoQueryConfig)
+	videoList, err := v.v.GetVideoList(custommiddleware.GRPCContext(c), &videoQueryConfig)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getTagInfo returns a tag's category, description, aliases and implications. Aliases resolve to their tag.
+func (r RouteHandler) getTagInfo(c echo.Context) error {
+	info, err := r.v.GetTag(context.Background(), &videoproto.TagLookup{Tag: c.Param("tag")})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
+
+func newTagData(info *videoproto.TagInfo) TagData {
+	return TagData{
+		Name:         info.Name,
+		Category:     info.Category.String(),
+		Description:  info.Description,
+		Aliases:      info.Aliases,
+		Implies:      info.Implies,
+		ImpliedBy:    info.ImpliedBy,
+		VideoCount:   info.VideoCount,
+		MatchedAlias: info.MatchedAlias,
+	}
+}
+
+func newTagListData(tags []*videoproto.TagInfo) TagListData {
+	data := TagListData{Tags: make([]TagData, 0, len(tags))}
+	for _, tag := range tags {
+		data.Tags = append(data.Tags, newTagData(tag))
+	}
+
+	return data
+}
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getTagHistory returns a tag's edit history, newest first. The video service checks that the user is trusted.
+func (r RouteHandler) getTagHistory(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	pageNumber, err := strconv.ParseInt(c.QueryParam("page"), 10, 64)
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	resp, err := r.v.GetTagHistory(custommiddleware.GRPCContext(c), &videoproto.TagHistoryRequest{
+		UserID:     userID,
+		Tag:        c.Param("tag"),
+		PageNumber: pageNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	data := TagHistoryData{
+		Edits:         make([]TagEditData, 0, len(resp.Edits)),
+		NumberOfEdits: resp.NumberOfEdits,
+		CurrentPage:   pageNumber,
+	}
+	for _, edit := range resp.Edits {
+		data.Edits = append(data.Edits, TagEditData{
+			EditID:       edit.Id,
+			Tag:          edit.Tag,
+			UserID:       edit.UserID,
+			Action:       edit.Action,
+			Details:      edit.Details,
+			CreationDate: edit.CreationDate,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &data)
+}
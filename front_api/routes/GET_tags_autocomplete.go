@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getTagSuggestions returns the most used tags starting with the q query parameter, matching aliases too
+func (r RouteHandler) getTagSuggestions(c echo.Context) error {
+	limit, _ := strconv.ParseInt(c.QueryParam("limit"), 10, 64)
+
+	resp, err := r.v.AutocompleteTags(context.Background(), &videoproto.TagAutocompleteRequest{
+		Prefix: c.QueryParam("q"),
+		Limit:  limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagListData(resp.Tags))
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getTagCounts returns the number of videos with each of the comma separated tags, or the most used tags if none are
+// given
+func (r RouteHandler) getTagCounts(c echo.Context) error {
+	var tags []string
+	if c.QueryParam("tags") != "" {
+		tags = strings.Split(c.QueryParam("tags"), ",")
+	}
+
+	limit, _ := strconv.ParseInt(c.QueryParam("limit"), 10, 64)
+
+	resp, err := r.v.GetTagCounts(context.Background(), &videoproto.TagCountsRequest{
+		Tags:  tags,
+		Limit: limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagListData(resp.Tags))
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newAPITokenData(token *userproto.APIToken) APITokenData {
+	return APITokenData{
+		ID:        token.TokenID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		Created:   token.CreationDate,
+		ExpiresAt: token.ExpiresAt,
+		LastUsed:  token.LastUsed,
+	}
+}
+
+// getAPITokens lists the current user's API tokens which haven't expired
+func (r RouteHandler) getAPITokens(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.u.ListAPITokens(custommiddleware.GRPCContext(c), &userproto.ListAPITokensRequest{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	tokens := []APITokenData{}
+	for _, token := range resp.Tokens {
+		tokens = append(tokens, newAPITokenData(token))
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
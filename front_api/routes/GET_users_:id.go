@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
@@ -33,16 +34,9 @@ func (v RouteHandler) getUser(c echo.Context) error {
 		pageNumberInt = num
 	}
 
-	rank, ok := c.Get(custommiddleware.UserRankKey).(int32)
-	if !ok {
-		log.Error("Failed to assert user rank to an int (this should not happen)")
-	}
-	// doesn't matter if it fails, 0 is a fine default rank
-	showUnapproved := false
-	if rank > 0 {
-		// privileged user, can show unapproved videos
-		showUnapproved = true
-	}
+	// Privileged users can see unapproved videos
+	showUnapproved := custommiddleware.HasPermission(c, permissions.VideoApprove)
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 
 	videoQueryConfig := videoproto.VideoQueryConfig{
 		OrderBy:        videoproto.OrderCategory_upload_date,
@@ -51,9 +45,10 @@ func (v RouteHandler) getUser(c echo.Context) error {
 		ContainsTag:    "",
 		FromUserID:     idInt,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := v.v.GetVideoList(context.TODO(), &videoQueryConfig)
+	videoList, err := v.v.GetVideoList(custommiddleware.GRPCContext(c), &videoQueryConfig)
 	if err != nil {
 		return err
 	}
@@ -65,6 +60,14 @@ func (v RouteHandler) getUser(c echo.Context) error {
 		return err
 	}
 
+	followCounts, err := v.u.GetFollowCounts(context.TODO(), &userproto.FollowCountsRequest{
+		UserID:   idInt,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return err
+	}
+
 	pageRange, err := getPageRange(int(videoList.NumberOfVideos), int(pageNumberInt))
 	if err != nil {
 		err1 := fmt.Errorf("failed to calculate page range. Err: %s", err)
@@ -76,7 +79,16 @@ func (v RouteHandler) getUser(c echo.Context) error {
 	data := ProfileData{
 		UserID:            idInt,
 		Username:          user.Username,
-		ProfilePictureURL: "/static/images/placeholder1.jpg",
+		DisplayName:       user.DisplayName,
+		Bio:               user.Bio,
+		Links:             user.Links,
+		ProfilePictureURL: profilePictureURL(user),
+		BannerURL:         user.BannerURL,
+		Foreign:           user.Foreign,
+		MergedInto:        user.MergedInto,
+		UserSubscribers:   followCounts.Followers,
+		Following:         followCounts.Following,
+		Subscribed:        followCounts.FollowedByViewer,
 		PaginationData: PaginationData{
 			Pages:                pageRange,
 			PathsAndQueryStrings: queryStrings,
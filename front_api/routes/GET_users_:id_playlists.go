@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// getUserPlaylists lists the user's playlists. Only public playlists are listed for other users.
+func (r RouteHandler) getUserPlaylists(c echo.Context) error {
+	ownerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// Logged out users only see public playlists
+	userID, _ := c.Get(custommiddleware.UserIDKey).(int64)
+
+	resp, err := r.v.GetUserPlaylists(custommiddleware.GRPCContext(c), &videoproto.UserPlaylistsRequest{
+		OwnerID: ownerID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	playlists := make([]PlaylistData, 0, len(resp.Playlists))
+	for _, playlist := range resp.Playlists {
+		playlists = append(playlists, newPlaylistData(playlist))
+	}
+
+	return c.JSON(http.StatusOK, playlists)
+}
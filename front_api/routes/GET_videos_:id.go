@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strconv"
 
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 )
@@ -19,9 +21,15 @@ func (v *RouteHandler) getVideo(c echo.Context) error {
 		return err
 	}
 
-	// Increment views first
-	viewReq := videoproto.VideoViewing{VideoID: idInt}
-	_, err = v.v.ViewVideo(context.Background(), &viewReq)
+	// Increment views first. Anonymous users are deduplicated by IP, which the echo IPExtractor only takes from
+	// X-Forwarded-For when it's set by a trusted proxy; userID is 0 if not logged in
+	userID, _ := c.Get(custommiddleware.UserIDKey).(int64)
+	viewReq := videoproto.VideoViewing{
+		VideoID:  idInt,
+		UserID:   userID,
+		ClientIP: c.RealIP(),
+	}
+	_, err = v.v.ViewVideo(custommiddleware.GRPCContext(c), &viewReq)
 	if err != nil {
 		return err
 	}
@@ -35,6 +43,19 @@ func (v *RouteHandler) getVideo(c echo.Context) error {
 		return err
 	}
 
+	followCounts, err := v.u.GetFollowCounts(context.Background(), &userproto.FollowCountsRequest{
+		UserID:   videoInfo.AuthorID,
+		ViewerID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	author, err := v.u.GetUserFromID(context.Background(), &userproto.GetUserFromIDRequest{UserID: videoInfo.AuthorID})
+	if err != nil {
+		return err
+	}
+
 	rating := videoInfo.Rating
 
 	// lol
@@ -48,18 +69,46 @@ func (v *RouteHandler) getVideo(c echo.Context) error {
 		MPDLoc:           videoInfo.VideoLoc, // FIXME: fix this in videoservice LOL this is embarrassing
 		Views:            videoInfo.Views,
 		Rating:           rating,
+		RatingCount:      videoInfo.RatingCount,
+		RatingHistogram:  videoInfo.RatingHistogram,
 		AuthorID:         videoInfo.AuthorID, // TODO
 		Username:         videoInfo.AuthorName,
-		UserDescription:  "", // TODO: not implemented yet
+		UserDescription:  author.Bio,
 		VideoDescription: videoInfo.Description,
-		UserSubscribers:  0, // TODO: not implemented yet
-		ProfilePicture:   "/static/images/placeholder1.jpg",
+		UserSubscribers:  uint64(followCounts.Followers),
+		Subscribed:       followCounts.FollowedByViewer,
+		ProfilePicture:   profilePictureURL(author),
 		UploadDate:       videoInfo.UploadDate,
 		VideoID:          videoInfo.VideoID,
 		Comments:         nil,
 		Tags:             videoInfo.Tags,
 	}
 
+	for _, mirror := range videoInfo.AlsoAvailableFrom {
+		data.AlsoAvailableFrom = append(data.AlsoAvailableFrom, VideoMirror{
+			VideoID:      mirror.VideoID,
+			Website:      mirror.OriginalSite.String(),
+			OriginalLink: mirror.OriginalLink,
+		})
+	}
+
+	related, err := v.v.GetRelatedVideos(context.Background(), &videoproto.RelatedVideosRequest{VideoID: videoInfo.VideoID})
+	if err != nil {
+		return err
+	}
+
+	data.RelatedVideos = []Video{}
+	for _, video := range related.Videos {
+		data.RelatedVideos = append(data.RelatedVideos, Video{
+			Title:        video.VideoTitle,
+			VideoID:      video.VideoID,
+			Views:        video.Views,
+			AuthorName:   video.AuthorName,
+			ThumbnailLoc: video.ThumbnailLoc,
+			Rating:       video.Rating,
+		})
+	}
+
 	addUserProfileInfo(c, &data.L, v.u)
 
 	return c.JSON(http.StatusOK, data)
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleBanUser bans the user for duration seconds, or permanently if it's 0 or missing. The user service checks that
+// the user has the user.ban permission and outranks the target.
+func (r RouteHandler) handleBanUser(c echo.Context) error {
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var duration int64
+	if d := c.FormValue("duration"); d != "" {
+		duration, err = strconv.ParseInt(d, 10, 64)
+		if err != nil || duration < 0 {
+			return c.String(http.StatusBadRequest, "duration must be a number of seconds")
+		}
+	}
+
+	user, err := r.u.BanUser(custommiddleware.GRPCContext(c), &userproto.BanUserRequest{
+		ModeratorID:     userID,
+		UserID:          targetID,
+		DurationSeconds: duration,
+		Reason:          c.FormValue("reason"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newAdminUserData(user))
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleSetUserRank sets the user's rank to one of regular, trusted or admin. The user service checks that the user
+// has the user.manage permission and outranks the target.
+func (r RouteHandler) handleSetUserRank(c echo.Context) error {
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	rank, ok := userproto.UserRank_value[c.FormValue("rank")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "rank must be regular, trusted or admin")
+	}
+
+	user, err := r.u.SetUserRank(custommiddleware.GRPCContext(c), &userproto.SetUserRankRequest{
+		ActorID: userID,
+		UserID:  targetID,
+		Rank:    userproto.UserRank(rank),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newAdminUserData(user))
+}
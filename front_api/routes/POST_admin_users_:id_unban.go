@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleUnbanUser lifts the user's ban or suspension. The user service checks that the user has the user.ban
+// permission and outranks the target.
+func (r RouteHandler) handleUnbanUser(c echo.Context) error {
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := r.u.UnbanUser(custommiddleware.GRPCContext(c), &userproto.UnbanUserRequest{
+		ModeratorID: userID,
+		UserID:      targetID,
+		Reason:      c.FormValue("reason"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newAdminUserData(user))
+}
@@ -1,12 +1,12 @@
 package routes
 
 import (
-	"context"
 	"errors"
 	"net/http"
 	"strconv"
 
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -19,15 +19,9 @@ func (v RouteHandler) handleApproval(c echo.Context) error {
 		return err
 	}
 
-	rank, ok := c.Get(custommiddleware.UserRankKey).(int32)
-	if !ok {
-		log.Error("Failed to assert user rank to an int (this should not happen)")
-	}
-
-	if rank < 1 {
-		// privileged user, can show unapproved videos
-		// TODO(ivan): status forbidden
-		return errors.New("Insufficient user status")
+	// The video service checks the permission too, this just saves the round trip
+	if !custommiddleware.HasPermission(c, permissions.VideoApprove) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient user status")
 	}
 
 	// THERE IS TOO MUCH COPY PASTA HERE!
@@ -38,7 +32,7 @@ func (v RouteHandler) handleApproval(c echo.Context) error {
 		return errors.New("could not assert userid to int64")
 	}
 
-	_, err = v.v.ApproveVideo(context.Background(), &videoproto.VideoApproval{VideoID: idInt, UserID: UserIDInt})
+	_, err = v.v.ApproveVideo(custommiddleware.GRPCContext(c), &videoproto.VideoApproval{VideoID: idInt, UserID: UserIDInt})
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,6 @@
 package routes
 
 import (
-	"context"
 	"errors"
 	"net/http"
 
@@ -39,26 +38,30 @@ func (r RouteHandler) handleArchiveRequest(c echo.Context) error {
 			TagValue: contentValue,
 		}
 
-		_, err := r.s.DlTag(context.TODO(), &req)
+		_, err := r.s.DlTag(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
 	case "channel":
 		req := schedulerproto.ChannelRequest{
 			Website:   supportedWebsite,
+			UserID:    UserIDInt,
 			ChannelID: contentValue,
 		}
 
-		_, err := r.s.DlChannel(context.TODO(), &req)
+		_, err := r.s.DlChannel(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
 	case "playlist":
+		// The mirrored playlist is owned by the requesting user
 		req := schedulerproto.PlaylistRequest{
 			Website:    supportedWebsite,
+			UserID:     UserIDInt,
 			PlaylistID: contentValue,
+			Mirror:     c.FormValue("mirror") == "true",
 		}
-		_, err := r.s.DlPlaylist(context.TODO(), &req)
+		_, err := r.s.DlPlaylist(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newClaimData(claim *userproto.Claim) ClaimData {
+	data := ClaimData{
+		ClaimID:          claim.ClaimID,
+		ForeignAccountID: claim.ForeignAccountID,
+		Website:          claim.Website.String(),
+		ForeignUserID:    claim.ForeignUserID,
+		Code:             claim.Code,
+		ExpiresAt:        claim.ExpiresAt,
+		Completed:        claim.Completed,
+	}
+
+	if claim.Completed {
+		data.Mode = claim.Mode.String()
+	}
+
+	return data
+}
+
+// handleStartClaim starts a claim of the foreign user_id by the current user, or returns their open claim of it
+func (r RouteHandler) handleStartClaim(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	foreignAccountID, err := strconv.ParseInt(c.FormValue("user_id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "user_id must be a user ID")
+	}
+
+	claim, err := r.u.StartClaim(custommiddleware.GRPCContext(c), &userproto.StartClaimRequest{
+		ClaimantID:       userID,
+		ForeignAccountID: foreignAccountID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newClaimData(claim))
+}
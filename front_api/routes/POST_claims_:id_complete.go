@@ -0,0 +1,80 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleCompleteClaim checks the channel description of the claimed account for the claim's code, then converts the
+// account (mode convert, with a password and optional email) or merges it into the current user's account along with
+// its videos (mode merge). If moving the videos fails, completing the claim again retries it.
+func (r RouteHandler) handleCompleteClaim(c echo.Context) error {
+	claimID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	mode, ok := userproto.ClaimMode_value[c.FormValue("mode")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "mode must be convert or merge")
+	}
+
+	claim, err := r.u.GetClaim(custommiddleware.GRPCContext(c), &userproto.GetClaimRequest{
+		ClaimID:    claimID,
+		ClaimantID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Retries of completed claims don't need the code anymore, which may have been removed from the description
+	var description string
+	if !claim.Completed {
+		// The sites are numbered the same by both services
+		channel, err := r.s.GetChannelDescription(context.Background(), &schedulerproto.ChannelDescriptionRequest{
+			Website:   schedulerproto.SupportedSite(claim.Website),
+			ChannelID: claim.ForeignUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		description = channel.Description
+	}
+
+	claim, err = r.u.CompleteClaim(custommiddleware.GRPCContext(c), &userproto.CompleteClaimRequest{
+		ClaimID:            claimID,
+		ClaimantID:         userID,
+		Mode:               userproto.ClaimMode(mode),
+		ChannelDescription: description,
+		Email:              c.FormValue("email"),
+		Password:           c.FormValue("password"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if claim.Mode == userproto.ClaimMode_merge {
+		_, err = r.v.TransferVideos(custommiddleware.GRPCContext(c), &videoproto.VideoTransfer{
+			ClaimID: claimID,
+			UserID:  userID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.JSON(http.StatusOK, newClaimData(claim))
+}
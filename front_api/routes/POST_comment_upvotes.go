@@ -1,9 +1,9 @@
 package routes
 
 import (
-	"context"
 	"net/http"
 
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 )
@@ -22,20 +22,21 @@ func (r RouteHandler) handleUpvote(c echo.Context) error {
 		return err
 	}
 
-	userID, err := getAsInt64(data, "user_id")
+	userID, err := getCurrentUserID(c)
 	if err != nil {
 		return err
 	}
 
-	hasUpvoted, err := getAsBool(data, "user_has_upvoted")
+	// 1 for upvote, -1 for downvote, 0 to retract
+	vote, err := getAsInt64(data, "vote")
 	if err != nil {
 		return err
 	}
 
-	_, err = r.v.MakeCommentUpvote(context.Background(), &videoproto.CommentUpvote{
+	_, err = r.v.MakeCommentUpvote(custommiddleware.GRPCContext(c), &videoproto.CommentUpvote{
 		CommentId: commentID,
 		UserId:    userID,
-		IsUpvote:  hasUpvoted,
+		Vote:      int32(vote),
 	})
 	if err != nil {
 		return err
@@ -1,11 +1,11 @@
 package routes
 
 import (
-	"context"
 	"net/http"
 	"net/url"
 	"strconv"
 
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 )
@@ -47,7 +47,7 @@ func (r RouteHandler) handleComment(c echo.Context) error {
 	//	// nothing
 	//}
 
-	_, err = r.v.MakeComment(context.Background(), &videoproto.VideoComment{
+	_, err = r.v.MakeComment(custommiddleware.GRPCContext(c), &videoproto.VideoComment{
 		UserId:        userIDInt,
 		VideoId:       videoIDInt,
 		Comment:       content,
@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleDanmaku(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	positionMs, err := strconv.ParseInt(c.FormValue("position_ms"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	content := c.FormValue("content")
+
+	// Optional, white scrolling danmaku by default
+	color := int64(0xFFFFFF)
+	if c.FormValue("color") != "" {
+		color, err = strconv.ParseInt(c.FormValue("color"), 10, 32)
+		if err != nil {
+			return err
+		}
+	}
+
+	position := videoproto.DanmakuPosition_scrolling
+	if c.FormValue("position") != "" {
+		p, ok := videoproto.DanmakuPosition_value[c.FormValue("position")]
+		if !ok {
+			return c.String(http.StatusBadRequest, "unknown danmaku position")
+		}
+		position = videoproto.DanmakuPosition(p)
+	}
+
+	_, err = r.v.PostDanmaku(custommiddleware.GRPCContext(c), &videoproto.DanmakuPost{
+		UserID:     userID,
+		VideoID:    videoID,
+		PositionMs: positionMs,
+		Content:    content,
+		Position:   position,
+		Color:      int32(color),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
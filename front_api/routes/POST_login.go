@@ -15,6 +15,7 @@ func (r RouteHandler) handleLogin(c echo.Context) error {
 	loginReq := &userproto.LoginRequest{
 		Username: username,
 		Password: password,
+		Ip:       c.RealIP(),
 	}
 
 	loginResp, err := r.u.Login(context.Background(), loginReq)
@@ -22,5 +23,5 @@ func (r RouteHandler) handleLogin(c echo.Context) error {
 		return err
 	}
 
-	return setCookie(c, loginResp.Jwt)
+	return setCookie(c, loginResp.Jwt, loginResp.RefreshToken, loginResp.Expiry)
 }
@@ -3,15 +3,32 @@ package routes
 import (
 	"net/http"
 
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/labstack/echo/v4"
 )
 
+// handleLogout revokes the current session, or every session of the user if the everywhere form value is set
 func (r RouteHandler) handleLogout(c echo.Context) error {
-	cookie := new(http.Cookie)
-	cookie.Name = "jwt"
-	cookie.Value = ""
+	everywhere := c.FormValue("everywhere") != ""
+	userID, loggedIn := c.Get(custommiddleware.UserIDKey).(int64)
+	sessionID, _ := c.Get(custommiddleware.SessionIDKey).(int64)
+	if everywhere {
+		sessionID = 0
+	}
 
-	c.SetCookie(cookie)
+	// Tokens which don't belong to a session can only be revoked everywhere
+	if loggedIn && (sessionID != 0 || everywhere) {
+		_, err := r.u.RevokeSessions(custommiddleware.GRPCContext(c), &userproto.RevokeSessionsRequest{
+			UserID:    userID,
+			SessionID: sessionID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	custommiddleware.ClearSessionCookies(c)
 
 	return c.JSON(http.StatusOK, nil)
 }
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleMarkNotificationsRead marks the comma separated notification IDs in ids as read, or every notification if ids
+// is empty.
+func (r RouteHandler) handleMarkNotificationsRead(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var ids []int64
+	if idList := c.FormValue("ids"); idList != "" {
+		for _, idStr := range strings.Split(idList, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(idStr), 10, 64)
+			if err != nil {
+				return c.String(http.StatusBadRequest, "ids must be a comma separated list of notification IDs")
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	_, err = r.v.MarkNotificationsRead(custommiddleware.GRPCContext(c), &videoproto.NotificationsRead{
+		UserID:          userID,
+		NotificationIDs: ids,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
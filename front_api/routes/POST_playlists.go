@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+var playlistVisibilities = map[string]videoproto.PlaylistVisibility{
+	"public":   videoproto.PlaylistVisibility_public_playlist,
+	"unlisted": videoproto.PlaylistVisibility_unlisted_playlist,
+	"private":  videoproto.PlaylistVisibility_private_playlist,
+}
+
+var playlistVisibilityNames = map[videoproto.PlaylistVisibility]string{
+	videoproto.PlaylistVisibility_public_playlist:   "public",
+	videoproto.PlaylistVisibility_unlisted_playlist: "unlisted",
+	videoproto.PlaylistVisibility_private_playlist:  "private",
+}
+
+func newPlaylistData(p *videoproto.Playlist) PlaylistData {
+	data := PlaylistData{
+		PlaylistID:     p.PlaylistID,
+		UserID:         p.UserID,
+		Username:       p.UserName,
+		Title:          p.Title,
+		Description:    p.Description,
+		Visibility:     playlistVisibilityNames[p.Visibility],
+		NumberOfVideos: p.NumberOfVideos,
+		CreationDate:   p.CreationDate,
+		LastUpdated:    p.LastUpdated,
+		Mirrored:       p.Mirrored,
+	}
+
+	if p.Mirrored {
+		data.MirrorWebsite = p.MirrorSite.String()
+		data.MirrorID = p.MirrorID
+	}
+
+	return data
+}
+
+func (r RouteHandler) handleCreatePlaylist(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	visibility, ok := playlistVisibilities[c.FormValue("visibility")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "visibility must be public, unlisted or private")
+	}
+
+	playlist, err := r.v.CreatePlaylist(custommiddleware.GRPCContext(c), &videoproto.PlaylistCreation{
+		UserID:      userID,
+		Title:       c.FormValue("title"),
+		Description: c.FormValue("description"),
+		Visibility:  visibility,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newPlaylistData(playlist))
+}
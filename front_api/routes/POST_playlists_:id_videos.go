@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleAddToPlaylist appends the video to the end of the playlist
+func (r RouteHandler) handleAddToPlaylist(c echo.Context) error {
+	playlistID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	videoID, err := strconv.ParseInt(c.FormValue("video_id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.v.AddVideoToPlaylist(custommiddleware.GRPCContext(c), &videoproto.PlaylistVideoChange{
+		UserID:     userID,
+		PlaylistID: playlistID,
+		VideoID:    videoID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,62 @@
+package routes
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// The user service limits images to 5 MiB, this just rejects bigger ones before reading them
+const maxProfileImageBytes = 5 << 20
+
+func profileImageParam(c echo.Context) (userproto.ProfileImage, bool) {
+	image, ok := userproto.ProfileImage_value[c.Param("image")]
+	return userproto.ProfileImage(image), ok
+}
+
+// handleUploadProfileImage replaces the current user's avatar or banner. The user service crops and scales it.
+func (r RouteHandler) handleUploadProfileImage(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	image, ok := profileImageParam(c)
+	if !ok {
+		return c.String(http.StatusNotFound, "image must be avatar or banner")
+	}
+
+	fileHeader, err := c.FormFile(profileImageKey)
+	if err != nil {
+		return err
+	}
+
+	if fileHeader.Size > maxProfileImageBytes {
+		return c.String(http.StatusRequestEntityTooLarge, "image must be at most 5 MiB")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	user, err := r.u.UploadProfileImage(custommiddleware.GRPCContext(c), &userproto.ProfileImageUpload{
+		UserID: userID,
+		Image:  image,
+		Data:   data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newEditableProfileData(user))
+}
@@ -1,7 +1,6 @@
 package routes
 
 import (
-	"context"
 	"errors"
 	"net/http"
 	"strconv"
@@ -43,7 +42,7 @@ func (v RouteHandler) handleRating(c echo.Context) error {
 		Rating:  float32(rating),
 	}
 
-	_, err = v.v.RateVideo(context.TODO(), &rateReq)
+	_, err = v.v.RateVideo(custommiddleware.GRPCContext(c), &rateReq)
 	if err != nil {
 		return err
 	}
@@ -25,5 +25,5 @@ func (r RouteHandler) handleRegister(c echo.Context) error {
 
 	// TODO: use registration JWT to auth
 
-	return setCookie(c, regisResp.Jwt)
+	return setCookie(c, regisResp.Jwt, regisResp.RefreshToken, regisResp.Expiry)
 }
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+var contentTypes = map[string]videoproto.ContentType{
+	"video":   videoproto.ContentType_video_content,
+	"comment": videoproto.ContentType_comment_content,
+}
+
+var contentTypeNames = map[videoproto.ContentType]string{
+	videoproto.ContentType_video_content:   "video",
+	videoproto.ContentType_comment_content: "comment",
+}
+
+func (r RouteHandler) handleReport(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	contentType, ok := contentTypes[c.FormValue("content_type")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "content_type must be video or comment")
+	}
+
+	contentID, err := strconv.ParseInt(c.FormValue("content_id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	reason, ok := videoproto.ReportReason_value[c.FormValue("reason")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "unknown report reason")
+	}
+
+	_, err = r.v.ReportContent(custommiddleware.GRPCContext(c), &videoproto.ContentReport{
+		UserID:      userID,
+		ContentType: contentType,
+		ContentID:   contentID,
+		Reason:      videoproto.ReportReason(reason),
+		Details:     c.FormValue("details"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleResolveReports takes one of the actions dismiss, hide or ban_user. The video service checks that the user is
+// trusted.
+func (r RouteHandler) handleResolveReports(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	contentType, ok := contentTypes[c.FormValue("content_type")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "content_type must be video or comment")
+	}
+
+	contentID, err := strconv.ParseInt(c.FormValue("content_id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	action, ok := videoproto.ModerationAction_value[c.FormValue("action")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "unknown moderation action")
+	}
+
+	_, err = r.v.ResolveReports(custommiddleware.GRPCContext(c), &videoproto.ReportResolution{
+		UserID:      userID,
+		ContentType: contentType,
+		ContentID:   contentID,
+		Action:      videoproto.ModerationAction(action),
+		Note:        c.FormValue("note"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
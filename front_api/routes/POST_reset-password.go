@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleResetPassword sets a new password using the token from a password reset email. The user is logged out
+// everywhere, so has to log in again with the new password.
+func (r RouteHandler) handleResetPassword(c echo.Context) error {
+	_, err := r.u.ResetPassword(context.Background(), &userproto.ResetPasswordRequest{
+		Token:       c.FormValue("token"),
+		NewPassword: c.FormValue("password"),
+	})
+	if err != nil {
+		return err
+	}
+
+	custommiddleware.ClearSessionCookies(c)
+
+	return c.JSON(http.StatusOK, nil)
+}
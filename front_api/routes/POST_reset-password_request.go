@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleRequestPasswordReset emails reset links for the accounts registered with the email form value. The response
+// doesn't say whether there were any.
+// Requests are throttled per address and per client IP.
+func (r RouteHandler) handleRequestPasswordReset(c echo.Context) error {
+	_, err := r.u.RequestPasswordReset(context.Background(), &userproto.RequestPasswordResetRequest{
+		Email: c.FormValue("email"),
+		Ip:    c.RealIP(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
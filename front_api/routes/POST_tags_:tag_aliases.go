@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleAddTagAlias makes the alias form value resolve to the tag. If the alias is currently a tag, it's merged into
+// the tag. The video service checks that the user is trusted.
+func (r RouteHandler) handleAddTagAlias(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	info, err := r.v.AddTagAlias(custommiddleware.GRPCContext(c), &videoproto.TagAliasChange{
+		UserID: userID,
+		Tag:    c.Param("tag"),
+		Alias:  c.FormValue("alias"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleAddTagImplication makes the tag imply the implied_tag form value, and tags the tag's videos with it. The
+// video service checks that the user is trusted.
+func (r RouteHandler) handleAddTagImplication(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	info, err := r.v.AddTagImplication(custommiddleware.GRPCContext(c), &videoproto.TagImplicationChange{
+		UserID:     userID,
+		Tag:        c.Param("tag"),
+		ImpliedTag: c.FormValue("implied_tag"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
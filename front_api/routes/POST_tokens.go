@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleCreateAPIToken creates an API token for the current user. scopes is a comma separated list, and the token
+// expires after expires_in seconds, or the user service's default if it's missing. The token is only shown here.
+func (r RouteHandler) handleCreateAPIToken(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var lifetime int64
+	if e := c.FormValue("expires_in"); e != "" {
+		lifetime, err = strconv.ParseInt(e, 10, 64)
+		if err != nil || lifetime <= 0 {
+			return c.String(http.StatusBadRequest, "expires_in must be a number of seconds")
+		}
+	}
+
+	var scopes []string
+	for _, scope := range strings.Split(c.FormValue("scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	resp, err := r.u.CreateAPIToken(custommiddleware.GRPCContext(c), &userproto.CreateAPITokenRequest{
+		UserID:          userID,
+		Name:            c.FormValue("name"),
+		Scopes:          scopes,
+		LifetimeSeconds: lifetime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewAPITokenData{Token: resp.Token, APITokenData: newAPITokenData(resp.Info)})
+}
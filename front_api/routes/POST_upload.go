@@ -1,11 +1,11 @@
 package routes
 
 import (
-	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -63,7 +63,7 @@ func (r RouteHandler) upload(c echo.Context) error {
 	log.Infof("Tags: %s", tags)
 	log.Infof("Video len: %d thumb len: %d", len(videoBytes), len(thumbBytes))
 
-	uploadClient, err := r.v.UploadVideo(context.Background())
+	uploadClient, err := r.v.UploadVideo(custommiddleware.GRPCContext(c))
 	if err != nil {
 		return err
 	}
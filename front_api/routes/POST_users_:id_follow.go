@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newFollowData(counts *userproto.FollowCounts) FollowData {
+	return FollowData{
+		UserSubscribers: counts.Followers,
+		Following:       counts.Following,
+		Subscribed:      counts.FollowedByViewer,
+	}
+}
+
+func (r RouteHandler) handleFollow(c echo.Context) error {
+	followeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	counts, err := r.u.FollowUser(custommiddleware.GRPCContext(c), &userproto.FollowRequest{
+		FollowerID: userID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newFollowData(counts))
+}
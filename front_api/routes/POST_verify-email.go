@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleVerifyEmail confirms the token from a verification email
+func (r RouteHandler) handleVerifyEmail(c echo.Context) error {
+	_, err := r.u.VerifyEmail(context.Background(), &userproto.VerifyEmailRequest{
+		Token: c.FormValue("token"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleSendVerificationEmail emails the current user another verification link
+func (r RouteHandler) handleSendVerificationEmail(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.u.SendVerificationEmail(context.Background(), &userproto.SendVerificationEmailRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
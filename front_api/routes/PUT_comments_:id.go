@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleEditComment(c echo.Context) error {
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	err = c.Request().ParseForm()
+	if err != nil {
+		return err
+	}
+
+	content, err := url.QueryUnescape(c.Request().PostForm.Get("content"))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.v.EditComment(custommiddleware.GRPCContext(c), &videoproto.CommentEdit{
+		UserId:    userID,
+		CommentId: commentID,
+		Comment:   content,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
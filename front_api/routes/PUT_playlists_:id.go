@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func (r RouteHandler) handleUpdatePlaylist(c echo.Context) error {
+	playlistID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	visibility, ok := playlistVisibilities[c.FormValue("visibility")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "visibility must be public, unlisted or private")
+	}
+
+	playlist, err := r.v.UpdatePlaylist(custommiddleware.GRPCContext(c), &videoproto.PlaylistUpdate{
+		UserID:      userID,
+		PlaylistID:  playlistID,
+		Title:       c.FormValue("title"),
+		Description: c.FormValue("description"),
+		Visibility:  visibility,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newPlaylistData(playlist))
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleMoveInPlaylist moves the video to the given position in the playlist, starting at 0
+func (r RouteHandler) handleMoveInPlaylist(c echo.Context) error {
+	playlistID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	videoID, err := strconv.ParseInt(c.Param("videoID"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	position, err := strconv.ParseInt(c.FormValue("position"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.v.MoveVideoInPlaylist(custommiddleware.GRPCContext(c), &videoproto.PlaylistVideoMove{
+		UserID:     userID,
+		PlaylistID: playlistID,
+		VideoID:    videoID,
+		Position:   position,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+func newEditableProfileData(user *userproto.UserResponse) EditableProfileData {
+	return EditableProfileData{
+		DisplayName:       user.DisplayName,
+		Bio:               user.Bio,
+		Links:             user.Links,
+		ProfilePictureURL: profilePictureURL(user),
+		BannerURL:         user.BannerURL,
+	}
+}
+
+// handleUpdateProfile replaces the current user's display_name, bio and links, which is a JSON array of URLs
+func (r RouteHandler) handleUpdateProfile(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var links []string
+	if l := c.FormValue("links"); l != "" {
+		if err = json.Unmarshal([]byte(l), &links); err != nil {
+			return c.String(http.StatusBadRequest, "links must be a JSON array of URLs")
+		}
+	}
+
+	user, err := r.u.UpdateProfile(custommiddleware.GRPCContext(c), &userproto.UpdateProfileRequest{
+		UserID:      userID,
+		DisplayName: c.FormValue("display_name"),
+		Bio:         c.FormValue("bio"),
+		Links:       links,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newEditableProfileData(user))
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"net/http"
+
+	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleUpdateTag sets a tag's category (general, artist, series, character or meta) and description. The video
+// service checks that the user is trusted.
+func (r RouteHandler) handleUpdateTag(c echo.Context) error {
+	userID, err := getCurrentUserID(c)
+	if err != nil {
+		return err
+	}
+
+	category, ok := videoproto.TagCategory_value[c.FormValue("category")]
+	if !ok {
+		return c.String(http.StatusBadRequest, "unknown tag category")
+	}
+
+	info, err := r.v.UpdateTag(custommiddleware.GRPCContext(c), &videoproto.TagUpdate{
+		UserID:      userID,
+		Tag:         c.Param("tag"),
+		Category:    videoproto.TagCategory(category),
+		Description: c.FormValue("description"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTagData(info))
+}
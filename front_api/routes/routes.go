@@ -2,16 +2,15 @@ package routes
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/SEAPUNK/horahora/front_api/config"
 	custommiddleware "github.com/SEAPUNK/horahora/front_api/middleware"
 	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/labstack/echo/v4"
@@ -42,20 +41,110 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config) {
 
 	e.GET("/videos/:id", r.getVideo)
 	e.POST("/rate/:id", r.handleRating)
+	e.DELETE("/rate/:id", r.handleRetractRating)
 	e.POST("/approve/:id", r.handleApproval)
+	e.POST("/reject/:id", r.handleRejection)
+	e.GET("/pendingvideos", r.getPendingVideos)
 
 	e.POST("/login", r.handleLogin)
 	e.POST("/register", r.handleRegister)
 	e.POST("/logout", r.handleLogout)
+	e.POST("/verify-email", r.handleVerifyEmail)
+	e.POST("/verify-email/send", r.handleSendVerificationEmail)
+	e.POST("/reset-password", r.handleResetPassword)
+	e.POST("/reset-password/request", r.handleRequestPasswordReset)
 
 	e.GET("/archiverequests", r.getArchiveRequests)
 	e.POST("/archiverequests", r.handleArchiveRequest)
 
 	e.GET("/comments/:id", r.getComments)
 	e.POST("/comments/", r.handleComment)
+	e.PUT("/comments/:id", r.handleEditComment)
+	e.DELETE("/comments/:id", r.handleDeleteComment)
 
 	e.POST("/comment_upvotes/", r.handleUpvote)
+
+	e.POST("/reports", r.handleReport)
+	e.GET("/reports", r.getReports)
+	e.POST("/reports/resolve", r.handleResolveReports)
+
+	e.GET("/danmaku/:id", r.getDanmaku)
+	e.POST("/danmaku/:id", r.handleDanmaku)
+
+	e.POST("/playlists", r.handleCreatePlaylist)
+	e.GET("/playlists/:id", r.getPlaylist)
+	e.PUT("/playlists/:id", r.handleUpdatePlaylist)
+	e.DELETE("/playlists/:id", r.handleDeletePlaylist)
+	e.POST("/playlists/:id/videos", r.handleAddToPlaylist)
+	e.PUT("/playlists/:id/videos/:videoID", r.handleMoveInPlaylist)
+	e.DELETE("/playlists/:id/videos/:videoID", r.handleRemoveFromPlaylist)
+	e.GET("/users/:id/playlists", r.getUserPlaylists)
+
+	e.POST("/users/:id/follow", r.handleFollow)
+	e.DELETE("/users/:id/follow", r.handleUnfollow)
+	e.POST("/tag/:tag/follow", r.handleFollowTag)
+	e.DELETE("/tag/:tag/follow", r.handleUnfollowTag)
+	e.GET("/feed", r.getFeed)
+
+	e.GET("/tags/autocomplete", r.getTagSuggestions)
+	e.GET("/tags/counts", r.getTagCounts)
+	e.GET("/tags/:tag", r.getTagInfo)
+	e.PUT("/tags/:tag", r.handleUpdateTag)
+	e.POST("/tags/:tag/aliases", r.handleAddTagAlias)
+	e.DELETE("/tags/:tag/aliases/:alias", r.handleRemoveTagAlias)
+	e.POST("/tags/:tag/implications", r.handleAddTagImplication)
+	e.DELETE("/tags/:tag/implications/:impliedTag", r.handleRemoveTagImplication)
+	e.GET("/tags/:tag/history", r.getTagHistory)
+
+	e.GET("/notifications", r.getNotifications)
+	e.GET("/notifications/unread", r.getUnreadNotificationCount)
+	e.GET("/notifications/stream", r.streamNotifications)
+	e.POST("/notifications/read", r.handleMarkNotificationsRead)
+
 	e.POST("/upload", r.upload)
+
+	e.PUT("/profile", r.handleUpdateProfile)
+	e.POST("/profile/:image", r.handleUploadProfileImage)
+	e.DELETE("/profile/:image", r.handleRemoveProfileImage)
+
+	e.POST("/claims", r.handleStartClaim)
+	e.GET("/claims/:id", r.getClaim)
+	e.POST("/claims/:id/complete", r.handleCompleteClaim)
+
+	e.GET("/tokens", r.getAPITokens)
+	e.POST("/tokens", r.handleCreateAPIToken)
+	e.DELETE("/tokens/:id", r.handleRevokeAPIToken)
+
+	e.GET("/admin/users", r.getAdminUsers)
+	e.POST("/admin/users/:id/rank", r.handleSetUserRank)
+	e.POST("/admin/users/:id/ban", r.handleBanUser)
+	e.POST("/admin/users/:id/unban", r.handleUnbanUser)
+}
+
+// APITokenScopes are the scopes which API tokens need to call routes, keyed by method and path. Other GET routes need
+// the read scope, and other routes can only be called with a session, so that e.g. tokens can't create more tokens.
+// GET routes whose RPCs need a permission are listed too, since the read scope doesn't grant any.
+var APITokenScopes = map[string]permissions.Scope{
+	"POST /upload":          permissions.ScopeUpload,
+	"GET /archiverequests":  permissions.ScopeArchive,
+	"POST /archiverequests": permissions.ScopeArchive,
+
+	"GET /pendingvideos":                         permissions.ScopeModerate,
+	"POST /approve/:id":                          permissions.ScopeModerate,
+	"POST /reject/:id":                           permissions.ScopeModerate,
+	"GET /reports":                               permissions.ScopeModerate,
+	"POST /reports/resolve":                      permissions.ScopeModerate,
+	"PUT /tags/:tag":                             permissions.ScopeModerate,
+	"POST /tags/:tag/aliases":                    permissions.ScopeModerate,
+	"DELETE /tags/:tag/aliases/:alias":           permissions.ScopeModerate,
+	"POST /tags/:tag/implications":               permissions.ScopeModerate,
+	"DELETE /tags/:tag/implications/:impliedTag": permissions.ScopeModerate,
+	"GET /tags/:tag/history":                     permissions.ScopeModerate,
+	"DELETE /comments/:id":                       permissions.ScopeModerate,
+	"GET /admin/users":                           permissions.ScopeModerate,
+	"POST /admin/users/:id/rank":                 permissions.ScopeModerate,
+	"POST /admin/users/:id/ban":                  permissions.ScopeModerate,
+	"POST /admin/users/:id/unban":                permissions.ScopeModerate,
 }
 
 type Video struct {
@@ -80,16 +169,28 @@ type VideoDetail struct {
 	MPDLoc           string
 	Views            uint64
 	Rating           float64
+	RatingCount      int64
+	RatingHistogram  []int64 // Number of ratings rounding to each whole number from 0 to 10
 	VideoID          int64
 	AuthorID         int64
 	Username         string
 	UserDescription  string
 	VideoDescription string
 	UserSubscribers  uint64
+	Subscribed       bool // Whether the current user follows the author
 	ProfilePicture   string
 	UploadDate       string // should be a datetime
 	Comments         []Comment
 	Tags             []string
+	// The same video archived from other sites
+	AlsoAvailableFrom []VideoMirror
+	RelatedVideos     []Video
+}
+
+type VideoMirror struct {
+	VideoID      int64
+	Website      string
+	OriginalLink string
 }
 
 type LoggedInUserData struct {
@@ -97,6 +198,8 @@ type LoggedInUserData struct {
 	Username          string
 	ProfilePictureURL string
 	Rank              int32
+	EmailVerified     bool
+	Permissions       []string // e.g. video.approve, for deciding which moderation tools to show
 }
 
 type ProfileData struct {
@@ -104,33 +207,66 @@ type ProfileData struct {
 	PaginationData    PaginationData
 	UserID            int64
 	Username          string
+	DisplayName       string // Empty if the user hasn't set one
+	Bio               string
+	Links             []string
 	ProfilePictureURL string
+	BannerURL         string // Empty if the user hasn't uploaded one
+	Foreign           bool   // Created for archived videos, and can be claimed by its owner
+	MergedInto        int64  // The user this one was merged into after being claimed, or 0
+	UserSubscribers   int64
+	Following         int64
+	Subscribed        bool // Whether the current user follows this user
 	Videos            []Video
 }
 
+type FollowData struct {
+	UserSubscribers int64
+	Following       int64
+	Subscribed      bool
+}
+
+type FeedData struct {
+	L          LoggedInUserData
+	Videos     []Video
+	NextCursor string // Passed as the cursor query parameter for the next page, empty on the last page
+}
+
 type PaginationData struct {
 	PathsAndQueryStrings []string
 	Pages                []int
 	CurrentPage          int
 }
 
+type PlaylistData struct {
+	PlaylistID     int64
+	UserID         int64
+	Username       string
+	Title          string
+	Description    string
+	Visibility     string // public, unlisted or private
+	NumberOfVideos int64
+	CreationDate   string
+	LastUpdated    string
+	Mirrored       bool
+	MirrorWebsite  string
+	MirrorID       string
+}
+
+type PlaylistPageData struct {
+	L              LoggedInUserData
+	Playlist       PlaylistData
+	PaginationData PaginationData
+	Videos         []Video
+}
+
 type ArchiveRequestsPageData struct {
 	L                LoggedInUserData
 	ArchivalRequests []*schedulerproto.ContentArchivalEntry
 }
 
-func setCookie(c echo.Context, jwt string) error {
-	cookie := new(http.Cookie)
-	cookie.Name = "jwt"
-
-	cookie.Value = base64.StdEncoding.EncodeToString([]byte(jwt)) //
-	cookie.Expires = time.Now().Add(24 * time.Hour)
-
-	// cookie.SameSite = http.SameSiteStrictMode
-	//cookie.Secure = true // set this later
-
-	c.SetCookie(cookie)
-
+func setCookie(c echo.Context, jwt, refreshToken string, expiry int64) error {
+	custommiddleware.SetSessionCookies(c, jwt, refreshToken, expiry)
 	return c.JSON(http.StatusOK, nil)
 }
 
@@ -159,6 +295,17 @@ func getCurrentUserID(c echo.Context) (int64, error) {
 	return idInt, nil
 }
 
+// Shown for users who haven't uploaded an avatar
+const defaultProfilePictureURL = "/static/images/placeholder1.jpg"
+
+func profilePictureURL(user *userproto.UserResponse) string {
+	if user.AvatarURL == "" {
+		return defaultProfilePictureURL
+	}
+
+	return user.AvatarURL
+}
+
 func addUserProfileInfo(c echo.Context, l *LoggedInUserData, client userproto.UserServiceClient) {
 	id := c.Get(custommiddleware.UserIDKey)
 
@@ -183,9 +330,11 @@ func addUserProfileInfo(c echo.Context, l *LoggedInUserData, client userproto.Us
 	}
 
 	l.Username = userResp.Username
-	// l.ProfilePictureURL = userResp. // TODO
+	l.ProfilePictureURL = profilePictureURL(userResp)
 	l.UserID = idInt
 	l.Rank = int32(userResp.Rank)
+	l.EmailVerified = userResp.EmailVerified
+	l.Permissions, _ = c.Get(custommiddleware.PermissionsKey).([]string)
 }
 
 type CommentData struct {
@@ -196,12 +345,175 @@ type CommentData struct {
 	ProfileImage       string `json:"profile_picture_url"`
 	VoteScore          int64  `json:"upvote_count"`
 	CurrUserHasUpvoted bool   `json:"user_has_upvoted"`
+	CurrUserVote       int32  `json:"user_vote"`
 	ParentID           int64  `json:"parent,omitempty"`
+	Deleted            bool   `json:"deleted"`
+	EditedDate         string `json:"modified,omitempty"`
+	ReplyCount         int64  `json:"reply_count"`
+	Archived           bool   `json:"archived"` // Imported from the original site, read-only
+}
+
+type DanmakuData struct {
+	ID         int64  `json:"id"`
+	PositionMs int64  `json:"position_ms"`
+	Content    string `json:"content"`
+	Position   string `json:"position"` // scrolling, fixed_top or fixed_bottom
+	Color      int32  `json:"color"`
+}
+
+type PendingVideoData struct {
+	VideoID          int64    `json:"id"`
+	Title            string   `json:"title"`
+	AuthorID         int64    `json:"author_id"`
+	AuthorName       string   `json:"author_name"`
+	UploadDate       string   `json:"upload_date"`
+	Approvals        int64    `json:"approvals"`
+	Rejections       int64    `json:"rejections"`
+	RejectionReasons []string `json:"rejection_reasons"`
+	CurrUserVoted    bool     `json:"user_has_voted"`
+}
+
+type PendingVideosData struct {
+	Videos         []PendingVideoData `json:"videos"`
+	NumberOfVideos int64              `json:"number_of_videos"`
+	CurrentPage    int64              `json:"current_page"`
+}
+
+type ReportData struct {
+	ContentType   string   `json:"content_type"` // video or comment
+	ContentID     int64    `json:"content_id"`
+	VideoID       int64    `json:"video_id"`
+	Preview       string   `json:"preview"`
+	AuthorID      int64    `json:"author_id"`
+	AuthorName    string   `json:"author_name"`
+	ReportCount   int64    `json:"report_count"`
+	FirstReported string   `json:"first_reported"`
+	Reasons       []string `json:"reasons"`
+	Details       []string `json:"details"`
+}
+
+type ReportListData struct {
+	L               LoggedInUserData
+	Reports         []ReportData `json:"reports"`
+	NumberOfReports int64        `json:"number_of_reports"`
+	CurrentPage     int64        `json:"current_page"`
+}
+
+type NotificationData struct {
+	NotificationID int64  `json:"notification_id"`
+	Kind           string `json:"kind"`
+	Message        string `json:"message"`
+	VideoID        int64  `json:"video_id"`   // 0 if the notification isn't about a video
+	CommentID      int64  `json:"comment_id"` // 0 if the notification isn't about a comment
+	CreationDate   string `json:"creation_date"`
+	IsRead         bool   `json:"is_read"`
+}
+
+type NotificationListData struct {
+	Notifications         []NotificationData `json:"notifications"`
+	NumberOfNotifications int64              `json:"number_of_notifications"`
+	CurrentPage           int64              `json:"current_page"`
+}
+
+type UnreadNotificationCountData struct {
+	Count int64 `json:"count"`
+}
+
+type TagData struct {
+	Name         string   `json:"name"`
+	Category     string   `json:"category"` // general, artist, series, character or meta
+	Description  string   `json:"description"`
+	Aliases      []string `json:"aliases"`
+	Implies      []string `json:"implies"`
+	ImpliedBy    []string `json:"implied_by"`
+	VideoCount   int64    `json:"video_count"`
+	MatchedAlias string   `json:"matched_alias,omitempty"` // Set by autocomplete when an alias matched
+}
+
+type TagListData struct {
+	Tags []TagData `json:"tags"`
+}
+
+type TagEditData struct {
+	EditID       int64  `json:"edit_id"`
+	Tag          string `json:"tag"`
+	UserID       int64  `json:"user_id"`
+	Action       string `json:"action"`
+	Details      string `json:"details"`
+	CreationDate string `json:"creation_date"`
+}
+
+type TagHistoryData struct {
+	Edits         []TagEditData `json:"edits"`
+	NumberOfEdits int64         `json:"number_of_edits"`
+	CurrentPage   int64         `json:"current_page"`
+}
+
+type CommentListData struct {
+	Comments         []CommentData `json:"comments"`
+	NumberOfComments int64         `json:"number_of_comments"`
+	// Only returned for root comments, or for replies when archived=1
+	ArchivedComments         []CommentData `json:"archived_comments"`
+	NumberOfArchivedComments int64         `json:"number_of_archived_comments"`
+	CurrentPage              int64         `json:"current_page"`
+}
+
+// EditableProfileData is returned after the current user edits their profile
+type EditableProfileData struct {
+	DisplayName       string
+	Bio               string
+	Links             []string
+	ProfilePictureURL string
+	BannerURL         string
+}
+
+type AdminUserData struct {
+	UserID        int64  `json:"user_id"`
+	Username      string `json:"username"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Rank          string `json:"rank"` // regular, trusted or admin
+	Banned        bool   `json:"banned"`
+	BannedUntil   int64  `json:"banned_until"` // Unix time, or 0 if the ban is permanent
+	BanReason     string `json:"ban_reason"`
+}
+
+type ClaimData struct {
+	ClaimID          int64  `json:"claim_id"`
+	ForeignAccountID int64  `json:"user_id"`
+	Website          string `json:"website"` // niconico, bilibili or youtube
+	ForeignUserID    string `json:"foreign_user_id"`
+	Code             string `json:"code"`       // To be put in the channel description
+	ExpiresAt        int64  `json:"expires_at"` // Unix time
+	Completed        bool   `json:"completed"`
+	Mode             string `json:"mode,omitempty"` // convert or merge, once completed
+}
+
+type APITokenData struct {
+	ID        int64    `json:"id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	Created   int64    `json:"created"`    // Unix time
+	ExpiresAt int64    `json:"expires_at"` // Unix time
+	LastUsed  int64    `json:"last_used"`  // Unix time, or 0 if never used
+}
+
+type NewAPITokenData struct {
+	Token string `json:"token"` // Only shown once
+	APITokenData
+}
+
+type AdminUserListData struct {
+	L             LoggedInUserData
+	Users         []AdminUserData `json:"users"`
+	NumberOfUsers int64           `json:"number_of_users"`
+	CurrentPage   int64           `json:"current_page"`
 }
 
 const (
 	videoKey               = "file[0]"
 	thumbnailKey           = "file[1]"
+	profileImageKey        = "image"
 	MINIMUM_NUMBER_OF_TAGS = 5
 	fileUploadChunkSize    = 1024 * 1024
 )
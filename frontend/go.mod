@@ -8,7 +8,7 @@ require (
 	github.com/grpc-ecosystem/grpc-opentracing v0.0.0-20180507213350-8e809c8a8645
 	github.com/horahoradev/horahora/scheduler v0.0.0-20200823010907-db67efcf7d8c
 	github.com/horahoradev/horahora/user_service v0.0.0-20200929200329-a2cc6bce4184
-	github.com/horahoradev/horahora/video_service v0.0.0-20201021061128-99df23c0d6e6
+	github.com/horahoradev/horahora/video_service v0.0.0-20201205215129-690cef6cbea9
 	github.com/labstack/echo/v4 v4.1.16
 	github.com/labstack/gommon v0.3.0
 	github.com/moxiaomomo/grpc-jaeger v0.0.0-20180617090213-05b879580c4a // indirect
@@ -17,3 +17,11 @@ require (
 	github.com/uber/jaeger-client-go v2.25.0+incompatible
 	google.golang.org/grpc v1.33.0
 )
+
+replace github.com/horahoradev/horahora/scheduler => ../scheduler
+
+replace github.com/horahoradev/horahora/user_service => ../user_service
+
+replace github.com/horahoradev/horahora/video_service => ../video_service
+
+replace github.com/horahoradev/horahora/storage => ../storage
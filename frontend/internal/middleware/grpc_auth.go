@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"github.com/horahoradev/horahora/frontend/internal/config"
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -22,6 +23,7 @@ func NewGRPCAuth(config *config.Config) *JWTGRPCAuthenticator {
 
 const UserIDKey = "userid"
 const UserRank = "userrank"
+const AccessTokenKey = "accesstoken"
 
 func (j *JWTGRPCAuthenticator) GRPCAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -46,6 +48,7 @@ func (j *JWTGRPCAuthenticator) GRPCAuth(next echo.HandlerFunc) echo.HandlerFunc
 		}
 
 		c.Set(UserIDKey, uid)
+		c.Set(AccessTokenKey, string(jwtDecoded))
 
 		// TODO: add other stuff in here like username, profile picture location, etc
 		// If user is authenticated, get other metadata
@@ -77,3 +80,14 @@ func (j *JWTGRPCAuthenticator) authenticate(jwt string) (int64, error) {
 
 	return validationResp.Uid, nil
 }
+
+// GRPCContext returns a context which sends the current user's access token with calls, which the video service needs
+// to verify who's calling for anything which needs a permission
+func GRPCContext(c echo.Context) context.Context {
+	token, ok := c.Get(AccessTokenKey).(string)
+	if !ok {
+		return context.Background()
+	}
+
+	return permissions.WithAccessToken(context.Background(), token)
+}
@@ -47,6 +47,9 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config) {
 	e.POST("/comments/", r.handleComment)
 
 	e.POST("/comment_upvotes/", r.handleUpvote)
+
+	e.GET("/danmaku/:id", r.getDanmaku)
+	e.POST("/danmaku/:id", r.handleDanmaku)
 	e.GET("/upload", getUpload)
 	e.POST("/upload", r.upload)
 }
@@ -165,26 +168,28 @@ func (r RouteHandler) handleArchiveRequest(c echo.Context) error {
 			TagValue: contentValue,
 		}
 
-		_, err := r.s.DlTag(context.TODO(), &req)
+		_, err := r.s.DlTag(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
 	case "channel":
 		req := schedulerproto.ChannelRequest{
 			Website:   supportedWebsite,
+			UserID:    UserIDInt,
 			ChannelID: contentValue,
 		}
 
-		_, err := r.s.DlChannel(context.TODO(), &req)
+		_, err := r.s.DlChannel(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
 	case "playlist":
 		req := schedulerproto.PlaylistRequest{
 			Website:    supportedWebsite,
+			UserID:     UserIDInt,
 			PlaylistID: contentValue,
 		}
-		_, err := r.s.DlPlaylist(context.TODO(), &req)
+		_, err := r.s.DlPlaylist(custommiddleware.GRPCContext(c), &req)
 		if err != nil {
 			return err
 		}
@@ -281,6 +286,8 @@ func (v RouteHandler) getTag(c echo.Context) error {
 	}
 	// doesn't matter if it fails, 0 is a fine default rank
 	showUnapproved := false
+	// The video service checks that the viewer may see unapproved videos
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 	if rank > 0 {
 		// privileged user, can show unapproved videos
 		showUnapproved = true
@@ -292,9 +299,10 @@ func (v RouteHandler) getTag(c echo.Context) error {
 		PageNumber:     pageNumberInt,
 		ContainsTag:    tag,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := v.v.GetVideoList(context.TODO(), &videoQueryConfig)
+	videoList, err := v.v.GetVideoList(custommiddleware.GRPCContext(c), &videoQueryConfig)
 	if err != nil {
 		return err
 	}
@@ -360,6 +368,8 @@ func (v RouteHandler) getUser(c echo.Context) error {
 	}
 	// doesn't matter if it fails, 0 is a fine default rank
 	showUnapproved := false
+	// The video service checks that the viewer may see unapproved videos
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 	if rank > 0 {
 		// privileged user, can show unapproved videos
 		showUnapproved = true
@@ -372,9 +382,10 @@ func (v RouteHandler) getUser(c echo.Context) error {
 		ContainsTag:    "",
 		FromUserID:     idInt,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := v.v.GetVideoList(context.TODO(), &videoQueryConfig)
+	videoList, err := v.v.GetVideoList(custommiddleware.GRPCContext(c), &videoQueryConfig)
 	if err != nil {
 		return err
 	}
@@ -461,8 +472,8 @@ func (v *RouteHandler) getVideo(c echo.Context) error {
 	}
 
 	// Increment views first
-	viewReq := videoproto.VideoViewing{VideoID: idInt}
-	_, err = v.v.ViewVideo(context.Background(), &viewReq)
+	viewReq := videoproto.VideoViewing{VideoID: idInt, ClientIP: c.RealIP()}
+	_, err = v.v.ViewVideo(custommiddleware.GRPCContext(c), &viewReq)
 	if err != nil {
 		return err
 	}
@@ -537,7 +548,7 @@ func (v RouteHandler) handleRating(c echo.Context) error {
 		Rating:  float32(rating),
 	}
 
-	_, err = v.v.RateVideo(context.TODO(), &rateReq)
+	_, err = v.v.RateVideo(custommiddleware.GRPCContext(c), &rateReq)
 	return err
 }
 
@@ -559,6 +570,8 @@ func (h *RouteHandler) getHome(c echo.Context) error {
 	}
 	// doesn't matter if it fails, 0 is a fine default rank
 	showUnapproved := false
+	// The video service checks that the viewer may see unapproved videos
+	viewerID, _ := c.Get(custommiddleware.UserIDKey).(int64)
 	if rank > 0 {
 		// privileged user, can show unapproved videos
 		showUnapproved = true
@@ -599,9 +612,10 @@ func (h *RouteHandler) getHome(c echo.Context) error {
 		ContainsTag:    tag,
 		PageNumber:     pageNumberInt,
 		ShowUnapproved: showUnapproved,
+		ViewerID:       viewerID,
 	}
 
-	videoList, err := h.v.GetVideoList(context.TODO(), &req)
+	videoList, err := h.v.GetVideoList(custommiddleware.GRPCContext(c), &req)
 	if err != nil {
 		log.Errorf("Could not retrieve video list. Err: %s", err)
 		return c.String(http.StatusInternalServerError, "Could not retrieve video list")
@@ -705,7 +719,7 @@ func (v RouteHandler) handleApproval(c echo.Context) error {
 		return errors.New("could not assert userid to int64")
 	}
 
-	_, err = v.v.ApproveVideo(context.Background(), &videoproto.VideoApproval{VideoID: idInt, UserID: UserIDInt})
+	_, err = v.v.ApproveVideo(custommiddleware.GRPCContext(c), &videoproto.VideoApproval{VideoID: idInt, UserID: UserIDInt})
 	if err != nil {
 		return err
 	}
@@ -731,14 +745,14 @@ func (r RouteHandler) getComments(c echo.Context) error {
 		log.Error("Could not assert userid to int64 for getComments")
 	}
 
-	resp, err := r.v.GetCommentsForVideo(context.Background(), &videoproto.CommentRequest{VideoID: videoIDInt, CurrUserID: UserIDInt})
+	comments, err := r.getCommentThread(custommiddleware.GRPCContext(c), videoIDInt, UserIDInt, 0)
 	if err != nil {
 		return err
 	}
 
 	commentList := make([]CommentData, 0)
 
-	for _, comment := range resp.Comments {
+	for _, comment := range comments {
 		commentData := CommentData{
 			ID:                 comment.CommentId,
 			CreationDate:       comment.CreationDate,
@@ -758,6 +772,44 @@ func (r RouteHandler) getComments(c echo.Context) error {
 	return c.JSON(http.StatusOK, &commentList)
 }
 
+// getCommentThread returns every comment under parentID, followed by their replies. The video service returns one
+// level of the thread a page at a time, but the comments widget wants the whole thread as a flat list.
+func (r RouteHandler) getCommentThread(ctx context.Context, videoID, userID, parentID int64) ([]*videoproto.Comment, error) {
+	var comments []*videoproto.Comment
+	for page := int64(1); ; page++ {
+		resp, err := r.v.GetCommentsForVideo(ctx, &videoproto.CommentRequest{
+			VideoID:    videoID,
+			CurrUserID: userID,
+			ParentID:   parentID,
+			PageNumber: page,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, resp.Comments...)
+		if len(resp.Comments) == 0 || int64(len(comments)) >= resp.NumberOfComments {
+			break
+		}
+	}
+
+	thread := comments
+	for _, comment := range comments {
+		if comment.ReplyCount == 0 {
+			continue
+		}
+
+		replies, err := r.getCommentThread(ctx, videoID, userID, comment.CommentId)
+		if err != nil {
+			return nil, err
+		}
+
+		thread = append(thread, replies...)
+	}
+
+	return thread, nil
+}
+
 func (r RouteHandler) handleComment(c echo.Context) error {
 	err := c.Request().ParseForm()
 	if err != nil {
@@ -795,7 +847,7 @@ func (r RouteHandler) handleComment(c echo.Context) error {
 	//	// nothing
 	//}
 
-	_, err = r.v.MakeComment(context.Background(), &videoproto.VideoComment{
+	_, err = r.v.MakeComment(custommiddleware.GRPCContext(c), &videoproto.VideoComment{
 		UserId:        userIDInt,
 		VideoId:       videoIDInt,
 		Comment:       content,
@@ -820,6 +872,103 @@ type CommentData struct {
 	ParentID           int64  `json:"parent,omitempty"`
 }
 
+type DanmakuData struct {
+	ID         int64  `json:"id"`
+	PositionMs int64  `json:"position_ms"`
+	Content    string `json:"content"`
+	Position   string `json:"position"` // scrolling, fixed_top or fixed_bottom
+	Color      int32  `json:"color"`
+}
+
+// Playback positions are in milliseconds, and the range is [from, to)
+func (r RouteHandler) getDanmaku(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	fromMs, err := strconv.ParseInt(c.QueryParam("from"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	toMs, err := strconv.ParseInt(c.QueryParam("to"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.v.GetDanmaku(context.Background(), &videoproto.DanmakuRequest{
+		VideoID: videoID,
+		FromMs:  fromMs,
+		ToMs:    toMs,
+	})
+	if err != nil {
+		return err
+	}
+
+	danmakuList := make([]DanmakuData, 0, len(resp.Danmaku))
+	for _, d := range resp.Danmaku {
+		danmakuList = append(danmakuList, DanmakuData{
+			ID:         d.DanmakuID,
+			PositionMs: d.PositionMs,
+			Content:    d.Content,
+			Position:   d.Position.String(),
+			Color:      d.Color,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &danmakuList)
+}
+
+func (r RouteHandler) handleDanmaku(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	userID, ok := c.Get(custommiddleware.UserIDKey).(int64)
+	if !ok || userID == 0 {
+		return c.String(http.StatusUnauthorized, "must be logged in to post danmaku")
+	}
+
+	positionMs, err := strconv.ParseInt(c.FormValue("position_ms"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// Optional, white scrolling danmaku by default
+	color := int64(0xFFFFFF)
+	if c.FormValue("color") != "" {
+		color, err = strconv.ParseInt(c.FormValue("color"), 10, 32)
+		if err != nil {
+			return err
+		}
+	}
+
+	position := videoproto.DanmakuPosition_scrolling
+	if c.FormValue("position") != "" {
+		p, ok := videoproto.DanmakuPosition_value[c.FormValue("position")]
+		if !ok {
+			return c.String(http.StatusBadRequest, "unknown danmaku position")
+		}
+		position = videoproto.DanmakuPosition(p)
+	}
+
+	_, err = r.v.PostDanmaku(custommiddleware.GRPCContext(c), &videoproto.DanmakuPost{
+		UserID:     userID,
+		VideoID:    videoID,
+		PositionMs: positionMs,
+		Content:    c.FormValue("content"),
+		Position:   position,
+		Color:      int32(color),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
 func (r RouteHandler) handleUpvote(c echo.Context) error {
 	// DUMB!
 	err := c.Request().ParseForm()
@@ -844,10 +993,16 @@ func (r RouteHandler) handleUpvote(c echo.Context) error {
 		return err
 	}
 
-	_, err = r.v.MakeCommentUpvote(context.Background(), &videoproto.CommentUpvote{
+	// jquery-comments only toggles upvotes
+	var vote int32
+	if hasUpvoted {
+		vote = 1
+	}
+
+	_, err = r.v.MakeCommentUpvote(custommiddleware.GRPCContext(c), &videoproto.CommentUpvote{
 		CommentId: commentID,
 		UserId:    userID,
-		IsUpvote:  hasUpvoted,
+		Vote:      vote,
 	})
 
 	return err
@@ -912,7 +1067,7 @@ func (r RouteHandler) upload(c echo.Context) error {
 	log.Infof("Tags: %s", tags)
 	log.Infof("Video len: %d thumb len: %d", len(videoBytes), len(thumbBytes))
 
-	uploadClient, err := r.v.UploadVideo(context.Background())
+	uploadClient, err := r.v.UploadVideo(custommiddleware.GRPCContext(c))
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ func SetupTestRoutes(e *echo.Echo) {
 	e.GET("/login", getLogin)
 	e.GET("/register", getRegister)
 	e.GET("/comments/:id", getComments) // TODO: web client grpc
+	e.GET("/danmaku/:id", getTestDanmaku)
 	e.GET("/upload", getUpload)
 
 }
@@ -111,3 +112,12 @@ func getComments(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, &testComments)
 }
+
+func getTestDanmaku(c echo.Context) error {
+	testDanmaku := []DanmakuData{
+		{1, 1000, "WOW", "scrolling", 0xFFFFFF},
+		{2, 3000, "nice video", "fixed_top", 0xFF0000},
+	}
+
+	return c.JSON(http.StatusOK, &testDanmaku)
+}
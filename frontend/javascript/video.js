@@ -46,5 +46,84 @@ function loadComments(videoID, userID) {
     });
 }
 
+// Danmaku are fetched a window at a time as playback reaches them
+var danmakuWindowMs = 30000;
+var danmakuDurationMs = 8000;
+
+function loadDanmaku(videoID) {
+    var video = document.querySelector('#videoPlayer');
+    var overlay = document.querySelector('#danmaku-overlay');
+    var fetchedUntil = 0;
+    var pending = [];
+    var lastMs = 0;
+
+    function fetchWindow(fromMs) {
+        fetchedUntil = fromMs + danmakuWindowMs;
+        $.ajax({
+            url: '/danmaku/' + videoID,
+            data: {from: fromMs, to: fetchedUntil},
+            dataType: 'json',
+            success: function (response) {
+                pending = pending.concat(response);
+            },
+        });
+    }
+
+    function show(d) {
+        var el = document.createElement('div');
+        el.className = 'danmaku danmaku-' + d.position;
+        el.textContent = d.content;
+        el.style.color = '#' + ('000000' + d.color.toString(16)).slice(-6);
+        if (d.position === 'scrolling') {
+            el.style.top = Math.floor(Math.random() * 80) + '%';
+        }
+
+        overlay.appendChild(el);
+        setTimeout(function () {
+            overlay.removeChild(el);
+        }, danmakuDurationMs);
+    }
+
+    video.addEventListener('timeupdate', function () {
+        var nowMs = Math.floor(video.currentTime * 1000);
+        if (nowMs < lastMs || nowMs > fetchedUntil) {
+            // Seeked, so start over from the new position
+            pending = [];
+            fetchWindow(nowMs);
+        } else if (nowMs > fetchedUntil - danmakuWindowMs / 2) {
+            fetchWindow(fetchedUntil);
+        }
+
+        pending = pending.filter(function (d) {
+            if (d.position_ms < lastMs) {
+                return false;
+            }
+            if (d.position_ms < nowMs) {
+                show(d);
+                return false;
+            }
+            return true;
+        });
+        lastMs = nowMs;
+    });
+
+    $('#danmaku-form').on('submit', function (e) {
+        e.preventDefault();
+        var content = $('#danmaku-content').val();
+        var positionMs = Math.floor(video.currentTime * 1000);
+
+        $.ajax({
+            type: 'post',
+            url: '/danmaku/' + videoID,
+            data: {content: content, position_ms: positionMs},
+            success: function () {
+                show({content: content, position: 'scrolling', color: 0xFFFFFF});
+                $('#danmaku-content').val('');
+            },
+        });
+    });
+}
+
 // :(
-window.loadComments = loadComments;
\ No newline at end of file
+window.loadComments = loadComments;
+window.loadDanmaku = loadDanmaku;
\ No newline at end of file
@@ -9,6 +9,7 @@ require (
 	github.com/gomodule/redigo v2.0.0+incompatible
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
 	github.com/horahoradev/horahora v0.0.0-20200530195308-bb3e1a8e5cce // indirect
+	github.com/horahoradev/horahora/user_service v0.0.0-20200526031340-64e1705d00d7
 	github.com/horahoradev/horahora/video_service v0.0.0-20201205215129-690cef6cbea9
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/lib/pq v1.4.0
@@ -16,3 +17,10 @@ require (
 	github.com/stretchr/testify v1.5.1
 	google.golang.org/grpc v1.33.0
 )
+
+// The Dockerfile is built from the project root so that these are available
+replace github.com/horahoradev/horahora/video_service => ../video_service
+
+replace github.com/horahoradev/horahora/user_service => ../user_service
+
+replace github.com/horahoradev/horahora/storage => ../storage
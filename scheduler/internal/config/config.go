@@ -6,6 +6,8 @@ import (
 	"github.com/go-redsync/redsync"
 	"github.com/gomodule/redigo/redis"
 	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	proto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -41,6 +43,13 @@ type config struct {
 	Client                  proto.VideoServiceClient
 	SocksConnStr            string        `env:"SocksConn,required"`
 	SyncPollDelay           time.Duration `env:"SyncPollDelay,required"`
+	UserServiceGRPCAddress  string        `env:"UserServiceGRPCAddress,required"`
+	UserConn                *grpc.ClientConn
+	UserClient              userproto.UserServiceClient
+	// Users without the archive.unlimited permission can have at most this many archive requests
+	MaxArchiveRequests int `env:"MaxArchiveRequests" envDefault:"10"`
+	// Shared with the video service, which only accepts archived videos, mirrored playlists and notifications with it
+	ServiceSecret string `env:"ServiceSecret,required"`
 }
 
 func New() (*config, error) {
@@ -92,6 +101,7 @@ func New() (*config, error) {
 	}
 
 	config.GRPCConn, err = grpc.Dial(config.VideoServiceGRPCAddress, grpc.WithInsecure(),
+		grpc.WithPerRPCCredentials(permissions.ServiceSecretCredentials(config.ServiceSecret)),
 		//grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(opts...)),
 		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(opts...)))
 	if err != nil {
@@ -100,5 +110,13 @@ func New() (*config, error) {
 
 	config.Client = proto.NewVideoServiceClient(config.GRPCConn)
 
+	config.UserConn, err = grpc.Dial(config.UserServiceGRPCAddress, grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(opts...)))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config.UserClient = userproto.NewUserServiceClient(config.UserConn)
+
 	return &config, err
 }
@@ -63,6 +63,23 @@ func (d *downloader) SubscribeAndDownload(ctx context.Context, m *sync.Mutex) er
 				// FIXME: increase robustness
 				//return err
 			}
+
+			d.notifyCompletedRequests(r)
+		}
+	}
+}
+
+// notifyCompletedRequests tells subscribers about any archive requests that this video was the last download for
+func (d *downloader) notifyCompletedRequests(video *models.VideoDLRequest) {
+	notifications, err := video.ClaimCompletedRequests()
+	if err != nil {
+		log.Errorf("Could not check for completed archive requests for video %s. Err: %s", video.VideoID, err)
+		return
+	}
+
+	for _, notification := range notifications {
+		if err = notification.Send(context.TODO(), d.videoClient); err != nil {
+			log.Errorf("Could not send archive request notification. Err: %s", err)
 		}
 	}
 }
@@ -0,0 +1,78 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	proto "github.com/horahoradev/horahora/scheduler/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// channelURL returns the page of the channel whose videos youtube-dl reports the uploader ID for
+func channelURL(website proto.SupportedSite, channelID string) (string, error) {
+	switch website {
+	case proto.SupportedSite_youtube:
+		// Uploader IDs are channel IDs, handles or legacy usernames, depending on the channel's age
+		switch {
+		case strings.HasPrefix(channelID, "UC"):
+			return fmt.Sprintf("https://www.youtube.com/channel/%s", channelID), nil
+		case strings.HasPrefix(channelID, "@"):
+			return fmt.Sprintf("https://www.youtube.com/%s", channelID), nil
+		default:
+			return fmt.Sprintf("https://www.youtube.com/user/%s", channelID), nil
+		}
+
+	case proto.SupportedSite_niconico:
+		return fmt.Sprintf("https://www.nicovideo.jp/user/%s", channelID), nil
+
+	case proto.SupportedSite_bilibili:
+		return fmt.Sprintf("https://space.bilibili.com/%s", channelID), nil
+
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "no channel implementation for website %s", website)
+	}
+}
+
+func (s schedulerServer) GetChannelDescription(ctx context.Context, req *proto.ChannelDescriptionRequest) (*proto.ChannelDescription, error) {
+	if req.ChannelID == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+
+	url, err := channelURL(req.Website, req.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The channel's metadata is all we need, so skip resolving its videos
+	args := []string{"/scheduler/youtube-dl/youtube_dl/__main__.py",
+		"-J",
+		"--flat-playlist",
+		"--playlist-end", "1",
+	}
+	if s.SocksConnStr != "" {
+		args = append(args, "--proxy", s.SocksConnStr)
+	}
+	args = append(args, url)
+
+	cmd := exec.CommandContext(ctx, "/usr/bin/python3", args...)
+	payload, err := cmd.Output()
+	if err != nil {
+		log.Errorf("Command `%s` finished with err %s", cmd, err)
+		return nil, status.Errorf(codes.Unavailable, "could not fetch channel %s", url)
+	}
+
+	var channel struct {
+		Description string `json:"description"`
+	}
+	if err = json.Unmarshal(payload, &channel); err != nil {
+		log.Errorf("Failed to unmarshal channel metadata. Payload: %s. Err: %s", payload, err)
+		return nil, err
+	}
+
+	return &proto.ChannelDescription{Description: channel.Description}, nil
+}
@@ -8,24 +8,35 @@ import (
 	"net"
 
 	proto "github.com/horahoradev/horahora/scheduler/protocol"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
 	"github.com/jmoiron/sqlx"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type schedulerServer struct {
 	proto.UnimplementedSchedulerServer
-	M *models.ArchiveRequestRepo
+	M            *models.ArchiveRequestRepo
+	SocksConnStr string
+	UserClient   userproto.UserServiceClient
+	// Past this many archive requests, users need the archive.unlimited permission
+	MaxArchiveRequests int
 }
 
-func NewGRPCServer(ctx context.Context, conn *sqlx.DB, rs *redsync.Redsync, port int) error {
-	schedulerServer := initializeSchedulerServer(conn, rs)
+func NewGRPCServer(ctx context.Context, conn *sqlx.DB, rs *redsync.Redsync, socksConnStr string,
+	userClient userproto.UserServiceClient, maxArchiveRequests int, port int) error {
+	schedulerServer := initializeSchedulerServer(conn, rs, socksConnStr, userClient, maxArchiveRequests)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return err
 	}
 
-	serv := grpc.NewServer()
+	serv := grpc.NewServer(grpc.UnaryInterceptor(
+		permissions.CallerInterceptor(verifier.New(verifier.GRPCKeySource(userClient)))))
 	proto.RegisterSchedulerServer(serv, schedulerServer)
 
 	go func() {
@@ -36,15 +47,47 @@ func NewGRPCServer(ctx context.Context, conn *sqlx.DB, rs *redsync.Redsync, port
 	return serv.Serve(lis)
 }
 
-func initializeSchedulerServer(conn *sqlx.DB, rs *redsync.Redsync) schedulerServer {
+func initializeSchedulerServer(conn *sqlx.DB, rs *redsync.Redsync, socksConnStr string,
+	userClient userproto.UserServiceClient, maxArchiveRequests int) schedulerServer {
 	return schedulerServer{
-		M: models.NewArchiveRequest(conn, rs),
+		M:                  models.NewArchiveRequest(conn, rs),
+		SocksConnStr:       socksConnStr,
+		UserClient:         userClient,
+		MaxArchiveRequests: maxArchiveRequests,
 	}
 }
 
+// checkArchiveLimit refuses the user's request if they're at the limit of archive requests, unless they're the caller
+// and have the archive.unlimited permission
+func (s schedulerServer) checkArchiveLimit(ctx context.Context, userID int64) error {
+	count, err := s.M.CountArchivalRequests(userID)
+	if err != nil {
+		return err
+	}
+
+	if count < s.MaxArchiveRequests {
+		return nil
+	}
+
+	if err = permissions.RequireCaller(ctx, userID); err != nil {
+		return err
+	}
+
+	err = permissions.Require(ctx, s.UserClient, userID, permissions.ArchiveUnlimited)
+	if status.Code(err) == codes.PermissionDenied {
+		return status.Errorf(codes.ResourceExhausted, "at most %d archive requests are allowed", s.MaxArchiveRequests)
+	}
+
+	return err
+}
+
 func (s schedulerServer) DlChannel(ctx context.Context, req *proto.ChannelRequest) (*proto.Empty, error) {
 	ret := &proto.Empty{}
 
+	if err := s.checkArchiveLimit(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
 	err := s.M.New(models.Channel, string(req.ChannelID), req.Website, req.UserID)
 
 	return ret, err
@@ -53,7 +96,16 @@ func (s schedulerServer) DlChannel(ctx context.Context, req *proto.ChannelReques
 func (s schedulerServer) DlPlaylist(ctx context.Context, req *proto.PlaylistRequest) (*proto.Empty, error) {
 	ret := &proto.Empty{}
 
-	err := s.M.New(models.Playlist, req.PlaylistID, req.Website, req.UserID)
+	if err := s.checkArchiveLimit(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if req.Mirror {
+		err = s.M.NewMirroredPlaylist(req.PlaylistID, req.Website, req.UserID)
+	} else {
+		err = s.M.New(models.Playlist, req.PlaylistID, req.Website, req.UserID)
+	}
 
 	return ret, err
 }
@@ -61,6 +113,10 @@ func (s schedulerServer) DlPlaylist(ctx context.Context, req *proto.PlaylistRequ
 func (s schedulerServer) DlTag(ctx context.Context, req *proto.TagRequest) (*proto.Empty, error) {
 	ret := &proto.Empty{}
 
+	if err := s.checkArchiveLimit(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
 	err := s.M.New(models.Tag, req.TagValue, req.Website, req.UserID)
 
 	return ret, err
@@ -24,7 +24,7 @@ func init() {
 		log.Fatal(err)
 	}
 
-	s = initializeSchedulerServer(conf.Conn)
+	s = initializeSchedulerServer(conf.Conn, conf.Redlock, conf.SocksConnStr, conf.UserClient, conf.MaxArchiveRequests)
 }
 
 func TestTagDlNiconico(t *testing.T) {
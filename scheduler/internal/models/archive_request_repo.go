@@ -57,7 +57,24 @@ func (m *ArchiveRequestRepo) GetContentArchivalRequests(userID int64) ([]Content
 	return archivalRequests, nil
 }
 
+// CountArchivalRequests returns the number of the user's archive requests
+func (m *ArchiveRequestRepo) CountArchivalRequests(userID int64) (int, error) {
+	var count int
+	err := m.Db.Get(&count, "SELECT count(*) FROM user_download_subscriptions WHERE user_id = $1", userID)
+	return count, err
+}
+
 func (m *ArchiveRequestRepo) New(contentType contentType, contentValue string, website proto.SupportedSite, userID int64) error {
+	return m.newRequest(contentType, contentValue, website, userID, false)
+}
+
+// NewMirroredPlaylist requests a playlist, and also mirrors it as a horahora playlist owned by the user
+func (m *ArchiveRequestRepo) NewMirroredPlaylist(playlistID string, website proto.SupportedSite, userID int64) error {
+	return m.newRequest(Playlist, playlistID, website, userID, true)
+}
+
+func (m *ArchiveRequestRepo) newRequest(contentType contentType, contentValue string, website proto.SupportedSite,
+	userID int64, mirrorPlaylist bool) error {
 	tx, err := m.Db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return err
@@ -73,7 +90,11 @@ func (m *ArchiveRequestRepo) New(contentType contentType, contentValue string, w
 		return err
 	}
 
-	_, err = tx.Exec("INSERT INTO user_download_subscriptions (user_id, download_id) VALUES ($1, $2)", userID, downloadID)
+	// Requesting the same content again can turn on mirroring, but not off
+	_, err = tx.Exec("INSERT INTO user_download_subscriptions (user_id, download_id, mirror_playlist) VALUES ($1, $2, $3) "+
+		"ON CONFLICT (user_id, download_id) DO UPDATE "+
+		"SET mirror_playlist = user_download_subscriptions.mirror_playlist OR EXCLUDED.mirror_playlist",
+		userID, downloadID, mirrorPlaylist)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -132,6 +132,14 @@ func (v *CategoryDLRequest) ReportSyncMiss() error {
 	return nil
 }
 
+// GetPlaylistMirrorUserIDs returns the users who requested this playlist with the mirror option
+func (v *CategoryDLRequest) GetPlaylistMirrorUserIDs() ([]int64, error) {
+	var userIDs []int64
+	sql := "SELECT user_id FROM user_download_subscriptions WHERE download_id = $1 AND mirror_playlist"
+	err := v.Db.Select(&userIDs, sql, v.Id)
+	return userIDs, err
+}
+
 // Idempotent, ensures that videos are added and correct associations are created
 // returns bool indicating whether something was added
 func (v *CategoryDLRequest) AddVideo(videoID, url string) (bool, error) {
@@ -162,6 +170,15 @@ func (v *CategoryDLRequest) AddVideo(videoID, url string) (bool, error) {
 		return false, err
 	}
 
+	// New videos mean the request is no longer complete, so subscribers should hear about it finishing again
+	if rowsAffected >= 1 {
+		_, err = tx.Exec("UPDATE downloads SET notified_status = $1 WHERE id = $2", notificationPending, v.Id)
+		if err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	proto "github.com/horahoradev/horahora/scheduler/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+)
+
+// Values of downloads.notified_status
+const (
+	notificationPending = 0
+	notifiedCompleted   = 1
+	notifiedSyncFailed  = 2
+)
+
+// Notification kinds, as understood by video_service
+const (
+	NotificationArchiveCompleted = "archive_request_completed"
+	NotificationArchiveFailed    = "archive_request_failed"
+)
+
+// ArchiveNotification is a message to be sent to every user subscribed to an archive request
+type ArchiveNotification struct {
+	UserIDs []int64
+	Kind    string
+	Message string
+}
+
+// Send delivers the notification through video_service, which stores notifications for all services
+func (n ArchiveNotification) Send(ctx context.Context, client videoproto.VideoServiceClient) error {
+	if len(n.UserIDs) == 0 {
+		return nil
+	}
+
+	_, err := client.AddNotification(ctx, &videoproto.NotificationCreation{
+		UserIDs: n.UserIDs,
+		Kind:    n.Kind,
+		Message: n.Message,
+	})
+	return err
+}
+
+func describeRequest(r ContentArchivalRequest) string {
+	return fmt.Sprintf("%s %s %s", proto.SupportedSite_name[int32(r.Website)], r.ContentType, r.ContentValue)
+}
+
+func getSubscriberIDs(db *sqlx.DB, downloadID string) ([]int64, error) {
+	var userIDs []int64
+	err := db.Select(&userIDs, "SELECT user_id FROM user_download_subscriptions WHERE download_id = $1", downloadID)
+	return userIDs, err
+}
+
+// ClaimCompletedRequests returns notifications for each archive request containing this video which has no videos
+// left to download. Each request is only returned once until new videos are added to it.
+func (v *VideoDLRequest) ClaimCompletedRequests() ([]ArchiveNotification, error) {
+	sql := "UPDATE downloads SET notified_status = $1 WHERE notified_status != $1 " +
+		"AND id IN (SELECT download_id FROM downloads_to_videos WHERE video_id = $2) " +
+		"AND NOT EXISTS (SELECT 1 FROM downloads_to_videos dv INNER JOIN videos ON videos.id = dv.video_id " +
+		"WHERE dv.download_id = downloads.id AND videos.dlStatus = 0) " +
+		"RETURNING id, website, attribute_type, attribute_value, " +
+		"(SELECT count(*) FROM downloads_to_videos dv INNER JOIN videos ON videos.id = dv.video_id " +
+		"WHERE dv.download_id = downloads.id AND videos.dlStatus = 1), " +
+		"(SELECT count(*) FROM downloads_to_videos dv INNER JOIN videos ON videos.id = dv.video_id " +
+		"WHERE dv.download_id = downloads.id AND videos.dlStatus = 2)"
+	rows, err := v.Db.Query(sql, notifiedCompleted, v.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type completedRequest struct {
+		ContentArchivalRequest
+		id                    string
+		numArchived, numFails int
+	}
+
+	var completed []completedRequest
+	for rows.Next() {
+		var r completedRequest
+		err = rows.Scan(&r.id, &r.Website, &r.ContentType, &r.ContentValue, &r.numArchived, &r.numFails)
+		if err != nil {
+			return nil, err
+		}
+		completed = append(completed, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var notifications []ArchiveNotification
+	for _, r := range completed {
+		userIDs, err := getSubscriberIDs(v.Db, r.id)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, ArchiveNotification{
+			UserIDs: userIDs,
+			Kind:    NotificationArchiveCompleted,
+			Message: fmt.Sprintf("Your archive request for %s has finished: %d videos archived, %d failed",
+				describeRequest(r.ContentArchivalRequest), r.numArchived, r.numFails),
+		})
+	}
+
+	return notifications, nil
+}
+
+// ClaimSyncFailure returns a notification for a failed sync of this archive request, or nil if subscribers have
+// already been told about it.
+func (v *CategoryDLRequest) ClaimSyncFailure(syncErr error) (*ArchiveNotification, error) {
+	res, err := v.Db.Exec("UPDATE downloads SET notified_status = $1 WHERE id = $2 AND notified_status != $1",
+		notifiedSyncFailed, v.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil || rowsAffected == 0 {
+		return nil, err
+	}
+
+	userIDs, err := getSubscriberIDs(v.Db, v.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArchiveNotification{
+		UserIDs: userIDs,
+		Kind:    NotificationArchiveFailed,
+		Message: fmt.Sprintf("Your archive request for %s could not be synced: %s",
+			describeRequest(v.ContentArchivalRequest), syncErr),
+	}, nil
+}
+
+// ClearSyncFailure allows a later sync failure to be announced again once a sync has succeeded
+func (v *CategoryDLRequest) ClearSyncFailure() error {
+	_, err := v.Db.Exec("UPDATE downloads SET notified_status = $1 WHERE id = $2 AND notified_status = $3",
+		notificationPending, v.Id, notifiedSyncFailed)
+	return err
+}
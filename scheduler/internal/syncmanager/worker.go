@@ -1,6 +1,7 @@
 package syncmanager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,17 +11,21 @@ import (
 
 	"github.com/horahoradev/horahora/scheduler/internal/models"
 	proto "github.com/horahoradev/horahora/scheduler/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	log "github.com/sirupsen/logrus"
 )
 
 type SyncWorker struct {
 	R            *models.ArchiveRequestRepo
+	VideoClient  videoproto.VideoServiceClient
 	SocksConnStr string
 	SyncDelay    time.Duration
 }
 
-func NewWorker(r *models.ArchiveRequestRepo, socksConnStr string, syncDelay time.Duration) (*SyncWorker, error) {
+func NewWorker(r *models.ArchiveRequestRepo, videoClient videoproto.VideoServiceClient, socksConnStr string,
+	syncDelay time.Duration) (*SyncWorker, error) {
 	return &SyncWorker{R: r,
+		VideoClient:  videoClient,
 		SocksConnStr: socksConnStr,
 		SyncDelay:    syncDelay}, nil
 }
@@ -41,9 +46,14 @@ func (s *SyncWorker) Sync() error {
 			itemsAdded, err := s.syncDownloadList(&dlReq)
 			if err != nil {
 				log.Errorf("Sync worker dl list: %s", err)
+				s.notifySyncFailure(&dlReq, err)
 				continue
 			}
 
+			if err = dlReq.ClearSyncFailure(); err != nil {
+				log.Errorf("Sync worker clear sync failure: %s", err)
+			}
+
 			if itemsAdded {
 				err = dlReq.ReportSyncHit()
 				if err != nil {
@@ -63,6 +73,23 @@ func (s *SyncWorker) Sync() error {
 	}
 }
 
+// notifySyncFailure tells the request's subscribers that it couldn't be synced, once per run of failures
+func (s *SyncWorker) notifySyncFailure(dlReq *models.CategoryDLRequest, syncErr error) {
+	notification, err := dlReq.ClaimSyncFailure(syncErr)
+	if err != nil {
+		log.Errorf("Could not claim sync failure notification for %s. Err: %s", dlReq.Id, err)
+		return
+	}
+
+	if notification == nil {
+		return
+	}
+
+	if err = notification.Send(context.TODO(), s.VideoClient); err != nil {
+		log.Errorf("Could not send sync failure notification for %s. Err: %s", dlReq.Id, err)
+	}
+}
+
 func (s *SyncWorker) syncDownloadList(dlReq *models.CategoryDLRequest) (bool, error) {
 	videos, err := s.getDownloadList(dlReq)
 	if err != nil {
@@ -83,9 +110,100 @@ func (s *SyncWorker) syncDownloadList(dlReq *models.CategoryDLRequest) (bool, er
 		}
 	}
 
+	if dlReq.ContentType == models.Playlist {
+		if err = s.syncMirroredPlaylists(dlReq, videos); err != nil {
+			log.Errorf("Could not sync mirrored playlist %s. Err: %s", dlReq.ContentValue, err)
+		}
+	}
+
 	return newItemsAdded, nil
 }
 
+// syncMirroredPlaylists updates the horahora mirrors of the playlist for each user who asked for one. Videos are
+// matched up with the playlist once they've been archived.
+func (s *SyncWorker) syncMirroredPlaylists(dlReq *models.CategoryDLRequest, videos []VideoJSON) error {
+	userIDs, err := dlReq.GetPlaylistMirrorUserIDs()
+	if err != nil {
+		return err
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	title, err := s.getPlaylistTitle(dlReq)
+	if err != nil {
+		return err
+	}
+
+	// The download list is reversed, see getDownloadList
+	foreignVideoIDs := make([]string, len(videos))
+	for i, video := range videos {
+		foreignVideoIDs[len(videos)-1-i] = video.ID
+	}
+
+	for _, userID := range userIDs {
+		_, err = s.VideoClient.SyncMirroredPlaylist(context.TODO(), &videoproto.MirroredPlaylistSync{
+			UserID:          userID,
+			Site:            videoproto.Website(dlReq.Website),
+			PlaylistID:      dlReq.ContentValue,
+			Title:           title,
+			ForeignVideoIDs: foreignVideoIDs,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getPlaylistTitle fetches the playlist's title, which isn't in the flat download list
+func (s *SyncWorker) getPlaylistTitle(dlReq *models.CategoryDLRequest) (string, error) {
+	url, err := playlistURL(dlReq)
+	if err != nil {
+		return "", err
+	}
+
+	// The playlist's metadata is all we need, so skip resolving its videos
+	args := []string{"/scheduler/youtube-dl/youtube_dl/__main__.py",
+		"-J",
+		"--flat-playlist",
+		"--playlist-end", "1",
+	}
+	if s.SocksConnStr != "" {
+		args = append(args, "--proxy", s.SocksConnStr)
+	}
+	args = append(args, url)
+
+	cmd := exec.Command("/usr/bin/python3", args...)
+	payload, err := cmd.Output()
+	if err != nil {
+		log.Errorf("Command `%s` finished with err %s", cmd, err)
+		return "", err
+	}
+
+	var playlist struct {
+		Title string `json:"title"`
+	}
+	if err = json.Unmarshal(payload, &playlist); err != nil {
+		log.Errorf("Failed to unmarshal playlist metadata. Payload: %s. Err: %s", payload, err)
+		return "", err
+	}
+
+	return playlist.Title, nil
+}
+
+func playlistURL(dlReq *models.CategoryDLRequest) (string, error) {
+	switch dlReq.Website {
+	case proto.SupportedSite_youtube:
+		return fmt.Sprintf("https://www.youtube.com/playlist?list=%s", dlReq.ContentValue), nil
+
+	default:
+		return "", fmt.Errorf("content type %s is not implemented for website %s", dlReq.ContentType, dlReq.Website)
+	}
+}
+
 type VideoJSON struct {
 	Type  string `json:"_type"`
 	URL   string `json:"url"`
@@ -207,6 +325,15 @@ func (s *SyncWorker) getVideoListString(dlReq *models.CategoryDLRequest) ([]stri
 			log.Infof("Downloading videos from youtube user %s", dlReq.ContentValue)
 			args = append(args, fmt.Sprintf("https://www.youtube.com/channel/%s", dlReq.ContentValue))
 
+		case models.Playlist:
+			// Listed in playlist order
+			log.Infof("Downloading videos from youtube playlist %s", dlReq.ContentValue)
+			url, err := playlistURL(dlReq)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, url)
+
 		default:
 			err := fmt.Errorf("content type %s is not implemented for youtube.", dlReq.ContentType)
 			return nil, err
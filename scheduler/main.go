@@ -25,6 +25,7 @@ func main() {
 	}
 
 	defer cfg.GRPCConn.Close()
+	defer cfg.UserConn.Close()
 
 	ctx, close := context.WithCancel(context.Background())
 
@@ -80,7 +81,7 @@ func main() {
 
 		repo := models.NewArchiveRequest(cfg.Conn, cfg.Redlock)
 
-		worker, err := syncmanager.NewWorker(repo, cfg.SocksConnStr, cfg.SyncPollDelay)
+		worker, err := syncmanager.NewWorker(repo, cfg.Client, cfg.SocksConnStr, cfg.SyncPollDelay)
 		if err != nil {
 			log.Errorf("Sync worker exited wth err: %s", err)
 		}
@@ -95,7 +96,7 @@ func main() {
 	go func() {
 		defer wg.Done()
 
-		err := grpcserver.NewGRPCServer(ctx, cfg.Conn, cfg.Redlock, 7777)
+		err := grpcserver.NewGRPCServer(ctx, cfg.Conn, cfg.Redlock, cfg.SocksConnStr, cfg.UserClient, cfg.MaxArchiveRequests, 7777)
 		if err != nil {
 			log.Error(err)
 		}
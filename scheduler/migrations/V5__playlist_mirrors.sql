@@ -0,0 +1,2 @@
+-- Users who requested a playlist with the mirror option get a horahora playlist kept in sync with the original
+ALTER TABLE user_download_subscriptions ADD COLUMN mirror_playlist bool NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+-- Tracks which notification was last sent to subscribers, so each completion or failure is only announced once
+ALTER TABLE downloads ADD COLUMN notified_status int NOT NULL DEFAULT 0;
+/*
+    0: nothing sent since videos were last added
+    1: completion sent
+    2: sync failure sent
+ */
@@ -280,10 +280,97 @@ func (m *ChannelRequest) GetChannelID() string {
 	return ""
 }
 
+type ChannelDescriptionRequest struct {
+	Website              SupportedSite `protobuf:"varint,1,opt,name=website,proto3,enum=proto.SupportedSite" json:"website,omitempty"`
+	ChannelID            string        `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelDescriptionRequest) Reset()         { *m = ChannelDescriptionRequest{} }
+func (m *ChannelDescriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelDescriptionRequest) ProtoMessage()    {}
+func (*ChannelDescriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{5}
+}
+
+func (m *ChannelDescriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDescriptionRequest.Unmarshal(m, b)
+}
+func (m *ChannelDescriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDescriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *ChannelDescriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDescriptionRequest.Merge(m, src)
+}
+func (m *ChannelDescriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelDescriptionRequest.Size(m)
+}
+func (m *ChannelDescriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDescriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDescriptionRequest proto.InternalMessageInfo
+
+func (m *ChannelDescriptionRequest) GetWebsite() SupportedSite {
+	if m != nil {
+		return m.Website
+	}
+	return SupportedSite_niconico
+}
+
+func (m *ChannelDescriptionRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+type ChannelDescription struct {
+	Description          string   `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelDescription) Reset()         { *m = ChannelDescription{} }
+func (m *ChannelDescription) String() string { return proto.CompactTextString(m) }
+func (*ChannelDescription) ProtoMessage()    {}
+func (*ChannelDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{6}
+}
+
+func (m *ChannelDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDescription.Unmarshal(m, b)
+}
+func (m *ChannelDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDescription.Marshal(b, m, deterministic)
+}
+func (m *ChannelDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDescription.Merge(m, src)
+}
+func (m *ChannelDescription) XXX_Size() int {
+	return xxx_messageInfo_ChannelDescription.Size(m)
+}
+func (m *ChannelDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDescription proto.InternalMessageInfo
+
+func (m *ChannelDescription) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type PlaylistRequest struct {
 	Website              SupportedSite `protobuf:"varint,1,opt,name=website,proto3,enum=proto.SupportedSite" json:"website,omitempty"`
 	UserID               int64         `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	PlaylistID           string        `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Mirror               bool          `protobuf:"varint,4,opt,name=mirror,proto3" json:"mirror,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -293,7 +380,7 @@ func (m *PlaylistRequest) Reset()         { *m = PlaylistRequest{} }
 func (m *PlaylistRequest) String() string { return proto.CompactTextString(m) }
 func (*PlaylistRequest) ProtoMessage()    {}
 func (*PlaylistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b3fc28395a6d9c5, []int{5}
+	return fileDescriptor_2b3fc28395a6d9c5, []int{7}
 }
 
 func (m *PlaylistRequest) XXX_Unmarshal(b []byte) error {
@@ -335,6 +422,13 @@ func (m *PlaylistRequest) GetPlaylistID() string {
 	return ""
 }
 
+func (m *PlaylistRequest) GetMirror() bool {
+	if m != nil {
+		return m.Mirror
+	}
+	return false
+}
+
 type TagRequest struct {
 	Website              SupportedSite `protobuf:"varint,1,opt,name=website,proto3,enum=proto.SupportedSite" json:"website,omitempty"`
 	UserID               int64         `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -348,7 +442,7 @@ func (m *TagRequest) Reset()         { *m = TagRequest{} }
 func (m *TagRequest) String() string { return proto.CompactTextString(m) }
 func (*TagRequest) ProtoMessage()    {}
 func (*TagRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b3fc28395a6d9c5, []int{6}
+	return fileDescriptor_2b3fc28395a6d9c5, []int{8}
 }
 
 func (m *TagRequest) XXX_Unmarshal(b []byte) error {
@@ -397,6 +491,8 @@ func init() {
 	proto.RegisterType((*ListArchivalEntriesResponse)(nil), "proto.listArchivalEntriesResponse")
 	proto.RegisterType((*ContentArchivalEntry)(nil), "proto.contentArchivalEntry")
 	proto.RegisterType((*ChannelRequest)(nil), "proto.ChannelRequest")
+	proto.RegisterType((*ChannelDescriptionRequest)(nil), "proto.ChannelDescriptionRequest")
+	proto.RegisterType((*ChannelDescription)(nil), "proto.ChannelDescription")
 	proto.RegisterType((*PlaylistRequest)(nil), "proto.PlaylistRequest")
 	proto.RegisterType((*TagRequest)(nil), "proto.TagRequest")
 }
@@ -404,34 +500,38 @@ func init() {
 func init() { proto.RegisterFile("scheduler.proto", fileDescriptor_2b3fc28395a6d9c5) }
 
 var fileDescriptor_2b3fc28395a6d9c5 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x3a, 0x24, 0xae, 0x27, 0x51, 0x1a, 0x16, 0x88, 0x2c, 0x17, 0x21, 0xb3, 0x27, 0xab,
-	0x87, 0x22, 0x99, 0x72, 0xe0, 0x88, 0x68, 0x0f, 0xbd, 0x21, 0x63, 0x38, 0x82, 0xfc, 0x31, 0x4a,
-	0x2c, 0x2d, 0xb6, 0xeb, 0x5d, 0x53, 0x99, 0x1b, 0x3f, 0x86, 0xff, 0x89, 0x9c, 0x5d, 0x27, 0x31,
-	0x49, 0x50, 0x0f, 0x39, 0xac, 0xac, 0x79, 0xf3, 0x66, 0xde, 0xec, 0x5b, 0x0f, 0x9c, 0x8b, 0x64,
-	0x85, 0x69, 0xcd, 0xb1, 0xba, 0x2a, 0xab, 0x42, 0x16, 0x74, 0xb4, 0xfe, 0x30, 0x13, 0x46, 0xb7,
-	0x3f, 0x4a, 0xd9, 0xb0, 0x6b, 0x70, 0x78, 0x26, 0xe4, 0x87, 0x2a, 0x59, 0x65, 0x3f, 0x23, 0x7e,
-	0x9b, 0xcb, 0x2a, 0x43, 0x11, 0xe0, 0x7d, 0x8d, 0x42, 0xd2, 0x05, 0x8c, 0xbf, 0x08, 0xac, 0xee,
-	0x6e, 0x6c, 0xe2, 0x12, 0x6f, 0x18, 0xe8, 0x88, 0x85, 0x70, 0x71, 0xb0, 0x4a, 0x94, 0x45, 0x2e,
-	0x90, 0xbe, 0x03, 0x13, 0x15, 0x64, 0x13, 0x77, 0xe8, 0x4d, 0xfc, 0x0b, 0xa5, 0x7e, 0x95, 0x14,
-	0xb9, 0xc4, 0xbc, 0x57, 0xd7, 0x04, 0x1d, 0x97, 0xfd, 0x21, 0xf0, 0xfc, 0x10, 0xe3, 0xd8, 0x18,
-	0xf4, 0x0d, 0x98, 0x0f, 0x18, 0x8b, 0x4c, 0xa2, 0x6d, 0xb8, 0xc4, 0x9b, 0xf9, 0x2f, 0xb4, 0x8e,
-	0xa8, 0xcb, 0xb2, 0xa8, 0x24, 0xa6, 0xdf, 0xdb, 0x64, 0xd0, 0xb1, 0xa8, 0x0b, 0x13, 0x2d, 0x10,
-	0x36, 0x25, 0xda, 0x43, 0x97, 0x78, 0x56, 0xb0, 0x0b, 0x51, 0x06, 0x53, 0x1d, 0x7e, 0x8d, 0x78,
-	0x8d, 0xf6, 0x93, 0x35, 0xa5, 0x87, 0xb1, 0x07, 0x98, 0x7d, 0x5c, 0x45, 0x79, 0x8e, 0xbc, 0xf3,
-	0x69, 0x67, 0x10, 0xf2, 0xa8, 0x41, 0x16, 0x30, 0xae, 0xd5, 0x8d, 0x0c, 0x75, 0x23, 0x15, 0xd1,
-	0x97, 0x60, 0x25, 0xaa, 0xf5, 0xdd, 0x8d, 0x1e, 0x6f, 0x0b, 0xb0, 0x5f, 0x70, 0xfe, 0x89, 0x47,
-	0x4d, 0x6b, 0xfd, 0xc9, 0x95, 0x5f, 0x01, 0x94, 0xba, 0xf7, 0x46, 0x7a, 0x07, 0x61, 0xf7, 0x00,
-	0x61, 0xb4, 0x3c, 0xb9, 0xac, 0x03, 0x67, 0x32, 0x5a, 0x2a, 0xaf, 0x95, 0xe8, 0x26, 0xbe, 0x7c,
-	0x0f, 0xb3, 0x7e, 0x3b, 0x3a, 0x85, 0xb3, 0x3c, 0x4b, 0x8a, 0xf6, 0xcc, 0x07, 0x6d, 0x14, 0x67,
-	0x3c, 0x6b, 0xcf, 0x9c, 0xd0, 0x09, 0x98, 0x4d, 0x51, 0xcb, 0x3a, 0xc6, 0xb9, 0xe1, 0xff, 0x36,
-	0xc0, 0xfa, 0xdc, 0xfd, 0xfa, 0xd4, 0x07, 0x2b, 0xe5, 0xfa, 0xc9, 0x68, 0x37, 0x69, 0xff, 0x09,
-	0x9d, 0xa9, 0x86, 0xd5, 0x5a, 0x0c, 0xe8, 0x35, 0x40, 0xca, 0x3b, 0xb7, 0xe9, 0x42, 0x67, 0xff,
-	0xb1, 0x7f, 0xaf, 0xea, 0x12, 0x46, 0x29, 0x0f, 0xa3, 0x25, 0x7d, 0xaa, 0x13, 0x5b, 0xcf, 0xf6,
-	0xb8, 0xdf, 0xe0, 0xd9, 0x81, 0x25, 0xa2, 0xaf, 0x35, 0xed, 0xf8, 0x5a, 0x3a, 0xec, 0x7f, 0x14,
-	0xb5, 0x83, 0x6c, 0x10, 0x8f, 0xd7, 0xa4, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xd0,
-	0xde, 0xe6, 0x04, 0x04, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0x52, 0xfa, 0x93, 0xd3, 0xaa, 0x2b, 0x86, 0x55, 0x59, 0x86, 0x50, 0xf0, 0x55, 0xb5,
+	0x8b, 0x21, 0x95, 0x81, 0xc4, 0x25, 0xa2, 0xbb, 0xd8, 0x1d, 0x0a, 0x65, 0x97, 0xa0, 0x34, 0x39,
+	0x6a, 0x8d, 0xbc, 0x24, 0xb3, 0x1d, 0xa6, 0xbc, 0x06, 0xef, 0xc0, 0x43, 0xf1, 0x36, 0x28, 0xb1,
+	0xb3, 0x36, 0x6b, 0x8b, 0x10, 0xda, 0x45, 0x14, 0x9d, 0xcf, 0xdf, 0xf1, 0xf7, 0xf9, 0x9c, 0x63,
+	0xc3, 0x91, 0x8c, 0xd6, 0x18, 0xe7, 0x1c, 0xc5, 0x79, 0x26, 0x52, 0x95, 0x92, 0x4e, 0xf5, 0xa3,
+	0x3d, 0xe8, 0x5c, 0xde, 0x64, 0xaa, 0xa0, 0x17, 0xe0, 0x71, 0x26, 0xd5, 0x07, 0x11, 0xad, 0xd9,
+	0x8f, 0x90, 0x5f, 0x26, 0x4a, 0x30, 0x94, 0x01, 0xde, 0xe6, 0x28, 0x15, 0x99, 0x40, 0xf7, 0x8b,
+	0x44, 0x71, 0x35, 0x77, 0x2d, 0xdf, 0x9a, 0xb6, 0x03, 0x13, 0xd1, 0x05, 0x9c, 0xee, 0xcd, 0x92,
+	0x59, 0x9a, 0x48, 0x24, 0x6f, 0xa1, 0x87, 0x1a, 0x72, 0x2d, 0xbf, 0x3d, 0x1d, 0xcc, 0x4e, 0xb5,
+	0xfa, 0x79, 0x94, 0x26, 0x0a, 0x93, 0x46, 0x5e, 0x11, 0xd4, 0x5c, 0xfa, 0xcb, 0x82, 0xe7, 0xfb,
+	0x18, 0x87, 0x6c, 0x90, 0xd7, 0xd0, 0xbb, 0xc3, 0xa5, 0x64, 0x0a, 0x5d, 0xdb, 0xb7, 0xa6, 0xa3,
+	0xd9, 0xb1, 0xd1, 0x91, 0x79, 0x96, 0xa5, 0x42, 0x61, 0xfc, 0xad, 0x5c, 0x0c, 0x6a, 0x16, 0xf1,
+	0x61, 0x60, 0x04, 0x16, 0x45, 0x86, 0x6e, 0xdb, 0xb7, 0xa6, 0x4e, 0xb0, 0x0d, 0x11, 0x0a, 0x43,
+	0x13, 0x5e, 0x87, 0x3c, 0x47, 0xf7, 0x49, 0x45, 0x69, 0x60, 0xf4, 0x0e, 0x46, 0x1f, 0xd7, 0x61,
+	0x92, 0x20, 0xaf, 0xeb, 0xb4, 0x65, 0xc4, 0xfa, 0x27, 0x23, 0x13, 0xe8, 0xe6, 0xfa, 0x44, 0xb6,
+	0x3e, 0x91, 0x8e, 0xc8, 0x0b, 0x70, 0x22, 0xbd, 0xf5, 0xd5, 0xdc, 0xd8, 0xdb, 0x00, 0xf4, 0x3b,
+	0x9c, 0x18, 0xe1, 0x39, 0xca, 0x48, 0xb0, 0x4c, 0xb1, 0x34, 0xf9, 0x6f, 0x0f, 0x0d, 0x2d, 0xfb,
+	0xa1, 0xd6, 0x3b, 0x20, 0xbb, 0x5a, 0x65, 0x01, 0xe3, 0x4d, 0x58, 0x09, 0x39, 0xc1, 0x36, 0x44,
+	0x7f, 0x5a, 0x70, 0xf4, 0x89, 0x87, 0x45, 0x39, 0x1f, 0x8f, 0x5e, 0x9e, 0x97, 0x00, 0x99, 0xd9,
+	0xfb, 0xbe, 0x3e, 0x5b, 0x48, 0x99, 0x77, 0xc3, 0x84, 0x48, 0x45, 0xd5, 0xb7, 0x7e, 0x60, 0x22,
+	0x7a, 0x0b, 0xb0, 0x08, 0x57, 0x8f, 0x6e, 0xc7, 0x83, 0xbe, 0x0a, 0x57, 0x7a, 0x50, 0xb4, 0x99,
+	0xfb, 0xf8, 0xec, 0x3d, 0x8c, 0x9a, 0xdb, 0x91, 0x21, 0xf4, 0x13, 0x16, 0xa5, 0xe5, 0x37, 0x6e,
+	0x95, 0xd1, 0x92, 0x71, 0x56, 0x7e, 0x63, 0x8b, 0x0c, 0xa0, 0x57, 0xa4, 0xb9, 0xca, 0x97, 0x38,
+	0xb6, 0x67, 0xbf, 0x6d, 0x70, 0x3e, 0xd7, 0xf7, 0x96, 0xcc, 0xc0, 0x89, 0xb9, 0x69, 0x05, 0xa9,
+	0x9d, 0x36, 0xe7, 0xcf, 0x1b, 0x1a, 0x58, 0xdf, 0xe9, 0x16, 0xb9, 0x00, 0x88, 0x79, 0xdd, 0x05,
+	0x32, 0x31, 0xab, 0x0f, 0xda, 0xb2, 0x93, 0x75, 0x06, 0x9d, 0x98, 0x2f, 0xc2, 0x15, 0x79, 0x6a,
+	0x16, 0x36, 0x35, 0xdb, 0xe1, 0x7e, 0x85, 0x67, 0x7b, 0x5e, 0x00, 0xf2, 0xca, 0xd0, 0x0e, 0xbf,
+	0x29, 0x1e, 0xfd, 0x1b, 0x45, 0x3f, 0x20, 0xb4, 0x45, 0xae, 0xe1, 0x78, 0x85, 0x6a, 0xdf, 0x04,
+	0x36, 0x2b, 0xb0, 0x7b, 0x11, 0xbc, 0x93, 0x83, 0x0c, 0xda, 0x5a, 0x76, 0xab, 0xb5, 0x37, 0x7f,
+	0x06, 0x00, 0x06, 0xa4, 0x1e, 0xac, 0x19, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -450,6 +550,8 @@ type SchedulerClient interface {
 	DlPlaylist(ctx context.Context, in *PlaylistRequest, opts ...grpc.CallOption) (*Empty, error)
 	DlTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListArchivalEntries(ctx context.Context, in *ListArchivalEntriesRequest, opts ...grpc.CallOption) (*ListArchivalEntriesResponse, error)
+	// Fetches the current description of a channel from its website, e.g. to check a claim code put there by its owner
+	GetChannelDescription(ctx context.Context, in *ChannelDescriptionRequest, opts ...grpc.CallOption) (*ChannelDescription, error)
 }
 
 type schedulerClient struct {
@@ -496,12 +598,23 @@ func (c *schedulerClient) ListArchivalEntries(ctx context.Context, in *ListArchi
 	return out, nil
 }
 
+func (c *schedulerClient) GetChannelDescription(ctx context.Context, in *ChannelDescriptionRequest, opts ...grpc.CallOption) (*ChannelDescription, error) {
+	out := new(ChannelDescription)
+	err := c.cc.Invoke(ctx, "/proto.Scheduler/getChannelDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerServer is the server API for Scheduler service.
 type SchedulerServer interface {
 	DlChannel(context.Context, *ChannelRequest) (*Empty, error)
 	DlPlaylist(context.Context, *PlaylistRequest) (*Empty, error)
 	DlTag(context.Context, *TagRequest) (*Empty, error)
 	ListArchivalEntries(context.Context, *ListArchivalEntriesRequest) (*ListArchivalEntriesResponse, error)
+	// Fetches the current description of a channel from its website, e.g. to check a claim code put there by its owner
+	GetChannelDescription(context.Context, *ChannelDescriptionRequest) (*ChannelDescription, error)
 }
 
 // UnimplementedSchedulerServer can be embedded to have forward compatible implementations.
@@ -520,6 +633,9 @@ func (*UnimplementedSchedulerServer) DlTag(ctx context.Context, req *TagRequest)
 func (*UnimplementedSchedulerServer) ListArchivalEntries(ctx context.Context, req *ListArchivalEntriesRequest) (*ListArchivalEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArchivalEntries not implemented")
 }
+func (*UnimplementedSchedulerServer) GetChannelDescription(ctx context.Context, req *ChannelDescriptionRequest) (*ChannelDescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelDescription not implemented")
+}
 
 func RegisterSchedulerServer(s *grpc.Server, srv SchedulerServer) {
 	s.RegisterService(&_Scheduler_serviceDesc, srv)
@@ -597,6 +713,24 @@ func _Scheduler_ListArchivalEntries_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_GetChannelDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetChannelDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Scheduler/GetChannelDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetChannelDescription(ctx, req.(*ChannelDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Scheduler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Scheduler",
 	HandlerType: (*SchedulerServer)(nil),
@@ -617,6 +751,10 @@ var _Scheduler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "listArchivalEntries",
 			Handler:    _Scheduler_ListArchivalEntries_Handler,
 		},
+		{
+			MethodName: "getChannelDescription",
+			Handler:    _Scheduler_GetChannelDescription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "scheduler.proto",
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurin/blazer/b2"
 	"io"
 	"os"
@@ -62,6 +63,10 @@ func (s *B2Storage) Fetch(id string) (*os.File, error) {
 	r.ConcurrentDownloads = 1
 	if _, err := io.Copy(f, r); err != nil {
 		f.Close()
+		os.Remove(f.Name())
+		if b2.IsNotExist(err) {
+			return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+		}
 		return nil, err
 	}
 
@@ -83,3 +88,11 @@ func (s *B2Storage) Upload(path, desiredFilename string) error {
 	}
 	return w.Close()
 }
+
+func (s *B2Storage) Delete(id string) error {
+	err := s.Bucket.Object(id).Delete(context.Background())
+	if b2.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,10 @@
+module github.com/horahoradev/horahora/storage
+
+go 1.13
+
+require (
+	github.com/aws/aws-sdk-go-v2 v0.20.0
+	github.com/kurin/blazer v0.5.3
+	github.com/minio/minio-go/v7 v7.0.10
+	github.com/sirupsen/logrus v1.6.0
+)
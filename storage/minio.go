@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 
@@ -41,7 +42,13 @@ func (s *MinioStorage) Fetch(id string) (*os.File, error) {
 		return nil, err
 	}
 
+	// Objects are only requested once they're read, so a missing object shows up here
 	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+		}
 		return nil, err
 	}
 
@@ -67,3 +74,7 @@ func (s *MinioStorage) Upload(path, desiredFilename string) error {
 
 	return nil
 }
+
+func (s *MinioStorage) Delete(id string) error {
+	return s.Client.RemoveObject(context.Background(), s.Bucket, id, minio.RemoveObjectOptions{})
+}
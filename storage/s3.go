@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/aws/external"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	log "github.com/sirupsen/logrus"
@@ -38,6 +39,9 @@ func (s *S3Storage) Fetch(id string) (*os.File, error) {
 
 	getObjReq := s.S3Client.GetObjectRequest(getReq)
 	res, err := getObjReq.Send(context.Background())
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -120,3 +124,12 @@ func (s *S3Storage) Upload(path, desiredFilename string) error {
 	return err // TODO
 
 }
+
+func (s *S3Storage) Delete(id string) error {
+	delReq := s.S3Client.DeleteObjectRequest(&s3.DeleteObjectInput{
+		Bucket: &s.BucketName,
+		Key:    &id,
+	})
+	_, err := delReq.Send(context.TODO())
+	return err
+}
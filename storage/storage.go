@@ -0,0 +1,32 @@
+// Package storage abstracts the object storage which videos, thumbnails and profile images are uploaded to. It's its
+// own module, since both the video service and the user service upload to it.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrNotFound is returned, possibly wrapped, when fetching an object which doesn't exist
+var ErrNotFound = errors.New("object not found")
+
+type Storage interface {
+	// Fetch downloads the object to a file
+	Fetch(path string) (*os.File, error)
+	Upload(path, desiredFilename string) error
+	// Delete removes the object. Deleting an object which doesn't exist isn't an error.
+	Delete(path string) error
+}
+
+// New connects to the storage backend, which is either b2 or minio
+func New(backend, apiID, apiKey, bucketName, minioEndpoint string) (Storage, error) {
+	switch backend {
+	case "b2":
+		return NewB2(apiID, apiKey, bucketName)
+	case "minio":
+		return NewMinio(minioEndpoint, apiID, apiKey, bucketName)
+	default:
+		return nil, fmt.Errorf("Unknown storage backend %s", backend)
+	}
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/horahoradev/horahora/user_service/internal/model"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultAPITokenLifetime = 90 * 24 * time.Hour
+	MaxAPITokenLifetime     = 365 * 24 * time.Hour
+	MaxAPITokens            = 20 // Per user
+	MaxAPITokenNameLength   = 64 // In characters
+)
+
+// CreateAPIToken creates an API token for the user, which expires after lifetime, or DefaultAPITokenLifetime if it's
+// 0. The token is returned only here, since just its hash is stored.
+func CreateAPIToken(userID int64, name string, scopes []string, lifetime time.Duration, u *model.UserModel) (string, *model.APIToken, error) {
+	name = strings.TrimSpace(name)
+	if lifetime == 0 {
+		lifetime = DefaultAPITokenLifetime
+	}
+
+	switch {
+	case name == "":
+		return "", nil, status.Error(codes.InvalidArgument, "name is required")
+	case utf8.RuneCountInString(name) > MaxAPITokenNameLength:
+		return "", nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters long", MaxAPITokenNameLength)
+	case len(scopes) == 0:
+		return "", nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	case lifetime < 0 || lifetime > MaxAPITokenLifetime:
+		return "", nil, status.Errorf(codes.InvalidArgument, "tokens must expire within %d days", int(MaxAPITokenLifetime.Hours()/24))
+	}
+
+	seen := make(map[string]bool)
+	var unique []string
+	for _, scope := range scopes {
+		if !permissions.ValidScope(scope) {
+			return "", nil, status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+
+	user, err := u.GetUserWithID(userID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch {
+	case user.Banned:
+		return "", nil, errBannedUser
+	case user.Foreign:
+		return "", nil, status.Error(codes.PermissionDenied, "foreign users can't create API tokens")
+	}
+
+	count, err := u.CountAPITokens(userID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if count >= MaxAPITokens {
+		return "", nil, status.Errorf(codes.ResourceExhausted, "at most %d API tokens are allowed, revoke one first", MaxAPITokens)
+	}
+
+	return u.CreateAPIToken(userID, name, unique, lifetime)
+}
+
+// AuthenticateAPIToken returns the details of the token, which must belong to a user who isn't banned, and an access
+// token for the user which other services can verify
+func AuthenticateAPIToken(token string, keys *KeySet, u *model.UserModel) (*model.APIToken, string, error) {
+	if !strings.HasPrefix(token, permissions.APITokenPrefix) {
+		return nil, "", model.ErrInvalidAPIToken
+	}
+
+	apiToken, err := u.UseAPIToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := u.GetUserWithID(apiToken.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch {
+	case user.Banned:
+		return nil, "", errBannedUser
+	case len(apiToken.Scopes) == 0:
+		// Would be indistinguishable from a session's access token, with all of the user's permissions
+		return nil, "", status.Error(codes.PermissionDenied, "API token has no scopes")
+	}
+
+	// The access token doesn't belong to a session, and is only good for as long as any other. It carries the token's
+	// scopes, so that the other services only let it use their permissions.
+	accessToken, _, err := createAccessToken(user.ID, 0, apiToken.Scopes, keys, u)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiToken, accessToken, nil
+}
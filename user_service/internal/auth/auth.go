@@ -1,123 +1,311 @@
 package auth
 
 import (
+	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
-	"encoding/json"
+	dbsql "database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/horahoradev/horahora/user_service/internal/model"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
 )
 
-const hashCost = 5
+// Access tokens are short lived, and renewed with a refresh token
+const AccessTokenLifetime = 15 * time.Minute
 
-func Login(username, password string, privateKey *rsa.PrivateKey, u *model.UserModel) (string, error) {
+var errRevokedToken = status.Error(codes.Unauthenticated, "token has been revoked")
+
+// Tokens are handed out on login, registration and refresh
+type Tokens struct {
+	UserID       int64
+	AccessToken  string
+	RefreshToken string // Empty for foreign users, who can't log in
+	Expiry       time.Time
+}
+
+var errBannedUser = status.Error(codes.PermissionDenied, "user is banned")
+
+var errInvalidCredentials = status.Error(codes.Unauthenticated, "invalid username or password")
+
+// Login checks the user's password, and starts a new session. ip is the address of the client logging in, which may be
+// empty if unknown. Stored password hashes which are weaker than h would produce are replaced.
+func Login(username, password, ip string, keys *KeySet, h *PasswordHasher, t *LoginThrottle, u *model.UserModel) (*Tokens, error) {
 	uid, err := u.GetUserWithUsername(username)
-	if err != nil {
-		return "", err
+	switch {
+	case err == dbsql.ErrNoRows:
+		uid = 0
+	case err != nil:
+		return nil, err
 	}
 
-	passHash, err := u.GetPassHash(uid)
-	if err != nil {
-		return "", err
+	if err = t.reserve(uid, ip, u); err != nil {
+		return nil, err
 	}
 
-	isValid, err := compareHashedPassword([]byte(password), []byte(passHash))
+	// Users who don't exist are checked against an empty hash, which takes as long to fail as a real one
+	var passHash string
+	if uid != 0 {
+		passHash, err = u.GetPassHash(uid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	isValid, err := h.Verify(password, []byte(passHash))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if !isValid {
-		return "", errors.New("invalid password")
+		// Already recorded by the throttle
+		return nil, errInvalidCredentials
+	}
+
+	if err = u.ClearLoginFailures(uid); err != nil {
+		return nil, err
+	}
+
+	if h.NeedsRehash([]byte(passHash)) {
+		rehash(uid, password, h, u)
+	}
+
+	// Password is valid, but banned users still can't log in
+	user, err := u.GetUserWithID(uid)
+	if err != nil {
+		return nil, err
 	}
 
-	// Password is valid
-	payload := JWTPayload{UID: uid}
-	return CreateJWT(payload, privateKey)
+	if user.Banned {
+		return nil, errBannedUser
+	}
+
+	return newSession(uid, keys, u)
 }
 
-func Register(username, email, password string, u *model.UserModel, privateKey *rsa.PrivateKey, foreignUser bool,
-	foreignUserID string, foreignWebsite userproto.Site) (string, error) {
-	pwBytes := []byte(password)
+// rehash upgrades the user's password hash. Failing to do so doesn't stop them from logging in.
+func rehash(uid int64, password string, h *PasswordHasher, u *model.UserModel) {
+	passHash, err := h.Hash(password)
+	if err == nil {
+		err = u.SetPassHash(uid, passHash)
+	}
 
+	if err != nil {
+		log.Errorf("Could not rehash password of user %d. Err: %s", uid, err)
+	}
+}
+
+// Register creates a user. Passwords must satisfy ValidatePassword, except those of foreign users, which aren't used.
+func Register(username, email, password string, u *model.UserModel, keys *KeySet, h *PasswordHasher, foreignUser bool,
+	foreignUserID string, foreignWebsite userproto.Site) (*Tokens, error) {
 	var passHash []byte
 	var err error
-	// TODO: salt + pepper?
 	if !foreignUser {
-		passHash, err = bcrypt.GenerateFromPassword(pwBytes, hashCost)
+		if err = ValidatePassword(password); err != nil {
+			return nil, err
+		}
+
+		passHash, err = h.Hash(password)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 
 	uid, err := u.NewUser(username, email, passHash, foreignUser, foreignUserID, foreignWebsite)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	// Foreign users can't log in, so there's no session to refresh. The access token only tells the caller the new
+	// user's ID.
+	if foreignUser {
+		accessToken, expiry, err := createAccessToken(uid, 0, nil, keys, u)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Tokens{UserID: uid, AccessToken: accessToken, Expiry: expiry}, nil
 	}
 
-	payload := JWTPayload{UID: uid}
-	return CreateJWT(payload, privateKey)
+	return newSession(uid, keys, u)
 }
 
-type JWTPayload struct {
-	UID int64 `json:"uid"`
+// RefreshToken exchanges a refresh token for a new access token and refresh token
+func RefreshToken(refreshToken string, keys *KeySet, u *model.UserModel) (*Tokens, error) {
+	uid, sessionID, newRefreshToken, err := u.RotateRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.GetUserWithID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Banned {
+		return nil, errBannedUser
+	}
+
+	accessToken, expiry, err := createAccessToken(uid, sessionID, nil, keys, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tokens{UserID: uid, AccessToken: accessToken, RefreshToken: newRefreshToken, Expiry: expiry}, nil
 }
 
-func CreateJWT(payload JWTPayload, privateKey *rsa.PrivateKey) (string, error) {
-	// Instantiate a signer using RSASSA-PSS (SHA512) with the given private key.
-	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.PS512, Key: privateKey}, nil)
+func newSession(uid int64, keys *KeySet, u *model.UserModel) (*Tokens, error) {
+	sessionID, refreshToken, err := u.CreateSession(uid)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Sign a sample payload. Calling the signer returns a protected JWS object,
-	// which can then be serialized for output afterwards. An error would
-	// indicate a problem in an underlying cryptographic primitive.
+	accessToken, expiry, err := createAccessToken(uid, sessionID, nil, keys, u)
+	if err != nil {
+		return nil, err
+	}
 
-	payloadBytes, err := json.Marshal(payload)
+	return &Tokens{UserID: uid, AccessToken: accessToken, RefreshToken: refreshToken, Expiry: expiry}, nil
+}
+
+// createAccessToken issues an access token for the user. scopes are those of the API token it's issued for, if any,
+// and limit the permissions which the access token can use.
+func createAccessToken(uid, sessionID int64, scopes []string, keys *KeySet, u *model.UserModel) (string, time.Time, error) {
+	version, _, err := u.GetTokenState(uid, 0)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
+	}
+
+	jti := make([]byte, 16)
+	if _, err = rand.Read(jti); err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	expiry := now.Add(AccessTokenLifetime)
+	payload := JWTPayload{
+		Claims: jwt.Claims{
+			Issuer:   verifier.Issuer,
+			Subject:  strconv.FormatInt(uid, 10),
+			ID:       hex.EncodeToString(jti),
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(expiry),
+		},
+		UID:          uid,
+		SessionID:    sessionID,
+		TokenVersion: version,
+		Scopes:       scopes,
 	}
 
-	object, err := signer.Sign(payloadBytes)
+	token, err := CreateJWT(payload, keys)
+	return token, expiry, err
+}
+
+type JWTPayload = verifier.Claims
+
+// KeySet holds the key which tokens are signed with, and the public keys of every key which unexpired tokens may have
+// been signed with. Keys are identified by their JWK thumbprint.
+type KeySet struct {
+	signingKey jose.JSONWebKey
+	public     jose.JSONWebKeySet
+}
+
+// NewKeySet signs with signingKey. Retired keys are only published, so that tokens they signed stay valid until
+// they expire.
+func NewKeySet(signingKey *rsa.PrivateKey, retiredKeys []*rsa.PrivateKey) (*KeySet, error) {
+	k := KeySet{}
+	for i, key := range append([]*rsa.PrivateKey{signingKey}, retiredKeys...) {
+		jwk := jose.JSONWebKey{Key: &key.PublicKey, Algorithm: string(jose.PS512), Use: "sig"}
+		thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		jwk.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+		if i == 0 {
+			k.signingKey = jose.JSONWebKey{Key: key, KeyID: jwk.KeyID, Algorithm: jwk.Algorithm, Use: jwk.Use}
+		}
+		k.public.Keys = append(k.public.Keys, jwk)
+	}
+
+	return &k, nil
+}
+
+// JWKS returns the public keys
+func (k *KeySet) JWKS() jose.JSONWebKeySet {
+	return k.public
+}
+
+func CreateJWT(payload JWTPayload, keys *KeySet) (string, error) {
+	// Instantiate a signer using RSASSA-PSS (SHA512) with the signing key. The key ID is added to the header.
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.PS512, Key: keys.signingKey}, nil)
 	if err != nil {
 		return "", err
 	}
 
-	// Serialize the encrypted object using the full serialization format.
-	// Alternatively you can also use the compact format here by calling
-	// object.CompactSerialize() instead.
-	serialized := object.FullSerialize()
-
-	return serialized, nil
+	return jwt.Signed(signer).Claims(payload).CompactSerialize()
 }
 
-func ValidateJWT(jwt string, privateKey rsa.PrivateKey) (int64, error) {
-	object, err := jose.ParseSigned(jwt)
+// ValidateJWT verifies the token, and checks that neither the token's session nor the user's token version have been
+// revoked since it was issued
+func ValidateJWT(token string, v *verifier.Verifier, u *model.UserModel) (*JWTPayload, error) {
+	payload, err := v.Verify(token)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	// Now we can verify the signature on the payload. An error here would
-	// indicate the the message failed to verify, e.g. because the signature was
-	// broken or the message was tampered with.
-	output, err := object.Verify(&privateKey.PublicKey)
+	version, revoked, err := u.GetTokenState(payload.UID, payload.SessionID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	payload := JWTPayload{}
+	if revoked || version != payload.TokenVersion {
+		return nil, errRevokedToken
+	}
 
-	err = json.Unmarshal(output, &payload)
+	// Banning revokes the user's sessions anyway, but tokens which don't belong to a session are checked here
+	user, err := u.GetUserWithID(payload.UID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return payload.UID, nil
+	if user.Banned {
+		return nil, errBannedUser
+	}
+
+	return payload, nil
+}
+
+// ParsePrivateKeys parses any number of concatenated PEM encoded keys
+func ParsePrivateKeys(keypairs string) ([]*rsa.PrivateKey, error) {
+	var keys []*rsa.PrivateKey
+	rest := []byte(keypairs)
+	for {
+		var p *pem.Block
+		p, rest = pem.Decode(rest)
+		if p == nil {
+			return keys, nil
+		}
+
+		key, err := x509.ParsePKCS1PrivateKey(p.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
 }
 
 // Must be pem encoded
@@ -1,19 +1,32 @@
 package auth
 
 import (
-	"crypto/rsa"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
 	"github.com/horahoradev/horahora/user_service/internal/config"
+	"github.com/horahoradev/horahora/user_service/internal/mailer"
 	"github.com/horahoradev/horahora/user_service/internal/model"
 	proto "github.com/horahoradev/horahora/user_service/protocol"
-	"log"
-	"testing"
+	"github.com/horahoradev/horahora/user_service/verifier"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var u *model.UserModel
-var privateKey *rsa.PrivateKey
+var keys *KeySet
+var v *verifier.Verifier
+var hasher *PasswordHasher
+var throttle = &LoginThrottle{MaxAccountFailures: 5, MaxIPFailures: 20, Window: 15 * time.Minute}
+var resetThrottle = &PasswordResetThrottle{MaxAddressRequests: 3, MaxIPRequests: 10, Window: time.Hour}
 
 func init() {
 	cfg, err := config.New()
@@ -26,7 +39,19 @@ func init() {
 		log.Panic(err)
 	}
 
-	privateKey, err = ParsePrivateKey(cfg.RSAKeypair)
+	privateKey, err := ParsePrivateKey(cfg.RSAKeypair)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	keys, err = NewKeySet(privateKey, nil)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	v = verifier.New(verifier.StaticKeySource(keys.JWKS()))
+
+	hasher, err = NewPasswordHasher(Bcrypt, bcrypt.MinCost, DefaultArgon2Params)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -91,15 +116,403 @@ var RegistrationTests = []struct {
 func TestDomesticRegistrationAndLogin(t *testing.T) {
 	for _, user := range RegistrationTests {
 		// FIXME: need to clean up function signature
-		_, err := Register(user.username, user.email, user.password, u, privateKey, false, "", proto.Site_niconico)
+		_, err := Register(user.username, user.email, user.password, u, keys, hasher, false, "", proto.Site_niconico)
 		assert.NoError(t, err)
 
-		_, err = Login(user.username, user.password, privateKey, u)
+		_, err = Login(user.username, user.password, "", keys, hasher, throttle, u)
 		assert.NoError(t, err)
 	}
 }
 
 func TestForeignRegistration(t *testing.T) {
-	_, err := Register("foreignUser", "", "", u, privateKey, true, "10", proto.Site_niconico)
+	_, err := Register("foreignUser", "", "", u, keys, hasher, true, "10", proto.Site_niconico)
+	assert.NoError(t, err)
+}
+
+func TestForeignUsersWithTheSameName(t *testing.T) {
+	var userIDs []int64
+	for _, site := range []proto.Site{proto.Site_youtube, proto.Site_niconico} {
+		tokens, err := Register("Music", "", "", u, keys, hasher, true, "music-channel", site)
+		assert.NoError(t, err)
+		userIDs = append(userIDs, tokens.UserID)
+	}
+
+	users, err := u.GetUsersWithIDs(userIDs)
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	assert.NotEqual(t, users[0].Username, users[1].Username)
+	for _, user := range users {
+		assert.Equal(t, "Music", user.DisplayName)
+	}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	tokens, err := Login("mytestuser", "testpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	refreshed, err := RefreshToken(tokens.RefreshToken, keys, u)
+	assert.NoError(t, err)
+
+	_, err = ValidateJWT(refreshed.AccessToken, v, u)
+	assert.NoError(t, err)
+
+	// Past the grace period, reusing a rotated token revokes the whole session
+	_, err = u.Conn.Exec("UPDATE refresh_tokens SET rotated_at = rotated_at - interval '1 hour' "+
+		"WHERE token_hash = encode(sha256(convert_to($1, 'UTF8')), 'hex')", tokens.RefreshToken)
+	assert.NoError(t, err)
+
+	_, err = RefreshToken(tokens.RefreshToken, keys, u)
+	assert.Error(t, err)
+
+	_, err = RefreshToken(refreshed.RefreshToken, keys, u)
+	assert.Error(t, err)
+
+	_, err = ValidateJWT(refreshed.AccessToken, v, u)
+	assert.Error(t, err)
+}
+
+// Clients may refresh several times in parallel with the same token, which mustn't log them out
+func TestParallelRefreshes(t *testing.T) {
+	tokens, err := Login("mytestuser", "testpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	first, err := RefreshToken(tokens.RefreshToken, keys, u)
+	assert.NoError(t, err)
+
+	second, err := RefreshToken(tokens.RefreshToken, keys, u)
+	assert.NoError(t, err)
+	assert.Equal(t, first.RefreshToken, second.RefreshToken)
+
+	_, err = ValidateJWT(second.AccessToken, v, u)
+	assert.NoError(t, err)
+
+	third, err := RefreshToken(second.RefreshToken, keys, u)
+	assert.NoError(t, err)
+
+	// The successor has moved on, so the original token doesn't get anything
+	_, err = RefreshToken(tokens.RefreshToken, keys, u)
+	assert.Error(t, err)
+
+	_, err = RefreshToken(third.RefreshToken, keys, u)
+	assert.NoError(t, err)
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	tokens, err := Login("mytestuser", "testpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	payload, err := ValidateJWT(tokens.AccessToken, v, u)
+	assert.NoError(t, err)
+
+	assert.NoError(t, u.RevokeAllSessions(payload.UID))
+
+	_, err = ValidateJWT(tokens.AccessToken, v, u)
+	assert.Error(t, err)
+
+	_, err = RefreshToken(tokens.RefreshToken, keys, u)
+	assert.Error(t, err)
+}
+
+// tokenFromLastMessage extracts the token from the link in the last email sent
+func tokenFromLastMessage(t *testing.T, m *mailer.MemoryMailer) string {
+	messages := m.Messages()
+	assert.NotEmpty(t, messages)
+
+	match := regexp.MustCompile(`\?token=(\S+)`).FindStringSubmatch(messages[len(messages)-1].Body)
+	assert.Len(t, match, 2)
+
+	token, err := url.QueryUnescape(match[1])
+	assert.NoError(t, err)
+	return token
+}
+
+func TestEmailVerification(t *testing.T) {
+	m := mailer.NewMemoryMailer()
+	tokens, err := Register("unverifieduser", "unverified@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	assert.NoError(t, SendVerificationEmail(tokens.UserID, u, m, "http://localhost:3000"))
+	assert.Equal(t, "unverified@wow.com", m.Messages()[0].To)
+	token := tokenFromLastMessage(t, m)
+
+	uid, err := VerifyEmail(token, u)
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.UserID, uid)
+
+	user, err := u.GetUserWithID(uid)
+	assert.NoError(t, err)
+	assert.True(t, user.EmailVerified)
+
+	// Tokens are single use, and verified users don't get another one
+	_, err = VerifyEmail(token, u)
+	assert.Equal(t, model.ErrInvalidEmailToken, err)
+
+	assert.Error(t, SendVerificationEmail(tokens.UserID, u, m, "http://localhost:3000"))
+}
+
+func TestPasswordReset(t *testing.T) {
+	m := mailer.NewMemoryMailer()
+	_, err := Register("forgetfuluser", "forgetful@wow.com", "oldpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	oldTokens, err := Login("forgetfuluser", "oldpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	// Addresses are matched case insensitively, and unknown addresses aren't an error
+	assert.NoError(t, RequestPasswordReset("nobody@wow.com", "", resetThrottle, u, m, "http://localhost:3000"))
+	assert.Empty(t, m.Messages())
+
+	assert.NoError(t, RequestPasswordReset("Forgetful@wow.com", "", resetThrottle, u, m, "http://localhost:3000"))
+	token := tokenFromLastMessage(t, m)
+
+	_, err = ResetPassword(token, "newpassword", hasher, u)
+	assert.NoError(t, err)
+
+	_, err = ResetPassword(token, "anotherpassword", hasher, u)
+	assert.Equal(t, model.ErrInvalidEmailToken, err)
+
+	_, err = Login("forgetfuluser", "oldpassword", "", keys, hasher, throttle, u)
+	assert.Error(t, err)
+
+	_, err = Login("forgetfuluser", "newpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	// Existing sessions are logged out
+	_, err = RefreshToken(oldTokens.RefreshToken, keys, u)
+	assert.Error(t, err)
+}
+
+func TestLoginRehashesWeakPasswords(t *testing.T) {
+	_, err := Register("rehasheduser", "rehashed@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	argon, err := NewPasswordHasher(Argon2id, bcrypt.MinCost, Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1})
+	assert.NoError(t, err)
+
+	tokens, err := Login("rehasheduser", "testpassword", "", keys, argon, throttle, u)
+	assert.NoError(t, err)
+
+	passHash, err := u.GetPassHash(tokens.UserID)
 	assert.NoError(t, err)
+	assert.Contains(t, passHash, "$argon2id$")
+
+	_, err = Login("rehasheduser", "testpassword", "", keys, argon, throttle, u)
+	assert.NoError(t, err)
+}
+
+func TestLoginLockout(t *testing.T) {
+	_, err := Register("lockeduser", "locked@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	for i := int64(0); i < throttle.MaxAccountFailures; i++ {
+		_, err = Login("lockeduser", "wrongpassword", "10.0.0.1", keys, hasher, throttle, u)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// Even the right password is refused while locked out
+	_, err = Login("lockeduser", "testpassword", "10.0.0.2", keys, hasher, throttle, u)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Guessing many accounts from one IP address locks the address out
+	for i := int64(0); i < throttle.MaxIPFailures; i++ {
+		_, err = Login(fmt.Sprintf("nonexistentuser%d", i), "testpassword", "10.0.0.3", keys, hasher, throttle, u)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	_, err = Login("mytestuser", "testpassword", "10.0.0.3", keys, hasher, throttle, u)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = Login("mytestuser", "testpassword", "10.0.0.4", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+}
+
+func TestConcurrentLoginsCantPassTheThrottle(t *testing.T) {
+	_, err := Register("raceduser", "raced@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	attempts := int(throttle.MaxAccountFailures) * 3
+	errs := make(chan error, attempts)
+	var wg sync.WaitGroup
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := Login("raceduser", "wrongpassword", fmt.Sprintf("10.0.1.%d", i), keys, hasher, throttle, u)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	// Only the allowed number of guesses are checked against the password, however many arrive at once
+	var checked int64
+	for err := range errs {
+		if status.Code(err) == codes.Unauthenticated {
+			checked++
+		}
+	}
+	assert.LessOrEqual(t, checked, throttle.MaxAccountFailures)
+}
+
+func TestPasswordResetThrottle(t *testing.T) {
+	m := mailer.NewMemoryMailer()
+	_, err := Register("floodeduser", "flooded@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	for i := int64(0); i < resetThrottle.MaxAddressRequests; i++ {
+		assert.NoError(t, RequestPasswordReset("flooded@wow.com", fmt.Sprintf("10.0.2.%d", i), resetThrottle, u, m, "http://localhost:3000"))
+	}
+
+	// Changing the case of the address or the IP address doesn't get around the limit
+	err = RequestPasswordReset("Flooded@wow.com", "10.0.2.100", resetThrottle, u, m, "http://localhost:3000")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Len(t, m.Messages(), int(resetThrottle.MaxAddressRequests))
+
+	// Asking for many addresses from one IP address locks the address out
+	for i := int64(0); i < resetThrottle.MaxIPRequests; i++ {
+		assert.NoError(t, RequestPasswordReset(fmt.Sprintf("nobody%d@wow.com", i), "10.0.2.200", resetThrottle, u, m, "http://localhost:3000"))
+	}
+
+	err = RequestPasswordReset("forgetful@wow.com", "10.0.2.200", resetThrottle, u, m, "http://localhost:3000")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestBannedUsersCantLogIn(t *testing.T) {
+	tokens, err := Register("banneduser", "banned@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	moderatorID, err := u.GetUserWithUsername("mytestuser")
+	assert.NoError(t, err)
+
+	assert.NoError(t, u.Ban(moderatorID, tokens.UserID, nil, "spam"))
+
+	_, err = ValidateJWT(tokens.AccessToken, v, u)
+	assert.Error(t, err)
+
+	_, err = Login("banneduser", "testpassword", "", keys, hasher, throttle, u)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Suspensions lift themselves once they run out
+	expired := time.Now().Add(-time.Minute)
+	assert.NoError(t, u.Ban(moderatorID, tokens.UserID, &expired, "spam"))
+
+	tokens, err = Login("banneduser", "testpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	_, err = ValidateJWT(tokens.AccessToken, v, u)
+	assert.NoError(t, err)
+
+	assert.NoError(t, u.Unban(moderatorID, tokens.UserID, "appealed"))
+
+	var changes int
+	err = u.Conn.Get(&changes, "SELECT count(*) FROM user_audit_log WHERE user_id = $1", tokens.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, changes)
+}
+
+func TestClaimConvertsForeignAccount(t *testing.T) {
+	foreign, err := Register("claimableuser", "", "", u, keys, hasher, true, "claim-convert", proto.Site_youtube)
+	assert.NoError(t, err)
+
+	claimantID, err := u.GetUserWithUsername("mytestuser")
+	assert.NoError(t, err)
+
+	claim, err := StartClaim(claimantID, foreign.UserID, u)
+	assert.NoError(t, err)
+
+	// Starting again keeps the code which may already be in the description
+	again, err := StartClaim(claimantID, foreign.UserID, u)
+	assert.NoError(t, err)
+	assert.Equal(t, claim.Code, again.Code)
+
+	_, err = CompleteClaim(claim.ID, claimantID, model.ClaimConvert, "no code here", "", "claimedpassword", hasher, u)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	description := "My channel\n" + claim.Code
+	_, err = CompleteClaim(claim.ID, claimantID, model.ClaimConvert, description, "", "claimedpassword", hasher, u)
+	assert.NoError(t, err)
+
+	_, err = Login("claimableuser", "claimedpassword", "", keys, hasher, throttle, u)
+	assert.NoError(t, err)
+
+	// Videos archived later still belong to the account
+	owner, err := u.GetForeignUser("claim-convert", proto.Site_youtube)
+	assert.NoError(t, err)
+	assert.Equal(t, foreign.UserID, owner)
+
+	_, err = StartClaim(claimantID, foreign.UserID, u)
+	assert.Equal(t, model.ErrAccountNotClaimable, err)
+}
+
+func TestClaimMergesForeignAccount(t *testing.T) {
+	foreign, err := Register("mergeableuser", "", "", u, keys, hasher, true, "claim-merge", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	claimant, err := Register("mergingclaimant", "merging@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	followerID, err := u.GetUserWithUsername("mytestuser")
+	assert.NoError(t, err)
+	assert.NoError(t, u.Follow(followerID, foreign.UserID))
+
+	// Other users can't see or complete the claim
+	claim, err := StartClaim(claimant.UserID, foreign.UserID, u)
+	assert.NoError(t, err)
+
+	_, err = CompleteClaim(claim.ID, followerID, model.ClaimMerge, claim.Code, "", "", hasher, u)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	for i := 0; i < 2; i++ {
+		_, err = CompleteClaim(claim.ID, claimant.UserID, model.ClaimMerge, claim.Code, "", "", hasher, u)
+		assert.NoError(t, err)
+	}
+
+	owner, err := u.GetForeignUser("claim-merge", proto.Site_niconico)
+	assert.NoError(t, err)
+	assert.Equal(t, claimant.UserID, owner)
+
+	counts, err := u.GetFollowCounts(claimant.UserID, followerID)
+	assert.NoError(t, err)
+	assert.True(t, counts.FollowedByViewer)
+}
+
+func TestAPITokens(t *testing.T) {
+	tokens, err := Register("scriptuser", "script@wow.com", "testpassword", u, keys, hasher, false, "", proto.Site_niconico)
+	assert.NoError(t, err)
+
+	_, _, err = CreateAPIToken(tokens.UserID, "uploader", []string{"upload", "everything"}, 0, u)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = CreateAPIToken(tokens.UserID, "uploader", []string{"upload"}, 2*MaxAPITokenLifetime, u)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	token, info, err := CreateAPIToken(tokens.UserID, "uploader", []string{"upload", "read", "upload"}, 0, u)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"upload", "read"}, []string(info.Scopes))
+	assert.Nil(t, info.LastUsedAt)
+
+	authenticated, accessToken, err := AuthenticateAPIToken(token, keys, u)
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.UserID, authenticated.UserID)
+
+	payload, err := ValidateJWT(accessToken, v, u)
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.UserID, payload.UID)
+	// So that the other services only let it use the permissions of its scopes
+	assert.Equal(t, []string{"upload", "read"}, payload.Scopes)
+
+	listed, err := u.ListAPITokens(tokens.UserID)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.NotNil(t, listed[0].LastUsedAt)
+
+	// Only the hash is stored
+	var stored int
+	assert.NoError(t, u.Conn.Get(&stored, "SELECT count(*) FROM api_tokens WHERE token_hash = $1", token))
+	assert.Equal(t, 0, stored)
+
+	assert.NoError(t, u.RevokeAPIToken(tokens.UserID, info.ID))
+	_, _, err = AuthenticateAPIToken(token, keys, u)
+	assert.Equal(t, model.ErrInvalidAPIToken, err)
 }
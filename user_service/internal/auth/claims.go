@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/horahoradev/horahora/user_service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Long enough to edit a channel description and wait for the site's cache to catch up
+const ClaimLifetime = 7 * 24 * time.Hour
+
+// Claim codes are recognisable when they turn up in a channel description
+const claimCodePrefix = "horahora-claim-"
+
+func generateClaimCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return claimCodePrefix + strings.ToLower(base32.StdEncoding.EncodeToString(b)), nil
+}
+
+// StartClaim starts a claim of the foreign account by the claimant. They prove that they own it by putting the claim's
+// code in the description of its channel, and then calling CompleteClaim.
+func StartClaim(claimantID, foreignAccountID int64, u *model.UserModel) (*model.Claim, error) {
+	claimant, err := u.GetUserWithID(claimantID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claimant.Banned:
+		return nil, errBannedUser
+	case claimant.Foreign:
+		return nil, status.Error(codes.PermissionDenied, "foreign users can't claim accounts")
+	}
+
+	account, err := u.GetUserWithID(foreignAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.Foreign || account.MergedInto.Valid {
+		return nil, model.ErrAccountNotClaimable
+	}
+
+	code, err := generateClaimCode()
+	if err != nil {
+		return nil, err
+	}
+
+	return u.CreateClaim(claimantID, foreignAccountID, code, ClaimLifetime)
+}
+
+// GetClaim returns one of the claimant's claims
+func GetClaim(claimID, claimantID int64, u *model.UserModel) (*model.Claim, error) {
+	claim, err := u.GetClaim(claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Codes are only shown to the claimant
+	if claim.ClaimantID != claimantID {
+		return nil, model.ErrClaimNotFound
+	}
+
+	return claim, nil
+}
+
+// CompleteClaim checks that the claim's code is in channelDescription, the current description of the foreign
+// account's channel, and then converts or merges the account. When converting, password becomes the account's password
+// and email its optional email address. Completing a claim again with the same mode does nothing, so that callers can
+// retry.
+func CompleteClaim(claimID, claimantID int64, mode model.ClaimMode, channelDescription, email, password string,
+	h *PasswordHasher, u *model.UserModel) (*model.Claim, error) {
+	claim, err := GetClaim(claimID, claimantID, u)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claim.CompletedAt != nil && claim.Mode != nil && *claim.Mode == mode:
+		return claim, nil
+	case claim.CompletedAt != nil:
+		return nil, status.Error(codes.FailedPrecondition, "claim has already been completed")
+	case time.Now().After(claim.ExpiresAt):
+		return nil, status.Error(codes.FailedPrecondition, "claim has expired, start a new one")
+	case !strings.Contains(channelDescription, claim.Code):
+		return nil, status.Error(codes.FailedPrecondition, "claim code wasn't found in the channel description")
+	}
+
+	switch mode {
+	case model.ClaimConvert:
+		if err = ValidatePassword(password); err != nil {
+			return nil, err
+		}
+
+		passHash, err := h.Hash(password)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.ConvertClaimedAccount(claimID, email, passHash)
+	case model.ClaimMerge:
+		err = u.MergeClaimedAccount(claimID)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown claim mode %d", mode)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetClaim(claimID)
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/horahoradev/horahora/user_service/internal/mailer"
+	"github.com/horahoradev/horahora/user_service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	EmailVerificationLifetime = 48 * time.Hour
+	PasswordResetLifetime     = time.Hour
+)
+
+// Links in emails point to the website, at baseURL
+func emailLink(baseURL, path, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", baseURL, path, url.QueryEscape(token))
+}
+
+// SendVerificationEmail emails the user a link which verifies their email address
+func SendVerificationEmail(uid int64, u *model.UserModel, m mailer.Mailer, baseURL string) error {
+	user, err := u.GetUserWithID(uid)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case user.Email == "":
+		return status.Error(codes.FailedPrecondition, "user has no email address")
+	case user.EmailVerified:
+		return status.Error(codes.FailedPrecondition, "email address is already verified")
+	}
+
+	token, err := u.CreateEmailToken(uid, model.EmailVerification, user.Email, EmailVerificationLifetime)
+	if err != nil {
+		return err
+	}
+
+	return m.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your Horahora email address",
+		Body: fmt.Sprintf("Hi %s,\n\nFollow this link to verify your email address:\n\n%s\n\n"+
+			"The link expires in %s. If you didn't register on Horahora, you can ignore this email.\n",
+			user.Username, emailLink(baseURL, "verify-email", token), formatLifetime(EmailVerificationLifetime)),
+	})
+}
+
+// VerifyEmail consumes a token sent by SendVerificationEmail, and returns the verified user's ID
+func VerifyEmail(token string, u *model.UserModel) (int64, error) {
+	return u.VerifyEmail(token)
+}
+
+// RequestPasswordReset emails a reset link for each account registered with the address. It's not an error if there
+// are none, so that callers can't tell which addresses are registered. Requests are throttled per address and per IP
+// address.
+func RequestPasswordReset(email, ip string, t *PasswordResetThrottle, u *model.UserModel, m mailer.Mailer, baseURL string) error {
+	if err := t.reserve(email, ip, u); err != nil {
+		return err
+	}
+
+	users, err := u.GetUsersWithEmail(email)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		token, err := u.CreateEmailToken(user.ID, model.PasswordReset, user.Email, PasswordResetLifetime)
+		if err != nil {
+			return err
+		}
+
+		err = m.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your Horahora password",
+			Body: fmt.Sprintf("Hi %s,\n\nFollow this link to choose a new password:\n\n%s\n\n"+
+				"The link expires in %s. If you didn't ask to reset your password, you can ignore this email.\n",
+				user.Username, emailLink(baseURL, "reset-password", token), formatLifetime(PasswordResetLifetime)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResetPassword consumes a token sent by RequestPasswordReset and sets the user's password. Every session of the user
+// is revoked.
+func ResetPassword(token, newPassword string, h *PasswordHasher, u *model.UserModel) (int64, error) {
+	if err := ValidatePassword(newPassword); err != nil {
+		return 0, err
+	}
+
+	passHash, err := h.Hash(newPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.ResetPassword(token, passHash)
+}
+
+// Lifetimes are whole hours
+func formatLifetime(d time.Duration) string {
+	if d == time.Hour {
+		return "1 hour"
+	}
+
+	return fmt.Sprintf("%d hours", int(d.Hours()))
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MinPasswordLength = 8 // In characters
+	// bcrypt ignores everything after the first 72 bytes
+	MaxPasswordBytes = 72
+
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+
+	argon2idPrefix = "$argon2id$"
+	argon2SaltLen  = 16
+	argon2KeyLen   = 32
+)
+
+// Argon2Params are the cost parameters of argon2id
+type Argon2Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// The second recommended option of RFC 9106, for when 2 GiB of memory per hash isn't available
+var DefaultArgon2Params = Argon2Params{Memory: 64 * 1024, Iterations: 3, Parallelism: 4}
+
+// PasswordHasher hashes new passwords with the configured algorithm, and verifies hashes made with either algorithm.
+// Hashes which are weaker than the configured algorithm and cost are upgraded when the user next logs in.
+type PasswordHasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     Argon2Params
+	// Checked in place of hashes that can't match, see Verify
+	dummyHash []byte
+}
+
+func NewPasswordHasher(algorithm string, bcryptCost int, argon2Params Argon2Params) (*PasswordHasher, error) {
+	switch {
+	case algorithm != Bcrypt && algorithm != Argon2id:
+		return nil, fmt.Errorf("unknown password hashing algorithm %s", algorithm)
+	case bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost:
+		return nil, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	case argon2Params.Iterations < 1 || argon2Params.Parallelism < 1 || argon2Params.Memory < 8*uint32(argon2Params.Parallelism):
+		return nil, fmt.Errorf("invalid argon2 parameters %+v", argon2Params)
+	}
+
+	h := &PasswordHasher{algorithm: algorithm, bcryptCost: bcryptCost, argon2: argon2Params}
+
+	var err error
+	h.dummyHash, err = h.Hash("not a real password")
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// ValidatePassword enforces the password policy on new passwords
+func ValidatePassword(password string) error {
+	switch {
+	case utf8.RuneCountInString(password) < MinPasswordLength:
+		return status.Errorf(codes.InvalidArgument, "password must be at least %d characters long", MinPasswordLength)
+	case len(password) > MaxPasswordBytes:
+		return status.Errorf(codes.InvalidArgument, "password must be at most %d bytes long", MaxPasswordBytes)
+	case strings.TrimSpace(password) == "":
+		return status.Error(codes.InvalidArgument, "password must not be blank")
+	}
+
+	return nil
+}
+
+func (h *PasswordHasher) Hash(password string) ([]byte, error) {
+	if h.algorithm == Argon2id {
+		return hashArgon2id(password, h.argon2)
+	}
+
+	return bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+}
+
+// Verify returns true if the password matches the hash. Malformed and empty hashes, such as those of foreign users and
+// of users who don't exist, never match, but take as long to check as real ones so that the response time doesn't
+// reveal which accounts have passwords.
+func (h *PasswordHasher) Verify(password string, hash []byte) (bool, error) {
+	if strings.HasPrefix(string(hash), argon2idPrefix) {
+		params, salt, key, err := decodeArgon2id(string(hash))
+		if err != nil {
+			return h.verifyDummy(password)
+		}
+
+		other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	}
+
+	if _, err := bcrypt.Cost(hash); err != nil {
+		return h.verifyDummy(password)
+	}
+
+	return compareHashedPassword([]byte(password), hash)
+}
+
+func (h *PasswordHasher) verifyDummy(password string) (bool, error) {
+	if _, err := h.Verify(password, h.dummyHash); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// NeedsRehash returns true if the hash is weaker than what Hash would produce. Hashes are never migrated from argon2id
+// back to bcrypt.
+func (h *PasswordHasher) NeedsRehash(hash []byte) bool {
+	if strings.HasPrefix(string(hash), argon2idPrefix) {
+		params, _, _, err := decodeArgon2id(string(hash))
+		if err != nil || h.algorithm != Argon2id {
+			return false
+		}
+
+		return params.Memory < h.argon2.Memory || params.Iterations < h.argon2.Iterations
+	}
+
+	cost, err := bcrypt.Cost(hash)
+	if err != nil {
+		return false
+	}
+
+	return h.algorithm == Argon2id || cost < h.bcryptCost
+}
+
+// Hashes are stored in the PHC string format, e.g. $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>
+func hashArgon2id(password string, params Argon2Params) ([]byte, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, argon2KeyLen)
+	return []byte(fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, params.Memory,
+		params.Iterations, params.Parallelism, base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))), nil
+}
+
+func decodeArgon2id(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+	var version int
+	parts := strings.Split(strings.TrimPrefix(hash, argon2idPrefix), "$")
+	if len(parts) != 4 {
+		return params, nil, nil, fmt.Errorf("malformed argon2id hash")
+	}
+
+	if _, err := fmt.Sscanf(parts[0], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version %s", parts[0])
+	}
+
+	_, err := fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return params, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return params, nil, nil, err
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("malformed argon2id key")
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Cheap parameters so that the tests are fast
+var testArgon2Params = Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestValidatePassword(t *testing.T) {
+	assert.NoError(t, ValidatePassword("correct horse"))
+	assert.NoError(t, ValidatePassword("パスワードです。長い"))
+
+	for _, password := range []string{"", "short", "パスワード", "        ", strings.Repeat("a", MaxPasswordBytes+1)} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(ValidatePassword(password)), password)
+	}
+}
+
+func TestHashAndVerify(t *testing.T) {
+	for _, algorithm := range []string{Bcrypt, Argon2id} {
+		h, err := NewPasswordHasher(algorithm, bcrypt.MinCost, testArgon2Params)
+		assert.NoError(t, err)
+
+		hash, err := h.Hash("testpassword")
+		assert.NoError(t, err)
+		assert.False(t, h.NeedsRehash(hash), algorithm)
+
+		ok, err := h.Verify("testpassword", hash)
+		assert.NoError(t, err)
+		assert.True(t, ok, algorithm)
+
+		ok, err = h.Verify("wrongpassword", hash)
+		assert.NoError(t, err)
+		assert.False(t, ok, algorithm)
+	}
+}
+
+func TestVerifyRejectsMalformedHashes(t *testing.T) {
+	h, err := NewPasswordHasher(Argon2id, bcrypt.MinCost, testArgon2Params)
+	assert.NoError(t, err)
+
+	for _, hash := range []string{"", "garbage", "$argon2id$v=19$m=64,t=1,p=1$", "$argon2id$v=1$m=64,t=1,p=1$c2FsdA$a2V5"} {
+		ok, err := h.Verify("", []byte(hash))
+		assert.NoError(t, err)
+		assert.False(t, ok, hash)
+	}
+}
+
+// Hashes that can't match are checked against a dummy hash made with the configured algorithm, so they cost as much as
+// real ones, but even the dummy's own password doesn't match them
+func TestVerifyChecksDummyHash(t *testing.T) {
+	for _, algorithm := range []string{Bcrypt, Argon2id} {
+		h, err := NewPasswordHasher(algorithm, bcrypt.MinCost, testArgon2Params)
+		assert.NoError(t, err)
+		assert.False(t, h.NeedsRehash(h.dummyHash), algorithm)
+
+		ok, err := h.Verify("not a real password", nil)
+		assert.NoError(t, err)
+		assert.False(t, ok, algorithm)
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	weak, err := NewPasswordHasher(Bcrypt, bcrypt.MinCost, testArgon2Params)
+	assert.NoError(t, err)
+	strong, err := NewPasswordHasher(Bcrypt, bcrypt.MinCost+1, testArgon2Params)
+	assert.NoError(t, err)
+	argon, err := NewPasswordHasher(Argon2id, bcrypt.MinCost, testArgon2Params)
+	assert.NoError(t, err)
+	strongArgon, err := NewPasswordHasher(Argon2id, bcrypt.MinCost, Argon2Params{Memory: 128, Iterations: 1, Parallelism: 1})
+	assert.NoError(t, err)
+
+	weakHash, err := weak.Hash("testpassword")
+	assert.NoError(t, err)
+	argonHash, err := argon.Hash("testpassword")
+	assert.NoError(t, err)
+
+	assert.True(t, strong.NeedsRehash(weakHash))
+	assert.True(t, argon.NeedsRehash(weakHash))
+	assert.True(t, strongArgon.NeedsRehash(argonHash))
+	// Never downgraded
+	assert.False(t, weak.NeedsRehash(argonHash))
+	assert.False(t, argon.NeedsRehash([]byte("")))
+}
+
+func TestNewPasswordHasherValidatesConfig(t *testing.T) {
+	_, err := NewPasswordHasher("md5", bcrypt.DefaultCost, DefaultArgon2Params)
+	assert.Error(t, err)
+
+	_, err = NewPasswordHasher(Bcrypt, bcrypt.MaxCost+1, DefaultArgon2Params)
+	assert.Error(t, err)
+
+	_, err = NewPasswordHasher(Argon2id, bcrypt.DefaultCost, Argon2Params{})
+	assert.Error(t, err)
+}
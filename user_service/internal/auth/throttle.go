@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/horahoradev/horahora/user_service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errTooManyLoginFailures = status.Error(codes.ResourceExhausted, "too many failed login attempts, try again later")
+
+// LoginThrottle locks accounts and IP addresses out of logging in after too many failed attempts within Window. The
+// lockout lifts once the failures are older than Window, or when the account's password is reset. A value of 0
+// disables the corresponding limit.
+type LoginThrottle struct {
+	MaxAccountFailures int64
+	MaxIPFailures      int64
+	Window             time.Duration
+}
+
+// reserve counts the attempt as a failure up front, and returns an error if that puts the user or IP address past the
+// limit. Counting first means that concurrent attempts can't all pass the check before any of them is recorded. Locked
+// out attempts are forgotten, and aren't checked against the password at all, so they can't be used to keep guessing.
+//
+// The attempt stays recorded if the password turns out to be wrong, and is cleared along with the account's other
+// failures if it's right.
+func (t *LoginThrottle) reserve(uid int64, ip string, u *model.UserModel) error {
+	attemptID, err := u.RecordLoginFailure(uid, ip)
+	if err != nil {
+		return err
+	}
+
+	accountFailures, ipFailures, err := u.CountLoginFailures(uid, ip, time.Now().Add(-t.Window))
+	if err != nil {
+		return err
+	}
+
+	if (t.MaxAccountFailures > 0 && accountFailures > t.MaxAccountFailures) ||
+		(t.MaxIPFailures > 0 && ipFailures > t.MaxIPFailures) {
+		if err = u.DeleteLoginFailure(attemptID); err != nil {
+			return err
+		}
+
+		return errTooManyLoginFailures
+	}
+
+	return nil
+}
+
+var errTooManyPasswordResets = status.Error(codes.ResourceExhausted, "too many password reset requests, try again later")
+
+// PasswordResetThrottle limits how many password reset emails can be requested within Window, per email address and
+// per IP address, so that the reset form can't be used to flood someone's inbox. A value of 0 disables the
+// corresponding limit.
+type PasswordResetThrottle struct {
+	MaxAddressRequests int64
+	MaxIPRequests      int64
+	Window             time.Duration
+}
+
+// reserve records the request, and returns an error if that puts the address or IP address past the limit. Requests
+// for unregistered addresses count too, so that the limit doesn't reveal which addresses are registered.
+func (t *PasswordResetThrottle) reserve(email, ip string, u *model.UserModel) error {
+	requestID, err := u.RecordPasswordResetRequest(email, ip)
+	if err != nil {
+		return err
+	}
+
+	addressRequests, ipRequests, err := u.CountPasswordResetRequests(email, ip, time.Now().Add(-t.Window))
+	if err != nil {
+		return err
+	}
+
+	if (t.MaxAddressRequests > 0 && addressRequests > t.MaxAddressRequests) ||
+		(t.MaxIPRequests > 0 && ipRequests > t.MaxIPRequests) {
+		if err = u.DeletePasswordResetRequest(requestID); err != nil {
+			return err
+		}
+
+		return errTooManyPasswordResets
+	}
+
+	return nil
+}
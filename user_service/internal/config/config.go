@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/caarlos0/env"
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
@@ -18,8 +20,44 @@ type PostgresInfo struct {
 type config struct {
 	PostgresInfo
 	RSAKeypair string `env:"RSA_KEYPAIR,required"`
-	GRPCPort   int64  `env:"GRPCPort,required"`
-	DbConn     *sqlx.DB
+	// Previous keypairs, PEM encoded and concatenated. Their public keys are still published after rotating
+	// RSA_KEYPAIR, so that tokens they signed stay valid until they expire.
+	RSARetiredKeypairs string `env:"RSA_RETIRED_KEYPAIRS"`
+	GRPCPort           int64  `env:"GRPCPort,required"`
+	// Links in emails point here
+	BaseURL string `env:"BASE_URL" envDefault:"http://localhost:3000"`
+	// SMTPHost is required unless MailLogOnly is set, in which case emails are logged instead of sent. Logged emails
+	// include their verification and password reset links, so this is only for development.
+	MailLogOnly  bool   `env:"MAIL_LOG_ONLY"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT" envDefault:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	MailFrom     string `env:"MAIL_FROM" envDefault:"noreply@horahora.org"`
+	// New passwords are hashed with bcrypt or argon2id. Existing hashes are upgraded on login.
+	PasswordHash      string `env:"PASSWORD_HASH" envDefault:"bcrypt"`
+	BcryptCost        int    `env:"BCRYPT_COST" envDefault:"10"`
+	Argon2Memory      uint   `env:"ARGON2_MEMORY" envDefault:"65536"` // KiB
+	Argon2Iterations  uint   `env:"ARGON2_ITERATIONS" envDefault:"3"`
+	Argon2Parallelism uint   `env:"ARGON2_PARALLELISM" envDefault:"4"`
+	// Logins are refused after this many failures within LoginFailureWindow, per account and per IP address
+	MaxAccountLoginFailures int64         `env:"MAX_ACCOUNT_LOGIN_FAILURES" envDefault:"5"`
+	MaxIPLoginFailures      int64         `env:"MAX_IP_LOGIN_FAILURES" envDefault:"20"`
+	LoginFailureWindow      time.Duration `env:"LOGIN_FAILURE_WINDOW" envDefault:"15m"`
+	// Password reset emails are refused after this many requests within PasswordResetWindow, per email address and per
+	// IP address
+	MaxAddressPasswordResets int64         `env:"MAX_ADDRESS_PASSWORD_RESETS" envDefault:"3"`
+	MaxIPPasswordResets      int64         `env:"MAX_IP_PASSWORD_RESETS" envDefault:"10"`
+	PasswordResetWindow      time.Duration `env:"PASSWORD_RESET_WINDOW" envDefault:"1h"`
+	// Profile images are stored like videos, so these are named the same as the video service's settings. Image
+	// uploads are disabled if StorageBackend is unset.
+	StorageBackend string `env:"StorageBackend"`
+	StorageAPIID   string `env:"StorageAPIID"`
+	StorageAPIKey  string `env:"StorageAPIKey"`
+	MinioEndpoint  string `env:"MinioEndpoint"`
+	BucketName     string `env:"BucketName"`
+	OriginFQDN     string `env:"OriginFQDN"`
+	DbConn         *sqlx.DB
 }
 
 func New() (*config, error) {
@@ -30,6 +68,9 @@ func New() (*config, error) {
 	}
 
 	err = env.Parse(&config)
+	if err != nil {
+		return nil, err
+	}
 
 	// https://www.calhoun.io/connecting-to-a-postgresql-database-with-gos-database-sql-package/
 	conn, err := sqlx.Connect("postgres", fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=disable", config.Hostname, config.Username, config.Password, config.Db))
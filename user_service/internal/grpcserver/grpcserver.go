@@ -2,80 +2,240 @@ package grpcserver
 
 import (
 	"context"
-	"crypto/rsa"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/horahoradev/horahora/storage"
 	"github.com/horahoradev/horahora/user_service/internal/auth"
+	"github.com/horahoradev/horahora/user_service/internal/mailer"
 	"github.com/horahoradev/horahora/user_service/internal/model"
+	"github.com/horahoradev/horahora/user_service/internal/profile"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	"github.com/horahoradev/horahora/user_service/verifier"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/jmoiron/sqlx"
 
 	proto "github.com/horahoradev/horahora/user_service/protocol"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCServer struct {
-	db         *sqlx.DB
-	privateKey *rsa.PrivateKey
-	um         *model.UserModel
+	db            *sqlx.DB
+	keys          *auth.KeySet
+	verifier      *verifier.Verifier
+	um            *model.UserModel
+	hasher        *auth.PasswordHasher
+	throttle      *auth.LoginThrottle
+	resetThrottle *auth.PasswordResetThrottle
+	mailer        mailer.Mailer
+	baseURL       string // Of the website, which links in emails point to
+	// Profile images are uploaded here, and served from originFQDN. Nil if image uploads aren't configured.
+	storage    storage.Storage
+	originFQDN string
 }
 
 // Compile-time implementation check
 var _ proto.UserServiceServer = (*GRPCServer)(nil)
 
-func NewGRPCServer(db *sqlx.DB, privateKey *rsa.PrivateKey, port int64) error {
+func NewGRPCServer(db *sqlx.DB, keys *auth.KeySet, hasher *auth.PasswordHasher, throttle *auth.LoginThrottle,
+	resetThrottle *auth.PasswordResetThrottle, m mailer.Mailer, baseURL string, store storage.Storage, originFQDN string, port int64) error {
 	um, err := model.NewUserModel(db)
 	if err != nil {
 		return err
 	}
 
 	g := GRPCServer{
-		db:         db,
-		privateKey: privateKey,
-		um:         um,
+		db:            db,
+		keys:          keys,
+		verifier:      verifier.New(verifier.StaticKeySource(keys.JWKS())),
+		um:            um,
+		hasher:        hasher,
+		throttle:      throttle,
+		resetThrottle: resetThrottle,
+		mailer:        m,
+		baseURL:       baseURL,
+		storage:       store,
+		originFQDN:    originFQDN,
 	}
 
+	go g.pruneExpiredTokens()
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	log.Infof("Listening on port %d", port)
-	grpcServer := grpc.NewServer()
+	// Leave room for profile images on top of the default limit
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(profile.MaxImageBytes+1<<20),
+		grpc.UnaryInterceptor(permissions.CallerInterceptor(g.verifier)))
 	proto.RegisterUserServiceServer(grpcServer, g)
 	return grpcServer.Serve(lis)
 }
 
 func (g GRPCServer) Register(ctx context.Context, req *proto.RegisterRequest) (*proto.RegisterResponse, error) {
 	log.Infof("Handling registration for user %s", req.Username)
-	jwt, err := auth.Register(req.Username, req.Email, req.Password, g.um, g.privateKey, req.ForeignUser, req.ForeignUserID, req.ForeignWebsite)
+	tokens, err := auth.Register(req.Username, req.Email, req.Password, g.um, g.keys, g.hasher, req.ForeignUser, req.ForeignUserID, req.ForeignWebsite)
 	if err != nil {
 		log.Errorf("auth: failed to register user %s, failed with err %s", req.Username, err)
 		return nil, err
 	}
 
+	if !req.ForeignUser && req.Email != "" {
+		go g.sendVerificationEmail(tokens.UserID)
+	}
+
 	p := proto.RegisterResponse{
-		Jwt: jwt,
+		Jwt:          tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		Expiry:       tokens.Expiry.Unix(),
 	}
 
 	return &p, nil
 }
 
 func (g GRPCServer) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
-	log.Info("Handling login for user %s", req.Username)
-	jwt, err := auth.Login(req.Username, req.Password, g.privateKey, g.um)
+	log.Infof("Handling login for user %s", req.Username)
+	tokens, err := auth.Login(req.Username, req.Password, req.Ip, g.keys, g.hasher, g.throttle, g.um)
 	if err != nil {
 		log.Errorf("auth login failed with err: %s", err)
 		return nil, err
 	}
 
-	p := proto.LoginResponse{
-		Jwt: jwt,
+	return newLoginResponse(tokens), nil
+}
+
+func (g GRPCServer) RefreshToken(ctx context.Context, req *proto.RefreshTokenRequest) (*proto.LoginResponse, error) {
+	tokens, err := auth.RefreshToken(req.RefreshToken, g.keys, g.um)
+	if err != nil {
+		log.Errorf("failed to refresh token, err: %s", err)
+		return nil, err
 	}
 
-	return &p, nil
+	return newLoginResponse(tokens), nil
+}
+
+func newLoginResponse(tokens *auth.Tokens) *proto.LoginResponse {
+	return &proto.LoginResponse{
+		Jwt:          tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		Expiry:       tokens.Expiry.Unix(),
+	}
+}
+
+func (g GRPCServer) RevokeSessions(ctx context.Context, req *proto.RevokeSessionsRequest) (*proto.RevokeSessionsResponse, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if req.SessionID == 0 {
+		err = g.um.RevokeAllSessions(req.UserID)
+	} else {
+		err = g.um.RevokeSession(req.UserID, req.SessionID)
+	}
+	if err != nil {
+		log.Errorf("failed to revoke sessions of user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	return &proto.RevokeSessionsResponse{}, nil
+}
+
+// sendVerificationEmail is run after registration, which succeeds even if the email can't be sent. The user can ask
+// for another one later.
+func (g GRPCServer) sendVerificationEmail(uid int64) {
+	if err := auth.SendVerificationEmail(uid, g.um, g.mailer, g.baseURL); err != nil {
+		log.Errorf("failed to send verification email to user %d, failed with err %s", uid, err)
+	}
+}
+
+func (g GRPCServer) SendVerificationEmail(ctx context.Context, req *proto.SendVerificationEmailRequest) (*proto.SendVerificationEmailResponse, error) {
+	if req.UserID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "must be logged in to verify an email address")
+	}
+
+	if err := auth.SendVerificationEmail(req.UserID, g.um, g.mailer, g.baseURL); err != nil {
+		log.Errorf("failed to send verification email to user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	return &proto.SendVerificationEmailResponse{}, nil
+}
+
+func (g GRPCServer) VerifyEmail(ctx context.Context, req *proto.VerifyEmailRequest) (*proto.UserResponse, error) {
+	uid, err := auth.VerifyEmail(req.Token, g.um)
+	if err != nil {
+		log.Errorf("failed to verify email, err: %s", err)
+		return nil, err
+	}
+
+	log.Infof("User %d verified their email address", uid)
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: uid})
+}
+
+func (g GRPCServer) RequestPasswordReset(ctx context.Context, req *proto.RequestPasswordResetRequest) (*proto.RequestPasswordResetResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email address is required")
+	}
+
+	if err := auth.RequestPasswordReset(req.Email, req.Ip, g.resetThrottle, g.um, g.mailer, g.baseURL); err != nil {
+		log.Errorf("failed to send password reset email, err: %s", err)
+		return nil, err
+	}
+
+	return &proto.RequestPasswordResetResponse{}, nil
+}
+
+func (g GRPCServer) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest) (*proto.ResetPasswordResponse, error) {
+	uid, err := auth.ResetPassword(req.Token, req.NewPassword, g.hasher, g.um)
+	if err != nil {
+		log.Errorf("failed to reset password, err: %s", err)
+		return nil, err
+	}
+
+	log.Infof("User %d reset their password", uid)
+
+	return &proto.ResetPasswordResponse{}, nil
+}
+
+func newUserResponse(user *model.User) *proto.UserResponse {
+	resp := &proto.UserResponse{
+		Username:      user.Username,
+		Email:         user.Email,
+		Rank:          proto.UserRank(user.Rank),
+		UserID:        user.ID,
+		Banned:        user.Banned,
+		EmailVerified: user.EmailVerified,
+		DisplayName:   user.DisplayName,
+		Bio:           user.Bio,
+		Links:         user.Links,
+		AvatarURL:     user.AvatarURL,
+		BannerURL:     user.BannerURL,
+		Foreign:       user.Foreign,
+		MergedInto:    user.MergedInto.Int64,
+	}
+
+	if user.Banned {
+		resp.BanReason = user.BanReason
+		if user.BannedUntil != nil {
+			resp.BannedUntil = user.BannedUntil.Unix()
+		}
+	}
+
+	return resp
 }
 
 func (g GRPCServer) GetUserFromID(ctx context.Context, req *proto.GetUserFromIDRequest) (*proto.UserResponse, error) {
@@ -83,30 +243,52 @@ func (g GRPCServer) GetUserFromID(ctx context.Context, req *proto.GetUserFromIDR
 
 	user, err := g.um.GetUserWithID(id)
 	if err != nil {
-		log.Errorf("failed to fetch user with id %s, failed with err %s", id, err)
+		log.Errorf("failed to fetch user with id %d, failed with err %s", id, err)
 		return nil, err
 	}
 
-	return &proto.UserResponse{
-		Username: user.Username,
-		Email:    user.Email,
-		Rank:     proto.UserRank(user.Rank),
-	}, nil
+	return newUserResponse(user), nil
+}
+
+func (g GRPCServer) GetUsersByIDs(ctx context.Context, req *proto.GetUsersByIDsRequest) (*proto.UsersResponse, error) {
+	users, err := g.um.GetUsersWithIDs(req.UserIDs)
+	if err != nil {
+		log.Errorf("failed to fetch users with ids %v, failed with err %s", req.UserIDs, err)
+		return nil, err
+	}
+
+	resp := proto.UsersResponse{}
+	for i := range users {
+		resp.Users = append(resp.Users, newUserResponse(&users[i]))
+	}
+
+	return &resp, nil
 }
 
 func (g GRPCServer) ValidateJWT(ctx context.Context, req *proto.ValidateJWTRequest) (*proto.ValidateJWTResponse, error) {
-	uid, err := auth.ValidateJWT(req.Jwt, *g.privateKey)
+	payload, err := auth.ValidateJWT(req.Jwt, g.verifier, g.um)
 	if err != nil {
 		log.Errorf("failed to validate JWT, err: %s", err)
 		return nil, err
 	}
 
 	return &proto.ValidateJWTResponse{
-		IsValid: true,
-		Uid:     uid,
+		IsValid:   true,
+		Uid:       payload.UID,
+		SessionID: payload.SessionID,
 	}, nil
 }
 
+func (g GRPCServer) GetJWKS(ctx context.Context, req *proto.GetJWKSRequest) (*proto.JWKS, error) {
+	jwks, err := json.Marshal(g.keys.JWKS())
+	if err != nil {
+		log.Errorf("failed to marshal JWKS, err: %s", err)
+		return nil, err
+	}
+
+	return &proto.JWKS{Jwks: string(jwks)}, nil
+}
+
 func (g GRPCServer) GetUserForForeignUID(ctx context.Context, req *proto.GetForeignUserRequest) (*proto.GetForeignUserResponse, error) {
 	uid, err := g.um.GetForeignUser(req.ForeignUserID, req.OriginalWebsite)
 	if err != nil {
@@ -118,3 +300,530 @@ func (g GRPCServer) GetUserForForeignUID(ctx context.Context, req *proto.GetFore
 		NewUID: uid,
 	}, nil
 }
+
+// authorizeModeration fetches the moderator and the user they're acting on, and checks that the moderator is the caller,
+// has the permission and outranks the user
+func (g GRPCServer) authorizeModeration(ctx context.Context, moderatorID, userID int64, p permissions.Permission) (*model.User, *model.User, error) {
+	if err := permissions.RequireCaller(ctx, moderatorID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := permissions.RequireScope(ctx, p); err != nil {
+		return nil, nil, err
+	}
+
+	moderator, err := g.um.GetUserWithID(moderatorID)
+	if err != nil {
+		log.Errorf("failed to fetch moderator with id %d, failed with err %s", moderatorID, err)
+		return nil, nil, err
+	}
+
+	user, err := g.um.GetUserWithID(userID)
+	if err != nil {
+		log.Errorf("failed to fetch user with id %d, failed with err %s", userID, err)
+		return nil, nil, err
+	}
+
+	switch {
+	case moderator.Banned || !permissions.RankHas(proto.UserRank(moderator.Rank), p):
+		return nil, nil, status.Errorf(codes.PermissionDenied, "%s permission required", p)
+	case moderator.Rank <= user.Rank:
+		return nil, nil, status.Error(codes.PermissionDenied, "can only moderate users of a lower rank")
+	}
+
+	return moderator, user, nil
+}
+
+func (g GRPCServer) BanUser(ctx context.Context, req *proto.BanUserRequest) (*proto.UserResponse, error) {
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ban duration can't be negative")
+	}
+
+	moderator, user, err := g.authorizeModeration(ctx, req.ModeratorID, req.UserID, permissions.UserBan)
+	if err != nil {
+		return nil, err
+	}
+
+	var until *time.Time
+	if req.DurationSeconds > 0 {
+		t := time.Now().Add(time.Duration(req.DurationSeconds) * time.Second)
+		until = &t
+	}
+
+	if err = g.um.Ban(moderator.ID, user.ID, until, req.Reason); err != nil {
+		log.Errorf("failed to ban user %d, failed with err %s", user.ID, err)
+		return nil, err
+	}
+
+	log.Infof("User %d banned by %d", user.ID, moderator.ID)
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: user.ID})
+}
+
+func (g GRPCServer) UnbanUser(ctx context.Context, req *proto.UnbanUserRequest) (*proto.UserResponse, error) {
+	moderator, user, err := g.authorizeModeration(ctx, req.ModeratorID, req.UserID, permissions.UserBan)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.um.Unban(moderator.ID, user.ID, req.Reason); err != nil {
+		log.Errorf("failed to unban user %d, failed with err %s", user.ID, err)
+		return nil, err
+	}
+
+	log.Infof("User %d unbanned by %d", user.ID, moderator.ID)
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: user.ID})
+}
+
+func (g GRPCServer) SetUserRank(ctx context.Context, req *proto.SetUserRankRequest) (*proto.UserResponse, error) {
+	if _, ok := proto.UserRank_name[int32(req.Rank)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown rank %d", req.Rank)
+	}
+
+	actor, user, err := g.authorizeModeration(ctx, req.ActorID, req.UserID, permissions.UserManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if int(req.Rank) > actor.Rank {
+		return nil, status.Error(codes.PermissionDenied, "can't grant a rank above your own")
+	}
+
+	if err = g.um.SetRank(actor.ID, user.ID, int(req.Rank)); err != nil {
+		log.Errorf("failed to set rank of user %d, failed with err %s", user.ID, err)
+		return nil, err
+	}
+
+	log.Infof("User %d given rank %s by %d", user.ID, req.Rank, actor.ID)
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: user.ID})
+}
+
+func (g GRPCServer) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.UserList, error) {
+	if err := permissions.RequireCaller(ctx, req.ActorID); err != nil {
+		return nil, err
+	}
+
+	allowed, err := g.HasPermission(ctx, &proto.PermissionCheck{UserID: req.ActorID, Permission: string(permissions.UserManage)})
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed.Allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "%s permission required", permissions.UserManage)
+	}
+
+	filter := model.UserFilter{
+		Query:      req.Query,
+		BannedOnly: req.BannedOnly,
+		LocalOnly:  req.LocalOnly,
+	}
+	for _, rank := range req.Ranks {
+		filter.Ranks = append(filter.Ranks, int(rank))
+	}
+
+	users, total, err := g.um.ListUsers(filter, req.PageNumber)
+	if err != nil {
+		log.Errorf("failed to list users, failed with err %s", err)
+		return nil, err
+	}
+
+	resp := proto.UserList{NumberOfUsers: total}
+	for i := range users {
+		resp.Users = append(resp.Users, newUserResponse(&users[i]))
+	}
+
+	return &resp, nil
+}
+
+// userPermissions returns the permissions of the user's rank, or none if the user is logged out or banned
+func (g GRPCServer) userPermissions(userID int64) ([]permissions.Permission, error) {
+	if userID == 0 {
+		return nil, nil
+	}
+
+	user, err := g.um.GetUserWithID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Banned {
+		return nil, nil
+	}
+
+	return permissions.RolePermissions[proto.UserRank(user.Rank)], nil
+}
+
+// HasPermission checks the user's permission. Calls from API tokens are also limited to the permissions of its scopes.
+func (g GRPCServer) HasPermission(ctx context.Context, req *proto.PermissionCheck) (*proto.PermissionCheckResult, error) {
+	if permissions.RequireScope(ctx, permissions.Permission(req.Permission)) != nil {
+		return &proto.PermissionCheckResult{Allowed: false}, nil
+	}
+
+	granted, err := g.userPermissions(req.UserID)
+	if err != nil {
+		log.Errorf("failed to fetch permissions of user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	for _, p := range granted {
+		if string(p) == req.Permission {
+			return &proto.PermissionCheckResult{Allowed: true}, nil
+		}
+	}
+
+	return &proto.PermissionCheckResult{Allowed: false}, nil
+}
+
+func (g GRPCServer) GetPermissions(ctx context.Context, req *proto.GetPermissionsRequest) (*proto.PermissionList, error) {
+	granted, err := g.userPermissions(req.UserID)
+	if err != nil {
+		log.Errorf("failed to fetch permissions of user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	resp := proto.PermissionList{}
+	for _, p := range granted {
+		resp.Permissions = append(resp.Permissions, string(p))
+	}
+
+	if scopes, ok := permissions.CallerScopes(ctx); ok {
+		resp.Permissions = permissions.ScopedPermissions(resp.Permissions, scopes)
+	}
+
+	return &resp, nil
+}
+
+func (g GRPCServer) UpdateProfile(ctx context.Context, req *proto.UpdateProfileRequest) (*proto.UserResponse, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := profile.Validate(req.DisplayName, req.Bio, req.Links); err != nil {
+		return nil, err
+	}
+
+	if err := g.um.UpdateProfile(req.UserID, req.DisplayName, req.Bio, req.Links); err != nil {
+		log.Errorf("failed to update profile of user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: req.UserID})
+}
+
+var profileImageSizes = map[proto.ProfileImage]profile.ImageSize{
+	proto.ProfileImage_avatar: profile.AvatarSize,
+	proto.ProfileImage_banner: profile.BannerSize,
+}
+
+func (g GRPCServer) UploadProfileImage(ctx context.Context, req *proto.ProfileImageUpload) (*proto.UserResponse, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	size, ok := profileImageSizes[req.Image]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown profile image %d", req.Image)
+	}
+
+	var url string
+	if len(req.Data) > 0 {
+		if g.storage == nil {
+			return nil, status.Error(codes.Unavailable, "image uploads aren't configured")
+		}
+
+		resized, err := profile.ResizeImage(req.Data, size)
+		if err != nil {
+			return nil, err
+		}
+
+		url, err = g.storeImage(req.Image.String(), resized)
+		if err != nil {
+			log.Errorf("failed to store %s of user %d, failed with err %s", req.Image, req.UserID, err)
+			return nil, err
+		}
+	}
+
+	oldURL, err := g.um.SetProfileImage(req.UserID, req.Image.String(), url)
+	if err != nil {
+		log.Errorf("failed to set %s of user %d, failed with err %s", req.Image, req.UserID, err)
+		g.deleteImage(url)
+		return nil, err
+	}
+
+	// Image URLs are only referenced by the user, so the replaced image can go once the new URL is saved
+	g.deleteImage(oldURL)
+
+	return g.GetUserFromID(ctx, &proto.GetUserFromIDRequest{UserID: req.UserID})
+}
+
+// deleteImage deletes a profile image uploaded by storeImage. Failures are only logged, since they just leave an
+// unreferenced object behind.
+func (g GRPCServer) deleteImage(url string) {
+	prefix := g.originFQDN + "/"
+	if g.storage == nil || !strings.HasPrefix(url, prefix) {
+		return
+	}
+
+	if err := g.storage.Delete(strings.TrimPrefix(url, prefix)); err != nil {
+		log.Errorf("could not delete profile image %s. Err: %s", url, err)
+	}
+}
+
+// storeImage uploads a JPEG under a new random name, so that caches never serve the old image, and returns its URL
+func (g GRPCServer) storeImage(prefix string, data []byte) (string, error) {
+	f, err := ioutil.TempFile("", "profile-*.jpg")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-%s.jpg", prefix, uuid.New())
+	if err = g.storage.Upload(f.Name(), name); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s", g.originFQDN, name), nil
+}
+
+func newAPITokenResponse(token *model.APIToken) *proto.APIToken {
+	resp := &proto.APIToken{
+		TokenID:      token.ID,
+		Name:         token.Name,
+		Scopes:       token.Scopes,
+		CreationDate: token.CreationDate.Unix(),
+		ExpiresAt:    token.ExpiresAt.Unix(),
+	}
+
+	if token.LastUsedAt != nil {
+		resp.LastUsed = token.LastUsedAt.Unix()
+	}
+
+	return resp
+}
+
+func (g GRPCServer) CreateAPIToken(ctx context.Context, req *proto.CreateAPITokenRequest) (*proto.NewAPIToken, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	// Otherwise a token could create another with more scopes
+	if _, ok := permissions.CallerScopes(ctx); ok {
+		return nil, status.Error(codes.PermissionDenied, "API tokens can't create API tokens")
+	}
+
+	lifetime := time.Duration(req.LifetimeSeconds) * time.Second
+	token, info, err := auth.CreateAPIToken(req.UserID, req.Name, req.Scopes, lifetime, g.um)
+	if err != nil {
+		log.Errorf("failed to create API token for user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	log.Infof("User %d created API token %d with scopes %v", req.UserID, info.ID, info.Scopes)
+
+	return &proto.NewAPIToken{Token: token, Info: newAPITokenResponse(info)}, nil
+}
+
+func (g GRPCServer) ListAPITokens(ctx context.Context, req *proto.ListAPITokensRequest) (*proto.APITokenList, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := g.um.ListAPITokens(req.UserID)
+	if err != nil {
+		log.Errorf("failed to list API tokens of user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	resp := &proto.APITokenList{}
+	for i := range tokens {
+		resp.Tokens = append(resp.Tokens, newAPITokenResponse(&tokens[i]))
+	}
+
+	return resp, nil
+}
+
+func (g GRPCServer) RevokeAPIToken(ctx context.Context, req *proto.RevokeAPITokenRequest) (*proto.RevokeAPITokenResponse, error) {
+	if err := permissions.RequireCaller(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := g.um.RevokeAPIToken(req.UserID, req.TokenID); err != nil {
+		log.Errorf("failed to revoke API token %d, failed with err %s", req.TokenID, err)
+		return nil, err
+	}
+
+	return &proto.RevokeAPITokenResponse{}, nil
+}
+
+func (g GRPCServer) AuthenticateAPIToken(ctx context.Context, req *proto.AuthenticateAPITokenRequest) (*proto.APITokenAuthentication, error) {
+	token, accessToken, err := auth.AuthenticateAPIToken(req.Token, g.keys, g.um)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.APITokenAuthentication{UserID: token.UserID, TokenID: token.ID, Scopes: token.Scopes, Jwt: accessToken}, nil
+}
+
+func newClaimResponse(claim *model.Claim) (*proto.Claim, error) {
+	// Websites are stored as their enum value
+	website, err := strconv.Atoi(claim.ForeignWebsite)
+	if err != nil {
+		return nil, fmt.Errorf("invalid foreign website %q: %w", claim.ForeignWebsite, err)
+	}
+
+	resp := &proto.Claim{
+		ClaimID:          claim.ID,
+		ForeignAccountID: claim.ForeignAccountID,
+		Website:          proto.Site(website),
+		ForeignUserID:    claim.ForeignUserID,
+		Code:             claim.Code,
+		ExpiresAt:        claim.ExpiresAt.Unix(),
+		Completed:        claim.CompletedAt != nil,
+	}
+
+	if claim.Mode != nil {
+		resp.Mode = proto.ClaimMode(*claim.Mode)
+	}
+
+	return resp, nil
+}
+
+func (g GRPCServer) StartClaim(ctx context.Context, req *proto.StartClaimRequest) (*proto.Claim, error) {
+	if err := permissions.RequireCaller(ctx, req.ClaimantID); err != nil {
+		return nil, err
+	}
+
+	claim, err := auth.StartClaim(req.ClaimantID, req.ForeignAccountID, g.um)
+	if err != nil {
+		log.Errorf("failed to start claim of user %d by user %d, failed with err %s", req.ForeignAccountID, req.ClaimantID, err)
+		return nil, err
+	}
+
+	return newClaimResponse(claim)
+}
+
+func (g GRPCServer) GetClaim(ctx context.Context, req *proto.GetClaimRequest) (*proto.Claim, error) {
+	if err := permissions.RequireCaller(ctx, req.ClaimantID); err != nil {
+		return nil, err
+	}
+
+	claim, err := auth.GetClaim(req.ClaimID, req.ClaimantID, g.um)
+	if err != nil {
+		log.Errorf("failed to fetch claim %d, failed with err %s", req.ClaimID, err)
+		return nil, err
+	}
+
+	return newClaimResponse(claim)
+}
+
+func (g GRPCServer) CompleteClaim(ctx context.Context, req *proto.CompleteClaimRequest) (*proto.Claim, error) {
+	if err := permissions.RequireCaller(ctx, req.ClaimantID); err != nil {
+		return nil, err
+	}
+
+	claim, err := auth.CompleteClaim(req.ClaimID, req.ClaimantID, model.ClaimMode(req.Mode), req.ChannelDescription,
+		req.Email, req.Password, g.hasher, g.um)
+	if err != nil {
+		log.Errorf("failed to complete claim %d, failed with err %s", req.ClaimID, err)
+		return nil, err
+	}
+
+	log.Infof("User %d claimed user %d with mode %s", req.ClaimantID, claim.ForeignAccountID, req.Mode)
+
+	return newClaimResponse(claim)
+}
+
+func (g GRPCServer) FollowUser(ctx context.Context, req *proto.FollowRequest) (*proto.FollowCounts, error) {
+	if err := permissions.RequireCaller(ctx, req.FollowerID); err != nil {
+		return nil, err
+	}
+
+	if err := g.um.Follow(req.FollowerID, req.FolloweeID); err != nil {
+		log.Errorf("failed to follow user %d for %d, failed with err %s", req.FolloweeID, req.FollowerID, err)
+		return nil, err
+	}
+
+	return g.GetFollowCounts(ctx, &proto.FollowCountsRequest{UserID: req.FolloweeID, ViewerID: req.FollowerID})
+}
+
+func (g GRPCServer) UnfollowUser(ctx context.Context, req *proto.FollowRequest) (*proto.FollowCounts, error) {
+	if err := permissions.RequireCaller(ctx, req.FollowerID); err != nil {
+		return nil, err
+	}
+
+	if err := g.um.Unfollow(req.FollowerID, req.FolloweeID); err != nil {
+		log.Errorf("failed to unfollow user %d for %d, failed with err %s", req.FolloweeID, req.FollowerID, err)
+		return nil, err
+	}
+
+	return g.GetFollowCounts(ctx, &proto.FollowCountsRequest{UserID: req.FolloweeID, ViewerID: req.FollowerID})
+}
+
+func (g GRPCServer) GetFollowCounts(ctx context.Context, req *proto.FollowCountsRequest) (*proto.FollowCounts, error) {
+	counts, err := g.um.GetFollowCounts(req.UserID, req.ViewerID)
+	if err != nil {
+		log.Errorf("failed to fetch follow counts for user %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	return &proto.FollowCounts{
+		Followers:        counts.Followers,
+		Following:        counts.Following,
+		FollowedByViewer: counts.FollowedByViewer,
+	}, nil
+}
+
+func (g GRPCServer) GetFollowing(ctx context.Context, req *proto.GetFollowingRequest) (*proto.FollowingList, error) {
+	followeeIDs, err := g.um.GetFollowing(req.UserID)
+	if err != nil {
+		log.Errorf("failed to fetch users followed by %d, failed with err %s", req.UserID, err)
+		return nil, err
+	}
+
+	return &proto.FollowingList{UserIDs: followeeIDs}, nil
+}
+
+func (g GRPCServer) pruneExpiredTokens() {
+	for {
+		n, err := g.um.PruneRefreshTokens()
+		if err != nil {
+			log.Errorf("could not prune refresh tokens. Err: %s", err)
+		} else if n > 0 {
+			log.Infof("Pruned %d expired refresh tokens", n)
+		}
+
+		if _, err = g.um.PruneLoginFailures(time.Now().Add(-g.throttle.Window)); err != nil {
+			log.Errorf("could not prune login failures. Err: %s", err)
+		}
+
+		if _, err = g.um.PrunePasswordResetRequests(time.Now().Add(-g.resetThrottle.Window)); err != nil {
+			log.Errorf("could not prune password reset requests. Err: %s", err)
+		}
+
+		n, err = g.um.PruneEmailTokens()
+		if err != nil {
+			log.Errorf("could not prune email tokens. Err: %s", err)
+		} else if n > 0 {
+			log.Infof("Pruned %d expired email tokens", n)
+		}
+
+		n, err = g.um.PruneAPITokens()
+		if err != nil {
+			log.Errorf("could not prune API tokens. Err: %s", err)
+		} else if n > 0 {
+			log.Infof("Pruned %d expired API tokens", n)
+		}
+
+		time.Sleep(time.Hour)
+	}
+}
@@ -0,0 +1,128 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/horahoradev/horahora/user_service/internal/auth"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	proto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// Handlers which act as the request's user are refused unless that user's access token came with the call. They're
+// refused before the database is used, so the server needs no model.
+func TestHandlersRequireCaller(t *testing.T) {
+	const callerID, otherUserID = 1, 2
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keys, err := auth.NewKeySet(key, nil)
+	assert.NoError(t, err)
+
+	token, err := auth.CreateJWT(auth.JWTPayload{
+		Claims: jwt.Claims{Issuer: verifier.Issuer, Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+		UID:    callerID,
+	}, keys)
+	assert.NoError(t, err)
+
+	interceptor := permissions.CallerInterceptor(verifier.New(verifier.StaticKeySource(keys.JWKS())))
+	g := GRPCServer{}
+
+	handlers := map[string]func(ctx context.Context, userID int64) error{
+		"UpdateProfile": func(ctx context.Context, userID int64) error {
+			_, err := g.UpdateProfile(ctx, &proto.UpdateProfileRequest{UserID: userID, DisplayName: "wow"})
+			return err
+		},
+		"UploadProfileImage": func(ctx context.Context, userID int64) error {
+			_, err := g.UploadProfileImage(ctx, &proto.ProfileImageUpload{UserID: userID, Image: proto.ProfileImage_avatar})
+			return err
+		},
+		"StartClaim": func(ctx context.Context, userID int64) error {
+			_, err := g.StartClaim(ctx, &proto.StartClaimRequest{ClaimantID: userID, ForeignAccountID: 3})
+			return err
+		},
+		"GetClaim": func(ctx context.Context, userID int64) error {
+			_, err := g.GetClaim(ctx, &proto.GetClaimRequest{ClaimID: 4, ClaimantID: userID})
+			return err
+		},
+		"CompleteClaim": func(ctx context.Context, userID int64) error {
+			_, err := g.CompleteClaim(ctx, &proto.CompleteClaimRequest{ClaimID: 4, ClaimantID: userID, Mode: proto.ClaimMode_merge})
+			return err
+		},
+		"FollowUser": func(ctx context.Context, userID int64) error {
+			_, err := g.FollowUser(ctx, &proto.FollowRequest{FollowerID: userID, FolloweeID: 3})
+			return err
+		},
+		"UnfollowUser": func(ctx context.Context, userID int64) error {
+			_, err := g.UnfollowUser(ctx, &proto.FollowRequest{FollowerID: userID, FolloweeID: 3})
+			return err
+		},
+		"RevokeSessions": func(ctx context.Context, userID int64) error {
+			_, err := g.RevokeSessions(ctx, &proto.RevokeSessionsRequest{UserID: userID})
+			return err
+		},
+		"CreateAPIToken": func(ctx context.Context, userID int64) error {
+			_, err := g.CreateAPIToken(ctx, &proto.CreateAPITokenRequest{UserID: userID, Name: "bot", Scopes: []string{"read"}})
+			return err
+		},
+		"ListAPITokens": func(ctx context.Context, userID int64) error {
+			_, err := g.ListAPITokens(ctx, &proto.ListAPITokensRequest{UserID: userID})
+			return err
+		},
+		"RevokeAPIToken": func(ctx context.Context, userID int64) error {
+			_, err := g.RevokeAPIToken(ctx, &proto.RevokeAPITokenRequest{UserID: userID, TokenID: 5})
+			return err
+		},
+	}
+
+	for name, handler := range handlers {
+		call := func(md metadata.MD, userID int64) error {
+			_, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/proto.UserService/" + name},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, handler(ctx, userID)
+				})
+			return err
+		}
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(call(metadata.Pairs(permissions.AccessTokenKey, token), otherUserID)), name)
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(metadata.MD{}, otherUserID)), name)
+	}
+}
+
+// Otherwise an API token could create another with more scopes than its own
+func TestAPITokensCantCreateAPITokens(t *testing.T) {
+	const callerID = 1
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keys, err := auth.NewKeySet(key, nil)
+	assert.NoError(t, err)
+
+	token, err := auth.CreateJWT(auth.JWTPayload{
+		Claims: jwt.Claims{Issuer: verifier.Issuer, Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+		UID:    callerID,
+		Scopes: []string{"read"},
+	}, keys)
+	assert.NoError(t, err)
+
+	interceptor := permissions.CallerInterceptor(verifier.New(verifier.StaticKeySource(keys.JWKS())))
+	g := GRPCServer{}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(permissions.AccessTokenKey, token))
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/proto.UserService/CreateAPIToken"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return g.CreateAPIToken(ctx, &proto.CreateAPITokenRequest{UserID: callerID, Name: "bot", Scopes: []string{"read"}})
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
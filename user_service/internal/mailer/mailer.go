@@ -0,0 +1,108 @@
+// Package mailer delivers the user service's emails, such as verification and password reset links
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string // Plain text
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
+
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer sends mail from the from address through the SMTP server at host:port. If username is empty, no
+// authentication is attempted.
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	m := SMTPMailer{
+		addr: host + ":" + strconv.Itoa(port),
+		from: from,
+	}
+
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &m
+}
+
+func (m *SMTPMailer) Send(msg Message) error {
+	// The recipient comes from user input, so mustn't be able to add headers
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return fmt.Errorf("invalid recipient or subject for mail to %q", msg.To)
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, formatMessage(m.from, msg, time.Now())); err != nil {
+		return fmt.Errorf("could not send mail to %s. Err: %s", msg.To, err)
+	}
+
+	return nil
+}
+
+func formatMessage(from string, msg Message, now time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	return b.Bytes()
+}
+
+// MemoryMailer keeps messages instead of sending them, for tests and for running without an SMTP server
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns every message sent so far, oldest first
+func (m *MemoryMailer) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Message(nil), m.messages...)
+}
+
+// LogMailer logs messages instead of sending them, for development without an SMTP server. Bodies are logged in full,
+// links and all, so it mustn't be used where the logs are less private than the recipients' inboxes.
+type LogMailer struct{}
+
+func (LogMailer) Send(msg Message) error {
+	log.Infof("Not sending mail to %s, no SMTP server is configured. Subject: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
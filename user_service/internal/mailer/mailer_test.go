@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessage(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	msg := string(formatMessage("noreply@horahora.org", Message{
+		To:      "user@example.com",
+		Subject: "パスワードのリセット",
+		Body:    "Hello",
+	}, now))
+
+	headers, body := splitMessage(msg)
+	assert.Contains(t, headers, "From: noreply@horahora.org\r\n")
+	assert.Contains(t, headers, "To: user@example.com\r\n")
+	assert.Contains(t, headers, "Subject: =?utf-8?q?")
+	assert.Contains(t, headers, "Date: Tue, 01 Jun 2021 12:00:00 +0000\r\n")
+	assert.Equal(t, "Hello", body)
+}
+
+func splitMessage(msg string) (string, string) {
+	i := strings.Index(msg, "\r\n\r\n")
+	return msg[:i+2], msg[i+4:]
+}
+
+func TestMemoryMailer(t *testing.T) {
+	m := NewMemoryMailer()
+	assert.NoError(t, m.Send(Message{To: "a@example.com"}))
+	assert.NoError(t, m.Send(Message{To: "b@example.com"}))
+
+	messages := m.Messages()
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, []string{messages[0].To, messages[1].To})
+}
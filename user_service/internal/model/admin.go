@@ -0,0 +1,172 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Actions recorded in the user audit log
+const (
+	AuditSetRank = "set_rank"
+	AuditBan     = "ban"
+	AuditUnban   = "unban"
+	// The claimant is the actor, and the foreign account is the user
+	AuditClaimConvert = "claim_convert"
+	AuditClaimMerge   = "claim_merge"
+)
+
+const UsersPerPage = 50
+
+// insertAuditLog records a change made to the user by actorID. It's done in the same transaction as the change, so
+// that neither happens without the other.
+func insertAuditLog(tx *sqlx.Tx, actorID, userID int64, action, details string) error {
+	sql := "INSERT INTO user_audit_log (actor_id, user_id, action, details) VALUES ($1, $2, $3, $4)"
+	_, err := tx.Exec(sql, actorID, userID, action, details)
+	return err
+}
+
+// SetRank changes the user's rank
+func (m *UserModel) SetRank(actorID, userID int64, rank int) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var oldRank int
+	if err = tx.QueryRow("SELECT rank FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&oldRank); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("UPDATE users SET rank = $1 WHERE id = $2", rank, userID); err != nil {
+		return err
+	}
+
+	if err = insertAuditLog(tx, actorID, userID, AuditSetRank, fmt.Sprintf("%d -> %d", oldRank, rank)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Ban bans the user until the given time, or permanently if it's nil, and logs them out everywhere
+func (m *UserModel) Ban(actorID, userID int64, until *time.Time, reason string) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sql := "UPDATE users SET banned = true, banned_until = $1, ban_reason = $2 WHERE id = $3"
+	if _, err = tx.Exec(sql, until, reason, userID); err != nil {
+		return err
+	}
+
+	if err = revokeAllSessions(tx, userID); err != nil {
+		return err
+	}
+
+	details := "permanent: " + reason
+	if until != nil {
+		details = fmt.Sprintf("until %s: %s", until.UTC().Format(time.RFC3339), reason)
+	}
+
+	if err = insertAuditLog(tx, actorID, userID, AuditBan, details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Unban lifts the user's ban or suspension
+func (m *UserModel) Unban(actorID, userID int64, reason string) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sql := "UPDATE users SET banned = false, banned_until = NULL, ban_reason = '' WHERE id = $1"
+	if _, err = tx.Exec(sql, userID); err != nil {
+		return err
+	}
+
+	if err = insertAuditLog(tx, actorID, userID, AuditUnban, reason); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UserFilter narrows down ListUsers. Zero values don't filter.
+type UserFilter struct {
+	// Matched against usernames and emails, ignoring case
+	Query string
+	// Only users with one of these ranks
+	Ranks []int
+	// Only users who are currently banned or suspended
+	BannedOnly bool
+	// Only users who registered here or claimed their account, rather than being created for archived videos
+	LocalOnly bool
+}
+
+// escapeLike escapes the LIKE wildcards in s, so that it's matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// ListUsers returns a page of the users matching the filter, ordered by ID, and the total number of matches. Pages
+// start at 1.
+func (m *UserModel) ListUsers(filter UserFilter, page int64) ([]User, int64, error) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.Query != "" {
+		args = append(args, "%"+escapeLike(filter.Query)+"%")
+		conditions = append(conditions, fmt.Sprintf("(username ILIKE $%d OR email ILIKE $%d)", len(args), len(args)))
+	}
+
+	if len(filter.Ranks) > 0 {
+		ranks := make([]int64, len(filter.Ranks))
+		for i, rank := range filter.Ranks {
+			ranks[i] = int64(rank)
+		}
+		args = append(args, pq.Array(ranks))
+		conditions = append(conditions, fmt.Sprintf("rank = ANY($%d)", len(args)))
+	}
+
+	if filter.BannedOnly {
+		conditions = append(conditions, "banned AND (banned_until IS NULL OR banned_until > Now())")
+	}
+
+	if filter.LocalOnly {
+		conditions = append(conditions, "(foreign_user_ID IS NULL OR claimed)")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := m.Conn.QueryRow("SELECT count(*) FROM users"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	args = append(args, UsersPerPage, (page-1)*UsersPerPage)
+	sql := fmt.Sprintf("SELECT %s FROM users%s ORDER BY id LIMIT $%d OFFSET $%d", userColumns, where, len(args)-1, len(args))
+
+	var users []User
+	if err := m.Conn.Select(&users, sql, args...); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
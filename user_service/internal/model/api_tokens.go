@@ -0,0 +1,99 @@
+package model
+
+import (
+	dbsql "database/sql"
+	"time"
+
+	"github.com/horahoradev/horahora/user_service/permissions"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIToken is a personal access token, as shown to its user. The token itself is only known when it's created.
+type APIToken struct {
+	ID           int64          `db:"id"`
+	UserID       int64          `db:"user_id"`
+	Name         string         `db:"name"`
+	Scopes       pq.StringArray `db:"scopes"`
+	CreationDate time.Time      `db:"creation_date"`
+	ExpiresAt    time.Time      `db:"expires_at"`
+	LastUsedAt   *time.Time     `db:"last_used_at"`
+}
+
+var ErrInvalidAPIToken = status.Error(codes.Unauthenticated, "API token is invalid or expired")
+
+const apiTokenColumns = "id, user_id, name, scopes, creation_date, expires_at, last_used_at"
+
+// CreateAPIToken generates a token for the user, and returns it along with its stored details
+func (m *UserModel) CreateAPIToken(userID int64, name string, scopes []string, lifetime time.Duration) (string, *APIToken, error) {
+	raw, _, err := generateToken()
+	if err != nil {
+		return "", nil, err
+	}
+	token := permissions.APITokenPrefix + raw
+
+	var apiToken APIToken
+	sql := "INSERT INTO api_tokens (user_id, name, token_hash, scopes, expires_at) VALUES ($1, $2, $3, $4, $5) " +
+		"RETURNING " + apiTokenColumns
+	err = m.Conn.Get(&apiToken, sql, userID, name, hashToken(token), pq.StringArray(scopes), time.Now().Add(lifetime))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, &apiToken, nil
+}
+
+// CountAPITokens returns the number of the user's tokens which haven't expired
+func (m *UserModel) CountAPITokens(userID int64) (int, error) {
+	var count int
+	sql := "SELECT count(*) FROM api_tokens WHERE user_id = $1 AND expires_at > Now()"
+	err := m.Conn.Get(&count, sql, userID)
+	return count, err
+}
+
+// ListAPITokens returns the user's tokens which haven't expired, newest first
+func (m *UserModel) ListAPITokens(userID int64) ([]APIToken, error) {
+	var tokens []APIToken
+	sql := "SELECT " + apiTokenColumns + " FROM api_tokens WHERE user_id = $1 AND expires_at > Now() " +
+		"ORDER BY creation_date DESC"
+	err := m.Conn.Select(&tokens, sql, userID)
+	return tokens, err
+}
+
+// RevokeAPIToken deletes one of the user's tokens
+func (m *UserModel) RevokeAPIToken(userID, tokenID int64) error {
+	res, err := m.Conn.Exec("DELETE FROM api_tokens WHERE id = $1 AND user_id = $2", tokenID, userID)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return status.Error(codes.NotFound, "API token not found")
+	}
+
+	return nil
+}
+
+// UseAPIToken returns the token's details and records that it was used
+func (m *UserModel) UseAPIToken(token string) (*APIToken, error) {
+	var apiToken APIToken
+	sql := "UPDATE api_tokens SET last_used_at = Now() WHERE token_hash = $1 AND expires_at > Now() " +
+		"RETURNING " + apiTokenColumns
+	err := m.Conn.Get(&apiToken, sql, hashToken(token))
+	if err == dbsql.ErrNoRows {
+		return nil, ErrInvalidAPIToken
+	}
+
+	return &apiToken, err
+}
+
+// PruneAPITokens deletes expired API tokens
+func (m *UserModel) PruneAPITokens() (int64, error) {
+	res, err := m.Conn.Exec("DELETE FROM api_tokens WHERE expires_at < Now()")
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
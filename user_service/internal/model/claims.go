@@ -0,0 +1,205 @@
+package model
+
+import (
+	dbsql "database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// What a completed claim did with the foreign account. Stored in account_claims.mode.
+type ClaimMode int
+
+const (
+	// The foreign account becomes a normal account which the claimant can log in to
+	ClaimConvert ClaimMode = iota
+	// The foreign account is merged into the claimant's account
+	ClaimMerge
+)
+
+// Claim is a user's attempt to prove that they own a foreign account
+type Claim struct {
+	ID               int64      `db:"id"`
+	ClaimantID       int64      `db:"claimant_id"`
+	ForeignAccountID int64      `db:"foreign_account_id"`
+	Code             string     `db:"code"`
+	ExpiresAt        time.Time  `db:"expires_at"`
+	CompletedAt      *time.Time `db:"completed_at"`
+	Mode             *ClaimMode `db:"mode"`
+	// Of the foreign account, for looking up its channel
+	ForeignUserID  string `db:"foreign_user_id"`
+	ForeignWebsite string `db:"foreign_website"`
+}
+
+var ErrClaimNotFound = status.Error(codes.NotFound, "claim not found")
+
+// ErrAccountNotClaimable is returned for accounts which weren't created for archived videos, or which have already
+// been claimed
+var ErrAccountNotClaimable = status.Error(codes.FailedPrecondition, "account isn't an unclaimed foreign account")
+
+const claimColumns = "account_claims.id, claimant_id, foreign_account_id, code, expires_at, completed_at, mode, " +
+	"users.foreign_user_ID AS foreign_user_id, users.foreign_website"
+
+// CreateClaim starts a claim of the foreign account by claimantID with the code. If the claimant already has an open
+// claim of the account, it's returned instead, so that the code they've put in their channel description stays valid.
+func (m *UserModel) CreateClaim(claimantID, foreignAccountID int64, code string, lifetime time.Duration) (*Claim, error) {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	sql := "SELECT account_claims.id FROM account_claims WHERE claimant_id = $1 AND foreign_account_id = $2 " +
+		"AND completed_at IS NULL AND expires_at > Now()"
+	var claimID int64
+	err = tx.QueryRow(sql, claimantID, foreignAccountID).Scan(&claimID)
+	switch {
+	case err == dbsql.ErrNoRows:
+		sql = "INSERT INTO account_claims (claimant_id, foreign_account_id, code, expires_at) VALUES ($1, $2, $3, $4) " +
+			"RETURNING id"
+		err = tx.QueryRow(sql, claimantID, foreignAccountID, code, time.Now().Add(lifetime)).Scan(&claimID)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	claim, err := getClaim(tx, claimID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return claim, tx.Commit()
+}
+
+// GetClaim returns the claim with the ID
+func (m *UserModel) GetClaim(claimID int64) (*Claim, error) {
+	var claim Claim
+	sql := "SELECT " + claimColumns + " FROM account_claims JOIN users ON users.id = foreign_account_id " +
+		"WHERE account_claims.id = $1"
+	err := m.Conn.Get(&claim, sql, claimID)
+	if err == dbsql.ErrNoRows {
+		return nil, ErrClaimNotFound
+	}
+
+	return &claim, err
+}
+
+// getClaim returns the claim with the ID in the transaction, optionally locking it
+func getClaim(tx *sqlx.Tx, claimID int64, forUpdate bool) (*Claim, error) {
+	sql := "SELECT " + claimColumns + " FROM account_claims JOIN users ON users.id = foreign_account_id " +
+		"WHERE account_claims.id = $1"
+	if forUpdate {
+		sql += " FOR UPDATE OF account_claims, users"
+	}
+
+	var claim Claim
+	err := tx.Get(&claim, sql, claimID)
+	if err == dbsql.ErrNoRows {
+		return nil, ErrClaimNotFound
+	}
+
+	return &claim, err
+}
+
+// beginClaimCompletion locks the open claim and its foreign account, and checks that the account can still be claimed.
+// Another claim may have been completed since this one was started.
+func beginClaimCompletion(tx *sqlx.Tx, claimID int64) (*Claim, error) {
+	claim, err := getClaim(tx, claimID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if claim.CompletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "claim has already been completed")
+	}
+
+	var claimable bool
+	sql := "SELECT foreign_user_ID IS NOT NULL AND NOT claimed AND merged_into IS NULL FROM users WHERE id = $1"
+	if err = tx.QueryRow(sql, claim.ForeignAccountID).Scan(&claimable); err != nil {
+		return nil, err
+	}
+
+	if !claimable {
+		return nil, ErrAccountNotClaimable
+	}
+
+	return claim, nil
+}
+
+// finishClaimCompletion marks the claim completed and records it in the audit log
+func finishClaimCompletion(tx *sqlx.Tx, claim *Claim, mode ClaimMode, action string) error {
+	sql := "UPDATE account_claims SET completed_at = Now(), mode = $1 WHERE id = $2"
+	if _, err := tx.Exec(sql, mode, claim.ID); err != nil {
+		return err
+	}
+
+	return insertAuditLog(tx, claim.ClaimantID, claim.ForeignAccountID, action, claim.Code)
+}
+
+// ConvertClaimedAccount completes the claim by turning the foreign account into a normal account, which can be logged
+// in to with the password. The email address is optional, and starts out unverified.
+func (m *UserModel) ConvertClaimedAccount(claimID int64, email string, passHash []byte) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	claim, err := beginClaimCompletion(tx, claimID)
+	if err != nil {
+		return err
+	}
+
+	sql := "UPDATE users SET claimed = true, email = $1, email_verified = false, pass_hash = $2 WHERE id = $3"
+	if _, err = tx.Exec(sql, email, string(passHash), claim.ForeignAccountID); err != nil {
+		return err
+	}
+
+	if err = finishClaimCompletion(tx, claim, ClaimConvert, AuditClaimConvert); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MergeClaimedAccount completes the claim by merging the foreign account into the claimant's account. Its followers
+// move over, and videos archived from it later belong to the claimant. Moving its existing videos is up to the video
+// service.
+func (m *UserModel) MergeClaimedAccount(claimID int64) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	claim, err := beginClaimCompletion(tx, claimID)
+	if err != nil {
+		return err
+	}
+
+	sql := "UPDATE users SET merged_into = $1 WHERE id = $2"
+	if _, err = tx.Exec(sql, claim.ClaimantID, claim.ForeignAccountID); err != nil {
+		return err
+	}
+
+	// The claimant may already be followed by some of the followers, and can't follow themselves
+	sql = "INSERT INTO follows (follower_id, followee_id) SELECT follower_id, $1 FROM follows " +
+		"WHERE followee_id = $2 AND follower_id <> $1 ON CONFLICT DO NOTHING"
+	if _, err = tx.Exec(sql, claim.ClaimantID, claim.ForeignAccountID); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM follows WHERE followee_id = $1", claim.ForeignAccountID); err != nil {
+		return err
+	}
+
+	if err = finishClaimCompletion(tx, claim, ClaimMerge, AuditClaimMerge); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	dbsql "database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// What an email token can be used for. Stored in email_tokens.purpose.
+type EmailTokenPurpose int
+
+const (
+	EmailVerification EmailTokenPurpose = iota
+	PasswordReset
+)
+
+var ErrInvalidEmailToken = status.Error(codes.InvalidArgument, "link is invalid or has expired")
+
+// CreateEmailToken generates a token for the purpose which can be sent to email, replacing any earlier token for the
+// same purpose
+func (m *UserModel) CreateEmailToken(userID int64, purpose EmailTokenPurpose, email string, lifetime time.Duration) (string, error) {
+	token, hash, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec("DELETE FROM email_tokens WHERE user_id = $1 AND purpose = $2", userID, purpose); err != nil {
+		return "", err
+	}
+
+	sql := "INSERT INTO email_tokens (token_hash, user_id, purpose, email, expires_at) VALUES ($1, $2, $3, $4, $5)"
+	if _, err = tx.Exec(sql, hash, userID, purpose, email, time.Now().Add(lifetime)); err != nil {
+		return "", err
+	}
+
+	return token, tx.Commit()
+}
+
+// consumeEmailToken deletes the token, so that it can only be used once, and returns its user and email address
+func consumeEmailToken(tx *sqlx.Tx, token string, purpose EmailTokenPurpose) (int64, string, error) {
+	var userID int64
+	var email string
+	var expired bool
+	sql := "DELETE FROM email_tokens WHERE token_hash = $1 AND purpose = $2 RETURNING user_id, email, expires_at < Now()"
+	err := tx.QueryRow(sql, hashToken(token), purpose).Scan(&userID, &email, &expired)
+	switch {
+	case err == dbsql.ErrNoRows:
+		return 0, "", ErrInvalidEmailToken
+	case err != nil:
+		return 0, "", err
+	case expired:
+		return 0, "", ErrInvalidEmailToken
+	}
+
+	return userID, email, nil
+}
+
+// VerifyEmail marks the address which the verification token was sent to as verified, and returns the user's ID. The
+// token is no good if the user has changed their address since.
+func (m *UserModel) VerifyEmail(token string) (int64, error) {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	userID, email, err := consumeEmailToken(tx, token, EmailVerification)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.Exec("UPDATE users SET email_verified = true WHERE id = $1 AND email = $2", userID, email)
+	if err != nil {
+		return 0, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return 0, ErrInvalidEmailToken
+	}
+
+	return userID, tx.Commit()
+}
+
+// ResetPassword sets the password of the reset token's user, logs them out everywhere and lifts any lockout. Receiving the token also
+// proves that they own the address it was sent to.
+func (m *UserModel) ResetPassword(token string, passHash []byte) (int64, error) {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	userID, email, err := consumeEmailToken(tx, token, PasswordReset)
+	if err != nil {
+		return 0, err
+	}
+
+	sql := "UPDATE users SET pass_hash = $1, email_verified = email_verified OR email = $2 WHERE id = $3"
+	if _, err = tx.Exec(sql, string(passHash), email, userID); err != nil {
+		return 0, err
+	}
+
+	if err = revokeAllSessions(tx, userID); err != nil {
+		return 0, err
+	}
+
+	// The user has proven that they own the account, so it's no longer locked
+	if _, err = tx.Exec("DELETE FROM login_failures WHERE user_id = $1", userID); err != nil {
+		return 0, err
+	}
+
+	return userID, tx.Commit()
+}
+
+// PruneEmailTokens deletes expired email tokens
+func (m *UserModel) PruneEmailTokens() (int64, error) {
+	res, err := m.Conn.Exec("DELETE FROM email_tokens WHERE expires_at < Now()")
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *UserModel) Follow(followerID, followeeID int64) error {
+	if followerID == followeeID {
+		return status.Error(codes.InvalidArgument, "users can't follow themselves")
+	}
+
+	if _, err := m.GetUserWithID(followeeID); err != nil {
+		return status.Error(codes.NotFound, "user does not exist")
+	}
+
+	sql := "INSERT INTO follows (follower_id, followee_id, creation_date) VALUES ($1, $2, Now()) ON CONFLICT DO NOTHING"
+	_, err := m.Conn.Exec(sql, followerID, followeeID)
+	return err
+}
+
+func (m *UserModel) Unfollow(followerID, followeeID int64) error {
+	_, err := m.Conn.Exec("DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2", followerID, followeeID)
+	return err
+}
+
+type FollowCounts struct {
+	Followers        int64 `db:"followers"`
+	Following        int64 `db:"following"`
+	FollowedByViewer bool  `db:"followed_by_viewer"`
+}
+
+// GetFollowCounts returns the user's follower and following counts, and whether the viewer follows them
+func (m *UserModel) GetFollowCounts(userID, viewerID int64) (*FollowCounts, error) {
+	sql := "SELECT (SELECT count(*) FROM follows WHERE followee_id = $1) AS followers, " +
+		"(SELECT count(*) FROM follows WHERE follower_id = $1) AS following, " +
+		"EXISTS(SELECT 1 FROM follows WHERE follower_id = $2 AND followee_id = $1) AS followed_by_viewer"
+
+	var counts FollowCounts
+	if err := m.Conn.Get(&counts, sql, userID, viewerID); err != nil {
+		return nil, err
+	}
+
+	return &counts, nil
+}
+
+func (m *UserModel) GetFollowing(userID int64) ([]int64, error) {
+	var followeeIDs []int64
+	err := m.Conn.Select(&followeeIDs, "SELECT followee_id FROM follows WHERE follower_id = $1", userID)
+	return followeeIDs, err
+}
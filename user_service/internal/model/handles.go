@@ -0,0 +1,77 @@
+package model
+
+import (
+	"crypto/sha256"
+	dbsql "database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/horahoradev/horahora/user_service/internal/profile"
+	proto "github.com/horahoradev/horahora/user_service/protocol"
+)
+
+// Foreign users are registered with the name they go by on their website, which needn't be unique across websites, or
+// even on one. The name becomes their display name, and their username is an internal handle derived from it.
+const (
+	maxForeignHandleBase = 48 // In characters, leaving room for the suffix
+	foreignHandleHashLen = 6
+	// Past the hashed suffix, a counter is added. Running out means something else is wrong.
+	maxForeignHandleAttempts = 10
+)
+
+// foreignHandleCandidates returns the usernames to try for a foreign user, in order. The first is their name, and the
+// rest are suffixed with a hash of their foreign ID, so that the same user always gets the same candidates and two
+// users with the same name rarely compete past the first one.
+func foreignHandleCandidates(name, foreignUserID string, website proto.Site) []string {
+	base := strings.Join(strings.Fields(name), " ")
+	if base == "" {
+		base = website.String() + "-user"
+	}
+
+	if utf8.RuneCountInString(base) > maxForeignHandleBase {
+		base = string([]rune(base)[:maxForeignHandleBase])
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", website, foreignUserID)))
+	suffixed := base + "-" + hex.EncodeToString(sum[:])[:foreignHandleHashLen]
+
+	candidates := []string{base, suffixed}
+	for i := 2; len(candidates) < maxForeignHandleAttempts; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s-%d", suffixed, i))
+	}
+
+	return candidates
+}
+
+// foreignDisplayName fits the foreign user's name into a display name
+func foreignDisplayName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if utf8.RuneCountInString(name) > profile.MaxDisplayNameLength {
+		name = string([]rune(name)[:profile.MaxDisplayNameLength])
+	}
+
+	return name
+}
+
+// newForeignUser registers a foreign user under the first free handle for their name
+func (m *UserModel) newForeignUser(name, email string, foreignUserID string, foreignWebsite proto.Site) (int64, error) {
+	sql := "INSERT INTO users (username, display_name, email, pass_hash, foreign_user_ID, foreign_website) " +
+		"VALUES ($1, $2, $3, '', $4, $5) ON CONFLICT ON CONSTRAINT username_key DO NOTHING RETURNING id"
+
+	for _, handle := range foreignHandleCandidates(name, foreignUserID, foreignWebsite) {
+		var id int64
+		err := m.Conn.QueryRow(sql, handle, foreignDisplayName(name), email, foreignUserID, foreignWebsite).Scan(&id)
+		switch {
+		case err == dbsql.ErrNoRows:
+			continue // Taken
+		case err != nil:
+			return 0, err
+		}
+
+		return id, nil
+	}
+
+	return 0, fmt.Errorf("no free handle for foreign user %s on %s", foreignUserID, foreignWebsite)
+}
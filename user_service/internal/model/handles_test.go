@@ -0,0 +1,35 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	proto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForeignHandleCandidates(t *testing.T) {
+	candidates := foreignHandleCandidates("Music", "UC123", proto.Site_youtube)
+	assert.Len(t, candidates, maxForeignHandleAttempts)
+	assert.Equal(t, "Music", candidates[0])
+	assert.Regexp(t, `^Music-[0-9a-f]{6}$`, candidates[1])
+	assert.Equal(t, candidates[1]+"-2", candidates[2])
+
+	// The same user always gets the same handles, and other users with the same name get different ones
+	assert.Equal(t, candidates, foreignHandleCandidates("Music", "UC123", proto.Site_youtube))
+	assert.NotEqual(t, candidates[1], foreignHandleCandidates("Music", "UC456", proto.Site_youtube)[1])
+	assert.NotEqual(t, candidates[1], foreignHandleCandidates("Music", "UC123", proto.Site_niconico)[1])
+}
+
+func TestForeignHandleCandidatesCleansNames(t *testing.T) {
+	assert.Equal(t, "two words", foreignHandleCandidates("  two\n words ", "1", proto.Site_niconico)[0])
+	assert.Equal(t, "bilibili-user", foreignHandleCandidates(" ", "1", proto.Site_bilibili)[0])
+
+	long := foreignHandleCandidates(strings.Repeat("長", 100), "1", proto.Site_niconico)
+	assert.Equal(t, strings.Repeat("長", maxForeignHandleBase), long[0])
+}
+
+func TestForeignDisplayName(t *testing.T) {
+	assert.Equal(t, "a b", foreignDisplayName("a\n\tb"))
+	assert.Len(t, []rune(foreignDisplayName(strings.Repeat("名", 100))), 64)
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// RecordLoginFailure records a failed login for the user, or 0 if the username didn't exist, from the IP address, and
+// returns its ID
+func (m *UserModel) RecordLoginFailure(userID int64, ip string) (int64, error) {
+	var id int64
+	sql := "INSERT INTO login_failures (user_id, ip) VALUES (NULLIF($1, 0), NULLIF($2, '')) RETURNING id"
+	err := m.Conn.QueryRow(sql, userID, ip).Scan(&id)
+	return id, err
+}
+
+// DeleteLoginFailure forgets one failed login
+func (m *UserModel) DeleteLoginFailure(id int64) error {
+	_, err := m.Conn.Exec("DELETE FROM login_failures WHERE id = $1", id)
+	return err
+}
+
+// CountLoginFailures returns the number of failed logins since the given time for the user, and from the IP address
+func (m *UserModel) CountLoginFailures(userID int64, ip string, since time.Time) (int64, int64, error) {
+	var accountFailures, ipFailures int64
+	sql := "SELECT count(*) FILTER (WHERE user_id = $1), count(*) FILTER (WHERE ip = $2) FROM login_failures " +
+		"WHERE creation_date > $3 AND (user_id = $1 OR ip = $2)"
+	err := m.Conn.QueryRow(sql, userID, ip, since).Scan(&accountFailures, &ipFailures)
+	return accountFailures, ipFailures, err
+}
+
+// ClearLoginFailures forgets the user's failed logins, after they log in or reset their password. Failures from their
+// IP address are kept, since they may have been guessing other accounts' passwords.
+func (m *UserModel) ClearLoginFailures(userID int64) error {
+	_, err := m.Conn.Exec("DELETE FROM login_failures WHERE user_id = $1", userID)
+	return err
+}
+
+// PruneLoginFailures deletes failures which are too old to count towards throttling
+func (m *UserModel) PruneLoginFailures(before time.Time) (int64, error) {
+	res, err := m.Conn.Exec("DELETE FROM login_failures WHERE creation_date < $1", before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// RecordPasswordResetRequest records a password reset request for the email address from the IP address, and returns
+// its ID. Addresses are compared case insensitively, like GetUsersWithEmail does.
+func (m *UserModel) RecordPasswordResetRequest(email, ip string) (int64, error) {
+	var id int64
+	sql := "INSERT INTO password_reset_requests (email, ip) VALUES (lower($1), NULLIF($2, '')) RETURNING id"
+	err := m.Conn.QueryRow(sql, email, ip).Scan(&id)
+	return id, err
+}
+
+// DeletePasswordResetRequest forgets one password reset request
+func (m *UserModel) DeletePasswordResetRequest(id int64) error {
+	_, err := m.Conn.Exec("DELETE FROM password_reset_requests WHERE id = $1", id)
+	return err
+}
+
+// CountPasswordResetRequests returns the number of password reset requests since the given time for the email address,
+// and from the IP address
+func (m *UserModel) CountPasswordResetRequests(email, ip string, since time.Time) (int64, int64, error) {
+	var addressRequests, ipRequests int64
+	sql := "SELECT count(*) FILTER (WHERE email = lower($1)), count(*) FILTER (WHERE ip = $2) " +
+		"FROM password_reset_requests WHERE creation_date > $3 AND (email = lower($1) OR ip = $2)"
+	err := m.Conn.QueryRow(sql, email, ip, since).Scan(&addressRequests, &ipRequests)
+	return addressRequests, ipRequests, err
+}
+
+// PrunePasswordResetRequests deletes requests which are too old to count towards throttling
+func (m *UserModel) PrunePasswordResetRequests(before time.Time) (int64, error) {
+	res, err := m.Conn.Exec("DELETE FROM password_reset_requests WHERE creation_date < $1", before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// Profile is the part of a user which they can edit themselves
+type Profile struct {
+	DisplayName string         `db:"display_name"`
+	Bio         string         `db:"bio"`
+	Links       pq.StringArray `db:"links"`
+	AvatarURL   string         `db:"avatar_url"`
+	BannerURL   string         `db:"banner_url"`
+}
+
+// Profile images, named after their columns
+const (
+	AvatarImage = "avatar"
+	BannerImage = "banner"
+)
+
+// UpdateProfile replaces the user's display name, bio and links
+func (m *UserModel) UpdateProfile(userID int64, displayName, bio string, links []string) error {
+	if links == nil {
+		links = []string{}
+	}
+
+	sql := "UPDATE users SET display_name = $1, bio = $2, links = $3 WHERE id = $4"
+	_, err := m.Conn.Exec(sql, displayName, bio, pq.Array(links), userID)
+	return err
+}
+
+// SetProfileImage sets the URL of the user's avatar or banner, or removes it if url is empty, and returns the URL it
+// replaced
+func (m *UserModel) SetProfileImage(userID int64, image, url string) (string, error) {
+	var column string
+	switch image {
+	case AvatarImage:
+		column = "avatar_url"
+	case BannerImage:
+		column = "banner_url"
+	default:
+		return "", fmt.Errorf("unknown profile image %s", image)
+	}
+
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	// Locked, so that concurrent uploads each get the URL which they actually replaced
+	var oldURL string
+	err = tx.QueryRow("SELECT COALESCE("+column+", '') FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&oldURL)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = tx.Exec("UPDATE users SET "+column+" = $1 WHERE id = $2", url, userID); err != nil {
+		return "", err
+	}
+
+	return oldURL, tx.Commit()
+}
@@ -0,0 +1,230 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	dbsql "database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Refresh tokens expire if a session goes unused for this long
+	RefreshTokenLifetime = 30 * 24 * time.Hour
+	// A rotated token still gets its successor for this long, since clients may refresh in parallel with the same
+	// token. Past this, reusing it counts as theft.
+	RefreshTokenReuseGrace = 30 * time.Second
+)
+
+var ErrInvalidRefreshToken = status.Error(codes.Unauthenticated, "refresh token is invalid or expired")
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateToken returns a random token, and the hash which is stored in its place
+func generateToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return token, hashToken(token), nil
+}
+
+// successorToken derives the token which replaces a rotated token. Without the salt, which is only stored, knowing the
+// rotated token isn't enough to work out its successor.
+func successorToken(token string, salt []byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(salt)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// insertRefreshToken generates a new refresh token for the session. Only its hash is stored.
+func insertRefreshToken(tx *sqlx.Tx, sessionID int64) (string, error) {
+	token, hash, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	return token, storeRefreshToken(tx, sessionID, hash)
+}
+
+func storeRefreshToken(tx *sqlx.Tx, sessionID int64, hash string) error {
+	sql := "INSERT INTO refresh_tokens (token_hash, session_id, expires_at) VALUES ($1, $2, $3)"
+	_, err := tx.Exec(sql, hash, sessionID, time.Now().Add(RefreshTokenLifetime))
+	return err
+}
+
+// CreateSession starts a new session for the user, and returns its ID and first refresh token
+func (m *UserModel) CreateSession(userID int64) (int64, string, error) {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return 0, "", err
+	}
+	defer tx.Rollback()
+
+	var sessionID int64
+	if err = tx.QueryRow("INSERT INTO sessions (user_id) VALUES ($1) RETURNING id", userID).Scan(&sessionID); err != nil {
+		return 0, "", err
+	}
+
+	token, err := insertRefreshToken(tx, sessionID)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return sessionID, token, tx.Commit()
+}
+
+// RotateRefreshToken exchanges a refresh token for a new one in the same session, and returns the session's user, ID
+// and new token. A token which was rotated less than RefreshTokenReuseGrace ago gets the same successor again, as long
+// as that hasn't been rotated too. Presenting a token which was rotated before that revokes the session, since either
+// it or its replacement must have been stolen.
+func (m *UserModel) RotateRefreshToken(token string) (int64, int64, string, error) {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return 0, 0, "", err
+	}
+	defer tx.Rollback()
+
+	var userID, sessionID int64
+	var rotated, expired, revoked, inGrace bool
+	var salt []byte
+	sql := "SELECT sessions.user_id, sessions.id, refresh_tokens.rotated, refresh_tokens.expires_at < Now(), " +
+		"sessions.revoked, COALESCE(refresh_tokens.rotated_at > Now() - $2 * interval '1 second', false), refresh_tokens.successor_salt " +
+		"FROM refresh_tokens JOIN sessions ON sessions.id = refresh_tokens.session_id " +
+		"WHERE refresh_tokens.token_hash = $1 FOR UPDATE"
+	err = tx.QueryRow(sql, hashToken(token), RefreshTokenReuseGrace.Seconds()).
+		Scan(&userID, &sessionID, &rotated, &expired, &revoked, &inGrace, &salt)
+	switch {
+	case err == dbsql.ErrNoRows:
+		return 0, 0, "", ErrInvalidRefreshToken
+	case err != nil:
+		return 0, 0, "", err
+	case revoked || expired:
+		return 0, 0, "", ErrInvalidRefreshToken
+	case rotated && inGrace && salt != nil:
+		// A parallel refresh, so hand out the same successor if it's still current
+		successor := successorToken(token, salt)
+		var current bool
+		sql = "SELECT NOT rotated AND expires_at > Now() FROM refresh_tokens WHERE token_hash = $1"
+		err = tx.QueryRow(sql, hashToken(successor)).Scan(&current)
+		switch {
+		case err == dbsql.ErrNoRows || (err == nil && !current):
+			return 0, 0, "", ErrInvalidRefreshToken
+		case err != nil:
+			return 0, 0, "", err
+		}
+
+		return userID, sessionID, successor, nil
+	case rotated:
+		log.Warnf("Refresh token reused for session %d of user %d, revoking the session", sessionID, userID)
+		if _, err = tx.Exec("UPDATE sessions SET revoked = true WHERE id = $1", sessionID); err != nil {
+			return 0, 0, "", err
+		}
+
+		if err = tx.Commit(); err != nil {
+			return 0, 0, "", err
+		}
+
+		return 0, 0, "", ErrInvalidRefreshToken
+	}
+
+	salt = make([]byte, 32)
+	if _, err = rand.Read(salt); err != nil {
+		return 0, 0, "", err
+	}
+
+	sql = "UPDATE refresh_tokens SET rotated = true, rotated_at = Now(), successor_salt = $2 WHERE token_hash = $1"
+	if _, err = tx.Exec(sql, hashToken(token), salt); err != nil {
+		return 0, 0, "", err
+	}
+
+	if _, err = tx.Exec("UPDATE sessions SET last_used = Now() WHERE id = $1", sessionID); err != nil {
+		return 0, 0, "", err
+	}
+
+	newToken := successorToken(token, salt)
+	if err = storeRefreshToken(tx, sessionID, hashToken(newToken)); err != nil {
+		return 0, 0, "", err
+	}
+
+	return userID, sessionID, newToken, tx.Commit()
+}
+
+// RevokeSession logs out one of the user's sessions. Its refresh tokens stop working, as do access tokens issued for
+// it.
+func (m *UserModel) RevokeSession(userID, sessionID int64) error {
+	_, err := m.Conn.Exec("UPDATE sessions SET revoked = true WHERE id = $1 AND user_id = $2", sessionID, userID)
+	return err
+}
+
+// RevokeAllSessions logs out every session of the user, and bumps their token version so that every access token
+// they hold stops working too
+func (m *UserModel) RevokeAllSessions(userID int64) error {
+	tx, err := m.Conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = revokeAllSessions(tx, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func revokeAllSessions(tx *sqlx.Tx, userID int64) error {
+	if _, err := tx.Exec("UPDATE users SET token_version = token_version + 1 WHERE id = $1", userID); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec("UPDATE sessions SET revoked = true WHERE user_id = $1 AND NOT revoked", userID)
+	return err
+}
+
+// GetTokenState returns the user's current token version, and whether the session has been revoked. Session ID 0 is
+// used by tokens which don't belong to a session, and is never revoked.
+func (m *UserModel) GetTokenState(userID, sessionID int64) (int64, bool, error) {
+	var version int64
+	var revoked bool
+	sql := "SELECT token_version, COALESCE((SELECT revoked FROM sessions WHERE id = $2 AND user_id = $1), $2 != 0) " +
+		"FROM users WHERE id = $1"
+	err := m.Conn.QueryRow(sql, userID, sessionID).Scan(&version, &revoked)
+	switch {
+	case err == dbsql.ErrNoRows:
+		return 0, false, status.Error(codes.NotFound, "user does not exist")
+	case err != nil:
+		return 0, false, err
+	}
+
+	return version, revoked, nil
+}
+
+// PruneRefreshTokens deletes refresh tokens which expired long enough ago that reuse detection no longer matters, and
+// forgets the successors of tokens rotated before the grace period
+func (m *UserModel) PruneRefreshTokens() (int64, error) {
+	sql := "UPDATE refresh_tokens SET successor_salt = NULL " +
+		"WHERE successor_salt IS NOT NULL AND rotated_at < Now() - $1 * interval '1 second'"
+	if _, err := m.Conn.Exec(sql, RefreshTokenReuseGrace.Seconds()); err != nil {
+		return 0, err
+	}
+
+	res, err := m.Conn.Exec("DELETE FROM refresh_tokens WHERE expires_at < $1", time.Now().Add(-RefreshTokenLifetime))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -1,8 +1,8 @@
 package model
 
 import (
-	"database/sql"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,7 +13,7 @@ import (
 
 	proto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type UserModel struct {
@@ -26,24 +26,16 @@ func NewUserModel(db *sqlx.DB) (*UserModel, error) {
 	return u, nil
 }
 
-// Password in this context is in plaintext
+// NewUser creates a user. Foreign users may be given a different username, see newForeignUser.
 func (m *UserModel) NewUser(username, email string, passHash []byte, foreignUser bool, foreignUserID string, foreignWebsite proto.Site) (int64, error) {
-	// Username is unique, so will fail if user already exists
-	var res *sql.Row
-	var err error
-
-	// FIXME: I've never noticed an issue here... but this RETURNING clause looks funky, no ON CONFLICT
-	switch foreignUser {
-	case true:
-		res = m.Conn.QueryRow("INSERT INTO users (username, email, pass_hash, foreign_user_ID, foreign_website) "+
-			"VALUES ($1, $2, $3, $4, $5) returning id", username, email, string(passHash), foreignUserID, foreignWebsite)
-	case false:
-		res = m.Conn.QueryRow("INSERT INTO users (username, email, pass_hash) "+
-			"VALUES ($1, $2, $3) returning id", username, email, string(passHash))
+	if foreignUser {
+		return m.newForeignUser(username, email, foreignUserID, foreignWebsite)
 	}
 
+	// Username is unique, so will fail if user already exists
 	var id int64 = 0
-	err = res.Scan(&id)
+	err := m.Conn.QueryRow("INSERT INTO users (username, email, pass_hash) "+
+		"VALUES ($1, $2, $3) returning id", username, email, string(passHash)).Scan(&id)
 	if err != nil {
 		return 0, err
 	}
@@ -56,10 +48,27 @@ type User struct {
 	Username string `db:"username"`
 	Email    string `db:"email"`
 	Rank     int    `db:"rank"`
+	Banned   bool   `db:"banned"`
+	// Whether the user has followed a verification or password reset link sent to Email
+	EmailVerified bool `db:"email_verified"`
+	// Nil if the ban is permanent. Both are left over from the last ban if the user isn't banned anymore.
+	BannedUntil *time.Time `db:"banned_until"`
+	BanReason   string     `db:"ban_reason"`
+	// Created for the uploader of archived videos, and not claimed by them
+	Foreign bool `db:"is_foreign"`
+	// The account which this one was merged into after being claimed
+	MergedInto dbsql.NullInt64 `db:"merged_into"`
+	Profile
 }
 
+// userColumns are selected into User. A suspension which has run out doesn't count as a ban, so nothing needs to lift
+// it.
+const userColumns = "id, username, email, rank, banned AND (banned_until IS NULL OR banned_until > Now()) AS banned, " +
+	"email_verified, banned_until, ban_reason, foreign_user_ID IS NOT NULL AND NOT claimed AS is_foreign, merged_into, " +
+	"display_name, bio, links, avatar_url, banner_url"
+
 func (m *UserModel) GetUserWithID(userID int64) (*User, error) {
-	sql := "SELECT id, username, email, rank FROM users WHERE id=$1"
+	sql := "SELECT " + userColumns + " FROM users WHERE id=$1"
 	var user []User
 
 	err := m.Conn.Select(&user, sql, userID)
@@ -74,6 +83,34 @@ func (m *UserModel) GetUserWithID(userID int64) (*User, error) {
 	return &user[0], nil
 }
 
+// GetUsersWithIDs fetches all users in userIDs in a single query. Missing users are omitted.
+func (m *UserModel) GetUsersWithIDs(userIDs []int64) ([]User, error) {
+	sql := "SELECT " + userColumns + " FROM users WHERE id = ANY($1)"
+	var users []User
+
+	err := m.Conn.Select(&users, sql, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUsersWithEmail returns the users who registered with the email address, ignoring case. Unclaimed foreign users,
+// who can't log in, are skipped.
+func (m *UserModel) GetUsersWithEmail(email string) ([]User, error) {
+	sql := "SELECT " + userColumns + " FROM users " +
+		"WHERE lower(email) = lower($1) AND (foreign_user_ID IS NULL OR claimed)"
+	var users []User
+
+	err := m.Conn.Select(&users, sql, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (m *UserModel) GetUserWithUsername(username string) (int64, error) {
 	sql := "SELECT id FROM users WHERE username=$1"
 
@@ -102,9 +139,16 @@ func (m *UserModel) GetPassHash(uid int64) (string, error) {
 	return passHash, nil
 }
 
-// Maybe I should cut down on the copy pasta
+// SetPassHash replaces the user's password hash, e.g. with a stronger hash of the same password
+func (m *UserModel) SetPassHash(uid int64, passHash []byte) error {
+	_, err := m.Conn.Exec("UPDATE users SET pass_hash = $1 WHERE id = $2", string(passHash), uid)
+	return err
+}
+
+// GetForeignUser returns the account which owns videos archived from the foreign user. If it was merged into another
+// account after being claimed, that account is returned.
 func (m *UserModel) GetForeignUser(foreignUserID string, foreignWebsite proto.Site) (int64, error) {
-	sql := "SELECT id FROM users WHERE foreign_user_ID=$1 AND foreign_website=$2"
+	sql := "SELECT COALESCE(merged_into, id) FROM users WHERE foreign_user_ID=$1 AND foreign_website=$2"
 
 	row := m.Conn.QueryRow(sql, foreignUserID, foreignWebsite)
 
@@ -0,0 +1,139 @@
+package profile
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// Decoders for the accepted upload formats
+	_ "image/gif"
+	_ "image/png"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImageSize is the size which an uploaded image is cropped and scaled to
+type ImageSize struct {
+	Width, Height int
+}
+
+var (
+	AvatarSize = ImageSize{Width: 256, Height: 256}
+	BannerSize = ImageSize{Width: 1500, Height: 500}
+)
+
+const (
+	MaxImageBytes = 5 << 20
+	// Decoded images take 4 bytes per pixel, so this also bounds memory use for small files which decode to huge
+	// images
+	maxImagePixels = 25 * 1000 * 1000
+	jpegQuality    = 90
+)
+
+var ErrInvalidImage = status.Error(codes.InvalidArgument, "image must be a JPEG, PNG or GIF")
+
+// ResizeImage crops the image to the aspect ratio of size around its center, scales it to size, and encodes it as a
+// JPEG. Transparent areas become white. Re-encoding also strips metadata such as EXIF locations.
+func ResizeImage(data []byte, size ImageSize) ([]byte, error) {
+	if len(data) > MaxImageBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "image must be at most %d bytes", MaxImageBytes)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, status.Errorf(codes.InvalidArgument, "image must be at most %d pixels", maxImagePixels)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	crop := centerCrop(src.Bounds(), size)
+
+	// Flatten the cropped area onto white, which also converts it to RGBA for scaling
+	flat := image.NewRGBA(image.Rect(0, 0, crop.Dx(), crop.Dy()))
+	draw.Draw(flat, flat.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, crop.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err = jpeg.Encode(&buf, scale(flat, size), &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// centerCrop returns the largest rectangle in bounds with the aspect ratio of size, centered
+func centerCrop(bounds image.Rectangle, size ImageSize) image.Rectangle {
+	w, h := bounds.Dx(), bounds.Dy()
+
+	// Compare w/h with size.Width/size.Height without dividing
+	if w*size.Height > h*size.Width {
+		w = h * size.Width / size.Height
+	} else {
+		h = w * size.Height / size.Width
+	}
+
+	// Tiny images can round down to nothing
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+
+	min := bounds.Min.Add(image.Pt((bounds.Dx()-w)/2, (bounds.Dy()-h)/2))
+	return image.Rectangle{Min: min, Max: min.Add(image.Pt(w, h))}
+}
+
+// scale resizes src with a box filter, i.e. each output pixel is the average of the source pixels it covers. When
+// enlarging, each output pixel covers a single source pixel.
+func scale(src *image.RGBA, size ImageSize) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size.Width, size.Height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+
+	for y := 0; y < size.Height; y++ {
+		y0, y1 := span(y, size.Height, sh)
+
+		for x := 0; x < size.Width; x++ {
+			x0, x1 := span(x, size.Width, sw)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(row[sx*4+c])
+					}
+				}
+			}
+
+			n := (x1 - x0) * (y1 - y0)
+			i := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+
+	return dst
+}
+
+// span returns the range of source pixels covered by output pixel i of n, out of srcLen source pixels
+func span(i, n, srcLen int) (int, int) {
+	start := i * srcLen / n
+	end := (i + 1) * srcLen / n
+	if end <= start {
+		end = start + 1
+	}
+
+	return start, end
+}
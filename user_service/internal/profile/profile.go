@@ -0,0 +1,46 @@
+// Package profile validates the editable parts of user profiles, and prepares uploaded profile images for storage
+package profile
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxDisplayNameLength = 64 // In characters
+	MaxBioLength         = 2000
+	MaxLinks             = 5
+	MaxLinkLength        = 256
+)
+
+// Validate checks the text fields of a profile. Links must be absolute http or https URLs, since they're rendered as
+// links on the user's page.
+func Validate(displayName, bio string, links []string) error {
+	switch {
+	case utf8.RuneCountInString(displayName) > MaxDisplayNameLength:
+		return status.Errorf(codes.InvalidArgument, "display name must be at most %d characters long", MaxDisplayNameLength)
+	case strings.ContainsAny(displayName, "\r\n"):
+		return status.Error(codes.InvalidArgument, "display name must be a single line")
+	case utf8.RuneCountInString(bio) > MaxBioLength:
+		return status.Errorf(codes.InvalidArgument, "bio must be at most %d characters long", MaxBioLength)
+	case len(links) > MaxLinks:
+		return status.Errorf(codes.InvalidArgument, "at most %d links are allowed", MaxLinks)
+	}
+
+	for _, link := range links {
+		if len(link) > MaxLinkLength {
+			return status.Errorf(codes.InvalidArgument, "links must be at most %d bytes long", MaxLinkLength)
+		}
+
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "%q isn't an http or https URL", link)
+		}
+	}
+
+	return nil
+}
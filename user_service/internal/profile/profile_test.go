@@ -0,0 +1,87 @@
+package profile
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("", "", nil))
+	assert.NoError(t, Validate("名前", "hello", []string{"https://example.com/me", "http://example.org"}))
+
+	for _, err := range []error{
+		Validate(strings.Repeat("a", MaxDisplayNameLength+1), "", nil),
+		Validate("two\nlines", "", nil),
+		Validate("", strings.Repeat("a", MaxBioLength+1), nil),
+		Validate("", "", []string{"javascript:alert(1)"}),
+		Validate("", "", []string{"example.com"}),
+		Validate("", "", make([]string, MaxLinks+1)),
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestResizeImage(t *testing.T) {
+	// A wide image, red on the left half and blue on the right
+	src := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+	for y := 0; y < 500; y++ {
+		for x := 0; x < 1000; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 500 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+
+	out, err := ResizeImage(encodePNG(t, src), AvatarSize)
+	assert.NoError(t, err)
+
+	img, err := jpeg.Decode(bytes.NewReader(out))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, AvatarSize.Width, AvatarSize.Height), img.Bounds())
+
+	// The crop is centered, so both halves are still there
+	r, _, b, _ := img.At(10, 128).RGBA()
+	assert.True(t, r > b)
+	r, _, b, _ = img.At(245, 128).RGBA()
+	assert.True(t, b > r)
+}
+
+func TestResizeImageEnlargesAndFlattens(t *testing.T) {
+	// Fully transparent
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 1))
+
+	out, err := ResizeImage(encodePNG(t, src), BannerSize)
+	assert.NoError(t, err)
+
+	img, err := jpeg.Decode(bytes.NewReader(out))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, BannerSize.Width, BannerSize.Height), img.Bounds())
+
+	r, g, b, _ := img.At(750, 250).RGBA()
+	assert.True(t, r > 0xf000 && g > 0xf000 && b > 0xf000)
+}
+
+func TestResizeImageRejectsInvalidImages(t *testing.T) {
+	_, err := ResizeImage([]byte("not an image"), AvatarSize)
+	assert.Equal(t, ErrInvalidImage, err)
+
+	_, err = ResizeImage(make([]byte, MaxImageBytes+1), AvatarSize)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/horahoradev/horahora/user_service/internal/auth"
 
 	"github.com/horahoradev/horahora/user_service/internal/grpcserver"
+	"github.com/horahoradev/horahora/user_service/internal/mailer"
 
+	"github.com/horahoradev/horahora/storage"
 	"github.com/horahoradev/horahora/user_service/internal/config"
 	_ "github.com/lib/pq"
 )
@@ -22,7 +24,59 @@ func main() {
 		log.Fatalf("Could not parse RSA keypair. Err: %s", err)
 	}
 
-	err = grpcserver.NewGRPCServer(conf.DbConn, privateKey, conf.GRPCPort)
+	retiredKeys, err := auth.ParsePrivateKeys(conf.RSARetiredKeypairs)
+	if err != nil {
+		log.Fatalf("Could not parse retired RSA keypairs. Err: %s", err)
+	}
+
+	keys, err := auth.NewKeySet(privateKey, retiredKeys)
+	if err != nil {
+		log.Fatalf("Could not create key set. Err: %s", err)
+	}
+
+	hasher, err := auth.NewPasswordHasher(conf.PasswordHash, conf.BcryptCost, auth.Argon2Params{
+		Memory:      uint32(conf.Argon2Memory),
+		Iterations:  uint32(conf.Argon2Iterations),
+		Parallelism: uint8(conf.Argon2Parallelism),
+	})
+	if err != nil {
+		log.Fatalf("Invalid password hashing configuration. Err: %s", err)
+	}
+
+	throttle := &auth.LoginThrottle{
+		MaxAccountFailures: conf.MaxAccountLoginFailures,
+		MaxIPFailures:      conf.MaxIPLoginFailures,
+		Window:             conf.LoginFailureWindow,
+	}
+
+	resetThrottle := &auth.PasswordResetThrottle{
+		MaxAddressRequests: conf.MaxAddressPasswordResets,
+		MaxIPRequests:      conf.MaxIPPasswordResets,
+		Window:             conf.PasswordResetWindow,
+	}
+
+	var m mailer.Mailer
+	switch {
+	case conf.SMTPHost != "":
+		m = mailer.NewSMTPMailer(conf.SMTPHost, conf.SMTPPort, conf.SMTPUsername, conf.SMTPPassword, conf.MailFrom)
+	case conf.MailLogOnly:
+		log.Print("MAIL_LOG_ONLY is set, so emails and the links in them will be logged instead of sent")
+		m = mailer.LogMailer{}
+	default:
+		log.Fatal("SMTP_HOST is required, or set MAIL_LOG_ONLY to log emails instead during development")
+	}
+
+	var store storage.Storage
+	if conf.StorageBackend != "" {
+		store, err = storage.New(conf.StorageBackend, conf.StorageAPIID, conf.StorageAPIKey, conf.BucketName, conf.MinioEndpoint)
+		if err != nil {
+			log.Fatalf("Could not connect to storage. Err: %s", err)
+		}
+	} else {
+		log.Print("StorageBackend is unset, so profile image uploads are disabled")
+	}
+
+	err = grpcserver.NewGRPCServer(conf.DbConn, keys, hasher, throttle, resetThrottle, m, conf.BaseURL, store, conf.OriginFQDN, conf.GRPCPort)
 	if err != nil {
 		log.Fatalf("gRPC server terminated with error: %s", err)
 	}
@@ -0,0 +1,7 @@
+-- Editable profile fields. An empty display name means the username is shown, and images are stored as URLs in object
+-- storage, or empty if the user hasn't uploaded one.
+ALTER TABLE users ADD COLUMN display_name varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN bio text NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN links text[] NOT NULL DEFAULT '{}';
+ALTER TABLE users ADD COLUMN avatar_url text NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN banner_url text NOT NULL DEFAULT '';
@@ -0,0 +1,19 @@
+-- Foreign users are created for the uploaders of archived videos. Their real owners can claim them, either converting
+-- them into normal accounts or merging them into their existing account. Both keep the foreign user ID, so that
+-- videos archived later still find the account.
+ALTER TABLE users ADD COLUMN claimed bool NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN merged_into int REFERENCES users(id);
+
+-- The claimant proves that they own the foreign account by putting the code in its channel description
+CREATE TABLE account_claims (
+    id SERIAL primary key,
+    claimant_id int NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    foreign_account_id int NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code varchar(64) NOT NULL,
+    creation_date timestamp NOT NULL DEFAULT Now(),
+    expires_at timestamp NOT NULL,
+    completed_at timestamp,
+    mode smallint -- Set on completion, see model.ClaimMode
+);
+
+CREATE INDEX account_claims_claimant_idx ON account_claims (claimant_id, foreign_account_id);
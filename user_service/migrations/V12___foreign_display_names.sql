@@ -0,0 +1,3 @@
+-- Foreign users now keep the name they go by on their website as their display name, and get a unique handle as their
+-- username. Existing foreign users already have unique usernames, which were their names, so they're kept as handles.
+UPDATE users SET display_name = left(username, 64) WHERE foreign_user_ID IS NOT NULL AND display_name = '';
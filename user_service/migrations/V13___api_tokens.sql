@@ -0,0 +1,14 @@
+-- Personal access tokens for scripts and bots. Like refresh tokens, only their hashes are stored. Scopes are the names
+-- in permissions.Scopes.
+CREATE TABLE api_tokens (
+    id SERIAL primary key,
+    user_id int NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name varchar(64) NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    scopes text[] NOT NULL,
+    creation_date timestamp NOT NULL DEFAULT Now(),
+    expires_at timestamp NOT NULL,
+    last_used_at timestamp -- NULL if never used
+);
+
+CREATE INDEX api_tokens_user_id_idx ON api_tokens (user_id);
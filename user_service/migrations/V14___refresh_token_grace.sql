@@ -0,0 +1,4 @@
+-- A rotated token's successor is derived from the token and a random salt, so that a client which refreshes several
+-- times in parallel with the same token gets the same successor each time, without the successor being stored
+ALTER TABLE refresh_tokens ADD COLUMN rotated_at timestamp;
+ALTER TABLE refresh_tokens ADD COLUMN successor_salt bytea;
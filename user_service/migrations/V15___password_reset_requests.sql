@@ -0,0 +1,11 @@
+-- Password reset requests are counted per email address and per IP address, so that the reset form can't be used to
+-- flood inboxes. ip is null if the caller didn't pass one.
+CREATE TABLE password_reset_requests (
+    id SERIAL primary key,
+    email varchar(255) NOT NULL,
+    ip varchar(64),
+    creation_date timestamp NOT NULL DEFAULT Now()
+);
+
+CREATE INDEX password_reset_requests_email_idx ON password_reset_requests (email, creation_date);
+CREATE INDEX password_reset_requests_ip_idx ON password_reset_requests (ip, creation_date);
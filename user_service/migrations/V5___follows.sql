@@ -0,0 +1,8 @@
+CREATE TABLE follows (
+    follower_id int REFERENCES users(id),
+    followee_id int REFERENCES users(id),
+    creation_date timestamp DEFAULT Now(),
+    PRIMARY KEY (follower_id, followee_id)
+);
+
+CREATE INDEX follows_followee_idx ON follows (followee_id);
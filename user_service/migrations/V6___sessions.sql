@@ -0,0 +1,25 @@
+-- Access tokens carry the token version they were issued with. Incrementing it revokes every access token the user
+-- holds.
+ALTER TABLE users ADD COLUMN token_version int NOT NULL DEFAULT 0;
+
+-- A session is a chain of refresh tokens, starting at login
+CREATE TABLE sessions (
+    id SERIAL primary key,
+    user_id int NOT NULL REFERENCES users(id),
+    creation_date timestamp DEFAULT Now(),
+    last_used timestamp DEFAULT Now(),
+    revoked bool NOT NULL DEFAULT false
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id) WHERE NOT revoked;
+
+-- Only the SHA-256 of each refresh token is stored. Rotated tokens are kept, so that reuse of a stolen token can be
+-- detected.
+CREATE TABLE refresh_tokens (
+    token_hash char(64) primary key,
+    session_id int NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    expires_at timestamp NOT NULL,
+    rotated bool NOT NULL DEFAULT false
+);
+
+CREATE INDEX refresh_tokens_session_id_idx ON refresh_tokens (session_id);
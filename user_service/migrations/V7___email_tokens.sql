@@ -0,0 +1,14 @@
+ALTER TABLE users ADD COLUMN email_verified bool NOT NULL DEFAULT false;
+
+-- Single use tokens for email verification and password reset links. Only the SHA-256 of each token is stored.
+-- Purpose is 0 for email verification, 1 for password reset.
+CREATE TABLE email_tokens (
+    token_hash char(64) primary key,
+    user_id int NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose smallint NOT NULL,
+    email varchar(255) NOT NULL, -- The address the token was sent to
+    creation_date timestamp DEFAULT Now(),
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX email_tokens_user_id_purpose_idx ON email_tokens (user_id, purpose);
@@ -0,0 +1,11 @@
+-- Failed logins are counted per account and per IP address to throttle brute forcing. user_id is null if the
+-- username didn't exist, and ip is null if the caller didn't pass one.
+CREATE TABLE login_failures (
+    id SERIAL primary key,
+    user_id int REFERENCES users(id) ON DELETE CASCADE,
+    ip varchar(64),
+    creation_date timestamp NOT NULL DEFAULT Now()
+);
+
+CREATE INDEX login_failures_user_id_idx ON login_failures (user_id, creation_date);
+CREATE INDEX login_failures_ip_idx ON login_failures (ip, creation_date);
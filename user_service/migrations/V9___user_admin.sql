@@ -0,0 +1,15 @@
+-- Bans with banned_until set are suspensions, which lift themselves once it passes. Permanent bans leave it null.
+ALTER TABLE users ADD COLUMN banned_until timestamp;
+ALTER TABLE users ADD COLUMN ban_reason text NOT NULL DEFAULT '';
+
+-- Every change made to a user by a moderator or administrator. Details depend on the action, e.g. the new rank.
+CREATE TABLE user_audit_log (
+    id SERIAL primary key,
+    actor_id int REFERENCES users(id) ON DELETE SET NULL,
+    user_id int NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action varchar(32) NOT NULL,
+    details text NOT NULL DEFAULT '',
+    creation_date timestamp NOT NULL DEFAULT Now()
+);
+
+CREATE INDEX user_audit_log_user_id_idx ON user_audit_log (user_id, creation_date);
@@ -0,0 +1,167 @@
+package permissions
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/horahoradev/horahora/user_service/verifier"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AccessTokenKey is the gRPC metadata key which the calling user's access token is sent under. Services can be called
+// directly, so user IDs in requests only say who the caller claims to be, and the token proves it.
+const AccessTokenKey = "x-access-token"
+
+// ServiceSecretKey is the gRPC metadata key which services send the service secret under, for RPCs which only other
+// services may call
+const ServiceSecretKey = "x-service-secret"
+
+type callerKey struct{}
+
+type caller struct {
+	uid    int64
+	scopes []string // nil unless the access token is an API token's
+}
+
+// WithAccessToken returns a context which sends the access token with outgoing calls
+func WithAccessToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, AccessTokenKey, token)
+}
+
+// ForwardAccessToken returns a context which sends the access token of the incoming call in ctx with outgoing calls,
+// for services which call others on the user's behalf
+func ForwardAccessToken(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get(AccessTokenKey); len(tokens) > 0 {
+		return WithAccessToken(ctx, tokens[0])
+	}
+
+	return ctx
+}
+
+// Caller returns the user whose verified access token came with the call, or false if there was none
+func Caller(ctx context.Context) (int64, bool) {
+	c, ok := ctx.Value(callerKey{}).(caller)
+	return c.uid, ok
+}
+
+// CallerScopes returns the scopes of the API token which the call's access token was issued for, or false if the call
+// didn't come from an API token
+func CallerScopes(ctx context.Context) ([]string, bool) {
+	c, _ := ctx.Value(callerKey{}).(caller)
+	return c.scopes, c.scopes != nil
+}
+
+// RequireScope returns a PermissionDenied error if the call came from an API token whose scopes don't let it use the
+// permission
+func RequireScope(ctx context.Context, p Permission) error {
+	scopes, ok := CallerScopes(ctx)
+	if ok && len(ScopedPermissions([]string{string(p)}, scopes)) == 0 {
+		return status.Errorf(codes.PermissionDenied, "API token's scopes don't allow the %s permission", p)
+	}
+
+	return nil
+}
+
+// RequireCaller returns an Unauthenticated error unless the call came with a verified access token for the user
+func RequireCaller(ctx context.Context, userID int64) error {
+	uid, ok := Caller(ctx)
+	switch {
+	case !ok:
+		return status.Error(codes.Unauthenticated, "an access token is required")
+	case uid != userID:
+		return status.Error(codes.PermissionDenied, "access token belongs to another user")
+	}
+
+	return nil
+}
+
+type serviceSecret string
+
+func (s serviceSecret) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{ServiceSecretKey: string(s)}, nil
+}
+
+// Services talk to each other over plaintext inside the cluster
+func (s serviceSecret) RequireTransportSecurity() bool {
+	return false
+}
+
+// ServiceSecretCredentials sends the service secret with every call on a connection, for services which call RPCs that
+// only other services may. Dial with grpc.WithPerRPCCredentials.
+func ServiceSecretCredentials(secret string) credentials.PerRPCCredentials {
+	return serviceSecret(secret)
+}
+
+// RequireService returns an error unless the call came with the service secret. No calls are accepted if the secret is
+// empty.
+func RequireService(ctx context.Context, secret string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	sent := md.Get(ServiceSecretKey)
+	switch {
+	case secret == "":
+		return status.Error(codes.PermissionDenied, "no service secret is configured, so service calls are refused")
+	case len(sent) == 0:
+		return status.Error(codes.Unauthenticated, "a service secret is required")
+	case subtle.ConstantTimeCompare([]byte(sent[0]), []byte(secret)) != 1:
+		return status.Error(codes.PermissionDenied, "service secret is invalid")
+	}
+
+	return nil
+}
+
+// withCaller verifies the access token sent with the call, if any, and returns a context with its user
+func withCaller(ctx context.Context, v *verifier.Verifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(AccessTokenKey)
+	if len(tokens) == 0 {
+		return ctx, nil
+	}
+
+	claims, err := v.Verify(tokens[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "access token is invalid or expired")
+	}
+
+	return context.WithValue(ctx, callerKey{}, caller{uid: claims.UID, scopes: claims.Scopes}), nil
+}
+
+// CallerInterceptor verifies the access token sent with calls, if any, so that handlers can get the calling user with
+// Caller. Calls with a bad token are refused rather than treated as anonymous.
+//
+// Tokens are only verified locally, so a revoked session's token is accepted until it expires.
+func CallerInterceptor(v *verifier.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withCaller(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+type callerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s callerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamCallerInterceptor is CallerInterceptor for streaming RPCs
+func StreamCallerInterceptor(v *verifier.Verifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withCaller(ss.Context(), v)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, callerStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,161 @@
+// Package permissions maps user ranks to the named permissions which the services check, and provides a gRPC
+// interceptor for services which authorize calls with them. The user service is the source of truth: other services
+// ask it through HasPermission rather than checking ranks themselves.
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Permission string
+
+const (
+	// Vote on pending videos, and see videos which haven't been approved yet
+	VideoApprove Permission = "video.approve"
+	// Hide videos through reports
+	VideoDelete Permission = "video.delete"
+	// Delete other users' comments
+	CommentDelete Permission = "comment.delete"
+	// See and resolve reports
+	ReportResolve Permission = "report.resolve"
+	// Edit tag definitions, aliases and implications
+	TagEdit Permission = "tag.edit"
+	// Ban, suspend and unban users of a lower rank
+	UserBan Permission = "user.ban"
+	// List users, and change the ranks of users of a lower rank
+	UserManage Permission = "user.manage"
+	// Exempt from limits on archival requests
+	ArchiveUnlimited Permission = "archive.unlimited"
+)
+
+// Pseudo-permissions for interceptor rules which only check who is calling. No rank has them.
+const (
+	// The request's userID must be the calling user
+	Self Permission = "self"
+	// As Self, but logged out callers may leave the userID 0
+	SelfOrAnonymous Permission = "self-or-anonymous"
+	// The call must come with the service secret, for RPCs which only other services call
+	Service Permission = "service"
+)
+
+var trustedPermissions = []Permission{VideoApprove, VideoDelete, ReportResolve, TagEdit, UserBan, ArchiveUnlimited}
+
+// RolePermissions lists the permissions of each rank. Banned users have none.
+var RolePermissions = map[userproto.UserRank][]Permission{
+	userproto.UserRank_regular: nil,
+	userproto.UserRank_trusted: trustedPermissions,
+	userproto.UserRank_admin:   append([]Permission{CommentDelete, UserManage}, trustedPermissions...),
+}
+
+// RankHas returns true if users of the rank have the permission
+func RankHas(rank userproto.UserRank, p Permission) bool {
+	for _, granted := range RolePermissions[rank] {
+		if granted == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Require returns a PermissionDenied error unless the user has the permission, and the call didn't come from an API
+// token whose scopes don't allow it. User ID 0, which callers use for logged out users, has no permissions.
+func Require(ctx context.Context, client userproto.UserServiceClient, userID int64, p Permission) error {
+	if userID == 0 {
+		return status.Errorf(codes.Unauthenticated, "must be logged in (%s permission required)", p)
+	}
+
+	if err := RequireScope(ctx, p); err != nil {
+		return err
+	}
+
+	resp, err := client.HasPermission(ForwardAccessToken(ctx), &userproto.PermissionCheck{UserID: userID, Permission: string(p)})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Allowed {
+		return status.Errorf(codes.PermissionDenied, "%s permission required", p)
+	}
+
+	return nil
+}
+
+type userIDRequest interface {
+	GetUserID() int64
+}
+
+// Some older messages spell it user_id
+type userIdRequest interface {
+	GetUserId() int64
+}
+
+func requestUserID(req interface{}) (int64, bool) {
+	switch r := req.(type) {
+	case userIDRequest:
+		return r.GetUserID(), true
+	case userIdRequest:
+		return r.GetUserId(), true
+	default:
+		return 0, false
+	}
+}
+
+// UnaryServerInterceptor authenticates callers like CallerInterceptor, and authorizes calls to the methods in rules,
+// keyed by full method name such as "/proto.VideoService/ApproveVideo". The request's userID field must be the user
+// whose access token came with the call, so only methods whose userID is the caller should be listed. Methods with a
+// real permission also require the calling user to have it, and Service methods require serviceSecret instead. Methods
+// which aren't in rules are let through.
+func UnaryServerInterceptor(client userproto.UserServiceClient, v *verifier.Verifier, serviceSecret string,
+	rules map[string]Permission) grpc.UnaryServerInterceptor {
+	authenticate := CallerInterceptor(v)
+	authorize := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		p, ok := rules[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if p == Service {
+			if err := RequireService(ctx, serviceSecret); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}
+
+		userID, ok := requestUserID(req)
+		if !ok {
+			// A misconfigured rule, so fail closed
+			return nil, status.Error(codes.Internal, fmt.Sprintf("cannot authorize %s, request has no user ID", info.FullMethod))
+		}
+
+		if p == SelfOrAnonymous && userID == 0 {
+			return handler(ctx, req)
+		}
+
+		if err := RequireCaller(ctx, userID); err != nil {
+			return nil, err
+		}
+
+		if p != Self && p != SelfOrAnonymous {
+			if err := Require(ctx, client, userID, p); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return authenticate(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authorize(ctx, req, info, handler)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package permissions
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/horahoradev/horahora/user_service/verifier"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func TestRankHas(t *testing.T) {
+	assert.False(t, RankHas(userproto.UserRank_regular, VideoApprove))
+	assert.True(t, RankHas(userproto.UserRank_trusted, VideoApprove))
+	assert.False(t, RankHas(userproto.UserRank_trusted, CommentDelete))
+	assert.True(t, RankHas(userproto.UserRank_admin, CommentDelete))
+	assert.False(t, RankHas(userproto.UserRank_trusted, UserManage))
+	assert.True(t, RankHas(userproto.UserRank_admin, UserManage))
+
+	// Admins can do everything trusted users can
+	for _, p := range RolePermissions[userproto.UserRank_trusted] {
+		assert.True(t, RankHas(userproto.UserRank_admin, p), p)
+	}
+}
+
+// newMockClient grants the user with ID 1 the given permissions
+func newMockClient(t *testing.T, granted ...Permission) (*usermocks.MockUserServiceClient, func()) {
+	mockCtrl := gomock.NewController(t)
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+	client.EXPECT().HasPermission(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *userproto.PermissionCheck, opts ...grpc.CallOption) (*userproto.PermissionCheckResult, error) {
+			allowed := false
+			for _, p := range granted {
+				allowed = allowed || (req.UserID == 1 && string(p) == req.Permission)
+			}
+			return &userproto.PermissionCheckResult{Allowed: allowed}, nil
+		}).AnyTimes()
+
+	return client, mockCtrl.Finish
+}
+
+func TestRequire(t *testing.T) {
+	client, done := newMockClient(t, VideoApprove)
+	defer done()
+
+	assert.NoError(t, Require(context.Background(), client, 1, VideoApprove))
+	assert.Equal(t, codes.PermissionDenied, status.Code(Require(context.Background(), client, 1, TagEdit)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(Require(context.Background(), client, 2, VideoApprove)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(Require(context.Background(), client, 0, VideoApprove)))
+}
+
+// newTestSigner returns a verifier, and a function which signs access tokens for users that it accepts. Tokens with
+// scopes are those of API tokens.
+func newTestSigner(t *testing.T) (*verifier.Verifier, func(uid int64, scopes ...string) string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	public := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.PS512), Use: "sig"}
+	v := verifier.New(verifier.StaticKeySource(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{public}}))
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.PS512, Key: jose.JSONWebKey{Key: key, KeyID: "test"}}, nil)
+	assert.NoError(t, err)
+
+	return v, func(uid int64, scopes ...string) string {
+		claims := verifier.Claims{
+			Claims: jwt.Claims{Issuer: verifier.Issuer, Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+			UID:    uid,
+			Scopes: scopes,
+		}
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		assert.NoError(t, err)
+		return token
+	}
+}
+
+// incoming returns a context for an incoming call which came with the access token
+func incoming(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AccessTokenKey, token))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	client, done := newMockClient(t, VideoApprove)
+	defer done()
+
+	v, sign := newTestSigner(t)
+	interceptor := UnaryServerInterceptor(client, v, "secret", map[string]Permission{
+		"/proto.UserService/GetFollowing":    VideoApprove,
+		"/proto.UserService/Login":           VideoApprove,
+		"/proto.UserService/GetUserFromID":   Self,
+		"/proto.UserService/GetFollowCounts": SelfOrAnonymous,
+		"/proto.UserService/GetJWKS":         Service,
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	call := func(ctx context.Context, method string, req interface{}) (interface{}, error) {
+		return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	resp, err := call(incoming(sign(1)), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", resp)
+
+	_, err = call(incoming(sign(2)), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 2})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The user ID in the request isn't enough, the caller has to prove it
+	_, err = call(context.Background(), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(incoming(sign(2)), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(incoming("not a token"), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// LoginRequest has no userID field, so the rule can't be applied
+	_, err = call(incoming(sign(1)), "/proto.UserService/Login", &userproto.LoginRequest{Username: "admin"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// API tokens only get the permissions of their scopes
+	_, err = call(incoming(sign(1, string(ScopeRead))), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(incoming(sign(1, string(ScopeModerate))), "/proto.UserService/GetFollowing", &userproto.GetFollowingRequest{UserID: 1})
+	assert.NoError(t, err)
+
+	// Self only needs the caller to be the request's user
+	_, err = call(incoming(sign(2)), "/proto.UserService/GetUserFromID", &userproto.GetUserFromIDRequest{UserID: 2})
+	assert.NoError(t, err)
+
+	_, err = call(incoming(sign(2)), "/proto.UserService/GetUserFromID", &userproto.GetUserFromIDRequest{UserID: 3})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(context.Background(), "/proto.UserService/GetUserFromID", &userproto.GetUserFromIDRequest{UserID: 2})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Logged out callers can leave the user ID 0, but can't claim to be someone
+	_, err = call(context.Background(), "/proto.UserService/GetFollowCounts", &userproto.FollowCountsRequest{UserID: 0})
+	assert.NoError(t, err)
+
+	_, err = call(context.Background(), "/proto.UserService/GetFollowCounts", &userproto.FollowCountsRequest{UserID: 2})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Service methods need the secret, and a user's token isn't enough
+	_, err = call(metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceSecretKey, "secret")),
+		"/proto.UserService/GetJWKS", &userproto.GetJWKSRequest{})
+	assert.NoError(t, err)
+
+	_, err = call(metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceSecretKey, "guess")),
+		"/proto.UserService/GetJWKS", &userproto.GetJWKSRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(incoming(sign(1)), "/proto.UserService/GetJWKS", &userproto.GetJWKSRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Methods without a rule are let through
+	resp, err = call(context.Background(), "/proto.UserService/GetUsersByIDs", &userproto.GetUsersByIDsRequest{UserIDs: []int64{2}})
+	assert.NoError(t, err)
+	assert.Equal(t, "handled", resp)
+}
+
+func TestRequireServiceWithoutSecret(t *testing.T) {
+	// An unconfigured secret mustn't match calls which send an empty one
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceSecretKey, ""))
+	assert.Equal(t, codes.PermissionDenied, status.Code(RequireService(ctx, "")))
+}
+
+func TestCallerInterceptor(t *testing.T) {
+	v, sign := newTestSigner(t)
+	interceptor := CallerInterceptor(v)
+	call := func(ctx context.Context) (interface{}, error) {
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/proto.UserService/BanUser"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, RequireCaller(ctx, 3)
+			})
+	}
+
+	_, err := call(incoming(sign(3)))
+	assert.NoError(t, err)
+
+	_, err = call(incoming(sign(4)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(context.Background())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(incoming("not a token"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestScopedPermissions(t *testing.T) {
+	granted := []string{string(VideoApprove), string(TagEdit), string(ArchiveUnlimited)}
+
+	assert.Empty(t, ScopedPermissions(granted, []string{string(ScopeRead), string(ScopeUpload)}))
+	assert.Equal(t, []string{string(ArchiveUnlimited)}, ScopedPermissions(granted, []string{string(ScopeArchive)}))
+	assert.Equal(t, []string{string(VideoApprove), string(TagEdit)}, ScopedPermissions(granted, []string{string(ScopeModerate)}))
+
+	// Scopes don't grant permissions which the user doesn't have
+	assert.Empty(t, ScopedPermissions(nil, []string{string(ScopeModerate)}))
+}
+
+func TestRequireScope(t *testing.T) {
+	v, sign := newTestSigner(t)
+	interceptor := CallerInterceptor(v)
+	call := func(token string, p Permission) error {
+		_, err := interceptor(incoming(token), nil, &grpc.UnaryServerInfo{FullMethod: "/proto.UserService/HasPermission"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, RequireScope(ctx, p)
+			})
+		return err
+	}
+
+	// Sessions aren't limited by scopes
+	assert.NoError(t, call(sign(1), UserBan))
+
+	assert.NoError(t, call(sign(1, string(ScopeModerate)), UserBan))
+	assert.NoError(t, call(sign(1, string(ScopeRead), string(ScopeArchive)), ArchiveUnlimited))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(sign(1, string(ScopeRead), string(ScopeArchive)), UserBan)))
+}
+
+func TestValidScope(t *testing.T) {
+	for _, scope := range Scopes {
+		assert.True(t, ValidScope(string(scope)))
+	}
+	assert.False(t, ValidScope("admin"))
+}
@@ -0,0 +1,58 @@
+package permissions
+
+// API tokens start with this, so that they can be told apart from access tokens and spotted by secret scanners
+const APITokenPrefix = "hh_"
+
+// Scope limits what an API token can be used for. A token acts as its user, but only with the permissions of its
+// scopes, and front_api only lets it call the routes of its scopes. The scopes are in the claims of the access tokens
+// issued for API tokens, so the services check them too.
+type Scope string
+
+const (
+	// Fetch videos, comments, profiles and so on
+	ScopeRead Scope = "read"
+	// Upload videos
+	ScopeUpload Scope = "upload"
+	// Make archival requests
+	ScopeArchive Scope = "archive"
+	// Use the user's moderation permissions
+	ScopeModerate Scope = "moderate"
+)
+
+var Scopes = []Scope{ScopeRead, ScopeUpload, ScopeArchive, ScopeModerate}
+
+// ScopePermissions lists the permissions which each scope lets a token use, if its user has them
+var ScopePermissions = map[Scope][]Permission{
+	ScopeArchive:  {ArchiveUnlimited},
+	ScopeModerate: {VideoApprove, VideoDelete, CommentDelete, ReportResolve, TagEdit, UserBan, UserManage},
+}
+
+// ValidScope returns true if s names a scope
+func ValidScope(s string) bool {
+	for _, scope := range Scopes {
+		if string(scope) == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScopedPermissions returns the granted permissions which the scopes let a token use
+func ScopedPermissions(granted []string, scopes []string) []string {
+	allowed := make(map[Permission]bool)
+	for _, scope := range scopes {
+		for _, p := range ScopePermissions[Scope(scope)] {
+			allowed[p] = true
+		}
+	}
+
+	var scoped []string
+	for _, p := range granted {
+		if allowed[Permission(p)] {
+			scoped = append(scoped, p)
+		}
+	}
+
+	return scoped
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/horahoradev/horahora/protocol (interfaces: UserServiceClient)
+// Source: github.com/horahoradev/horahora/user_service/protocol (interfaces: UserServiceClient)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -35,6 +35,206 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateAPIToken mocks base method.
+func (m *MockUserServiceClient) AuthenticateAPIToken(arg0 context.Context, arg1 *proto.AuthenticateAPITokenRequest, arg2 ...grpc.CallOption) (*proto.APITokenAuthentication, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.APITokenAuthentication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockUserServiceClientMockRecorder) AuthenticateAPIToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).AuthenticateAPIToken), varargs...)
+}
+
+// BanUser mocks base method.
+func (m *MockUserServiceClient) BanUser(arg0 context.Context, arg1 *proto.BanUserRequest, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BanUser", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockUserServiceClientMockRecorder) BanUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockUserServiceClient)(nil).BanUser), varargs...)
+}
+
+// CompleteClaim mocks base method.
+func (m *MockUserServiceClient) CompleteClaim(arg0 context.Context, arg1 *proto.CompleteClaimRequest, arg2 ...grpc.CallOption) (*proto.Claim, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteClaim", varargs...)
+	ret0, _ := ret[0].(*proto.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteClaim indicates an expected call of CompleteClaim.
+func (mr *MockUserServiceClientMockRecorder) CompleteClaim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteClaim", reflect.TypeOf((*MockUserServiceClient)(nil).CompleteClaim), varargs...)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockUserServiceClient) CreateAPIToken(arg0 context.Context, arg1 *proto.CreateAPITokenRequest, arg2 ...grpc.CallOption) (*proto.NewAPIToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.NewAPIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockUserServiceClientMockRecorder) CreateAPIToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).CreateAPIToken), varargs...)
+}
+
+// FollowUser mocks base method.
+func (m *MockUserServiceClient) FollowUser(arg0 context.Context, arg1 *proto.FollowRequest, arg2 ...grpc.CallOption) (*proto.FollowCounts, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FollowUser", varargs...)
+	ret0, _ := ret[0].(*proto.FollowCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockUserServiceClientMockRecorder) FollowUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockUserServiceClient)(nil).FollowUser), varargs...)
+}
+
+// GetClaim mocks base method.
+func (m *MockUserServiceClient) GetClaim(arg0 context.Context, arg1 *proto.GetClaimRequest, arg2 ...grpc.CallOption) (*proto.Claim, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClaim", varargs...)
+	ret0, _ := ret[0].(*proto.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaim indicates an expected call of GetClaim.
+func (mr *MockUserServiceClientMockRecorder) GetClaim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaim", reflect.TypeOf((*MockUserServiceClient)(nil).GetClaim), varargs...)
+}
+
+// GetFollowCounts mocks base method.
+func (m *MockUserServiceClient) GetFollowCounts(arg0 context.Context, arg1 *proto.FollowCountsRequest, arg2 ...grpc.CallOption) (*proto.FollowCounts, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowCounts", varargs...)
+	ret0, _ := ret[0].(*proto.FollowCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCounts indicates an expected call of GetFollowCounts.
+func (mr *MockUserServiceClientMockRecorder) GetFollowCounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCounts", reflect.TypeOf((*MockUserServiceClient)(nil).GetFollowCounts), varargs...)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUserServiceClient) GetFollowing(arg0 context.Context, arg1 *proto.GetFollowingRequest, arg2 ...grpc.CallOption) (*proto.FollowingList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowing", varargs...)
+	ret0, _ := ret[0].(*proto.FollowingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUserServiceClientMockRecorder) GetFollowing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUserServiceClient)(nil).GetFollowing), varargs...)
+}
+
+// GetJWKS mocks base method.
+func (m *MockUserServiceClient) GetJWKS(arg0 context.Context, arg1 *proto.GetJWKSRequest, arg2 ...grpc.CallOption) (*proto.JWKS, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJWKS", varargs...)
+	ret0, _ := ret[0].(*proto.JWKS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockUserServiceClientMockRecorder) GetJWKS(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockUserServiceClient)(nil).GetJWKS), varargs...)
+}
+
+// GetPermissions mocks base method.
+func (m *MockUserServiceClient) GetPermissions(arg0 context.Context, arg1 *proto.GetPermissionsRequest, arg2 ...grpc.CallOption) (*proto.PermissionList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPermissions", varargs...)
+	ret0, _ := ret[0].(*proto.PermissionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissions indicates an expected call of GetPermissions.
+func (mr *MockUserServiceClientMockRecorder) GetPermissions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissions", reflect.TypeOf((*MockUserServiceClient)(nil).GetPermissions), varargs...)
+}
+
 // GetUserForForeignUID mocks base method.
 func (m *MockUserServiceClient) GetUserForForeignUID(arg0 context.Context, arg1 *proto.GetForeignUserRequest, arg2 ...grpc.CallOption) (*proto.GetForeignUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +275,86 @@ func (mr *MockUserServiceClientMockRecorder) GetUserFromID(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFromID", reflect.TypeOf((*MockUserServiceClient)(nil).GetUserFromID), varargs...)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *MockUserServiceClient) GetUsersByIDs(arg0 context.Context, arg1 *proto.GetUsersByIDsRequest, arg2 ...grpc.CallOption) (*proto.UsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsersByIDs", varargs...)
+	ret0, _ := ret[0].(*proto.UsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserServiceClientMockRecorder) GetUsersByIDs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserServiceClient)(nil).GetUsersByIDs), varargs...)
+}
+
+// HasPermission mocks base method.
+func (m *MockUserServiceClient) HasPermission(arg0 context.Context, arg1 *proto.PermissionCheck, arg2 ...grpc.CallOption) (*proto.PermissionCheckResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HasPermission", varargs...)
+	ret0, _ := ret[0].(*proto.PermissionCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockUserServiceClientMockRecorder) HasPermission(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockUserServiceClient)(nil).HasPermission), varargs...)
+}
+
+// ListAPITokens mocks base method.
+func (m *MockUserServiceClient) ListAPITokens(arg0 context.Context, arg1 *proto.ListAPITokensRequest, arg2 ...grpc.CallOption) (*proto.APITokenList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPITokens", varargs...)
+	ret0, _ := ret[0].(*proto.APITokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockUserServiceClientMockRecorder) ListAPITokens(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockUserServiceClient)(nil).ListAPITokens), varargs...)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserServiceClient) ListUsers(arg0 context.Context, arg1 *proto.ListUsersRequest, arg2 ...grpc.CallOption) (*proto.UserList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(*proto.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserServiceClientMockRecorder) ListUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceClient)(nil).ListUsers), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockUserServiceClient) Login(arg0 context.Context, arg1 *proto.LoginRequest, arg2 ...grpc.CallOption) (*proto.LoginResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +375,26 @@ func (mr *MockUserServiceClientMockRecorder) Login(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserServiceClient)(nil).Login), varargs...)
 }
 
+// RefreshToken mocks base method.
+func (m *MockUserServiceClient) RefreshToken(arg0 context.Context, arg1 *proto.RefreshTokenRequest, arg2 ...grpc.CallOption) (*proto.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshToken", varargs...)
+	ret0, _ := ret[0].(*proto.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockUserServiceClientMockRecorder) RefreshToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockUserServiceClient)(nil).RefreshToken), varargs...)
+}
+
 // Register mocks base method.
 func (m *MockUserServiceClient) Register(arg0 context.Context, arg1 *proto.RegisterRequest, arg2 ...grpc.CallOption) (*proto.RegisterResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +415,226 @@ func (mr *MockUserServiceClientMockRecorder) Register(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserServiceClient)(nil).Register), varargs...)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockUserServiceClient) RequestPasswordReset(arg0 context.Context, arg1 *proto.RequestPasswordResetRequest, arg2 ...grpc.CallOption) (*proto.RequestPasswordResetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*proto.RequestPasswordResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUserServiceClientMockRecorder) RequestPasswordReset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUserServiceClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserServiceClient) ResetPassword(arg0 context.Context, arg1 *proto.ResetPasswordRequest, arg2 ...grpc.CallOption) (*proto.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*proto.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceClientMockRecorder) ResetPassword(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// RevokeAPIToken mocks base method.
+func (m *MockUserServiceClient) RevokeAPIToken(arg0 context.Context, arg1 *proto.RevokeAPITokenRequest, arg2 ...grpc.CallOption) (*proto.RevokeAPITokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.RevokeAPITokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockUserServiceClientMockRecorder) RevokeAPIToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).RevokeAPIToken), varargs...)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockUserServiceClient) RevokeSessions(arg0 context.Context, arg1 *proto.RevokeSessionsRequest, arg2 ...grpc.CallOption) (*proto.RevokeSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSessions", varargs...)
+	ret0, _ := ret[0].(*proto.RevokeSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockUserServiceClientMockRecorder) RevokeSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockUserServiceClient)(nil).RevokeSessions), varargs...)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockUserServiceClient) SendVerificationEmail(arg0 context.Context, arg1 *proto.SendVerificationEmailRequest, arg2 ...grpc.CallOption) (*proto.SendVerificationEmailResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendVerificationEmail", varargs...)
+	ret0, _ := ret[0].(*proto.SendVerificationEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockUserServiceClientMockRecorder) SendVerificationEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockUserServiceClient)(nil).SendVerificationEmail), varargs...)
+}
+
+// SetUserRank mocks base method.
+func (m *MockUserServiceClient) SetUserRank(arg0 context.Context, arg1 *proto.SetUserRankRequest, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetUserRank", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserRank indicates an expected call of SetUserRank.
+func (mr *MockUserServiceClientMockRecorder) SetUserRank(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRank", reflect.TypeOf((*MockUserServiceClient)(nil).SetUserRank), varargs...)
+}
+
+// StartClaim mocks base method.
+func (m *MockUserServiceClient) StartClaim(arg0 context.Context, arg1 *proto.StartClaimRequest, arg2 ...grpc.CallOption) (*proto.Claim, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartClaim", varargs...)
+	ret0, _ := ret[0].(*proto.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartClaim indicates an expected call of StartClaim.
+func (mr *MockUserServiceClientMockRecorder) StartClaim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartClaim", reflect.TypeOf((*MockUserServiceClient)(nil).StartClaim), varargs...)
+}
+
+// UnbanUser mocks base method.
+func (m *MockUserServiceClient) UnbanUser(arg0 context.Context, arg1 *proto.UnbanUserRequest, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnbanUser", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanUser indicates an expected call of UnbanUser.
+func (mr *MockUserServiceClientMockRecorder) UnbanUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockUserServiceClient)(nil).UnbanUser), varargs...)
+}
+
+// UnfollowUser mocks base method.
+func (m *MockUserServiceClient) UnfollowUser(arg0 context.Context, arg1 *proto.FollowRequest, arg2 ...grpc.CallOption) (*proto.FollowCounts, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnfollowUser", varargs...)
+	ret0, _ := ret[0].(*proto.FollowCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockUserServiceClientMockRecorder) UnfollowUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockUserServiceClient)(nil).UnfollowUser), varargs...)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUserServiceClient) UpdateProfile(arg0 context.Context, arg1 *proto.UpdateProfileRequest, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProfile", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserServiceClientMockRecorder) UpdateProfile(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateProfile), varargs...)
+}
+
+// UploadProfileImage mocks base method.
+func (m *MockUserServiceClient) UploadProfileImage(arg0 context.Context, arg1 *proto.ProfileImageUpload, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadProfileImage", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadProfileImage indicates an expected call of UploadProfileImage.
+func (mr *MockUserServiceClientMockRecorder) UploadProfileImage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadProfileImage", reflect.TypeOf((*MockUserServiceClient)(nil).UploadProfileImage), varargs...)
+}
+
 // ValidateJWT mocks base method.
 func (m *MockUserServiceClient) ValidateJWT(arg0 context.Context, arg1 *proto.ValidateJWTRequest, arg2 ...grpc.CallOption) (*proto.ValidateJWTResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,3 +654,23 @@ func (mr *MockUserServiceClientMockRecorder) ValidateJWT(arg0, arg1 interface{},
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJWT", reflect.TypeOf((*MockUserServiceClient)(nil).ValidateJWT), varargs...)
 }
+
+// VerifyEmail mocks base method.
+func (m *MockUserServiceClient) VerifyEmail(arg0 context.Context, arg1 *proto.VerifyEmailRequest, arg2 ...grpc.CallOption) (*proto.UserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(*proto.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserServiceClientMockRecorder) VerifyEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserServiceClient)(nil).VerifyEmail), varargs...)
+}
@@ -24,6 +24,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ProfileImage int32
+
+const (
+	ProfileImage_avatar ProfileImage = 0
+	ProfileImage_banner ProfileImage = 1
+)
+
+var ProfileImage_name = map[int32]string{
+	0: "avatar",
+	1: "banner",
+}
+
+var ProfileImage_value = map[string]int32{
+	"avatar": 0,
+	"banner": 1,
+}
+
+func (x ProfileImage) String() string {
+	return proto.EnumName(ProfileImage_name, int32(x))
+}
+
+func (ProfileImage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{0}
+}
+
+type ClaimMode int32
+
+const (
+	ClaimMode_convert ClaimMode = 0
+	ClaimMode_merge   ClaimMode = 1
+)
+
+var ClaimMode_name = map[int32]string{
+	0: "convert",
+	1: "merge",
+}
+
+var ClaimMode_value = map[string]int32{
+	"convert": 0,
+	"merge":   1,
+}
+
+func (x ClaimMode) String() string {
+	return proto.EnumName(ClaimMode_name, int32(x))
+}
+
+func (ClaimMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{1}
+}
+
 type Site int32
 
 const (
@@ -49,7 +99,7 @@ func (x Site) String() string {
 }
 
 func (Site) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68a7ca558839fd2b, []int{0}
+	return fileDescriptor_68a7ca558839fd2b, []int{2}
 }
 
 type UserRank int32
@@ -77,727 +127,3998 @@ func (x UserRank) String() string {
 }
 
 func (UserRank) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68a7ca558839fd2b, []int{1}
+	return fileDescriptor_68a7ca558839fd2b, []int{3}
 }
 
-type GetForeignUserRequest struct {
-	OriginalWebsite      Site     `protobuf:"varint,1,opt,name=originalWebsite,proto3,enum=proto.Site" json:"originalWebsite,omitempty"`
-	ForeignUserID        string   `protobuf:"bytes,2,opt,name=foreignUserID,proto3" json:"foreignUserID,omitempty"`
+type FollowRequest struct {
+	FollowerID           int64    `protobuf:"varint,1,opt,name=followerID,proto3" json:"followerID,omitempty"`
+	FolloweeID           int64    `protobuf:"varint,2,opt,name=followeeID,proto3" json:"followeeID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetForeignUserRequest) Reset()         { *m = GetForeignUserRequest{} }
-func (m *GetForeignUserRequest) String() string { return proto.CompactTextString(m) }
-func (*GetForeignUserRequest) ProtoMessage()    {}
-func (*GetForeignUserRequest) Descriptor() ([]byte, []int) {
+func (m *FollowRequest) Reset()         { *m = FollowRequest{} }
+func (m *FollowRequest) String() string { return proto.CompactTextString(m) }
+func (*FollowRequest) ProtoMessage()    {}
+func (*FollowRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{0}
 }
 
-func (m *GetForeignUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetForeignUserRequest.Unmarshal(m, b)
+func (m *FollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowRequest.Unmarshal(m, b)
 }
-func (m *GetForeignUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetForeignUserRequest.Marshal(b, m, deterministic)
+func (m *FollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowRequest.Marshal(b, m, deterministic)
 }
-func (m *GetForeignUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetForeignUserRequest.Merge(m, src)
+func (m *FollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowRequest.Merge(m, src)
 }
-func (m *GetForeignUserRequest) XXX_Size() int {
-	return xxx_messageInfo_GetForeignUserRequest.Size(m)
+func (m *FollowRequest) XXX_Size() int {
+	return xxx_messageInfo_FollowRequest.Size(m)
 }
-func (m *GetForeignUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetForeignUserRequest.DiscardUnknown(m)
+func (m *FollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetForeignUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_FollowRequest proto.InternalMessageInfo
 
-func (m *GetForeignUserRequest) GetOriginalWebsite() Site {
+func (m *FollowRequest) GetFollowerID() int64 {
 	if m != nil {
-		return m.OriginalWebsite
+		return m.FollowerID
 	}
-	return Site_niconico
+	return 0
 }
 
-func (m *GetForeignUserRequest) GetForeignUserID() string {
+func (m *FollowRequest) GetFolloweeID() int64 {
 	if m != nil {
-		return m.ForeignUserID
+		return m.FolloweeID
 	}
-	return ""
+	return 0
 }
 
-type GetForeignUserResponse struct {
-	NewUID               int64    `protobuf:"varint,1,opt,name=newUID,proto3" json:"newUID,omitempty"`
+type FollowCountsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ViewerID             int64    `protobuf:"varint,2,opt,name=viewerID,proto3" json:"viewerID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetForeignUserResponse) Reset()         { *m = GetForeignUserResponse{} }
-func (m *GetForeignUserResponse) String() string { return proto.CompactTextString(m) }
-func (*GetForeignUserResponse) ProtoMessage()    {}
-func (*GetForeignUserResponse) Descriptor() ([]byte, []int) {
+func (m *FollowCountsRequest) Reset()         { *m = FollowCountsRequest{} }
+func (m *FollowCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*FollowCountsRequest) ProtoMessage()    {}
+func (*FollowCountsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{1}
 }
 
-func (m *GetForeignUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetForeignUserResponse.Unmarshal(m, b)
+func (m *FollowCountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowCountsRequest.Unmarshal(m, b)
 }
-func (m *GetForeignUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetForeignUserResponse.Marshal(b, m, deterministic)
+func (m *FollowCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowCountsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetForeignUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetForeignUserResponse.Merge(m, src)
+func (m *FollowCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowCountsRequest.Merge(m, src)
 }
-func (m *GetForeignUserResponse) XXX_Size() int {
-	return xxx_messageInfo_GetForeignUserResponse.Size(m)
+func (m *FollowCountsRequest) XXX_Size() int {
+	return xxx_messageInfo_FollowCountsRequest.Size(m)
 }
-func (m *GetForeignUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetForeignUserResponse.DiscardUnknown(m)
+func (m *FollowCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowCountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetForeignUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_FollowCountsRequest proto.InternalMessageInfo
 
-func (m *GetForeignUserResponse) GetNewUID() int64 {
+func (m *FollowCountsRequest) GetUserID() int64 {
 	if m != nil {
-		return m.NewUID
+		return m.UserID
 	}
 	return 0
 }
 
-type ValidateJWTRequest struct {
-	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+func (m *FollowCountsRequest) GetViewerID() int64 {
+	if m != nil {
+		return m.ViewerID
+	}
+	return 0
+}
+
+type FollowCounts struct {
+	Followers            int64    `protobuf:"varint,1,opt,name=followers,proto3" json:"followers,omitempty"`
+	Following            int64    `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	FollowedByViewer     bool     `protobuf:"varint,3,opt,name=followedByViewer,proto3" json:"followedByViewer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateJWTRequest) Reset()         { *m = ValidateJWTRequest{} }
-func (m *ValidateJWTRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidateJWTRequest) ProtoMessage()    {}
-func (*ValidateJWTRequest) Descriptor() ([]byte, []int) {
+func (m *FollowCounts) Reset()         { *m = FollowCounts{} }
+func (m *FollowCounts) String() string { return proto.CompactTextString(m) }
+func (*FollowCounts) ProtoMessage()    {}
+func (*FollowCounts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{2}
 }
 
-func (m *ValidateJWTRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateJWTRequest.Unmarshal(m, b)
+func (m *FollowCounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowCounts.Unmarshal(m, b)
 }
-func (m *ValidateJWTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateJWTRequest.Marshal(b, m, deterministic)
+func (m *FollowCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowCounts.Marshal(b, m, deterministic)
 }
-func (m *ValidateJWTRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateJWTRequest.Merge(m, src)
+func (m *FollowCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowCounts.Merge(m, src)
 }
-func (m *ValidateJWTRequest) XXX_Size() int {
-	return xxx_messageInfo_ValidateJWTRequest.Size(m)
+func (m *FollowCounts) XXX_Size() int {
+	return xxx_messageInfo_FollowCounts.Size(m)
 }
-func (m *ValidateJWTRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateJWTRequest.DiscardUnknown(m)
+func (m *FollowCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowCounts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateJWTRequest proto.InternalMessageInfo
+var xxx_messageInfo_FollowCounts proto.InternalMessageInfo
 
-func (m *ValidateJWTRequest) GetJwt() string {
+func (m *FollowCounts) GetFollowers() int64 {
 	if m != nil {
-		return m.Jwt
+		return m.Followers
 	}
-	return ""
+	return 0
 }
 
-type ValidateJWTResponse struct {
-	IsValid              bool     `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
-	Uid                  int64    `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+func (m *FollowCounts) GetFollowing() int64 {
+	if m != nil {
+		return m.Following
+	}
+	return 0
+}
+
+func (m *FollowCounts) GetFollowedByViewer() bool {
+	if m != nil {
+		return m.FollowedByViewer
+	}
+	return false
+}
+
+type GetFollowingRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateJWTResponse) Reset()         { *m = ValidateJWTResponse{} }
-func (m *ValidateJWTResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidateJWTResponse) ProtoMessage()    {}
-func (*ValidateJWTResponse) Descriptor() ([]byte, []int) {
+func (m *GetFollowingRequest) Reset()         { *m = GetFollowingRequest{} }
+func (m *GetFollowingRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFollowingRequest) ProtoMessage()    {}
+func (*GetFollowingRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{3}
 }
 
-func (m *ValidateJWTResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateJWTResponse.Unmarshal(m, b)
+func (m *GetFollowingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFollowingRequest.Unmarshal(m, b)
 }
-func (m *ValidateJWTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateJWTResponse.Marshal(b, m, deterministic)
+func (m *GetFollowingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFollowingRequest.Marshal(b, m, deterministic)
 }
-func (m *ValidateJWTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateJWTResponse.Merge(m, src)
+func (m *GetFollowingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFollowingRequest.Merge(m, src)
 }
-func (m *ValidateJWTResponse) XXX_Size() int {
-	return xxx_messageInfo_ValidateJWTResponse.Size(m)
+func (m *GetFollowingRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFollowingRequest.Size(m)
 }
-func (m *ValidateJWTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateJWTResponse.DiscardUnknown(m)
+func (m *GetFollowingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFollowingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateJWTResponse proto.InternalMessageInfo
-
-func (m *ValidateJWTResponse) GetIsValid() bool {
-	if m != nil {
-		return m.IsValid
-	}
-	return false
-}
+var xxx_messageInfo_GetFollowingRequest proto.InternalMessageInfo
 
-func (m *ValidateJWTResponse) GetUid() int64 {
+func (m *GetFollowingRequest) GetUserID() int64 {
 	if m != nil {
-		return m.Uid
+		return m.UserID
 	}
 	return 0
 }
 
-type RegisterRequest struct {
-	Email       string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Username    string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password    string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	ForeignUser bool   `protobuf:"varint,4,opt,name=foreignUser,proto3" json:"foreignUser,omitempty"`
-	// the password field will not be used
-	ForeignUserID        string   `protobuf:"bytes,5,opt,name=foreignUserID,proto3" json:"foreignUserID,omitempty"`
-	ForeignWebsite       Site     `protobuf:"varint,6,opt,name=foreignWebsite,proto3,enum=proto.Site" json:"foreignWebsite,omitempty"`
+type FollowingList struct {
+	UserIDs              []int64  `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
-func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterRequest) ProtoMessage()    {}
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
+func (m *FollowingList) Reset()         { *m = FollowingList{} }
+func (m *FollowingList) String() string { return proto.CompactTextString(m) }
+func (*FollowingList) ProtoMessage()    {}
+func (*FollowingList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{4}
 }
 
-func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterRequest.Unmarshal(m, b)
-}
-func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
-}
-func (m *RegisterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterRequest.Merge(m, src)
-}
-func (m *RegisterRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterRequest.Size(m)
+func (m *FollowingList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowingList.Unmarshal(m, b)
 }
-func (m *RegisterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
-
-func (m *RegisterRequest) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+func (m *FollowingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowingList.Marshal(b, m, deterministic)
 }
-
-func (m *RegisterRequest) GetUsername() string {
-	if m != nil {
-		return m.Username
-	}
-	return ""
+func (m *FollowingList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowingList.Merge(m, src)
 }
-
-func (m *RegisterRequest) GetPassword() string {
-	if m != nil {
-		return m.Password
-	}
-	return ""
+func (m *FollowingList) XXX_Size() int {
+	return xxx_messageInfo_FollowingList.Size(m)
 }
-
-func (m *RegisterRequest) GetForeignUser() bool {
-	if m != nil {
-		return m.ForeignUser
-	}
-	return false
+func (m *FollowingList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowingList.DiscardUnknown(m)
 }
 
-func (m *RegisterRequest) GetForeignUserID() string {
-	if m != nil {
-		return m.ForeignUserID
-	}
-	return ""
-}
+var xxx_messageInfo_FollowingList proto.InternalMessageInfo
 
-func (m *RegisterRequest) GetForeignWebsite() Site {
+func (m *FollowingList) GetUserIDs() []int64 {
 	if m != nil {
-		return m.ForeignWebsite
+		return m.UserIDs
 	}
-	return Site_niconico
+	return nil
 }
 
-type LoginRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type PermissionCheck struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Permission           string   `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
-func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
-func (*LoginRequest) ProtoMessage()    {}
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+func (m *PermissionCheck) Reset()         { *m = PermissionCheck{} }
+func (m *PermissionCheck) String() string { return proto.CompactTextString(m) }
+func (*PermissionCheck) ProtoMessage()    {}
+func (*PermissionCheck) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{5}
 }
 
-func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
+func (m *PermissionCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionCheck.Unmarshal(m, b)
 }
-func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+func (m *PermissionCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionCheck.Marshal(b, m, deterministic)
 }
-func (m *LoginRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginRequest.Merge(m, src)
+func (m *PermissionCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionCheck.Merge(m, src)
 }
-func (m *LoginRequest) XXX_Size() int {
-	return xxx_messageInfo_LoginRequest.Size(m)
+func (m *PermissionCheck) XXX_Size() int {
+	return xxx_messageInfo_PermissionCheck.Size(m)
 }
-func (m *LoginRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
+func (m *PermissionCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionCheck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
+var xxx_messageInfo_PermissionCheck proto.InternalMessageInfo
 
-func (m *LoginRequest) GetUsername() string {
+func (m *PermissionCheck) GetUserID() int64 {
 	if m != nil {
-		return m.Username
+		return m.UserID
 	}
-	return ""
+	return 0
 }
 
-func (m *LoginRequest) GetPassword() string {
+func (m *PermissionCheck) GetPermission() string {
 	if m != nil {
-		return m.Password
+		return m.Permission
 	}
 	return ""
 }
 
-type LoginResponse struct {
-	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+type PermissionCheckResult struct {
+	Allowed              bool     `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
-func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
-func (*LoginResponse) ProtoMessage()    {}
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+func (m *PermissionCheckResult) Reset()         { *m = PermissionCheckResult{} }
+func (m *PermissionCheckResult) String() string { return proto.CompactTextString(m) }
+func (*PermissionCheckResult) ProtoMessage()    {}
+func (*PermissionCheckResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{6}
 }
 
-func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
+func (m *PermissionCheckResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionCheckResult.Unmarshal(m, b)
 }
-func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+func (m *PermissionCheckResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionCheckResult.Marshal(b, m, deterministic)
 }
-func (m *LoginResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginResponse.Merge(m, src)
+func (m *PermissionCheckResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionCheckResult.Merge(m, src)
 }
-func (m *LoginResponse) XXX_Size() int {
-	return xxx_messageInfo_LoginResponse.Size(m)
+func (m *PermissionCheckResult) XXX_Size() int {
+	return xxx_messageInfo_PermissionCheckResult.Size(m)
 }
-func (m *LoginResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
+func (m *PermissionCheckResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionCheckResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+var xxx_messageInfo_PermissionCheckResult proto.InternalMessageInfo
 
-func (m *LoginResponse) GetJwt() string {
+func (m *PermissionCheckResult) GetAllowed() bool {
 	if m != nil {
-		return m.Jwt
+		return m.Allowed
 	}
-	return ""
+	return false
 }
 
-type RegisterResponse struct {
-	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+type GetPermissionsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
-func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterResponse) ProtoMessage()    {}
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
+func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
+func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsRequest) ProtoMessage()    {}
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68a7ca558839fd2b, []int{7}
 }
 
-func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterResponse.Unmarshal(m, b)
+func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPermissionsRequest.Unmarshal(m, b)
 }
-func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
+func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPermissionsRequest.Marshal(b, m, deterministic)
 }
-func (m *RegisterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterResponse.Merge(m, src)
+func (m *GetPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsRequest.Merge(m, src)
 }
-func (m *RegisterResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterResponse.Size(m)
+func (m *GetPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPermissionsRequest.Size(m)
 }
-func (m *RegisterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
+func (m *GetPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPermissionsRequest proto.InternalMessageInfo
 
-func (m *RegisterResponse) GetJwt() string {
+func (m *GetPermissionsRequest) GetUserID() int64 {
 	if m != nil {
-		return m.Jwt
+		return m.UserID
+	}
+	return 0
+}
+
+type PermissionList struct {
+	Permissions          []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PermissionList) Reset()         { *m = PermissionList{} }
+func (m *PermissionList) String() string { return proto.CompactTextString(m) }
+func (*PermissionList) ProtoMessage()    {}
+func (*PermissionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{8}
+}
+
+func (m *PermissionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionList.Unmarshal(m, b)
+}
+func (m *PermissionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionList.Marshal(b, m, deterministic)
+}
+func (m *PermissionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionList.Merge(m, src)
+}
+func (m *PermissionList) XXX_Size() int {
+	return xxx_messageInfo_PermissionList.Size(m)
+}
+func (m *PermissionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionList proto.InternalMessageInfo
+
+func (m *PermissionList) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type BanUserRequest struct {
+	ModeratorID          int64    `protobuf:"varint,1,opt,name=moderatorID,proto3" json:"moderatorID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	DurationSeconds      int64    `protobuf:"varint,3,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanUserRequest) Reset()         { *m = BanUserRequest{} }
+func (m *BanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*BanUserRequest) ProtoMessage()    {}
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{9}
+}
+
+func (m *BanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanUserRequest.Unmarshal(m, b)
+}
+func (m *BanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanUserRequest.Marshal(b, m, deterministic)
+}
+func (m *BanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanUserRequest.Merge(m, src)
+}
+func (m *BanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_BanUserRequest.Size(m)
+}
+func (m *BanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanUserRequest proto.InternalMessageInfo
+
+func (m *BanUserRequest) GetModeratorID() int64 {
+	if m != nil {
+		return m.ModeratorID
+	}
+	return 0
+}
+
+func (m *BanUserRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *BanUserRequest) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *BanUserRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-type GetUserFromIDRequest struct {
+type UpdateProfileRequest struct {
 	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Bio                  string   `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links                []string `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUserFromIDRequest) Reset()         { *m = GetUserFromIDRequest{} }
-func (m *GetUserFromIDRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUserFromIDRequest) ProtoMessage()    {}
-func (*GetUserFromIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a7ca558839fd2b, []int{8}
+func (m *UpdateProfileRequest) Reset()         { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()    {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{10}
 }
 
-func (m *GetUserFromIDRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserFromIDRequest.Unmarshal(m, b)
+func (m *UpdateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRequest.Unmarshal(m, b)
 }
-func (m *GetUserFromIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserFromIDRequest.Marshal(b, m, deterministic)
+func (m *UpdateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRequest.Marshal(b, m, deterministic)
 }
-func (m *GetUserFromIDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserFromIDRequest.Merge(m, src)
+func (m *UpdateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRequest.Merge(m, src)
 }
-func (m *GetUserFromIDRequest) XXX_Size() int {
-	return xxx_messageInfo_GetUserFromIDRequest.Size(m)
+func (m *UpdateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRequest.Size(m)
 }
-func (m *GetUserFromIDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserFromIDRequest.DiscardUnknown(m)
+func (m *UpdateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserFromIDRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateProfileRequest proto.InternalMessageInfo
 
-func (m *GetUserFromIDRequest) GetUserID() int64 {
+func (m *UpdateProfileRequest) GetUserID() int64 {
 	if m != nil {
 		return m.UserID
 	}
 	return 0
 }
 
-type UserResponse struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Rank                 UserRank `protobuf:"varint,3,opt,name=rank,proto3,enum=proto.UserRank" json:"rank,omitempty"`
+func (m *UpdateProfileRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetLinks() []string {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type ProfileImageUpload struct {
+	UserID               int64        `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Image                ProfileImage `protobuf:"varint,2,opt,name=image,proto3,enum=proto.ProfileImage" json:"image,omitempty"`
+	Data                 []byte       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ProfileImageUpload) Reset()         { *m = ProfileImageUpload{} }
+func (m *ProfileImageUpload) String() string { return proto.CompactTextString(m) }
+func (*ProfileImageUpload) ProtoMessage()    {}
+func (*ProfileImageUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{11}
+}
+
+func (m *ProfileImageUpload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileImageUpload.Unmarshal(m, b)
+}
+func (m *ProfileImageUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileImageUpload.Marshal(b, m, deterministic)
+}
+func (m *ProfileImageUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileImageUpload.Merge(m, src)
+}
+func (m *ProfileImageUpload) XXX_Size() int {
+	return xxx_messageInfo_ProfileImageUpload.Size(m)
+}
+func (m *ProfileImageUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileImageUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileImageUpload proto.InternalMessageInfo
+
+func (m *ProfileImageUpload) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ProfileImageUpload) GetImage() ProfileImage {
+	if m != nil {
+		return m.Image
+	}
+	return ProfileImage_avatar
+}
+
+func (m *ProfileImageUpload) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CreateAPITokenRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	LifetimeSeconds      int64    `protobuf:"varint,4,opt,name=lifetimeSeconds,proto3" json:"lifetimeSeconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserResponse) Reset()         { *m = UserResponse{} }
-func (m *UserResponse) String() string { return proto.CompactTextString(m) }
-func (*UserResponse) ProtoMessage()    {}
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a7ca558839fd2b, []int{9}
+func (m *CreateAPITokenRequest) Reset()         { *m = CreateAPITokenRequest{} }
+func (m *CreateAPITokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPITokenRequest) ProtoMessage()    {}
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{12}
 }
 
-func (m *UserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserResponse.Unmarshal(m, b)
+func (m *CreateAPITokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPITokenRequest.Unmarshal(m, b)
 }
-func (m *UserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserResponse.Marshal(b, m, deterministic)
+func (m *CreateAPITokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPITokenRequest.Marshal(b, m, deterministic)
 }
-func (m *UserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserResponse.Merge(m, src)
+func (m *CreateAPITokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPITokenRequest.Merge(m, src)
 }
-func (m *UserResponse) XXX_Size() int {
-	return xxx_messageInfo_UserResponse.Size(m)
+func (m *CreateAPITokenRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPITokenRequest.Size(m)
 }
-func (m *UserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserResponse.DiscardUnknown(m)
+func (m *CreateAPITokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPITokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateAPITokenRequest proto.InternalMessageInfo
 
-func (m *UserResponse) GetUsername() string {
+func (m *CreateAPITokenRequest) GetUserID() int64 {
 	if m != nil {
-		return m.Username
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *CreateAPITokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
 
-func (m *UserResponse) GetEmail() string {
+func (m *CreateAPITokenRequest) GetScopes() []string {
 	if m != nil {
-		return m.Email
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *CreateAPITokenRequest) GetLifetimeSeconds() int64 {
+	if m != nil {
+		return m.LifetimeSeconds
+	}
+	return 0
+}
+
+type APIToken struct {
+	TokenID              int64    `protobuf:"varint,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreationDate         int64    `protobuf:"varint,4,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	LastUsed             int64    `protobuf:"varint,6,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIToken) Reset()         { *m = APIToken{} }
+func (m *APIToken) String() string { return proto.CompactTextString(m) }
+func (*APIToken) ProtoMessage()    {}
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{13}
+}
+
+func (m *APIToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIToken.Unmarshal(m, b)
+}
+func (m *APIToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIToken.Marshal(b, m, deterministic)
+}
+func (m *APIToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIToken.Merge(m, src)
+}
+func (m *APIToken) XXX_Size() int {
+	return xxx_messageInfo_APIToken.Size(m)
+}
+func (m *APIToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIToken proto.InternalMessageInfo
+
+func (m *APIToken) GetTokenID() int64 {
+	if m != nil {
+		return m.TokenID
+	}
+	return 0
+}
+
+func (m *APIToken) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
 
-func (m *UserResponse) GetRank() UserRank {
+func (m *APIToken) GetScopes() []string {
 	if m != nil {
-		return m.Rank
+		return m.Scopes
 	}
-	return UserRank_regular
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("proto.Site", Site_name, Site_value)
-	proto.RegisterEnum("proto.UserRank", UserRank_name, UserRank_value)
-	proto.RegisterType((*GetForeignUserRequest)(nil), "proto.GetForeignUserRequest")
-	proto.RegisterType((*GetForeignUserResponse)(nil), "proto.GetForeignUserResponse")
-	proto.RegisterType((*ValidateJWTRequest)(nil), "proto.validateJWTRequest")
-	proto.RegisterType((*ValidateJWTResponse)(nil), "proto.validateJWTResponse")
-	proto.RegisterType((*RegisterRequest)(nil), "proto.RegisterRequest")
-	proto.RegisterType((*LoginRequest)(nil), "proto.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "proto.LoginResponse")
-	proto.RegisterType((*RegisterResponse)(nil), "proto.RegisterResponse")
-	proto.RegisterType((*GetUserFromIDRequest)(nil), "proto.GetUserFromIDRequest")
-	proto.RegisterType((*UserResponse)(nil), "proto.UserResponse")
+func (m *APIToken) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("userservice.proto", fileDescriptor_68a7ca558839fd2b) }
+func (m *APIToken) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
 
-var fileDescriptor_68a7ca558839fd2b = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4b, 0x6f, 0xda, 0x4c,
-	0x14, 0xc5, 0xe6, 0x11, 0xb8, 0x3c, 0xe2, 0x6f, 0xc2, 0x47, 0xa9, 0xdb, 0x4a, 0xd4, 0x42, 0x55,
-	0x94, 0x05, 0x8d, 0x48, 0xbb, 0xec, 0xa2, 0x2a, 0x22, 0xa2, 0xea, 0xca, 0x2d, 0xc9, 0xb2, 0x32,
-	0xe1, 0x06, 0x4d, 0x0b, 0x1e, 0x3a, 0x33, 0x0e, 0xea, 0x7f, 0xed, 0xba, 0xbf, 0xa3, 0x9a, 0xf1,
-	0x18, 0xdb, 0x98, 0x64, 0x81, 0xf0, 0x7d, 0xf8, 0xcc, 0x3d, 0xe7, 0xdc, 0x31, 0xfc, 0x17, 0x09,
-	0xe4, 0x02, 0xf9, 0x03, 0xbd, 0xc3, 0xd1, 0x96, 0x33, 0xc9, 0x48, 0x55, 0xff, 0x79, 0x12, 0xfe,
-	0xbf, 0x46, 0x39, 0x65, 0x1c, 0xe9, 0x2a, 0x9c, 0x0b, 0xe4, 0x3e, 0xfe, 0x8a, 0x50, 0x48, 0xf2,
-	0x1e, 0x4e, 0x19, 0xa7, 0x2b, 0x1a, 0x06, 0xeb, 0x5b, 0x5c, 0x08, 0x2a, 0xb1, 0x6f, 0x0d, 0xac,
-	0xf3, 0xce, 0xb8, 0x19, 0x03, 0x8c, 0x54, 0xca, 0x3f, 0xec, 0x21, 0x43, 0x68, 0xdf, 0xa7, 0x60,
-	0xb3, 0x49, 0xdf, 0x1e, 0x58, 0xe7, 0x0d, 0x3f, 0x9f, 0xf4, 0x2e, 0xa1, 0x77, 0x78, 0xaa, 0xd8,
-	0xb2, 0x50, 0x20, 0xe9, 0x41, 0x2d, 0xc4, 0xdd, 0x7c, 0x36, 0xd1, 0xa7, 0x95, 0x7d, 0x13, 0x79,
-	0x6f, 0x80, 0x3c, 0x04, 0x6b, 0xba, 0x0c, 0x24, 0x7e, 0xbe, 0xfd, 0x96, 0x0c, 0xe9, 0x40, 0xf9,
-	0xc7, 0x4e, 0xea, 0xd6, 0x86, 0xaf, 0x1e, 0xbd, 0x8f, 0x70, 0x96, 0xeb, 0x33, 0xb0, 0x7d, 0x38,
-	0xa1, 0xe2, 0x46, 0x15, 0x74, 0x73, 0xdd, 0x4f, 0x42, 0x05, 0x11, 0xd1, 0xa5, 0x1e, 0xb3, 0xec,
-	0xab, 0x47, 0xef, 0x8f, 0x05, 0xa7, 0x3e, 0xae, 0xa8, 0x90, 0xa9, 0x1a, 0x5d, 0xa8, 0xe2, 0x26,
-	0xa0, 0x6b, 0x73, 0x54, 0x1c, 0x10, 0x17, 0xea, 0x4a, 0xd8, 0x30, 0xd8, 0xa0, 0xe1, 0xb9, 0x8f,
-	0x55, 0x6d, 0x1b, 0x08, 0xb1, 0x63, 0x7c, 0xd9, 0x2f, 0xc7, 0xb5, 0x24, 0x26, 0x03, 0x68, 0x66,
-	0xf4, 0xe8, 0x57, 0xf4, 0x44, 0xd9, 0x54, 0x51, 0xc6, 0xea, 0x11, 0x19, 0xc9, 0x15, 0x74, 0x4c,
-	0x22, 0xb1, 0xa8, 0x56, 0xb4, 0xe8, 0xa0, 0xc5, 0x9b, 0x42, 0xeb, 0x0b, 0x5b, 0xd1, 0x30, 0xa1,
-	0x96, 0x25, 0x61, 0x3d, 0x41, 0xc2, 0xce, 0x93, 0xf0, 0x5e, 0x43, 0xdb, 0xe0, 0x18, 0x8d, 0x8b,
-	0x66, 0x0c, 0xc1, 0x49, 0x85, 0x7c, 0xb4, 0x6b, 0x04, 0xdd, 0x6b, 0x94, 0x8a, 0xd2, 0x94, 0xb3,
-	0xcd, 0x6c, 0x92, 0x0c, 0xd6, 0x83, 0x5a, 0x14, 0x93, 0x37, 0xab, 0x10, 0x47, 0xde, 0x3d, 0xb4,
-	0x72, 0x2b, 0xf3, 0x14, 0x81, 0xbd, 0x6f, 0x76, 0xd6, 0xb7, 0x21, 0x54, 0x78, 0x10, 0xfe, 0xd4,
-	0xbe, 0x74, 0xc6, 0x8e, 0x51, 0x4b, 0xbd, 0xf4, 0x5d, 0xe5, 0x7d, 0x5d, 0xbd, 0x78, 0x0b, 0x15,
-	0xbd, 0xd2, 0x2d, 0xa8, 0x87, 0xf4, 0x8e, 0xa9, 0x9f, 0x53, 0x52, 0xd1, 0x82, 0xae, 0xa9, 0xfa,
-	0x39, 0x16, 0x69, 0xc2, 0xc9, 0x6f, 0x16, 0xc9, 0x68, 0x81, 0x8e, 0x7d, 0x71, 0x09, 0x8d, 0x3d,
-	0x86, 0xaa, 0x70, 0x5c, 0x45, 0xeb, 0x80, 0x3b, 0x25, 0x15, 0x48, 0x1e, 0x09, 0x89, 0x4b, 0xc7,
-	0x22, 0x0d, 0xa8, 0x06, 0xcb, 0x0d, 0x0d, 0x1d, 0x7b, 0xfc, 0xd7, 0x86, 0xa6, 0xe2, 0xf2, 0x35,
-	0xbe, 0x9a, 0xe4, 0x03, 0xd4, 0x13, 0xc1, 0x48, 0xcf, 0x8c, 0x75, 0xb0, 0x8a, 0xee, 0xb3, 0x42,
-	0x3e, 0xd6, 0xc1, 0x2b, 0x91, 0x77, 0x50, 0xd5, 0x96, 0x90, 0x33, 0xd3, 0x93, 0x35, 0xda, 0xed,
-	0xe6, 0x93, 0xfb, 0xb7, 0xa6, 0xd0, 0xbc, 0x49, 0xaf, 0x0c, 0x79, 0x6e, 0xda, 0x8a, 0xd7, 0xcd,
-	0x75, 0x8f, 0x95, 0xf6, 0x38, 0x9f, 0xa0, 0x9d, 0xf3, 0x91, 0xbc, 0x30, 0xed, 0xc7, 0xdc, 0x75,
-	0x93, 0x11, 0xb3, 0x56, 0x7a, 0x25, 0x32, 0x4f, 0x97, 0x81, 0xf1, 0xe4, 0x03, 0x31, 0x9b, 0x90,
-	0x97, 0x29, 0x56, 0xf1, 0x63, 0xe5, 0xbe, 0x7a, 0xa4, 0x9a, 0xc0, 0x2e, 0x6a, 0xba, 0x7e, 0xf5,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x5f, 0xb9, 0xb0, 0x09, 0x05, 0x00, 0x00,
+func (m *APIToken) GetLastUsed() int64 {
+	if m != nil {
+		return m.LastUsed
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+type NewAPIToken struct {
+	Token                string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Info                 *APIToken `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *NewAPIToken) Reset()         { *m = NewAPIToken{} }
+func (m *NewAPIToken) String() string { return proto.CompactTextString(m) }
+func (*NewAPIToken) ProtoMessage()    {}
+func (*NewAPIToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{14}
+}
 
-// UserServiceClient is the client API for UserService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserServiceClient interface {
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	ValidateJWT(ctx context.Context, in *ValidateJWTRequest, opts ...grpc.CallOption) (*ValidateJWTResponse, error)
-	GetUserFromID(ctx context.Context, in *GetUserFromIDRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	GetUserForForeignUID(ctx context.Context, in *GetForeignUserRequest, opts ...grpc.CallOption) (*GetForeignUserResponse, error)
+func (m *NewAPIToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewAPIToken.Unmarshal(m, b)
+}
+func (m *NewAPIToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewAPIToken.Marshal(b, m, deterministic)
+}
+func (m *NewAPIToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAPIToken.Merge(m, src)
+}
+func (m *NewAPIToken) XXX_Size() int {
+	return xxx_messageInfo_NewAPIToken.Size(m)
+}
+func (m *NewAPIToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAPIToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewAPIToken proto.InternalMessageInfo
+
+func (m *NewAPIToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *NewAPIToken) GetInfo() *APIToken {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ListAPITokensRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAPITokensRequest) Reset()         { *m = ListAPITokensRequest{} }
+func (m *ListAPITokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAPITokensRequest) ProtoMessage()    {}
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{15}
+}
+
+func (m *ListAPITokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPITokensRequest.Unmarshal(m, b)
+}
+func (m *ListAPITokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPITokensRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAPITokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPITokensRequest.Merge(m, src)
+}
+func (m *ListAPITokensRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAPITokensRequest.Size(m)
+}
+func (m *ListAPITokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPITokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPITokensRequest proto.InternalMessageInfo
+
+func (m *ListAPITokensRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+// Expired tokens are left out
+type APITokenList struct {
+	Tokens               []*APIToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *APITokenList) Reset()         { *m = APITokenList{} }
+func (m *APITokenList) String() string { return proto.CompactTextString(m) }
+func (*APITokenList) ProtoMessage()    {}
+func (*APITokenList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{16}
+}
+
+func (m *APITokenList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APITokenList.Unmarshal(m, b)
+}
+func (m *APITokenList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APITokenList.Marshal(b, m, deterministic)
+}
+func (m *APITokenList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APITokenList.Merge(m, src)
+}
+func (m *APITokenList) XXX_Size() int {
+	return xxx_messageInfo_APITokenList.Size(m)
+}
+func (m *APITokenList) XXX_DiscardUnknown() {
+	xxx_messageInfo_APITokenList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APITokenList proto.InternalMessageInfo
+
+func (m *APITokenList) GetTokens() []*APIToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokeAPITokenRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	TokenID              int64    `protobuf:"varint,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPITokenRequest) Reset()         { *m = RevokeAPITokenRequest{} }
+func (m *RevokeAPITokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPITokenRequest) ProtoMessage()    {}
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{17}
+}
+
+func (m *RevokeAPITokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPITokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPITokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPITokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPITokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPITokenRequest.Merge(m, src)
+}
+func (m *RevokeAPITokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPITokenRequest.Size(m)
+}
+func (m *RevokeAPITokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPITokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPITokenRequest proto.InternalMessageInfo
+
+func (m *RevokeAPITokenRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *RevokeAPITokenRequest) GetTokenID() int64 {
+	if m != nil {
+		return m.TokenID
+	}
+	return 0
+}
+
+type RevokeAPITokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPITokenResponse) Reset()         { *m = RevokeAPITokenResponse{} }
+func (m *RevokeAPITokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPITokenResponse) ProtoMessage()    {}
+func (*RevokeAPITokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{18}
+}
+
+func (m *RevokeAPITokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPITokenResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPITokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPITokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPITokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPITokenResponse.Merge(m, src)
+}
+func (m *RevokeAPITokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPITokenResponse.Size(m)
+}
+func (m *RevokeAPITokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPITokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPITokenResponse proto.InternalMessageInfo
+
+type AuthenticateAPITokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthenticateAPITokenRequest) Reset()         { *m = AuthenticateAPITokenRequest{} }
+func (m *AuthenticateAPITokenRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateAPITokenRequest) ProtoMessage()    {}
+func (*AuthenticateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{19}
+}
+
+func (m *AuthenticateAPITokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticateAPITokenRequest.Unmarshal(m, b)
+}
+func (m *AuthenticateAPITokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticateAPITokenRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthenticateAPITokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateAPITokenRequest.Merge(m, src)
+}
+func (m *AuthenticateAPITokenRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthenticateAPITokenRequest.Size(m)
+}
+func (m *AuthenticateAPITokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateAPITokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateAPITokenRequest proto.InternalMessageInfo
+
+func (m *AuthenticateAPITokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type APITokenAuthentication struct {
+	UserID  int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	TokenID int64    `protobuf:"varint,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Scopes  []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// A short-lived access token for the user, which is sent to other services with calls made with the API token
+	Jwt                  string   `protobuf:"bytes,4,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APITokenAuthentication) Reset()         { *m = APITokenAuthentication{} }
+func (m *APITokenAuthentication) String() string { return proto.CompactTextString(m) }
+func (*APITokenAuthentication) ProtoMessage()    {}
+func (*APITokenAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{20}
+}
+
+func (m *APITokenAuthentication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APITokenAuthentication.Unmarshal(m, b)
+}
+func (m *APITokenAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APITokenAuthentication.Marshal(b, m, deterministic)
+}
+func (m *APITokenAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APITokenAuthentication.Merge(m, src)
+}
+func (m *APITokenAuthentication) XXX_Size() int {
+	return xxx_messageInfo_APITokenAuthentication.Size(m)
+}
+func (m *APITokenAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_APITokenAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APITokenAuthentication proto.InternalMessageInfo
+
+func (m *APITokenAuthentication) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *APITokenAuthentication) GetTokenID() int64 {
+	if m != nil {
+		return m.TokenID
+	}
+	return 0
+}
+
+func (m *APITokenAuthentication) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *APITokenAuthentication) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+type StartClaimRequest struct {
+	ClaimantID           int64    `protobuf:"varint,1,opt,name=claimantID,proto3" json:"claimantID,omitempty"`
+	ForeignAccountID     int64    `protobuf:"varint,2,opt,name=foreignAccountID,proto3" json:"foreignAccountID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartClaimRequest) Reset()         { *m = StartClaimRequest{} }
+func (m *StartClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*StartClaimRequest) ProtoMessage()    {}
+func (*StartClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{21}
+}
+
+func (m *StartClaimRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartClaimRequest.Unmarshal(m, b)
+}
+func (m *StartClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartClaimRequest.Marshal(b, m, deterministic)
+}
+func (m *StartClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartClaimRequest.Merge(m, src)
+}
+func (m *StartClaimRequest) XXX_Size() int {
+	return xxx_messageInfo_StartClaimRequest.Size(m)
+}
+func (m *StartClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartClaimRequest proto.InternalMessageInfo
+
+func (m *StartClaimRequest) GetClaimantID() int64 {
+	if m != nil {
+		return m.ClaimantID
+	}
+	return 0
+}
+
+func (m *StartClaimRequest) GetForeignAccountID() int64 {
+	if m != nil {
+		return m.ForeignAccountID
+	}
+	return 0
+}
+
+type GetClaimRequest struct {
+	ClaimID              int64    `protobuf:"varint,1,opt,name=claimID,proto3" json:"claimID,omitempty"`
+	ClaimantID           int64    `protobuf:"varint,2,opt,name=claimantID,proto3" json:"claimantID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClaimRequest) Reset()         { *m = GetClaimRequest{} }
+func (m *GetClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClaimRequest) ProtoMessage()    {}
+func (*GetClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{22}
+}
+
+func (m *GetClaimRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClaimRequest.Unmarshal(m, b)
+}
+func (m *GetClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClaimRequest.Marshal(b, m, deterministic)
+}
+func (m *GetClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClaimRequest.Merge(m, src)
+}
+func (m *GetClaimRequest) XXX_Size() int {
+	return xxx_messageInfo_GetClaimRequest.Size(m)
+}
+func (m *GetClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClaimRequest proto.InternalMessageInfo
+
+func (m *GetClaimRequest) GetClaimID() int64 {
+	if m != nil {
+		return m.ClaimID
+	}
+	return 0
+}
+
+func (m *GetClaimRequest) GetClaimantID() int64 {
+	if m != nil {
+		return m.ClaimantID
+	}
+	return 0
+}
+
+type CompleteClaimRequest struct {
+	ClaimID              int64     `protobuf:"varint,1,opt,name=claimID,proto3" json:"claimID,omitempty"`
+	ClaimantID           int64     `protobuf:"varint,2,opt,name=claimantID,proto3" json:"claimantID,omitempty"`
+	Mode                 ClaimMode `protobuf:"varint,3,opt,name=mode,proto3,enum=proto.ClaimMode" json:"mode,omitempty"`
+	ChannelDescription   string    `protobuf:"bytes,4,opt,name=channelDescription,proto3" json:"channelDescription,omitempty"`
+	Email                string    `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string    `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CompleteClaimRequest) Reset()         { *m = CompleteClaimRequest{} }
+func (m *CompleteClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteClaimRequest) ProtoMessage()    {}
+func (*CompleteClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{23}
+}
+
+func (m *CompleteClaimRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteClaimRequest.Unmarshal(m, b)
+}
+func (m *CompleteClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteClaimRequest.Marshal(b, m, deterministic)
+}
+func (m *CompleteClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteClaimRequest.Merge(m, src)
+}
+func (m *CompleteClaimRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteClaimRequest.Size(m)
+}
+func (m *CompleteClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteClaimRequest proto.InternalMessageInfo
+
+func (m *CompleteClaimRequest) GetClaimID() int64 {
+	if m != nil {
+		return m.ClaimID
+	}
+	return 0
+}
+
+func (m *CompleteClaimRequest) GetClaimantID() int64 {
+	if m != nil {
+		return m.ClaimantID
+	}
+	return 0
+}
+
+func (m *CompleteClaimRequest) GetMode() ClaimMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ClaimMode_convert
+}
+
+func (m *CompleteClaimRequest) GetChannelDescription() string {
+	if m != nil {
+		return m.ChannelDescription
+	}
+	return ""
+}
+
+func (m *CompleteClaimRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CompleteClaimRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type Claim struct {
+	ClaimID              int64     `protobuf:"varint,1,opt,name=claimID,proto3" json:"claimID,omitempty"`
+	ForeignAccountID     int64     `protobuf:"varint,2,opt,name=foreignAccountID,proto3" json:"foreignAccountID,omitempty"`
+	Website              Site      `protobuf:"varint,3,opt,name=website,proto3,enum=proto.Site" json:"website,omitempty"`
+	ForeignUserID        string    `protobuf:"bytes,4,opt,name=foreignUserID,proto3" json:"foreignUserID,omitempty"`
+	Code                 string    `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	ExpiresAt            int64     `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Completed            bool      `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	Mode                 ClaimMode `protobuf:"varint,8,opt,name=mode,proto3,enum=proto.ClaimMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Claim) Reset()         { *m = Claim{} }
+func (m *Claim) String() string { return proto.CompactTextString(m) }
+func (*Claim) ProtoMessage()    {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{24}
+}
+
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Claim.Unmarshal(m, b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return xxx_messageInfo_Claim.Size(m)
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
+func (m *Claim) GetClaimID() int64 {
+	if m != nil {
+		return m.ClaimID
+	}
+	return 0
+}
+
+func (m *Claim) GetForeignAccountID() int64 {
+	if m != nil {
+		return m.ForeignAccountID
+	}
+	return 0
+}
+
+func (m *Claim) GetWebsite() Site {
+	if m != nil {
+		return m.Website
+	}
+	return Site_niconico
+}
+
+func (m *Claim) GetForeignUserID() string {
+	if m != nil {
+		return m.ForeignUserID
+	}
+	return ""
+}
+
+func (m *Claim) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Claim) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Claim) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *Claim) GetMode() ClaimMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ClaimMode_convert
+}
+
+type UnbanUserRequest struct {
+	ModeratorID          int64    `protobuf:"varint,1,opt,name=moderatorID,proto3" json:"moderatorID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanUserRequest) Reset()         { *m = UnbanUserRequest{} }
+func (m *UnbanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanUserRequest) ProtoMessage()    {}
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{25}
+}
+
+func (m *UnbanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanUserRequest.Unmarshal(m, b)
+}
+func (m *UnbanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UnbanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanUserRequest.Merge(m, src)
+}
+func (m *UnbanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanUserRequest.Size(m)
+}
+func (m *UnbanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanUserRequest proto.InternalMessageInfo
+
+func (m *UnbanUserRequest) GetModeratorID() int64 {
+	if m != nil {
+		return m.ModeratorID
+	}
+	return 0
+}
+
+func (m *UnbanUserRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *UnbanUserRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SetUserRankRequest struct {
+	ActorID              int64    `protobuf:"varint,1,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Rank                 UserRank `protobuf:"varint,3,opt,name=rank,proto3,enum=proto.UserRank" json:"rank,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRankRequest) Reset()         { *m = SetUserRankRequest{} }
+func (m *SetUserRankRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserRankRequest) ProtoMessage()    {}
+func (*SetUserRankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{26}
+}
+
+func (m *SetUserRankRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRankRequest.Unmarshal(m, b)
+}
+func (m *SetUserRankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRankRequest.Marshal(b, m, deterministic)
+}
+func (m *SetUserRankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRankRequest.Merge(m, src)
+}
+func (m *SetUserRankRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserRankRequest.Size(m)
+}
+func (m *SetUserRankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRankRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRankRequest proto.InternalMessageInfo
+
+func (m *SetUserRankRequest) GetActorID() int64 {
+	if m != nil {
+		return m.ActorID
+	}
+	return 0
+}
+
+func (m *SetUserRankRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *SetUserRankRequest) GetRank() UserRank {
+	if m != nil {
+		return m.Rank
+	}
+	return UserRank_regular
+}
+
+type ListUsersRequest struct {
+	ActorID              int64      `protobuf:"varint,1,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	Query                string     `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Ranks                []UserRank `protobuf:"varint,3,rep,packed,name=ranks,proto3,enum=proto.UserRank" json:"ranks,omitempty"`
+	BannedOnly           bool       `protobuf:"varint,4,opt,name=bannedOnly,proto3" json:"bannedOnly,omitempty"`
+	LocalOnly            bool       `protobuf:"varint,5,opt,name=localOnly,proto3" json:"localOnly,omitempty"`
+	PageNumber           int64      `protobuf:"varint,6,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{27}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetActorID() int64 {
+	if m != nil {
+		return m.ActorID
+	}
+	return 0
+}
+
+func (m *ListUsersRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetRanks() []UserRank {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetBannedOnly() bool {
+	if m != nil {
+		return m.BannedOnly
+	}
+	return false
+}
+
+func (m *ListUsersRequest) GetLocalOnly() bool {
+	if m != nil {
+		return m.LocalOnly
+	}
+	return false
+}
+
+func (m *ListUsersRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type UserList struct {
+	Users                []*UserResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NumberOfUsers        int64           `protobuf:"varint,2,opt,name=numberOfUsers,proto3" json:"numberOfUsers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UserList) Reset()         { *m = UserList{} }
+func (m *UserList) String() string { return proto.CompactTextString(m) }
+func (*UserList) ProtoMessage()    {}
+func (*UserList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{28}
+}
+
+func (m *UserList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserList.Unmarshal(m, b)
+}
+func (m *UserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserList.Marshal(b, m, deterministic)
+}
+func (m *UserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserList.Merge(m, src)
+}
+func (m *UserList) XXX_Size() int {
+	return xxx_messageInfo_UserList.Size(m)
+}
+func (m *UserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserList proto.InternalMessageInfo
+
+func (m *UserList) GetUsers() []*UserResponse {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *UserList) GetNumberOfUsers() int64 {
+	if m != nil {
+		return m.NumberOfUsers
+	}
+	return 0
+}
+
+type GetForeignUserRequest struct {
+	OriginalWebsite      Site     `protobuf:"varint,1,opt,name=originalWebsite,proto3,enum=proto.Site" json:"originalWebsite,omitempty"`
+	ForeignUserID        string   `protobuf:"bytes,2,opt,name=foreignUserID,proto3" json:"foreignUserID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetForeignUserRequest) Reset()         { *m = GetForeignUserRequest{} }
+func (m *GetForeignUserRequest) String() string { return proto.CompactTextString(m) }
+func (*GetForeignUserRequest) ProtoMessage()    {}
+func (*GetForeignUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{29}
+}
+
+func (m *GetForeignUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetForeignUserRequest.Unmarshal(m, b)
+}
+func (m *GetForeignUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetForeignUserRequest.Marshal(b, m, deterministic)
+}
+func (m *GetForeignUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetForeignUserRequest.Merge(m, src)
+}
+func (m *GetForeignUserRequest) XXX_Size() int {
+	return xxx_messageInfo_GetForeignUserRequest.Size(m)
+}
+func (m *GetForeignUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetForeignUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetForeignUserRequest proto.InternalMessageInfo
+
+func (m *GetForeignUserRequest) GetOriginalWebsite() Site {
+	if m != nil {
+		return m.OriginalWebsite
+	}
+	return Site_niconico
+}
+
+func (m *GetForeignUserRequest) GetForeignUserID() string {
+	if m != nil {
+		return m.ForeignUserID
+	}
+	return ""
+}
+
+type GetForeignUserResponse struct {
+	NewUID               int64    `protobuf:"varint,1,opt,name=newUID,proto3" json:"newUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetForeignUserResponse) Reset()         { *m = GetForeignUserResponse{} }
+func (m *GetForeignUserResponse) String() string { return proto.CompactTextString(m) }
+func (*GetForeignUserResponse) ProtoMessage()    {}
+func (*GetForeignUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{30}
+}
+
+func (m *GetForeignUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetForeignUserResponse.Unmarshal(m, b)
+}
+func (m *GetForeignUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetForeignUserResponse.Marshal(b, m, deterministic)
+}
+func (m *GetForeignUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetForeignUserResponse.Merge(m, src)
+}
+func (m *GetForeignUserResponse) XXX_Size() int {
+	return xxx_messageInfo_GetForeignUserResponse.Size(m)
+}
+func (m *GetForeignUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetForeignUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetForeignUserResponse proto.InternalMessageInfo
+
+func (m *GetForeignUserResponse) GetNewUID() int64 {
+	if m != nil {
+		return m.NewUID
+	}
+	return 0
+}
+
+type ValidateJWTRequest struct {
+	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateJWTRequest) Reset()         { *m = ValidateJWTRequest{} }
+func (m *ValidateJWTRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateJWTRequest) ProtoMessage()    {}
+func (*ValidateJWTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{31}
+}
+
+func (m *ValidateJWTRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateJWTRequest.Unmarshal(m, b)
+}
+func (m *ValidateJWTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateJWTRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateJWTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateJWTRequest.Merge(m, src)
+}
+func (m *ValidateJWTRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateJWTRequest.Size(m)
+}
+func (m *ValidateJWTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateJWTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateJWTRequest proto.InternalMessageInfo
+
+func (m *ValidateJWTRequest) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+type ValidateJWTResponse struct {
+	IsValid              bool     `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	Uid                  int64    `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	SessionID            int64    `protobuf:"varint,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateJWTResponse) Reset()         { *m = ValidateJWTResponse{} }
+func (m *ValidateJWTResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateJWTResponse) ProtoMessage()    {}
+func (*ValidateJWTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{32}
+}
+
+func (m *ValidateJWTResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateJWTResponse.Unmarshal(m, b)
+}
+func (m *ValidateJWTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateJWTResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateJWTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateJWTResponse.Merge(m, src)
+}
+func (m *ValidateJWTResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateJWTResponse.Size(m)
+}
+func (m *ValidateJWTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateJWTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateJWTResponse proto.InternalMessageInfo
+
+func (m *ValidateJWTResponse) GetIsValid() bool {
+	if m != nil {
+		return m.IsValid
+	}
+	return false
+}
+
+func (m *ValidateJWTResponse) GetUid() int64 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *ValidateJWTResponse) GetSessionID() int64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{33}
+}
+
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RevokeSessionsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	SessionID            int64    `protobuf:"varint,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsRequest) Reset()         { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()    {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{34}
+}
+
+func (m *RevokeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsRequest.Merge(m, src)
+}
+func (m *RevokeSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsRequest.Size(m)
+}
+func (m *RevokeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *RevokeSessionsRequest) GetSessionID() int64 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+type RevokeSessionsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsResponse) Reset()         { *m = RevokeSessionsResponse{} }
+func (m *RevokeSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsResponse) ProtoMessage()    {}
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{35}
+}
+
+func (m *RevokeSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsResponse.Unmarshal(m, b)
+}
+func (m *RevokeSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsResponse.Merge(m, src)
+}
+func (m *RevokeSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsResponse.Size(m)
+}
+func (m *RevokeSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsResponse proto.InternalMessageInfo
+
+type GetJWKSRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJWKSRequest) Reset()         { *m = GetJWKSRequest{} }
+func (m *GetJWKSRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJWKSRequest) ProtoMessage()    {}
+func (*GetJWKSRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{36}
+}
+
+func (m *GetJWKSRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJWKSRequest.Unmarshal(m, b)
+}
+func (m *GetJWKSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJWKSRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJWKSRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJWKSRequest.Merge(m, src)
+}
+func (m *GetJWKSRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJWKSRequest.Size(m)
+}
+func (m *GetJWKSRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJWKSRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJWKSRequest proto.InternalMessageInfo
+
+type JWKS struct {
+	Jwks                 string   `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JWKS) Reset()         { *m = JWKS{} }
+func (m *JWKS) String() string { return proto.CompactTextString(m) }
+func (*JWKS) ProtoMessage()    {}
+func (*JWKS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{37}
+}
+
+func (m *JWKS) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JWKS.Unmarshal(m, b)
+}
+func (m *JWKS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JWKS.Marshal(b, m, deterministic)
+}
+func (m *JWKS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JWKS.Merge(m, src)
+}
+func (m *JWKS) XXX_Size() int {
+	return xxx_messageInfo_JWKS.Size(m)
+}
+func (m *JWKS) XXX_DiscardUnknown() {
+	xxx_messageInfo_JWKS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JWKS proto.InternalMessageInfo
+
+func (m *JWKS) GetJwks() string {
+	if m != nil {
+		return m.Jwks
+	}
+	return ""
+}
+
+type SendVerificationEmailRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendVerificationEmailRequest) Reset()         { *m = SendVerificationEmailRequest{} }
+func (m *SendVerificationEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*SendVerificationEmailRequest) ProtoMessage()    {}
+func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{38}
+}
+
+func (m *SendVerificationEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendVerificationEmailRequest.Unmarshal(m, b)
+}
+func (m *SendVerificationEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendVerificationEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *SendVerificationEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendVerificationEmailRequest.Merge(m, src)
+}
+func (m *SendVerificationEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_SendVerificationEmailRequest.Size(m)
+}
+func (m *SendVerificationEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendVerificationEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendVerificationEmailRequest proto.InternalMessageInfo
+
+func (m *SendVerificationEmailRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type SendVerificationEmailResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendVerificationEmailResponse) Reset()         { *m = SendVerificationEmailResponse{} }
+func (m *SendVerificationEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*SendVerificationEmailResponse) ProtoMessage()    {}
+func (*SendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{39}
+}
+
+func (m *SendVerificationEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendVerificationEmailResponse.Unmarshal(m, b)
+}
+func (m *SendVerificationEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendVerificationEmailResponse.Marshal(b, m, deterministic)
+}
+func (m *SendVerificationEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendVerificationEmailResponse.Merge(m, src)
+}
+func (m *SendVerificationEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_SendVerificationEmailResponse.Size(m)
+}
+func (m *SendVerificationEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendVerificationEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendVerificationEmailResponse proto.InternalMessageInfo
+
+type VerifyEmailRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{40}
+}
+
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
+}
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(m, src)
+}
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
+}
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
+
+func (m *VerifyEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{41}
+}
+
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(m, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *RequestPasswordResetRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetResponse) Reset()         { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()    {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{42}
+}
+
+func (m *RequestPasswordResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetResponse.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetResponse.Merge(m, src)
+}
+func (m *RequestPasswordResetResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetResponse.Size(m)
+}
+func (m *RequestPasswordResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetResponse proto.InternalMessageInfo
+
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{43}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordResponse) Reset()         { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()    {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{44}
+}
+
+func (m *ResetPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordResponse.Merge(m, src)
+}
+func (m *ResetPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordResponse.Size(m)
+}
+func (m *ResetPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordResponse proto.InternalMessageInfo
+
+type RegisterRequest struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// For foreign users, the name on their website. It becomes their display name, and their username is suffixed if
+	// it's taken.
+	Username    string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ForeignUser bool   `protobuf:"varint,4,opt,name=foreignUser,proto3" json:"foreignUser,omitempty"`
+	// the password field will not be used
+	ForeignUserID        string   `protobuf:"bytes,5,opt,name=foreignUserID,proto3" json:"foreignUserID,omitempty"`
+	ForeignWebsite       Site     `protobuf:"varint,6,opt,name=foreignWebsite,proto3,enum=proto.Site" json:"foreignWebsite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
+func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterRequest) ProtoMessage()    {}
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{45}
+}
+
+func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterRequest.Unmarshal(m, b)
+}
+func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterRequest.Merge(m, src)
+}
+func (m *RegisterRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterRequest.Size(m)
+}
+func (m *RegisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
+
+func (m *RegisterRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetForeignUser() bool {
+	if m != nil {
+		return m.ForeignUser
+	}
+	return false
+}
+
+func (m *RegisterRequest) GetForeignUserID() string {
+	if m != nil {
+		return m.ForeignUserID
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetForeignWebsite() Site {
+	if m != nil {
+		return m.ForeignWebsite
+	}
+	return Site_niconico
+}
+
+type LoginRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
+func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()    {}
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{46}
+}
+
+func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
+}
+func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+}
+func (m *LoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginRequest.Merge(m, src)
+}
+func (m *LoginRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginRequest.Size(m)
+}
+func (m *LoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
+
+func (m *LoginRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Expiry               int64    `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
+func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()    {}
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{47}
+}
+
+func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
+}
+func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+}
+func (m *LoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginResponse.Merge(m, src)
+}
+func (m *LoginResponse) XXX_Size() int {
+	return xxx_messageInfo_LoginResponse.Size(m)
+}
+func (m *LoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+
+func (m *LoginResponse) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Expiry               int64    `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
+func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse) ProtoMessage()    {}
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{48}
+}
+
+func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse.Unmarshal(m, b)
+}
+func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse.Merge(m, src)
+}
+func (m *RegisterResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse.Size(m)
+}
+func (m *RegisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
+
+func (m *RegisterResponse) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *RegisterResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *RegisterResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type GetUserFromIDRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserFromIDRequest) Reset()         { *m = GetUserFromIDRequest{} }
+func (m *GetUserFromIDRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserFromIDRequest) ProtoMessage()    {}
+func (*GetUserFromIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{49}
+}
+
+func (m *GetUserFromIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserFromIDRequest.Unmarshal(m, b)
+}
+func (m *GetUserFromIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserFromIDRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUserFromIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserFromIDRequest.Merge(m, src)
+}
+func (m *GetUserFromIDRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserFromIDRequest.Size(m)
+}
+func (m *GetUserFromIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserFromIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserFromIDRequest proto.InternalMessageInfo
+
+func (m *GetUserFromIDRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+// Batched variant of GetUserFromID, used to avoid a lookup per row when building lists
+type GetUsersByIDsRequest struct {
+	UserIDs              []int64  `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUsersByIDsRequest) Reset()         { *m = GetUsersByIDsRequest{} }
+func (m *GetUsersByIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUsersByIDsRequest) ProtoMessage()    {}
+func (*GetUsersByIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{50}
+}
+
+func (m *GetUsersByIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsersByIDsRequest.Unmarshal(m, b)
+}
+func (m *GetUsersByIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsersByIDsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUsersByIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsersByIDsRequest.Merge(m, src)
+}
+func (m *GetUsersByIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUsersByIDsRequest.Size(m)
+}
+func (m *GetUsersByIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsersByIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsersByIDsRequest proto.InternalMessageInfo
+
+func (m *GetUsersByIDsRequest) GetUserIDs() []int64 {
+	if m != nil {
+		return m.UserIDs
+	}
+	return nil
+}
+
+// Users which couldn't be found are omitted
+type UsersResponse struct {
+	Users                []*UserResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UsersResponse) Reset()         { *m = UsersResponse{} }
+func (m *UsersResponse) String() string { return proto.CompactTextString(m) }
+func (*UsersResponse) ProtoMessage()    {}
+func (*UsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{51}
+}
+
+func (m *UsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsersResponse.Unmarshal(m, b)
+}
+func (m *UsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsersResponse.Marshal(b, m, deterministic)
+}
+func (m *UsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersResponse.Merge(m, src)
+}
+func (m *UsersResponse) XXX_Size() int {
+	return xxx_messageInfo_UsersResponse.Size(m)
+}
+func (m *UsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsersResponse proto.InternalMessageInfo
+
+func (m *UsersResponse) GetUsers() []*UserResponse {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type UserResponse struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Rank                 UserRank `protobuf:"varint,3,opt,name=rank,proto3,enum=proto.UserRank" json:"rank,omitempty"`
+	UserID               int64    `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	Banned               bool     `protobuf:"varint,5,opt,name=banned,proto3" json:"banned,omitempty"`
+	EmailVerified        bool     `protobuf:"varint,6,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	BannedUntil          int64    `protobuf:"varint,7,opt,name=bannedUntil,proto3" json:"bannedUntil,omitempty"`
+	BanReason            string   `protobuf:"bytes,8,opt,name=banReason,proto3" json:"banReason,omitempty"`
+	DisplayName          string   `protobuf:"bytes,9,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Bio                  string   `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links                []string `protobuf:"bytes,11,rep,name=links,proto3" json:"links,omitempty"`
+	AvatarURL            string   `protobuf:"bytes,12,opt,name=avatarURL,proto3" json:"avatarURL,omitempty"`
+	BannerURL            string   `protobuf:"bytes,13,opt,name=bannerURL,proto3" json:"bannerURL,omitempty"`
+	Foreign              bool     `protobuf:"varint,14,opt,name=foreign,proto3" json:"foreign,omitempty"`
+	MergedInto           int64    `protobuf:"varint,15,opt,name=mergedInto,proto3" json:"mergedInto,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserResponse) Reset()         { *m = UserResponse{} }
+func (m *UserResponse) String() string { return proto.CompactTextString(m) }
+func (*UserResponse) ProtoMessage()    {}
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a7ca558839fd2b, []int{52}
+}
+
+func (m *UserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserResponse.Unmarshal(m, b)
+}
+func (m *UserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserResponse.Marshal(b, m, deterministic)
+}
+func (m *UserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserResponse.Merge(m, src)
+}
+func (m *UserResponse) XXX_Size() int {
+	return xxx_messageInfo_UserResponse.Size(m)
+}
+func (m *UserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserResponse proto.InternalMessageInfo
+
+func (m *UserResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *UserResponse) GetRank() UserRank {
+	if m != nil {
+		return m.Rank
+	}
+	return UserRank_regular
+}
+
+func (m *UserResponse) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *UserResponse) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *UserResponse) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+func (m *UserResponse) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+func (m *UserResponse) GetBanReason() string {
+	if m != nil {
+		return m.BanReason
+	}
+	return ""
+}
+
+func (m *UserResponse) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UserResponse) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *UserResponse) GetLinks() []string {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *UserResponse) GetAvatarURL() string {
+	if m != nil {
+		return m.AvatarURL
+	}
+	return ""
+}
+
+func (m *UserResponse) GetBannerURL() string {
+	if m != nil {
+		return m.BannerURL
+	}
+	return ""
+}
+
+func (m *UserResponse) GetForeign() bool {
+	if m != nil {
+		return m.Foreign
+	}
+	return false
+}
+
+func (m *UserResponse) GetMergedInto() int64 {
+	if m != nil {
+		return m.MergedInto
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.ProfileImage", ProfileImage_name, ProfileImage_value)
+	proto.RegisterEnum("proto.ClaimMode", ClaimMode_name, ClaimMode_value)
+	proto.RegisterEnum("proto.Site", Site_name, Site_value)
+	proto.RegisterEnum("proto.UserRank", UserRank_name, UserRank_value)
+	proto.RegisterType((*FollowRequest)(nil), "proto.FollowRequest")
+	proto.RegisterType((*FollowCountsRequest)(nil), "proto.FollowCountsRequest")
+	proto.RegisterType((*FollowCounts)(nil), "proto.FollowCounts")
+	proto.RegisterType((*GetFollowingRequest)(nil), "proto.GetFollowingRequest")
+	proto.RegisterType((*FollowingList)(nil), "proto.FollowingList")
+	proto.RegisterType((*PermissionCheck)(nil), "proto.PermissionCheck")
+	proto.RegisterType((*PermissionCheckResult)(nil), "proto.PermissionCheckResult")
+	proto.RegisterType((*GetPermissionsRequest)(nil), "proto.GetPermissionsRequest")
+	proto.RegisterType((*PermissionList)(nil), "proto.PermissionList")
+	proto.RegisterType((*BanUserRequest)(nil), "proto.BanUserRequest")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "proto.UpdateProfileRequest")
+	proto.RegisterType((*ProfileImageUpload)(nil), "proto.ProfileImageUpload")
+	proto.RegisterType((*CreateAPITokenRequest)(nil), "proto.CreateAPITokenRequest")
+	proto.RegisterType((*APIToken)(nil), "proto.APIToken")
+	proto.RegisterType((*NewAPIToken)(nil), "proto.NewAPIToken")
+	proto.RegisterType((*ListAPITokensRequest)(nil), "proto.ListAPITokensRequest")
+	proto.RegisterType((*APITokenList)(nil), "proto.APITokenList")
+	proto.RegisterType((*RevokeAPITokenRequest)(nil), "proto.RevokeAPITokenRequest")
+	proto.RegisterType((*RevokeAPITokenResponse)(nil), "proto.RevokeAPITokenResponse")
+	proto.RegisterType((*AuthenticateAPITokenRequest)(nil), "proto.AuthenticateAPITokenRequest")
+	proto.RegisterType((*APITokenAuthentication)(nil), "proto.APITokenAuthentication")
+	proto.RegisterType((*StartClaimRequest)(nil), "proto.StartClaimRequest")
+	proto.RegisterType((*GetClaimRequest)(nil), "proto.GetClaimRequest")
+	proto.RegisterType((*CompleteClaimRequest)(nil), "proto.CompleteClaimRequest")
+	proto.RegisterType((*Claim)(nil), "proto.Claim")
+	proto.RegisterType((*UnbanUserRequest)(nil), "proto.UnbanUserRequest")
+	proto.RegisterType((*SetUserRankRequest)(nil), "proto.SetUserRankRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "proto.ListUsersRequest")
+	proto.RegisterType((*UserList)(nil), "proto.UserList")
+	proto.RegisterType((*GetForeignUserRequest)(nil), "proto.GetForeignUserRequest")
+	proto.RegisterType((*GetForeignUserResponse)(nil), "proto.GetForeignUserResponse")
+	proto.RegisterType((*ValidateJWTRequest)(nil), "proto.validateJWTRequest")
+	proto.RegisterType((*ValidateJWTResponse)(nil), "proto.validateJWTResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "proto.RefreshTokenRequest")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "proto.RevokeSessionsRequest")
+	proto.RegisterType((*RevokeSessionsResponse)(nil), "proto.RevokeSessionsResponse")
+	proto.RegisterType((*GetJWKSRequest)(nil), "proto.GetJWKSRequest")
+	proto.RegisterType((*JWKS)(nil), "proto.JWKS")
+	proto.RegisterType((*SendVerificationEmailRequest)(nil), "proto.SendVerificationEmailRequest")
+	proto.RegisterType((*SendVerificationEmailResponse)(nil), "proto.SendVerificationEmailResponse")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "proto.VerifyEmailRequest")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "proto.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "proto.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "proto.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "proto.ResetPasswordResponse")
+	proto.RegisterType((*RegisterRequest)(nil), "proto.RegisterRequest")
+	proto.RegisterType((*LoginRequest)(nil), "proto.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "proto.LoginResponse")
+	proto.RegisterType((*RegisterResponse)(nil), "proto.RegisterResponse")
+	proto.RegisterType((*GetUserFromIDRequest)(nil), "proto.GetUserFromIDRequest")
+	proto.RegisterType((*GetUsersByIDsRequest)(nil), "proto.GetUsersByIDsRequest")
+	proto.RegisterType((*UsersResponse)(nil), "proto.UsersResponse")
+	proto.RegisterType((*UserResponse)(nil), "proto.UserResponse")
+}
+
+func init() { proto.RegisterFile("userservice.proto", fileDescriptor_68a7ca558839fd2b) }
+
+var fileDescriptor_68a7ca558839fd2b = []byte{
+	// 2232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0xdb, 0x6e, 0x1b, 0xc7,
+	0x95, 0x57, 0x89, 0x3c, 0xbc, 0x88, 0x1e, 0x51, 0x0a, 0xbb, 0x96, 0x5d, 0x61, 0xec, 0x24, 0x8e,
+	0x80, 0xca, 0xae, 0xdc, 0xc6, 0x48, 0x2f, 0x08, 0x2c, 0xb1, 0x52, 0x98, 0xb8, 0xb6, 0xb1, 0x0a,
+	0xe5, 0x87, 0xa0, 0x70, 0x57, 0xe4, 0x88, 0x9e, 0x68, 0xb9, 0xcb, 0xec, 0x0e, 0xa5, 0xe8, 0x03,
+	0xfa, 0xd6, 0x97, 0xfe, 0x48, 0xbf, 0xa0, 0xe8, 0x73, 0x7f, 0xa1, 0x40, 0x3f, 0xa6, 0x98, 0xcb,
+	0xce, 0xce, 0xde, 0x28, 0xbb, 0x48, 0x1f, 0x04, 0xed, 0x9c, 0x33, 0x73, 0xce, 0x99, 0x73, 0x9f,
+	0x43, 0xb8, 0xb3, 0x0c, 0x49, 0x10, 0x92, 0xe0, 0x8a, 0x4e, 0xc8, 0xfe, 0x22, 0xf0, 0x99, 0x8f,
+	0xea, 0xe2, 0x1f, 0x7e, 0x05, 0x9d, 0x63, 0xdf, 0x75, 0xfd, 0x6b, 0x9b, 0xfc, 0xb0, 0x24, 0x21,
+	0x43, 0xf7, 0x01, 0x2e, 0x04, 0x80, 0x04, 0xa3, 0xe1, 0xa0, 0xbc, 0x5b, 0x7e, 0x54, 0xb5, 0x0d,
+	0x88, 0x81, 0x27, 0xa3, 0xe1, 0xa0, 0x92, 0xc0, 0x93, 0xd1, 0x10, 0x8f, 0x60, 0x53, 0x12, 0x3c,
+	0xf2, 0x97, 0x1e, 0x0b, 0x23, 0xb2, 0xdb, 0xb0, 0xb6, 0x0c, 0x0d, 0x92, 0x6a, 0x85, 0x2c, 0x68,
+	0x5c, 0x51, 0x22, 0x99, 0x49, 0x62, 0x7a, 0x8d, 0xaf, 0xa0, 0x6d, 0x92, 0x42, 0x3b, 0xd0, 0x8c,
+	0x04, 0x09, 0x15, 0x99, 0x18, 0x10, 0x63, 0xa9, 0x37, 0x53, 0xa4, 0x62, 0x00, 0xda, 0x83, 0x9e,
+	0xda, 0x3a, 0x3d, 0xbc, 0x39, 0x13, 0x1c, 0x06, 0xd5, 0xdd, 0xf2, 0xa3, 0x86, 0x9d, 0x81, 0xe3,
+	0x5f, 0xc0, 0xe6, 0x09, 0x61, 0xc7, 0xd1, 0xd9, 0x5b, 0xae, 0x80, 0x3f, 0x8b, 0x54, 0x48, 0xbd,
+	0xd9, 0x0b, 0x1a, 0x32, 0x34, 0x80, 0x75, 0x89, 0xe2, 0x52, 0x56, 0x1f, 0x55, 0xed, 0x68, 0x89,
+	0x47, 0xb0, 0xf1, 0x9a, 0x04, 0x73, 0x1a, 0x86, 0xd4, 0xf7, 0x8e, 0xde, 0x91, 0xc9, 0x65, 0xa1,
+	0x62, 0xee, 0x03, 0x2c, 0xf4, 0x56, 0x71, 0x9f, 0xa6, 0x6d, 0x40, 0xf0, 0x2f, 0x61, 0x2b, 0x45,
+	0xca, 0x26, 0xe1, 0xd2, 0x15, 0xdc, 0x1d, 0x79, 0x21, 0x41, 0xb1, 0x61, 0x47, 0x4b, 0xfc, 0x18,
+	0xb6, 0x4e, 0x08, 0x8b, 0x4f, 0xdd, 0x66, 0x1c, 0x7c, 0x00, 0xdd, 0x78, 0xb7, 0xb8, 0xda, 0x2e,
+	0xb4, 0x62, 0x19, 0xe4, 0xf5, 0x9a, 0xb6, 0x09, 0xc2, 0x7f, 0x2d, 0x43, 0xf7, 0xd0, 0xf1, 0xc6,
+	0x21, 0x09, 0x22, 0xf2, 0xbb, 0xd0, 0x9a, 0xfb, 0x53, 0x12, 0x38, 0xcc, 0x8f, 0x79, 0x98, 0x20,
+	0x43, 0x80, 0x4a, 0x42, 0x09, 0x8f, 0x60, 0x63, 0xba, 0x0c, 0x1c, 0x46, 0x7d, 0xef, 0x94, 0x4c,
+	0x7c, 0x6f, 0x1a, 0x0a, 0xa3, 0x55, 0xed, 0x34, 0x98, 0x53, 0x08, 0x88, 0x13, 0xfa, 0xde, 0xa0,
+	0x26, 0x54, 0xa5, 0x56, 0xf8, 0x47, 0xe8, 0x8f, 0x17, 0x53, 0x87, 0x91, 0xd7, 0x81, 0x7f, 0x41,
+	0x5d, 0x72, 0x9b, 0x3f, 0xee, 0x42, 0x6b, 0x4a, 0xc3, 0x85, 0xeb, 0xdc, 0xbc, 0x74, 0xe6, 0x44,
+	0xe9, 0xdd, 0x04, 0xa1, 0x1e, 0x54, 0xcf, 0xa9, 0x2f, 0xe4, 0x68, 0xda, 0xfc, 0x13, 0xf5, 0xa1,
+	0xee, 0x52, 0xef, 0x32, 0x1c, 0xd4, 0x84, 0x3a, 0xe4, 0x02, 0xbb, 0x80, 0x14, 0xcf, 0xd1, 0xdc,
+	0x99, 0x91, 0xf1, 0xc2, 0xf5, 0x9d, 0x69, 0x21, 0xdf, 0x3d, 0xa8, 0x53, 0xbe, 0x4d, 0x70, 0xec,
+	0x1e, 0xf4, 0x65, 0x94, 0xee, 0x2f, 0x24, 0x85, 0xb7, 0x02, 0x67, 0xcb, 0x2d, 0x08, 0x41, 0x6d,
+	0xea, 0x30, 0x47, 0x88, 0xd0, 0xb6, 0xc5, 0x37, 0xfe, 0x4b, 0x19, 0xb6, 0x8e, 0x02, 0xe2, 0x30,
+	0xf2, 0xfc, 0xf5, 0xe8, 0x5b, 0xff, 0x92, 0x78, 0xb7, 0xdd, 0x14, 0x41, 0xcd, 0x8b, 0xaf, 0x28,
+	0xbe, 0xf9, 0xde, 0x70, 0xe2, 0x2f, 0x08, 0x57, 0x33, 0xbf, 0x8a, 0x5a, 0x71, 0x3b, 0xb8, 0xf4,
+	0x82, 0x30, 0x3a, 0x27, 0x91, 0x1d, 0x6a, 0xd2, 0x0e, 0x29, 0x30, 0xfe, 0x7b, 0x19, 0x1a, 0x91,
+	0x04, 0xdc, 0x15, 0x19, 0xff, 0xd0, 0xbc, 0xa3, 0xe5, 0x07, 0x31, 0xc7, 0xd0, 0x9e, 0xf0, 0x9b,
+	0x51, 0xdf, 0x1b, 0x3a, 0x8c, 0x28, 0xce, 0x09, 0x18, 0x0f, 0x7e, 0xf2, 0xe3, 0x82, 0x06, 0x24,
+	0x7c, 0xce, 0x06, 0x75, 0x19, 0xfc, 0x1a, 0xc0, 0x93, 0x8c, 0xeb, 0x84, 0x6c, 0x1c, 0x92, 0xe9,
+	0x60, 0x4d, 0x26, 0x99, 0x68, 0x8d, 0xbf, 0x82, 0xd6, 0x4b, 0x72, 0xad, 0x45, 0xee, 0x43, 0x5d,
+	0xc8, 0x28, 0x04, 0x6e, 0xda, 0x72, 0x81, 0x1e, 0x40, 0x8d, 0x7a, 0x17, 0xbe, 0x10, 0xb7, 0x75,
+	0xb0, 0xa1, 0x8c, 0xa3, 0x35, 0x2d, 0x90, 0x78, 0x1f, 0xfa, 0x3c, 0x46, 0x22, 0xe8, 0xad, 0xd1,
+	0xf5, 0x0c, 0xda, 0xd1, 0x5e, 0x11, 0x5b, 0x9f, 0xc2, 0x9a, 0xe0, 0x26, 0xc3, 0x2a, 0x87, 0x8d,
+	0x42, 0xe3, 0x11, 0x6c, 0xd9, 0xe4, 0xca, 0xbf, 0x7c, 0x6f, 0x53, 0x1b, 0x76, 0xa8, 0x24, 0xec,
+	0x80, 0x07, 0xb0, 0x9d, 0x26, 0x15, 0x2e, 0x7c, 0x2f, 0x24, 0xf8, 0x29, 0xdc, 0x7d, 0xbe, 0x64,
+	0xef, 0x88, 0xc7, 0xe8, 0x24, 0xc7, 0xab, 0x72, 0xf5, 0x84, 0x19, 0x6c, 0x47, 0x1b, 0x8d, 0xc3,
+	0xd4, 0xf7, 0x3e, 0x5c, 0xb4, 0x42, 0x77, 0xe8, 0x41, 0xf5, 0xfb, 0x6b, 0xa6, 0xc2, 0x9c, 0x7f,
+	0xe2, 0xb7, 0x70, 0xe7, 0x94, 0x39, 0x01, 0x3b, 0x72, 0x1d, 0x3a, 0x37, 0xea, 0xd8, 0x84, 0xaf,
+	0x1d, 0x8f, 0xc5, 0x75, 0x2c, 0x86, 0xc8, 0x82, 0x10, 0x10, 0x3a, 0xf3, 0x9e, 0x4f, 0x26, 0xbc,
+	0xbe, 0x68, 0x09, 0x32, 0x70, 0xfc, 0x0d, 0x6c, 0x9c, 0x90, 0x24, 0xf9, 0x01, 0xac, 0x0b, 0x62,
+	0xb1, 0x6b, 0xab, 0x65, 0x8a, 0x71, 0x25, 0xcd, 0x18, 0xff, 0xbb, 0x0c, 0xfd, 0x23, 0x7f, 0xbe,
+	0x70, 0x09, 0x23, 0x3f, 0x0d, 0x49, 0xf4, 0x31, 0xd4, 0x78, 0x36, 0x15, 0x09, 0xa1, 0x7b, 0x70,
+	0x47, 0xf9, 0x8d, 0xd8, 0xf0, 0x96, 0x23, 0x6c, 0x81, 0x46, 0xfb, 0x80, 0x26, 0xef, 0x1c, 0xcf,
+	0x23, 0xee, 0x90, 0x84, 0x93, 0x80, 0x2e, 0xb8, 0x65, 0x94, 0x22, 0x73, 0x30, 0xdc, 0xc6, 0x64,
+	0xee, 0x50, 0x57, 0x04, 0x54, 0xd3, 0x96, 0x0b, 0x1e, 0x4c, 0x0b, 0x27, 0x0c, 0xaf, 0xfd, 0x40,
+	0x06, 0x53, 0xd3, 0xd6, 0x6b, 0xfc, 0xb7, 0x0a, 0xd4, 0xc5, 0x9d, 0x56, 0x5c, 0xe6, 0x03, 0x14,
+	0x8f, 0x3e, 0x86, 0xf5, 0x6b, 0x72, 0x1e, 0x52, 0x16, 0xdd, 0xad, 0xa5, 0xee, 0xc6, 0x41, 0x76,
+	0x84, 0x43, 0x0f, 0xa1, 0xa3, 0x8e, 0x8e, 0xa5, 0x8f, 0xc9, 0x3b, 0x25, 0x81, 0x3c, 0xe7, 0x4c,
+	0xb8, 0x96, 0xe4, 0x6d, 0xc4, 0x77, 0x32, 0x6f, 0xac, 0xa5, 0xf3, 0xc6, 0x0e, 0x34, 0x27, 0xca,
+	0x52, 0xd3, 0xc1, 0xba, 0x28, 0xa6, 0x31, 0x40, 0x6b, 0xbd, 0xb1, 0x52, 0xeb, 0x78, 0x0a, 0xbd,
+	0xb1, 0x77, 0xfe, 0x53, 0x55, 0xc4, 0xb8, 0xce, 0x55, 0x13, 0x75, 0xce, 0x05, 0x74, 0x4a, 0x98,
+	0xe0, 0xe1, 0x78, 0x97, 0x86, 0x4b, 0x39, 0x13, 0x93, 0x47, 0xb4, 0x2c, 0xa4, 0xff, 0x10, 0x6a,
+	0x81, 0xe3, 0x5d, 0x2a, 0x75, 0xf7, 0xd4, 0xa5, 0x38, 0xf2, 0x2d, 0x87, 0xdb, 0x02, 0x8b, 0xff,
+	0x55, 0x86, 0x1e, 0xcf, 0x59, 0x9c, 0x5f, 0x78, 0x3b, 0xb3, 0x3e, 0xd4, 0x7f, 0x58, 0x92, 0xe0,
+	0x46, 0xa5, 0x7b, 0xb9, 0x40, 0x9f, 0x40, 0x9d, 0x13, 0x93, 0xf1, 0x9d, 0xc7, 0x4b, 0xa2, 0xb9,
+	0xf7, 0x9f, 0x73, 0xdf, 0x9c, 0xbe, 0xf2, 0xdc, 0x1b, 0x61, 0xda, 0x86, 0x6d, 0x40, 0xb8, 0x95,
+	0x5c, 0x7f, 0xe2, 0xb8, 0x02, 0x5d, 0x97, 0x56, 0xd2, 0x00, 0x7e, 0x7a, 0xe1, 0xcc, 0xc8, 0xcb,
+	0xe5, 0xfc, 0x9c, 0x04, 0xca, 0xc4, 0x06, 0x04, 0x7f, 0x07, 0x0d, 0x7e, 0x0b, 0x91, 0x81, 0x3f,
+	0x83, 0x3a, 0xe7, 0x1e, 0x25, 0xe0, 0x4d, 0x25, 0x91, 0xb4, 0x9c, 0xcc, 0x8b, 0xb6, 0xdc, 0xc1,
+	0x5d, 0xce, 0x13, 0x04, 0x5e, 0x5d, 0x8c, 0xc5, 0x11, 0xa9, 0xc6, 0x24, 0x10, 0x33, 0xd1, 0x71,
+	0x1d, 0xc7, 0x6e, 0x18, 0xe9, 0xea, 0xd7, 0xb0, 0xe1, 0x07, 0x74, 0x46, 0x3d, 0xc7, 0x7d, 0xa3,
+	0x1c, 0xbc, 0x9c, 0x75, 0xf0, 0xf4, 0x9e, 0xac, 0xa3, 0x57, 0x72, 0x1c, 0x1d, 0x3f, 0x81, 0xed,
+	0x34, 0x57, 0x29, 0x3c, 0xb7, 0xba, 0x47, 0xae, 0xc7, 0x71, 0x16, 0x96, 0x2b, 0xfc, 0x09, 0xa0,
+	0x2b, 0xc7, 0xa5, 0xbc, 0x4f, 0xfa, 0xfa, 0xcd, 0xb7, 0x91, 0x90, 0x2a, 0xd3, 0x96, 0xcd, 0x4c,
+	0xbb, 0x99, 0xd8, 0xa7, 0xc8, 0x0e, 0x60, 0x9d, 0x86, 0x67, 0x1c, 0x11, 0xb5, 0x9c, 0x6a, 0xc9,
+	0x49, 0x2c, 0xe9, 0x54, 0x29, 0x87, 0x7f, 0x72, 0x6b, 0x85, 0x44, 0xf4, 0x8a, 0xa3, 0xa1, 0x6a,
+	0xe6, 0x62, 0x00, 0xfe, 0x02, 0x36, 0x6d, 0x72, 0x11, 0x90, 0xf0, 0x5d, 0xa2, 0xda, 0x60, 0x68,
+	0x07, 0x06, 0x58, 0x89, 0x94, 0x80, 0xe1, 0x3f, 0x46, 0x55, 0xf1, 0x94, 0xbc, 0x57, 0x77, 0x9b,
+	0x94, 0xa4, 0x92, 0x96, 0x44, 0x57, 0xc6, 0x98, 0x9c, 0xaa, 0x8c, 0x3d, 0xe8, 0x9e, 0x10, 0xf6,
+	0xf5, 0x9b, 0x6f, 0x4e, 0x15, 0x07, 0x6c, 0x41, 0x8d, 0x2f, 0x79, 0x86, 0xf9, 0xfe, 0xfa, 0x32,
+	0x54, 0xe2, 0x89, 0x6f, 0xfc, 0x39, 0xec, 0x9c, 0x12, 0x6f, 0x7a, 0x46, 0x02, 0x7a, 0xa1, 0x8a,
+	0xe1, 0x1f, 0x78, 0x1e, 0xbd, 0xad, 0x3b, 0xf8, 0x39, 0xdc, 0x2b, 0x38, 0xa7, 0xc4, 0xd8, 0x03,
+	0x24, 0x90, 0x37, 0x09, 0x72, 0xf9, 0x75, 0xf9, 0x08, 0xee, 0xaa, 0x0d, 0xaf, 0x55, 0xaa, 0xb6,
+	0x49, 0x48, 0x98, 0x71, 0x48, 0x26, 0xfa, 0xb2, 0x99, 0xe8, 0xbb, 0x50, 0xa1, 0x0b, 0xe5, 0x61,
+	0x15, 0xba, 0xc0, 0xf7, 0x61, 0x27, 0x9f, 0x88, 0x12, 0xe8, 0x25, 0xf4, 0x05, 0x20, 0xc6, 0xae,
+	0x10, 0x89, 0xa7, 0x40, 0x8f, 0x5c, 0x47, 0x7b, 0xa3, 0x46, 0xdb, 0x00, 0xe1, 0x8f, 0x60, 0x2b,
+	0x45, 0x4f, 0x31, 0xfa, 0x4f, 0x19, 0x36, 0x6c, 0x32, 0xa3, 0x21, 0x23, 0xc1, 0xea, 0x2b, 0x58,
+	0xd0, 0xe0, 0xea, 0x34, 0x5a, 0x4d, 0xbd, 0x4e, 0xd4, 0xb1, 0x6a, 0xb2, 0x8e, 0x71, 0xe1, 0x8c,
+	0x90, 0x52, 0x39, 0xc7, 0x04, 0x65, 0x23, 0xb1, 0x9e, 0x57, 0x72, 0x9e, 0x42, 0x57, 0x01, 0xa2,
+	0x28, 0x5f, 0xcb, 0x46, 0x79, 0x6a, 0x0b, 0x3e, 0x83, 0xf6, 0x0b, 0x7f, 0x46, 0xb5, 0xf3, 0x9b,
+	0x97, 0x28, 0xaf, 0xb8, 0x44, 0x25, 0x75, 0x09, 0x69, 0xbf, 0xaa, 0xb6, 0xdf, 0x9f, 0xa0, 0xa3,
+	0xe8, 0xaa, 0xb0, 0xcd, 0xc4, 0x77, 0x26, 0xce, 0x2a, 0xd9, 0x38, 0xe3, 0x0e, 0x2b, 0x2a, 0xe4,
+	0x8d, 0x8a, 0x5e, 0xb5, 0xc2, 0x7f, 0x86, 0x5e, 0x6c, 0x94, 0xff, 0x0b, 0x87, 0x7d, 0xe8, 0x9f,
+	0xc8, 0x1a, 0x77, 0x1c, 0xf8, 0xf3, 0xd1, 0xf0, 0xb6, 0x10, 0x7a, 0xa2, 0xf7, 0x87, 0x87, 0x37,
+	0xa3, 0xa1, 0x59, 0xa8, 0x0a, 0xde, 0xe7, 0xbf, 0x81, 0x8e, 0x2a, 0x69, 0xea, 0x02, 0xef, 0x5f,
+	0x11, 0xf0, 0x3f, 0xaa, 0xd0, 0x36, 0xe1, 0x2b, 0xed, 0xa6, 0xdd, 0xb5, 0x62, 0xba, 0xeb, 0x7b,
+	0x15, 0x5f, 0xe3, 0xba, 0xb5, 0x74, 0x6b, 0x20, 0xab, 0xa2, 0x2a, 0x82, 0x6a, 0xc5, 0x5d, 0x55,
+	0x90, 0x97, 0xa9, 0x44, 0x3d, 0x81, 0x1a, 0x76, 0x12, 0xc8, 0x5d, 0x5e, 0xee, 0x1f, 0x7b, 0x8c,
+	0xba, 0xa2, 0xdb, 0xa9, 0xda, 0x26, 0x88, 0xe7, 0xcb, 0x73, 0xc7, 0xb3, 0x65, 0xf7, 0xd1, 0x10,
+	0x72, 0xc7, 0x80, 0xf4, 0xc3, 0xb9, 0x59, 0xf8, 0x70, 0x86, 0x9c, 0x87, 0x73, 0xcb, 0x78, 0x38,
+	0x73, 0x3e, 0xce, 0x95, 0xc3, 0x9c, 0x60, 0x6c, 0xbf, 0x18, 0xb4, 0x25, 0x1f, 0x0d, 0x50, 0x52,
+	0x78, 0x44, 0x60, 0x3b, 0x5a, 0x0a, 0x09, 0xe0, 0xa6, 0x55, 0xd1, 0x34, 0xe8, 0xca, 0x4a, 0xa4,
+	0x96, 0xbc, 0x0f, 0x98, 0x93, 0x60, 0x46, 0xa6, 0x23, 0x8f, 0xf9, 0x83, 0x0d, 0xd9, 0x07, 0xc4,
+	0x90, 0xbd, 0x4f, 0xa1, 0x93, 0x78, 0x6c, 0x23, 0x80, 0x35, 0xc9, 0xb5, 0x57, 0xe2, 0xdf, 0x92,
+	0x47, 0xaf, 0xbc, 0xf7, 0x10, 0x20, 0xee, 0xf1, 0x50, 0x0b, 0xd6, 0x27, 0xbe, 0x77, 0x45, 0x02,
+	0xd6, 0x2b, 0xa1, 0x26, 0xd4, 0x05, 0xc5, 0x5e, 0x79, 0xef, 0x31, 0xd4, 0x44, 0xc5, 0x6e, 0x43,
+	0xc3, 0xa3, 0x13, 0x9f, 0xff, 0xf5, 0x4a, 0x7c, 0x75, 0x4e, 0x5d, 0xca, 0xff, 0x7a, 0x65, 0x7e,
+	0xf6, 0xc6, 0x5f, 0xb2, 0xe5, 0x39, 0xe9, 0x55, 0xf6, 0x9e, 0x40, 0x53, 0x1b, 0x9a, 0x63, 0x02,
+	0x32, 0x5b, 0xba, 0x82, 0x79, 0x0b, 0xd6, 0x59, 0xb0, 0x0c, 0x19, 0x99, 0xf6, 0xca, 0x9c, 0x85,
+	0x33, 0x9d, 0x53, 0xaf, 0x57, 0x39, 0xf8, 0xe7, 0x1d, 0x68, 0x71, 0x87, 0x3b, 0x95, 0x73, 0x3d,
+	0xf4, 0x7b, 0x68, 0x44, 0x01, 0x88, 0xb6, 0x95, 0xef, 0xa4, 0xd2, 0xa4, 0xf5, 0x51, 0x06, 0xae,
+	0x72, 0x6a, 0x09, 0xfd, 0x0a, 0xea, 0x22, 0x3d, 0xa0, 0xc8, 0xc9, 0xcd, 0x24, 0x64, 0xf5, 0x93,
+	0x40, 0x7d, 0xea, 0x18, 0x5a, 0x67, 0x71, 0x47, 0x80, 0x7e, 0xa6, 0xb6, 0x65, 0xbb, 0x09, 0xcb,
+	0xca, 0x43, 0x69, 0x3a, 0x87, 0xd0, 0x36, 0x0b, 0x3f, 0xb2, 0xb4, 0xa0, 0x99, 0x6e, 0xa0, 0x50,
+	0x96, 0x57, 0xd0, 0x4d, 0x96, 0x6c, 0xb4, 0xa3, 0xa9, 0xe4, 0x34, 0x06, 0xd6, 0xbd, 0x02, 0xac,
+	0x26, 0xf8, 0x18, 0xd6, 0x55, 0xa5, 0x47, 0x5b, 0x6a, 0x6f, 0xb2, 0xf2, 0x5b, 0x51, 0x22, 0xe7,
+	0x30, 0x5c, 0x42, 0x53, 0xd8, 0xca, 0x2d, 0xda, 0xe8, 0x81, 0xda, 0xb7, 0xaa, 0x15, 0xb0, 0x1e,
+	0xae, 0xde, 0xa4, 0xc5, 0xfa, 0x12, 0x5a, 0x46, 0xe5, 0xd7, 0x3a, 0xcf, 0x76, 0x03, 0x56, 0x5e,
+	0xbe, 0xc2, 0x25, 0xe4, 0x40, 0x5f, 0xed, 0x48, 0x54, 0x72, 0x84, 0xb5, 0x42, 0x0a, 0x7b, 0x05,
+	0xeb, 0xc1, 0xca, 0x3d, 0x9a, 0xc5, 0x0b, 0xe8, 0x24, 0x8a, 0x37, 0xba, 0xab, 0xcf, 0x65, 0x5b,
+	0x04, 0x6b, 0x27, 0x1f, 0xa9, 0xa9, 0x1d, 0x41, 0x27, 0x91, 0xf9, 0x35, 0xb5, 0xbc, 0x7a, 0x50,
+	0x74, 0xeb, 0x21, 0x74, 0x12, 0xe5, 0x20, 0x4d, 0x24, 0x51, 0x24, 0xb4, 0x93, 0x25, 0xea, 0x01,
+	0x2e, 0xa1, 0x71, 0x5c, 0x84, 0xfc, 0x20, 0xea, 0xb1, 0x79, 0x37, 0x19, 0x13, 0xcb, 0xf6, 0xfb,
+	0xd6, 0xbd, 0x02, 0xac, 0x26, 0xfb, 0x0c, 0xd6, 0xd5, 0xd4, 0x54, 0xbb, 0x5a, 0x72, 0x8a, 0x5a,
+	0x74, 0xab, 0xdf, 0x42, 0x53, 0x3f, 0x2f, 0x51, 0x14, 0xde, 0xe9, 0x07, 0x67, 0xd1, 0xe1, 0x2f,
+	0xa1, 0x65, 0xbc, 0x1a, 0xb5, 0x27, 0x65, 0x5f, 0x92, 0x45, 0x04, 0x9e, 0x41, 0x53, 0xbf, 0x03,
+	0x35, 0xf7, 0xf4, 0xcb, 0xd0, 0xda, 0x30, 0x0e, 0x73, 0x24, 0x2e, 0xa1, 0x13, 0xe8, 0x7c, 0xe5,
+	0x84, 0xf1, 0x74, 0x59, 0x67, 0xac, 0xd4, 0x50, 0xdb, 0xda, 0xc9, 0x87, 0xcb, 0x61, 0xb7, 0x20,
+	0xd4, 0x4d, 0x0e, 0xb5, 0x4d, 0x4b, 0x64, 0x67, 0xdd, 0xd6, 0x56, 0x86, 0x9e, 0x92, 0xe8, 0x08,
+	0x3a, 0x89, 0x49, 0xb1, 0x76, 0x8f, 0xbc, 0xf9, 0x71, 0x91, 0x3e, 0x8e, 0x01, 0xc9, 0x41, 0xaf,
+	0x39, 0xfa, 0xd5, 0x7a, 0xcd, 0xce, 0x83, 0x8b, 0xe8, 0x7c, 0x0e, 0x10, 0x8f, 0xb4, 0xd0, 0x20,
+	0xb2, 0x4b, 0x7a, 0xca, 0x65, 0xb5, 0x15, 0x46, 0x00, 0x71, 0x09, 0x1d, 0x40, 0x23, 0x9a, 0x54,
+	0x69, 0x8d, 0x9e, 0x90, 0xd5, 0x67, 0x7e, 0x07, 0x9d, 0xc4, 0x3c, 0x4a, 0x5f, 0x3c, 0x6f, 0x4a,
+	0x95, 0x39, 0x3d, 0x84, 0x6e, 0x72, 0xee, 0xac, 0xf5, 0x9f, 0x3b, 0x8e, 0xb6, 0x90, 0xc2, 0x1a,
+	0x43, 0x57, 0xa9, 0xfc, 0xc4, 0xec, 0x54, 0xcb, 0x90, 0x37, 0x51, 0xd5, 0x4a, 0x33, 0xc7, 0xa7,
+	0x66, 0xfe, 0xcf, 0x88, 0x92, 0x3b, 0x2e, 0xb5, 0xee, 0x15, 0x60, 0xb5, 0x15, 0xbe, 0x83, 0x7e,
+	0xde, 0x0c, 0x54, 0xe7, 0xc9, 0x15, 0x03, 0x52, 0x4d, 0x3c, 0x7f, 0x1e, 0x8a, 0x4b, 0xe8, 0x0b,
+	0x00, 0xf9, 0xb3, 0x91, 0x88, 0xdc, 0x28, 0xdd, 0x24, 0x7e, 0x8c, 0xb3, 0x36, 0x13, 0x50, 0xf9,
+	0x33, 0x98, 0x88, 0xf9, 0xf6, 0xd8, 0xbb, 0xf8, 0x1f, 0x0f, 0x0f, 0xc5, 0x30, 0xd3, 0x04, 0xea,
+	0x62, 0x9b, 0xf3, 0xc3, 0x5d, 0x11, 0x95, 0x43, 0x68, 0x9b, 0xbf, 0x91, 0x69, 0x12, 0x39, 0x3f,
+	0x9c, 0x59, 0x49, 0xf1, 0xd4, 0xaf, 0x64, 0xb8, 0x74, 0xbe, 0x26, 0xc0, 0x4f, 0xff, 0x3b, 0x00,
+	0xc1, 0x00, 0xd2, 0x3e, 0x9e, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserServiceClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// Fails with RESOURCE_EXHAUSTED after too many failed attempts for the account or from the IP address
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Access tokens are short lived. Validation fails once the token's session or all of the user's sessions have
+	// been revoked.
+	ValidateJWT(ctx context.Context, in *ValidateJWTRequest, opts ...grpc.CallOption) (*ValidateJWTResponse, error)
+	// Refresh tokens are single use: each refresh returns a new one. Reusing a refresh token revokes its session.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	// The public keys which access tokens may be signed with, for verifying them without calling ValidateJWT
+	GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*JWKS, error)
+	// Emails the user a link to verify their address. Registering sends one too.
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Emails a reset link for every account registered with the address. Succeeds even if there are none, so that it
+	// can't be used to find out which addresses are registered.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	// Reset links are single use. Resetting the password revokes all of the user's sessions.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	GetUserFromID(ctx context.Context, in *GetUserFromIDRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	GetUserForForeignUID(ctx context.Context, in *GetForeignUserRequest, opts ...grpc.CallOption) (*GetForeignUserResponse, error)
+	// The moderator must have the user.ban permission, and must outrank the user being banned. Banned users are logged
+	// out, and can't log in or use their tokens until the ban is lifted or runs out.
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// The actor must have the user.manage permission, must outrank the user, and can't grant a rank above their own
+	SetUserRank(ctx context.Context, in *SetUserRankRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Requires the user.manage permission
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*UserList, error)
+	// Permissions are named, e.g. video.approve, and granted by rank. Banned and logged out users have none. See the
+	// permissions package.
+	HasPermission(ctx context.Context, in *PermissionCheck, opts ...grpc.CallOption) (*PermissionCheckResult, error)
+	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*PermissionList, error)
+	// Replaces the user's display name, bio and links
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Crops and scales the image, and stores it as the user's avatar or banner. An empty image removes it.
+	UploadProfileImage(ctx context.Context, in *ProfileImageUpload, opts ...grpc.CallOption) (*UserResponse, error)
+	// Claiming lets the owner of a foreign account, which was created for archived videos, take it over. The claimant
+	// puts the claim's code in the account's channel description, and the caller checks the description before
+	// completing the claim. Only the claimant can see or complete their claims.
+	StartClaim(ctx context.Context, in *StartClaimRequest, opts ...grpc.CallOption) (*Claim, error)
+	GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*Claim, error)
+	// Converts the foreign account into a normal account, or merges it into the claimant's. Moving the videos of a
+	// merged account is up to the caller.
+	CompleteClaim(ctx context.Context, in *CompleteClaimRequest, opts ...grpc.CallOption) (*Claim, error)
+	// API tokens let scripts act as the user, limited to the token's scopes (see permissions.Scopes). The token itself
+	// is only returned on creation.
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*NewAPIToken, error)
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*APITokenList, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error)
+	// Returns the token's user and scopes, and records that it was used
+	AuthenticateAPIToken(ctx context.Context, in *AuthenticateAPITokenRequest, opts ...grpc.CallOption) (*APITokenAuthentication, error)
+	// Following returns the followee's counts
+	FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowCounts, error)
+	UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowCounts, error)
+	GetFollowCounts(ctx context.Context, in *FollowCountsRequest, opts ...grpc.CallOption) (*FollowCounts, error)
+	// Lists the IDs of the users that the user follows
+	GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*FollowingList, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ValidateJWT(ctx context.Context, in *ValidateJWTRequest, opts ...grpc.CallOption) (*ValidateJWTResponse, error) {
+	out := new(ValidateJWTResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ValidateJWT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*JWKS, error) {
+	out := new(JWKS)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetJWKS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error) {
+	out := new(SendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/SendVerificationEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserFromID(ctx context.Context, in *GetUserFromIDRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetUserFromID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetUsersByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserForForeignUID(ctx context.Context, in *GetForeignUserRequest, opts ...grpc.CallOption) (*GetForeignUserResponse, error) {
+	out := new(GetForeignUserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetUserForForeignUID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserRank(ctx context.Context, in *SetUserRankRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/SetUserRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*UserList, error) {
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) HasPermission(ctx context.Context, in *PermissionCheck, opts ...grpc.CallOption) (*PermissionCheckResult, error) {
+	out := new(PermissionCheckResult)
+	err := c.cc.Invoke(ctx, "/proto.UserService/HasPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*PermissionList, error) {
+	out := new(PermissionList)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadProfileImage(ctx context.Context, in *ProfileImageUpload, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/UploadProfileImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartClaim(ctx context.Context, in *StartClaimRequest, opts ...grpc.CallOption) (*Claim, error) {
+	out := new(Claim)
+	err := c.cc.Invoke(ctx, "/proto.UserService/StartClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*Claim, error) {
+	out := new(Claim)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteClaim(ctx context.Context, in *CompleteClaimRequest, opts ...grpc.CallOption) (*Claim, error) {
+	out := new(Claim)
+	err := c.cc.Invoke(ctx, "/proto.UserService/CompleteClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*NewAPIToken, error) {
+	out := new(NewAPIToken)
+	err := c.cc.Invoke(ctx, "/proto.UserService/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*APITokenList, error) {
+	out := new(APITokenList)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ListAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error) {
+	out := new(RevokeAPITokenResponse)
+	err := c.cc.Invoke(ctx, "/proto.UserService/RevokeAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AuthenticateAPIToken(ctx context.Context, in *AuthenticateAPITokenRequest, opts ...grpc.CallOption) (*APITokenAuthentication, error) {
+	out := new(APITokenAuthentication)
+	err := c.cc.Invoke(ctx, "/proto.UserService/AuthenticateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowCounts, error) {
+	out := new(FollowCounts)
+	err := c.cc.Invoke(ctx, "/proto.UserService/FollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowCounts, error) {
+	out := new(FollowCounts)
+	err := c.cc.Invoke(ctx, "/proto.UserService/UnfollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowCounts(ctx context.Context, in *FollowCountsRequest, opts ...grpc.CallOption) (*FollowCounts, error) {
+	out := new(FollowCounts)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetFollowCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*FollowingList, error) {
+	out := new(FollowingList)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+type UserServiceServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Fails with RESOURCE_EXHAUSTED after too many failed attempts for the account or from the IP address
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Access tokens are short lived. Validation fails once the token's session or all of the user's sessions have
+	// been revoked.
+	ValidateJWT(context.Context, *ValidateJWTRequest) (*ValidateJWTResponse, error)
+	// Refresh tokens are single use: each refresh returns a new one. Reusing a refresh token revokes its session.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	// The public keys which access tokens may be signed with, for verifying them without calling ValidateJWT
+	GetJWKS(context.Context, *GetJWKSRequest) (*JWKS, error)
+	// Emails the user a link to verify their address. Registering sends one too.
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*UserResponse, error)
+	// Emails a reset link for every account registered with the address. Succeeds even if there are none, so that it
+	// can't be used to find out which addresses are registered.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	// Reset links are single use. Resetting the password revokes all of the user's sessions.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	GetUserFromID(context.Context, *GetUserFromIDRequest) (*UserResponse, error)
+	GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*UsersResponse, error)
+	GetUserForForeignUID(context.Context, *GetForeignUserRequest) (*GetForeignUserResponse, error)
+	// The moderator must have the user.ban permission, and must outrank the user being banned. Banned users are logged
+	// out, and can't log in or use their tokens until the ban is lifted or runs out.
+	BanUser(context.Context, *BanUserRequest) (*UserResponse, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*UserResponse, error)
+	// The actor must have the user.manage permission, must outrank the user, and can't grant a rank above their own
+	SetUserRank(context.Context, *SetUserRankRequest) (*UserResponse, error)
+	// Requires the user.manage permission
+	ListUsers(context.Context, *ListUsersRequest) (*UserList, error)
+	// Permissions are named, e.g. video.approve, and granted by rank. Banned and logged out users have none. See the
+	// permissions package.
+	HasPermission(context.Context, *PermissionCheck) (*PermissionCheckResult, error)
+	GetPermissions(context.Context, *GetPermissionsRequest) (*PermissionList, error)
+	// Replaces the user's display name, bio and links
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UserResponse, error)
+	// Crops and scales the image, and stores it as the user's avatar or banner. An empty image removes it.
+	UploadProfileImage(context.Context, *ProfileImageUpload) (*UserResponse, error)
+	// Claiming lets the owner of a foreign account, which was created for archived videos, take it over. The claimant
+	// puts the claim's code in the account's channel description, and the caller checks the description before
+	// completing the claim. Only the claimant can see or complete their claims.
+	StartClaim(context.Context, *StartClaimRequest) (*Claim, error)
+	GetClaim(context.Context, *GetClaimRequest) (*Claim, error)
+	// Converts the foreign account into a normal account, or merges it into the claimant's. Moving the videos of a
+	// merged account is up to the caller.
+	CompleteClaim(context.Context, *CompleteClaimRequest) (*Claim, error)
+	// API tokens let scripts act as the user, limited to the token's scopes (see permissions.Scopes). The token itself
+	// is only returned on creation.
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*NewAPIToken, error)
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*APITokenList, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error)
+	// Returns the token's user and scopes, and records that it was used
+	AuthenticateAPIToken(context.Context, *AuthenticateAPITokenRequest) (*APITokenAuthentication, error)
+	// Following returns the followee's counts
+	FollowUser(context.Context, *FollowRequest) (*FollowCounts, error)
+	UnfollowUser(context.Context, *FollowRequest) (*FollowCounts, error)
+	GetFollowCounts(context.Context, *FollowCountsRequest) (*FollowCounts, error)
+	// Lists the IDs of the users that the user follows
+	GetFollowing(context.Context, *GetFollowingRequest) (*FollowingList, error)
+}
+
+// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (*UnimplementedUserServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedUserServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedUserServiceServer) ValidateJWT(ctx context.Context, req *ValidateJWTRequest) (*ValidateJWTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateJWT not implemented")
+}
+func (*UnimplementedUserServiceServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedUserServiceServer) RevokeSessions(ctx context.Context, req *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (*UnimplementedUserServiceServer) GetJWKS(ctx context.Context, req *GetJWKSRequest) (*JWKS, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (*UnimplementedUserServiceServer) SendVerificationEmail(ctx context.Context, req *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (*UnimplementedUserServiceServer) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (*UnimplementedUserServiceServer) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedUserServiceServer) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUserFromID(ctx context.Context, req *GetUserFromIDRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFromID not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUsersByIDs(ctx context.Context, req *GetUsersByIDsRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUserForForeignUID(ctx context.Context, req *GetForeignUserRequest) (*GetForeignUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserForForeignUID not implemented")
+}
+func (*UnimplementedUserServiceServer) BanUser(ctx context.Context, req *BanUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (*UnimplementedUserServiceServer) UnbanUser(ctx context.Context, req *UnbanUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (*UnimplementedUserServiceServer) SetUserRank(ctx context.Context, req *SetUserRankRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRank not implemented")
+}
+func (*UnimplementedUserServiceServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) HasPermission(ctx context.Context, req *PermissionCheck) (*PermissionCheckResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPermission not implemented")
+}
+func (*UnimplementedUserServiceServer) GetPermissions(ctx context.Context, req *GetPermissionsRequest) (*PermissionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (*UnimplementedUserServiceServer) UpdateProfile(ctx context.Context, req *UpdateProfileRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedUserServiceServer) UploadProfileImage(ctx context.Context, req *ProfileImageUpload) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadProfileImage not implemented")
+}
+func (*UnimplementedUserServiceServer) StartClaim(ctx context.Context, req *StartClaimRequest) (*Claim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartClaim not implemented")
+}
+func (*UnimplementedUserServiceServer) GetClaim(ctx context.Context, req *GetClaimRequest) (*Claim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaim not implemented")
+}
+func (*UnimplementedUserServiceServer) CompleteClaim(ctx context.Context, req *CompleteClaimRequest) (*Claim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteClaim not implemented")
+}
+func (*UnimplementedUserServiceServer) CreateAPIToken(ctx context.Context, req *CreateAPITokenRequest) (*NewAPIToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (*UnimplementedUserServiceServer) ListAPITokens(ctx context.Context, req *ListAPITokensRequest) (*APITokenList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (*UnimplementedUserServiceServer) RevokeAPIToken(ctx context.Context, req *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (*UnimplementedUserServiceServer) AuthenticateAPIToken(ctx context.Context, req *AuthenticateAPITokenRequest) (*APITokenAuthentication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateAPIToken not implemented")
+}
+func (*UnimplementedUserServiceServer) FollowUser(ctx context.Context, req *FollowRequest) (*FollowCounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (*UnimplementedUserServiceServer) UnfollowUser(ctx context.Context, req *FollowRequest) (*FollowCounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetFollowCounts(ctx context.Context, req *FollowCountsRequest) (*FollowCounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowCounts not implemented")
+}
+func (*UnimplementedUserServiceServer) GetFollowing(ctx context.Context, req *GetFollowingRequest) (*FollowingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
+
+func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
+	s.RegisterService(&_UserService_serviceDesc, srv)
+}
+
+func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ValidateJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateJWTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ValidateJWT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ValidateJWT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ValidateJWT(ctx, req.(*ValidateJWTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetJWKS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetJWKS(ctx, req.(*GetJWKSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/SendVerificationEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserFromID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFromIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserFromID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetUserFromID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserFromID(ctx, req.(*GetUserFromIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetUsersByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, req.(*GetUsersByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserForForeignUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForeignUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserForForeignUID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetUserForForeignUID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserForForeignUID(ctx, req.(*GetForeignUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type userServiceClient struct {
-	cc grpc.ClientConnInterface
+func _UserService_SetUserRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/SetUserRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserRank(ctx, req.(*SetUserRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
-	return &userServiceClient{cc}
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/proto.UserService/Register", in, out, opts...)
-	if err != nil {
+func _UserService_HasPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionCheck)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).HasPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/HasPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).HasPermission(ctx, req.(*PermissionCheck))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/proto.UserService/Login", in, out, opts...)
-	if err != nil {
+func _UserService_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) ValidateJWT(ctx context.Context, in *ValidateJWTRequest, opts ...grpc.CallOption) (*ValidateJWTResponse, error) {
-	out := new(ValidateJWTResponse)
-	err := c.cc.Invoke(ctx, "/proto.UserService/ValidateJWT", in, out, opts...)
-	if err != nil {
+func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetUserFromID(ctx context.Context, in *GetUserFromIDRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/proto.UserService/GetUserFromID", in, out, opts...)
-	if err != nil {
+func _UserService_UploadProfileImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileImageUpload)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadProfileImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/UploadProfileImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadProfileImage(ctx, req.(*ProfileImageUpload))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetUserForForeignUID(ctx context.Context, in *GetForeignUserRequest, opts ...grpc.CallOption) (*GetForeignUserResponse, error) {
-	out := new(GetForeignUserResponse)
-	err := c.cc.Invoke(ctx, "/proto.UserService/GetUserForForeignUID", in, out, opts...)
-	if err != nil {
+func _UserService_StartClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartClaimRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/StartClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartClaim(ctx, req.(*StartClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UserServiceServer is the server API for UserService service.
-type UserServiceServer interface {
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	ValidateJWT(context.Context, *ValidateJWTRequest) (*ValidateJWTResponse, error)
-	GetUserFromID(context.Context, *GetUserFromIDRequest) (*UserResponse, error)
-	GetUserForForeignUID(context.Context, *GetForeignUserRequest) (*GetForeignUserResponse, error)
+func _UserService_GetClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetClaim(ctx, req.(*GetClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+func _UserService_CompleteClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CompleteClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/CompleteClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CompleteClaim(ctx, req.(*CompleteClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedUserServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
-}
-func (*UnimplementedUserServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (*UnimplementedUserServiceServer) ValidateJWT(ctx context.Context, req *ValidateJWTRequest) (*ValidateJWTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateJWT not implemented")
-}
-func (*UnimplementedUserServiceServer) GetUserFromID(ctx context.Context, req *GetUserFromIDRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFromID not implemented")
+func _UserService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserServiceServer) GetUserForForeignUID(ctx context.Context, req *GetForeignUserRequest) (*GetForeignUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserForForeignUID not implemented")
+
+func _UserService_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ListAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
-	s.RegisterService(&_UserService_serviceDesc, srv)
+func _UserService_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/RevokeAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _UserService_AuthenticateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Register(ctx, in)
+		return srv.(UserServiceServer).AuthenticateAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.UserService/Register",
+		FullMethod: "/proto.UserService/AuthenticateAPIToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(UserServiceServer).AuthenticateAPIToken(ctx, req.(*AuthenticateAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _UserService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Login(ctx, in)
+		return srv.(UserServiceServer).FollowUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.UserService/Login",
+		FullMethod: "/proto.UserService/FollowUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UserServiceServer).FollowUser(ctx, req.(*FollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ValidateJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateJWTRequest)
+func _UserService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ValidateJWT(ctx, in)
+		return srv.(UserServiceServer).UnfollowUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.UserService/ValidateJWT",
+		FullMethod: "/proto.UserService/UnfollowUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ValidateJWT(ctx, req.(*ValidateJWTRequest))
+		return srv.(UserServiceServer).UnfollowUser(ctx, req.(*FollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserFromID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFromIDRequest)
+func _UserService_GetFollowCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowCountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserFromID(ctx, in)
+		return srv.(UserServiceServer).GetFollowCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.UserService/GetUserFromID",
+		FullMethod: "/proto.UserService/GetFollowCounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserFromID(ctx, req.(*GetUserFromIDRequest))
+		return srv.(UserServiceServer).GetFollowCounts(ctx, req.(*FollowCountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserForForeignUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForeignUserRequest)
+func _UserService_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserForForeignUID(ctx, in)
+		return srv.(UserServiceServer).GetFollowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.UserService/GetUserForForeignUID",
+		FullMethod: "/proto.UserService/GetFollowing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserForForeignUID(ctx, req.(*GetForeignUserRequest))
+		return srv.(UserServiceServer).GetFollowing(ctx, req.(*GetFollowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -818,14 +4139,122 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateJWT",
 			Handler:    _UserService_ValidateJWT_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _UserService_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _UserService_GetJWKS_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _UserService_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserService_ResetPassword_Handler,
+		},
 		{
 			MethodName: "GetUserFromID",
 			Handler:    _UserService_GetUserFromID_Handler,
 		},
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _UserService_GetUsersByIDs_Handler,
+		},
 		{
 			MethodName: "GetUserForForeignUID",
 			Handler:    _UserService_GetUserForForeignUID_Handler,
 		},
+		{
+			MethodName: "BanUser",
+			Handler:    _UserService_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _UserService_UnbanUser_Handler,
+		},
+		{
+			MethodName: "SetUserRank",
+			Handler:    _UserService_SetUserRank_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "HasPermission",
+			Handler:    _UserService_HasPermission_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _UserService_GetPermissions_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UserService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "UploadProfileImage",
+			Handler:    _UserService_UploadProfileImage_Handler,
+		},
+		{
+			MethodName: "StartClaim",
+			Handler:    _UserService_StartClaim_Handler,
+		},
+		{
+			MethodName: "GetClaim",
+			Handler:    _UserService_GetClaim_Handler,
+		},
+		{
+			MethodName: "CompleteClaim",
+			Handler:    _UserService_CompleteClaim_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _UserService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _UserService_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _UserService_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "AuthenticateAPIToken",
+			Handler:    _UserService_AuthenticateAPIToken_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _UserService_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _UserService_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "GetFollowCounts",
+			Handler:    _UserService_GetFollowCounts_Handler,
+		},
+		{
+			MethodName: "GetFollowing",
+			Handler:    _UserService_GetFollowing_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "userservice.proto",
@@ -0,0 +1,149 @@
+// Package verifier validates access tokens issued by the user service without calling it for every token. The user
+// service's public keys are fetched as a JWKS and cached.
+//
+// Tokens are only checked against their signature and standard claims. Revoked sessions are only caught by the user
+// service's ValidateJWT, so locally verified tokens stay usable until they expire.
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	Issuer = "horahora"
+	// Keys are refetched this often, so that newly added keys are picked up
+	keyCacheTTL = 10 * time.Minute
+	// Keys are refetched for an unknown key ID at most this often, so that bad tokens can't hammer the user service
+	minRefetchInterval = 30 * time.Second
+)
+
+var (
+	ErrUnknownKey = errors.New("token was signed with an unknown key")
+	ErrNoExpiry   = errors.New("token has no expiry")
+)
+
+// Claims are the claims of an access token
+type Claims struct {
+	jwt.Claims
+	UID          int64 `json:"uid"`
+	SessionID    int64 `json:"sid,omitempty"` // 0 if the token doesn't belong to a session
+	TokenVersion int64 `json:"ver"`
+	// Only set for the access tokens of API tokens, which can only use the permissions of their scopes
+	Scopes []string `json:"scp,omitempty"`
+}
+
+// KeySource fetches the current public keys
+type KeySource func(ctx context.Context) (*jose.JSONWebKeySet, error)
+
+// GRPCKeySource fetches keys with the user service's GetJWKS RPC
+func GRPCKeySource(client userproto.UserServiceClient) KeySource {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		resp, err := client.GetJWKS(ctx, &userproto.GetJWKSRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		var keys jose.JSONWebKeySet
+		if err = json.Unmarshal([]byte(resp.Jwks), &keys); err != nil {
+			return nil, err
+		}
+
+		return &keys, nil
+	}
+}
+
+// StaticKeySource always returns the same keys, e.g. for the user service itself
+func StaticKeySource(keys jose.JSONWebKeySet) KeySource {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		return &keys, nil
+	}
+}
+
+type Verifier struct {
+	source KeySource
+	now    func() time.Time
+
+	mu          sync.Mutex
+	keys        *jose.JSONWebKeySet
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func New(source KeySource) *Verifier {
+	return &Verifier{source: source, now: time.Now}
+}
+
+// Verify checks the token's signature, expiry and issuer, and returns its claims
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parsed.Headers) != 1 {
+		return nil, errors.New("token must have exactly one signature")
+	}
+
+	key, err := v.key(parsed.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An error here would indicate that the signature was broken or the token was tampered with
+	var claims Claims
+	if err = parsed.Claims(key, &claims); err != nil {
+		return nil, err
+	}
+
+	// Tokens issued before expiry was added would otherwise be valid forever
+	if claims.Expiry == nil {
+		return nil, ErrNoExpiry
+	}
+
+	if err = claims.Validate(jwt.Expected{Issuer: Issuer, Time: v.now()}); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+// key returns the public key with the ID, refetching the keys if they're stale or the ID is unknown. If refetching
+// fails, the cached keys are used.
+func (v *Verifier) key(keyID string) (interface{}, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	known := v.keys != nil && len(v.keys.Key(keyID)) > 0
+	stale := v.keys == nil || now.Sub(v.fetchedAt) > keyCacheTTL
+	if (stale || !known) && (v.keys == nil || now.Sub(v.lastAttempt) > minRefetchInterval) {
+		v.lastAttempt = now
+		keys, err := v.source(context.Background())
+		switch {
+		case err != nil && v.keys == nil:
+			return nil, err
+		case err == nil:
+			v.keys = keys
+			v.fetchedAt = now
+		}
+	}
+
+	if v.keys == nil {
+		return nil, ErrUnknownKey
+	}
+
+	found := v.keys.Key(keyID)
+	if len(found) == 0 {
+		return nil, ErrUnknownKey
+	}
+
+	return found[0].Key, nil
+}
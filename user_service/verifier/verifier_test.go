@@ -0,0 +1,147 @@
+package verifier
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+type testKey struct {
+	private *rsa.PrivateKey
+	id      string
+}
+
+func newTestKey(t *testing.T, id string) testKey {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return testKey{private: private, id: id}
+}
+
+func (k testKey) public() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: &k.private.PublicKey, KeyID: k.id, Algorithm: string(jose.PS512), Use: "sig"}
+}
+
+func (k testKey) sign(t *testing.T, claims Claims) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.PS512, Key: jose.JSONWebKey{Key: k.private, KeyID: k.id}}, nil)
+	assert.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	assert.NoError(t, err)
+	return token
+}
+
+func validClaims(now time.Time) Claims {
+	return Claims{
+		Claims: jwt.Claims{
+			Issuer:   Issuer,
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(15 * time.Minute)),
+		},
+		UID:       5,
+		SessionID: 7,
+	}
+}
+
+// countingSource returns whatever keys currently points to, and counts fetches
+func countingSource(keys *[]jose.JSONWebKey, fetches *int) KeySource {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		*fetches++
+		return &jose.JSONWebKeySet{Keys: *keys}, nil
+	}
+}
+
+func TestVerifyValidToken(t *testing.T) {
+	key := newTestKey(t, "a")
+	v := New(StaticKeySource(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.public()}}))
+
+	claims, err := v.Verify(key.sign(t, validClaims(time.Now())))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), claims.UID)
+	assert.Equal(t, int64(7), claims.SessionID)
+}
+
+func TestVerifyRejectsBadTokens(t *testing.T) {
+	key := newTestKey(t, "a")
+	other := newTestKey(t, "a")
+	v := New(StaticKeySource(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.public()}}))
+	now := time.Now()
+
+	expired := validClaims(now.Add(-time.Hour))
+	_, err := v.Verify(key.sign(t, expired))
+	assert.Error(t, err)
+
+	noExpiry := validClaims(now)
+	noExpiry.Expiry = nil
+	_, err = v.Verify(key.sign(t, noExpiry))
+	assert.Equal(t, ErrNoExpiry, err)
+
+	wrongIssuer := validClaims(now)
+	wrongIssuer.Issuer = "someone else"
+	_, err = v.Verify(key.sign(t, wrongIssuer))
+	assert.Error(t, err)
+
+	// Same key ID, different key
+	_, err = v.Verify(other.sign(t, validClaims(now)))
+	assert.Error(t, err)
+}
+
+func TestUnknownKeyTriggersRateLimitedRefetch(t *testing.T) {
+	oldKey := newTestKey(t, "old")
+	newKey := newTestKey(t, "new")
+
+	keys := []jose.JSONWebKey{oldKey.public()}
+	fetches := 0
+	now := time.Now()
+	v := New(countingSource(&keys, &fetches))
+	v.now = func() time.Time { return now }
+
+	_, err := v.Verify(oldKey.sign(t, validClaims(now)))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	// Cached keys are reused
+	_, err = v.Verify(oldKey.sign(t, validClaims(now)))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	// The user service rotates keys. The new key isn't looked up again straight away.
+	keys = []jose.JSONWebKey{newKey.public(), oldKey.public()}
+	_, err = v.Verify(newKey.sign(t, validClaims(now)))
+	assert.Equal(t, ErrUnknownKey, err)
+	assert.Equal(t, 1, fetches)
+
+	now = now.Add(minRefetchInterval + time.Second)
+	_, err = v.Verify(newKey.sign(t, validClaims(now)))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+
+	// Tokens signed with the retired key stay valid
+	_, err = v.Verify(oldKey.sign(t, validClaims(now)))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestStaleKeysAreRefetched(t *testing.T) {
+	key := newTestKey(t, "a")
+	keys := []jose.JSONWebKey{key.public()}
+	fetches := 0
+	now := time.Now()
+	v := New(countingSource(&keys, &fetches))
+	v.now = func() time.Time { return now }
+
+	_, err := v.Verify(key.sign(t, validClaims(now)))
+	assert.NoError(t, err)
+
+	// Keys which are no longer published stop working once the cache expires
+	keys = nil
+	now = now.Add(keyCacheTTL + time.Second)
+	_, err = v.Verify(key.sign(t, validClaims(now)))
+	assert.Equal(t, ErrUnknownKey, err)
+	assert.Equal(t, 2, fetches)
+}
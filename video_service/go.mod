@@ -15,12 +15,11 @@ require (
 	github.com/google/uuid v1.1.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
 	github.com/grpc-ecosystem/grpc-opentracing v0.0.0-20180507213350-8e809c8a8645
+	github.com/horahoradev/horahora/storage v0.0.0-00010101000000-000000000000
 	github.com/horahoradev/horahora/user_service v0.0.0-20200526031340-64e1705d00d7
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/kr/pretty v0.1.0 // indirect
-	github.com/kurin/blazer v0.5.3
 	github.com/lib/pq v1.4.0
-	github.com/minio/minio-go/v7 v7.0.10
 	github.com/onsi/ginkgo v1.10.1 // indirect
 	github.com/onsi/gomega v1.7.0 // indirect
 	github.com/opentracing/opentracing-go v1.2.0
@@ -31,4 +30,9 @@ require (
 	go.uber.org/atomic v1.7.0 // indirect
 	google.golang.org/grpc v1.33.0
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
+	gopkg.in/square/go-jose.v2 v2.5.1
 )
+
+replace github.com/horahoradev/horahora/user_service => ../user_service
+
+replace github.com/horahoradev/horahora/storage => ../storage
@@ -52,6 +52,14 @@ type config struct {
 	StorageAPIKey     string `env:"StorageAPIKey"`
 	MinioEndpoint     string `env:"MinioEndpoint"`
 	ApprovalThreshold int    `env:"ApprovalThreshold,required"`
+	// Repeat views from the same viewer within this window aren't counted
+	ViewDedupWindow time.Duration `env:"ViewDedupWindow" envDefault:"6h"`
+	// How often views recorded in redis are flushed to postgres
+	ViewFlushInterval time.Duration `env:"ViewFlushInterval" envDefault:"1m"`
+	// Salt for hashing the IPs of anonymous viewers
+	ViewerHashSalt string `env:"ViewerHashSalt"`
+	// Shared with the scheduler, which sends it for the RPCs that only it may call
+	ServiceSecret string `env:"ServiceSecret,required"`
 }
 
 func New() (*config, error) {
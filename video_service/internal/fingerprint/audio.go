@@ -0,0 +1,188 @@
+package fingerprint
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"sort"
+)
+
+// Audio is decoded by ffmpeg to mono signed 16 bit samples at this rate. Most of the perceptually relevant energy is
+// well below 2.7kHz, and a low rate keeps the FFTs cheap.
+const AudioSampleRate = 5512
+
+const (
+	audioFrameSize = 1024 // ~186ms
+	audioHopSize   = 128  // ~23ms, consecutive sub-fingerprints overlap heavily so that alignment doesn't matter much
+	numAudioBands  = 33
+	minBandFreq    = 300.0
+	maxBandFreq    = 2000.0
+)
+
+// bandEdges are logarithmically spaced FFT bin boundaries
+var bandEdges = func() [numAudioBands + 1]int {
+	var edges [numAudioBands + 1]int
+	ratio := math.Pow(maxBandFreq/minBandFreq, 1.0/numAudioBands)
+	for i := range edges {
+		freq := minBandFreq * math.Pow(ratio, float64(i))
+		edges[i] = int(freq * audioFrameSize / AudioSampleRate)
+	}
+	return edges
+}()
+
+var hannWindow = func() [audioFrameSize]float64 {
+	var w [audioFrameSize]float64
+	for i := range w {
+		w[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(audioFrameSize-1))
+	}
+	return w
+}()
+
+// AudioFingerprint computes one 32 bit sub-fingerprint per hop, following Haitsma and Kalker's "A Highly Robust Audio
+// Fingerprinting System": each bit is the sign of the change in energy difference between adjacent bands over time.
+func AudioFingerprint(samples []int16) []uint32 {
+	if len(samples) < audioFrameSize {
+		return nil
+	}
+
+	var prev [numAudioBands]float64
+	var ret []uint32
+	buf := make([]complex128, audioFrameSize)
+
+	for start := 0; start+audioFrameSize <= len(samples); start += audioHopSize {
+		for i := range buf {
+			buf[i] = complex(float64(samples[start+i])*hannWindow[i], 0)
+		}
+		fft(buf)
+
+		var energies [numAudioBands]float64
+		for b := 0; b < numAudioBands; b++ {
+			for bin := bandEdges[b]; bin < bandEdges[b+1]; bin++ {
+				energies[b] += cmplx.Abs(buf[bin]) * cmplx.Abs(buf[bin])
+			}
+		}
+
+		if start > 0 {
+			var sub uint32
+			for b := 0; b < numAudioBands-1; b++ {
+				if (energies[b]-energies[b+1])-(prev[b]-prev[b+1]) > 0 {
+					sub |= 1 << uint(b)
+				}
+			}
+			ret = append(ret, sub)
+		}
+
+		prev = energies
+	}
+
+	return ret
+}
+
+// fft is an in place radix-2 Cooley-Tukey FFT. len(x) must be a power of 2.
+func fft(x []complex128) {
+	n := len(x)
+
+	// Bit reversal permutation
+	shift := uint(64 - bits.Len(uint(n-1)))
+	for i := 0; i < n; i++ {
+		j := int(bits.Reverse64(uint64(i)) >> shift)
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	for size := 2; size <= n; size *= 2 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even := x[start+k]
+				odd := x[start+k+size/2] * w
+				x[start+k] = even + odd
+				x[start+k+size/2] = even - odd
+				w *= step
+			}
+		}
+	}
+}
+
+// Offsets with fewer than this many overlapping sub-fingerprints aren't compared, since short overlaps match by chance
+const minAudioOverlap = 256
+
+// Only the most voted offsets are compared in full
+const maxCandidateOffsets = 10
+
+// AudioSimilarity finds the best alignment of the two fingerprints and returns 1 minus the bit error rate there.
+// Unrelated audio scores around 0.5. Returns -1 if either video has no usable audio.
+//
+// Candidate offsets come from sub-fingerprints which match exactly, as in Haitsma and Kalker, rather than trying
+// every offset.
+func AudioSimilarity(a, b []uint32) float64 {
+	if len(a) < minAudioOverlap || len(b) < minAudioOverlap {
+		return -1
+	}
+
+	positions := make(map[uint32][]int, len(b))
+	for i, h := range b {
+		positions[h] = append(positions[h], i)
+	}
+
+	votes := make(map[int]int)
+	for i, h := range a {
+		// Silence and other degenerate sub-fingerprints repeat everywhere and would drown out the real offset
+		if len(positions[h]) > 16 {
+			continue
+		}
+		for _, j := range positions[h] {
+			votes[j-i]++
+		}
+	}
+
+	var offsets []int
+	for offset, count := range votes {
+		if count >= 2 {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	sort.Slice(offsets, func(i, j int) bool {
+		return votes[offsets[i]] > votes[offsets[j]]
+	})
+	if len(offsets) > maxCandidateOffsets {
+		offsets = offsets[:maxCandidateOffsets]
+	}
+
+	best := -1.0
+	for _, offset := range offsets {
+		if sim := alignedSimilarity(a, b, offset); sim > best {
+			best = sim
+		}
+	}
+
+	if best < 0 {
+		// No exact matches at all, so compare the start of both
+		best = math.Max(alignedSimilarity(a, b, 0), 0)
+	}
+
+	return best
+}
+
+// a[i] is compared with b[i+offset]
+func alignedSimilarity(a, b []uint32, offset int) float64 {
+	var errors, total int
+	for i := range a {
+		j := i + offset
+		if j < 0 || j >= len(b) {
+			continue
+		}
+
+		errors += bits.OnesCount32(a[i] ^ b[j])
+		total += numAudioBands - 1
+	}
+
+	if total < minAudioOverlap*(numAudioBands-1) {
+		return -1
+	}
+
+	return 1 - float64(errors)/float64(total)
+}
@@ -0,0 +1,139 @@
+// Package fingerprint computes perceptual fingerprints of videos so that reuploads and cross-site mirrors can be found
+// even though they've been re-encoded, rescaled or trimmed.
+package fingerprint
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Only the start of each video is fingerprinted, which is enough to identify it and bounds the cost for long videos
+	maxDurationSeconds = 300
+	// One frame is hashed every frameIntervalSeconds. Encoders place keyframes differently, so sampling at a fixed
+	// interval lines up better between two encodes of the same video than using the actual keyframes.
+	frameIntervalSeconds = 2
+)
+
+type Fingerprint struct {
+	FrameHashes []uint64
+	AudioHashes []uint32
+}
+
+// Compute fingerprints the video file at path using ffmpeg to decode it
+func Compute(path string) (*Fingerprint, error) {
+	frames, err := frameHashes(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash frames. Err: %s", err)
+	}
+
+	audio, err := audioHashes(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not fingerprint audio. Err: %s", err)
+	}
+
+	return &Fingerprint{FrameHashes: frames, AudioHashes: audio}, nil
+}
+
+func frameHashes(path string) ([]uint64, error) {
+	filter := fmt.Sprintf("fps=1/%d,scale=%d:%d:flags=area,format=gray", frameIntervalSeconds, FrameSize, FrameSize)
+	args := []string{"-v", "error", "-t", strconv.Itoa(maxDurationSeconds), "-i", path, "-an", "-vf", filter,
+		"-f", "rawvideo", "-"}
+
+	var hashes []uint64
+	err := runFFmpeg(args, func(r io.Reader) error {
+		frame := make([]byte, FrameSize*FrameSize)
+		for {
+			if _, err := io.ReadFull(r, frame); err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					return nil
+				}
+				return err
+			}
+
+			if hash, ok := PHash(frame); ok {
+				hashes = append(hashes, hash)
+			}
+		}
+	})
+
+	return hashes, err
+}
+
+func audioHashes(path string) ([]uint32, error) {
+	args := []string{"-v", "error", "-t", strconv.Itoa(maxDurationSeconds), "-i", path, "-vn", "-ac", "1",
+		"-ar", strconv.Itoa(AudioSampleRate), "-f", "s16le", "-"}
+
+	var samples []int16
+	err := runFFmpeg(args, func(r io.Reader) error {
+		buf := make([]byte, 2)
+		for {
+			if _, err := io.ReadFull(r, buf); err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					return nil
+				}
+				return err
+			}
+
+			samples = append(samples, int16(binary.LittleEndian.Uint16(buf)))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return AudioFingerprint(samples), nil
+}
+
+// runFFmpeg streams ffmpeg's stdout to read. Videos without a video or audio stream produce no output rather than an
+// error, since ffmpeg fails when the stream doesn't exist. If ffmpeg fails after producing some output, e.g. on a
+// truncated file, the output is kept.
+func runFFmpeg(args []string, read func(io.Reader) error) error {
+	cmd := exec.Command("ffmpeg", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	output := &countingReader{r: stdout}
+	readErr := read(bufio.NewReader(output))
+	// Drain anything left so that ffmpeg can exit
+	io.Copy(ioutil.Discard, output)
+
+	if err = cmd.Wait(); err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return err
+		}
+
+		msg := strings.TrimSpace(stderr.String())
+		if output.n == 0 && !strings.Contains(msg, "does not contain any stream") {
+			return fmt.Errorf("ffmpeg failed with %s: %s", err, msg)
+		}
+	}
+
+	return readErr
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,132 @@
+package fingerprint
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"math/rand"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A smooth gradient with a bright square, optionally brightened and with noise added
+func testFrame(squareX, squareY int, brightness int, noise int, rng *rand.Rand) []byte {
+	frame := make([]byte, FrameSize*FrameSize)
+	for y := 0; y < FrameSize; y++ {
+		for x := 0; x < FrameSize; x++ {
+			v := x*4 + y*2 + brightness
+			if x >= squareX && x < squareX+10 && y >= squareY && y < squareY+10 {
+				v += 100
+			}
+			if noise > 0 {
+				v += rng.Intn(2*noise+1) - noise
+			}
+			if v < 0 {
+				v = 0
+			}
+			if v > 255 {
+				v = 255
+			}
+			frame[y*FrameSize+x] = byte(v)
+		}
+	}
+	return frame
+}
+
+func TestPHashSimilarFrames(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	original, ok := PHash(testFrame(4, 4, 0, 0, rng))
+	assert.True(t, ok)
+
+	// Re-encoding artifacts and a brightness change shouldn't move the hash much
+	reencoded, ok := PHash(testFrame(4, 4, 10, 3, rng))
+	assert.True(t, ok)
+	assert.LessOrEqual(t, bits.OnesCount64(original^reencoded), maxFrameDistance)
+
+	different, ok := PHash(testFrame(20, 18, 0, 0, rng))
+	assert.True(t, ok)
+	assert.Greater(t, bits.OnesCount64(original^different), maxFrameDistance)
+}
+
+func TestPHashSkipsFlatFrames(t *testing.T) {
+	_, ok := PHash(make([]byte, FrameSize*FrameSize))
+	assert.False(t, ok)
+
+	_, ok = PHash(make([]byte, 10))
+	assert.False(t, ok)
+}
+
+func TestFrameSimilarity(t *testing.T) {
+	a := []uint64{0x0, 0xFFFF, 0xFFFF0000}
+	// One bit off, reordered, with an extra frame
+	b := []uint64{0xFFFF0001, 0x1, 0xF0F0F0F0F0F0F0F0}
+	assert.InDelta(t, 2.0/3.0, FrameSimilarity(a, b), 0.001)
+	assert.Equal(t, 0.0, FrameSimilarity(nil, b))
+}
+
+func TestBands(t *testing.T) {
+	assert.Equal(t, [NumBands]uint16{0x4444, 0x3333, 0x2222, 0x1111}, Bands(0x1111222233334444))
+}
+
+func TestFFT(t *testing.T) {
+	x := make([]complex128, 8)
+	for i := range x {
+		x[i] = complex(math.Cos(2*math.Pi*float64(i)/8), 0)
+	}
+	fft(x)
+
+	// A single cosine at bin 1 puts half its energy in bin 1 and half in bin 7
+	for i, v := range x {
+		switch i {
+		case 1, 7:
+			assert.InDelta(t, 4, cmplx.Abs(v), 1e-9)
+		default:
+			assert.InDelta(t, 0, cmplx.Abs(v), 1e-9)
+		}
+	}
+}
+
+// Noise shaped by a slowly varying melody, so that band energies change over time
+func testAudio(seconds int, seed int64) []int16 {
+	rng := rand.New(rand.NewSource(seed))
+	samples := make([]int16, seconds*AudioSampleRate)
+	freq := 440.0
+	phase := 0.0
+	for i := range samples {
+		if i%(AudioSampleRate/4) == 0 {
+			freq = 300 + rng.Float64()*1500
+		}
+		phase += 2 * math.Pi * freq / AudioSampleRate
+		samples[i] = int16(8000*math.Sin(phase) + float64(rng.Intn(500)))
+	}
+	return samples
+}
+
+func TestAudioSimilarity(t *testing.T) {
+	original := testAudio(20, 1)
+	fp := AudioFingerprint(original)
+
+	// The same audio with a second cut off the start and some added noise
+	rng := rand.New(rand.NewSource(3))
+	trimmed := make([]int16, len(original)-AudioSampleRate)
+	for i := range trimmed {
+		trimmed[i] = original[i+AudioSampleRate] + int16(rng.Intn(200)-100)
+	}
+
+	assert.Greater(t, AudioSimilarity(fp, AudioFingerprint(trimmed)), 0.8)
+	assert.Less(t, AudioSimilarity(fp, AudioFingerprint(testAudio(20, 2))), 0.65)
+	assert.Equal(t, -1.0, AudioSimilarity(nil, fp))
+}
+
+// Unreadable input must fail rather than produce an empty fingerprint which matches nothing
+func TestComputeFailsOnUnreadableInput(t *testing.T) {
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		t.Skip("ffmpeg is not installed")
+	}
+
+	_, err := Compute("/nonexistent/video.mp4")
+	assert.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package fingerprint
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// Frames are downscaled by ffmpeg to FrameSize x FrameSize grayscale before hashing
+const FrameSize = 32
+
+// Frames with less contrast than this (e.g. black or solid colour frames between scenes) hash to near-arbitrary values
+// and match each other across unrelated videos, so they're skipped.
+const minFrameStdDev = 4.0
+
+const hashSize = 8
+
+var dctCoefficients = func() [hashSize][FrameSize]float64 {
+	var c [hashSize][FrameSize]float64
+	for u := 0; u < hashSize; u++ {
+		for x := 0; x < FrameSize; x++ {
+			c[u][x] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / (2 * FrameSize))
+		}
+	}
+	return c
+}()
+
+// PHash computes a 64 bit perceptual hash of a FrameSize x FrameSize grayscale frame: the sign of each of the lowest
+// 8x8 DCT frequencies relative to their median. Similar images have hashes with a small hamming distance.
+// The second return value is false for frames which are too flat to hash meaningfully.
+func PHash(pixels []byte) (uint64, bool) {
+	if len(pixels) != FrameSize*FrameSize || isFlat(pixels) {
+		return 0, false
+	}
+
+	// Separable 2D DCT, only computing the frequencies we keep
+	var rows [FrameSize][hashSize]float64
+	for y := 0; y < FrameSize; y++ {
+		for u := 0; u < hashSize; u++ {
+			var sum float64
+			for x := 0; x < FrameSize; x++ {
+				sum += float64(pixels[y*FrameSize+x]) * dctCoefficients[u][x]
+			}
+			rows[y][u] = sum
+		}
+	}
+
+	var coeffs [hashSize * hashSize]float64
+	for v := 0; v < hashSize; v++ {
+		for u := 0; u < hashSize; u++ {
+			var sum float64
+			for y := 0; y < FrameSize; y++ {
+				sum += rows[y][u] * dctCoefficients[v][y]
+			}
+			coeffs[v*hashSize+u] = sum
+		}
+	}
+
+	// The DC term is just the average brightness, so it's left out of the median
+	sorted := make([]float64, len(coeffs)-1)
+	copy(sorted, coeffs[1:])
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, c := range coeffs {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+
+	return hash, true
+}
+
+func isFlat(pixels []byte) bool {
+	var sum, sumSq float64
+	for _, p := range pixels {
+		sum += float64(p)
+		sumSq += float64(p) * float64(p)
+	}
+
+	n := float64(len(pixels))
+	variance := sumSq/n - (sum/n)*(sum/n)
+	return math.Sqrt(math.Max(variance, 0)) < minFrameStdDev
+}
+
+// Frames within this hamming distance are considered the same frame
+const maxFrameDistance = 10
+
+// FrameSimilarity is the fraction of frames in the shorter video which have a close match anywhere in the other one.
+// Order is ignored, so trimmed or re-cut reuploads still match.
+func FrameSimilarity(a, b []uint64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	matched := 0
+	for _, ha := range a {
+		for _, hb := range b {
+			if bits.OnesCount64(ha^hb) <= maxFrameDistance {
+				matched++
+				break
+			}
+		}
+	}
+
+	return float64(matched) / float64(len(a))
+}
+
+// NumBands is the number of 16 bit bands a frame hash is split into for candidate lookup. Two hashes within
+// maxFrameDistance bits of each other are likely to share at least one band exactly.
+const NumBands = 4
+
+// Bands splits a frame hash for locality sensitive lookup
+func Bands(hash uint64) [NumBands]uint16 {
+	var b [NumBands]uint16
+	for i := 0; i < NumBands; i++ {
+		b[i] = uint16(hash >> (16 * uint(i)))
+	}
+	return b
+}
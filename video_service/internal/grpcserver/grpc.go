@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,17 +15,21 @@ import (
 	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
-	"github.com/horahoradev/horahora/video_service/internal/storage"
+	"github.com/horahoradev/horahora/storage"
 	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/horahoradev/horahora/video_service/internal/dashutils"
+	"github.com/horahoradev/horahora/video_service/internal/fingerprint"
 
 	"github.com/horahoradev/horahora/video_service/internal/models"
+	"github.com/horahoradev/horahora/video_service/internal/rawmeta"
 
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/user_service/verifier"
 	proto "github.com/horahoradev/horahora/video_service/protocol"
 
 	log "github.com/sirupsen/logrus"
@@ -39,18 +44,72 @@ const uploadDir = "/videoservice/test_files/"
 
 var _ proto.VideoServiceServer = (*GRPCServer)(nil)
 
+// methodPermissions lists the RPCs which act as the request's user, or always need a permission. Callers can reach the
+// video service directly, so the interceptor checks that the userID of their requests is the user whose access token
+// came with the call, and then checks that user's permission with the user service. RPCs which only the scheduler
+// calls need the service secret instead.
+var methodPermissions = map[string]permissions.Permission{
+	"/proto.VideoService/viewVideo":                  permissions.SelfOrAnonymous,
+	"/proto.VideoService/rateVideo":                  permissions.Self,
+	"/proto.VideoService/RetractRating":              permissions.Self,
+	"/proto.VideoService/MakeComment":                permissions.Self,
+	"/proto.VideoService/MakeCommentUpvote":          permissions.Self,
+	"/proto.VideoService/EditComment":                permissions.Self,
+	"/proto.VideoService/DeleteComment":              permissions.Self,
+	"/proto.VideoService/ReportContent":              permissions.Self,
+	"/proto.VideoService/PostDanmaku":                permissions.Self,
+	"/proto.VideoService/CreatePlaylist":             permissions.Self,
+	"/proto.VideoService/UpdatePlaylist":             permissions.Self,
+	"/proto.VideoService/DeletePlaylist":             permissions.Self,
+	"/proto.VideoService/AddVideoToPlaylist":         permissions.Self,
+	"/proto.VideoService/RemoveVideoFromPlaylist":    permissions.Self,
+	"/proto.VideoService/MoveVideoInPlaylist":        permissions.Self,
+	"/proto.VideoService/GetPlaylist":                permissions.SelfOrAnonymous,
+	"/proto.VideoService/GetUserPlaylists":           permissions.SelfOrAnonymous,
+	"/proto.VideoService/FollowTag":                  permissions.Self,
+	"/proto.VideoService/UnfollowTag":                permissions.Self,
+	"/proto.VideoService/GetFollowedTags":            permissions.Self,
+	"/proto.VideoService/GetFeed":                    permissions.Self,
+	"/proto.VideoService/ListNotifications":          permissions.Self,
+	"/proto.VideoService/MarkNotificationsRead":      permissions.Self,
+	"/proto.VideoService/GetUnreadNotificationCount": permissions.Self,
+	"/proto.VideoService/TransferVideos":             permissions.Self,
+	"/proto.VideoService/SyncMirroredPlaylist":       permissions.Service,
+	"/proto.VideoService/AddNotification":            permissions.Service,
+
+	"/proto.VideoService/ApproveVideo":         permissions.VideoApprove,
+	"/proto.VideoService/RejectVideo":          permissions.VideoApprove,
+	"/proto.VideoService/GetPendingVideos":     permissions.VideoApprove,
+	"/proto.VideoService/ListReports":          permissions.ReportResolve,
+	"/proto.VideoService/ResolveReports":       permissions.ReportResolve,
+	"/proto.VideoService/UpdateTag":            permissions.TagEdit,
+	"/proto.VideoService/AddTagAlias":          permissions.TagEdit,
+	"/proto.VideoService/RemoveTagAlias":       permissions.TagEdit,
+	"/proto.VideoService/AddTagImplication":    permissions.TagEdit,
+	"/proto.VideoService/RemoveTagImplication": permissions.TagEdit,
+	"/proto.VideoService/GetTagHistory":        permissions.TagEdit,
+}
+
 type GRPCServer struct {
 	VideoModel *models.VideoModel
+	ViewModel  *models.ViewModel
+	Danmaku    *models.DanmakuModel
+	Related    *models.RelatedModel
 	Local      bool
 	OriginFQDN string
 	Storage    storage.Storage
+	UserClient userproto.UserServiceClient
+	// Sent by the scheduler for the RPCs which only it may call
+	ServiceSecret string
 }
 
 // TODO: API is getting bloated
 func NewGRPCServer(bucketName string, db *sqlx.DB, port int, originFQDN string, local bool,
 	redisClient *redis.Client, client userproto.UserServiceClient, tracer opentracing.Tracer,
-	storageBackend, apiID, apiKey string, approvalThreshold int, minioEndpoint string) error {
-	g, err := initGRPCServer(bucketName, db, client, local, redisClient, originFQDN, storageBackend, apiID, apiKey, approvalThreshold, minioEndpoint)
+	storageBackend, apiID, apiKey string, approvalThreshold int, minioEndpoint string,
+	viewDedupWindow, viewFlushInterval time.Duration, viewerHashSalt, serviceSecret string) error {
+	g, err := initGRPCServer(bucketName, db, client, local, redisClient, originFQDN, storageBackend, apiID, apiKey,
+		approvalThreshold, minioEndpoint, viewDedupWindow, viewerHashSalt, serviceSecret)
 	if err != nil {
 		return err
 	}
@@ -62,36 +121,38 @@ func NewGRPCServer(bucketName string, db *sqlx.DB, port int, originFQDN string,
 
 	// TODO: context and return
 	go g.transcodeAndUploadVideos()
-
+	go g.flushViews(viewFlushInterval)
+	go g.backfillRawMetadata()
+	go g.attributeArchivedComments()
+	go g.backfillFingerprints()
+	go g.precomputeRelatedVideos()
+	go g.refreshRatingPrior()
+
+	v := verifier.New(verifier.GRPCKeySource(client))
 	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-		otgrpc.OpenTracingServerInterceptor(tracer))))
+		otgrpc.OpenTracingServerInterceptor(tracer),
+		permissions.UnaryServerInterceptor(client, v, serviceSecret, methodPermissions))),
+		grpc.StreamInterceptor(permissions.StreamCallerInterceptor(v)))
 	proto.RegisterVideoServiceServer(grpcServer, g)
 	return grpcServer.Serve(lis)
 }
 
 func initGRPCServer(bucketName string, db *sqlx.DB, client userproto.UserServiceClient, local bool,
-	redisClient *redis.Client, originFQDN, storageBackend, apiID, apiKey string, approvalThreshold int, minioEndpoint string) (*GRPCServer, error) {
+	redisClient *redis.Client, originFQDN, storageBackend, apiID, apiKey string, approvalThreshold int, minioEndpoint string,
+	viewDedupWindow time.Duration, viewerHashSalt, serviceSecret string) (*GRPCServer, error) {
 
 	g := &GRPCServer{
-		Local:      local,
-		OriginFQDN: originFQDN,
+		Local:         local,
+		OriginFQDN:    originFQDN,
+		UserClient:    client,
+		ServiceSecret: serviceSecret,
 	}
 
 	var err error
 
-	switch storageBackend {
-	case "b2":
-		g.Storage, err = storage.NewB2(apiID, apiKey, bucketName)
-		if err != nil {
-			return nil, err
-		}
-	case "minio":
-		g.Storage, err = storage.NewMinio(minioEndpoint, apiID, apiKey, bucketName)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, fmt.Errorf("Unknown storage backend %s", storageBackend)
+	g.Storage, err = storage.New(storageBackend, apiID, apiKey, bucketName, minioEndpoint)
+	if err != nil {
+		return nil, err
 	}
 
 	g.VideoModel, err = models.NewVideoModel(db, client, redisClient, approvalThreshold)
@@ -99,6 +160,21 @@ func initGRPCServer(bucketName string, db *sqlx.DB, client userproto.UserService
 		return nil, err
 	}
 
+	g.ViewModel, err = models.NewViewModel(db, redisClient, viewDedupWindow, viewerHashSalt)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Danmaku, err = models.NewDanmakuModel(db)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Related, err = models.NewRelatedModel(db, models.DefaultRelatednessSignals(db))
+	if err != nil {
+		return nil, err
+	}
+
 	return g, nil
 }
 
@@ -108,6 +184,16 @@ type VideoUpload struct {
 	MetaFileData *os.File
 }
 
+// authorizeUpload checks that users only upload as themselves. Archived videos have no domestic author, and only the
+// scheduler uploads them.
+func (g GRPCServer) authorizeUpload(ctx context.Context, meta *proto.InputFileMetadata) error {
+	if meta.DomesticAuthorID != 0 {
+		return permissions.RequireCaller(ctx, meta.DomesticAuthorID)
+	}
+
+	return permissions.RequireService(ctx, g.ServiceSecret)
+}
+
 func (g GRPCServer) UploadVideo(inpStream proto.VideoService_UploadVideoServer) error {
 	log.Info("Handling video upload")
 
@@ -116,7 +202,7 @@ func (g GRPCServer) UploadVideo(inpStream proto.VideoService_UploadVideoServer)
 	// UUID for tmp filename and all uploads provides probabilistic guarantee of uniqueness
 	id, err := uuid.NewUUID()
 	if err != nil {
-		log.Error("Could not generate uuid. Err: %s", err)
+		log.Errorf("Could not generate uuid. Err: %s", err)
 		return err
 	}
 
@@ -182,11 +268,20 @@ loop:
 				return err
 			}
 
+			if err = g.authorizeUpload(inpStream.Context(), r.Meta); err != nil {
+				log.Errorf("Refused upload of video %s. Err: %s", r.Meta.Title, err)
+				return err
+			}
+
 			video.Meta = r
 			log.Infof("Received metadata for video %s", video.Meta.Meta.Title)
 		}
 	}
 
+	if video.Meta == nil {
+		return status.Error(codes.InvalidArgument, "no metadata in stream")
+	}
+
 	err = ioutil.WriteFile(video.FileData.Name()+".jpg", video.Meta.Meta.Thumbnail, 0644)
 	if err != nil {
 		return LogAndRetErr("could not write thumbnail. Err: %s", err)
@@ -232,6 +327,18 @@ loop:
 		return LogAndRetErr("failed to save video to postgres. Err: %s", err)
 	}
 
+	if video.Meta.Meta.OriginalID != "" {
+		err = g.VideoModel.AddToMirroredPlaylists(videoID, video.Meta.Meta.OriginalSite, video.Meta.Meta.OriginalID)
+		if err != nil {
+			log.Errorf("failed to add video %d to mirrored playlists. Err: %s", videoID, err)
+		}
+	}
+
+	// The metadata file is still around locally, so there's no need to wait for the backfill to fetch it from storage
+	if err = g.importRawMetadata(videoID, video.MetaFileData); err != nil {
+		log.Errorf("failed to import raw metadata for video %d, will retry in backfill. Err: %s", videoID, err)
+	}
+
 	uploadResp := proto.UploadResponse{
 		VideoID: videoID,
 	}
@@ -325,12 +432,149 @@ func (g GRPCServer) transcodeAndUploadVideos() {
 					return
 				}
 
+				// The original is still on disk, so fingerprint it now rather than fetching it again in the backfill
+				if err = g.fingerprintVideo(int64(video.ID), vid.Name()); err != nil {
+					log.Errorf("failed to fingerprint video %d, will retry in backfill. Err: %s", video.ID, err)
+				}
+
 				log.Infof("Video %d has been successfully encoded", video.ID)
 			}(v)
 		}
 	}
 }
 
+// importRawMetadata imports everything we keep from the youtube-dl metadata file, then marks the video as imported
+func (g GRPCServer) importRawMetadata(videoID int64, metaFile *os.File) error {
+	if _, err := metaFile.Seek(0, 0); err != nil {
+		return err
+	}
+
+	meta, err := rawmeta.Parse(metaFile)
+	if err != nil {
+		return err
+	}
+
+	if err = g.Danmaku.ImportDanmaku(videoID, meta.Danmaku()); err != nil {
+		return fmt.Errorf("could not import danmaku. Err: %s", err)
+	}
+
+	if err = g.VideoModel.ImportArchivedComments(videoID, meta.ArchivedComments()); err != nil {
+		return fmt.Errorf("could not import archived comments. Err: %s", err)
+	}
+
+	return g.VideoModel.MarkRawMetadataImported(videoID)
+}
+
+// fingerprintVideo fingerprints the original upload and checks it against existing videos
+func (g GRPCServer) fingerprintVideo(videoID int64, path string) error {
+	fp, err := fingerprint.Compute(path)
+	if err != nil {
+		return err
+	}
+
+	if err = g.VideoModel.SaveFingerprint(videoID, fp); err != nil {
+		return err
+	}
+
+	return g.VideoModel.CheckForDuplicates(videoID, fp)
+}
+
+// backfillFingerprints fingerprints videos transcoded before duplicate detection existed, or whose fingerprinting failed
+func (g GRPCServer) backfillFingerprints() {
+	for {
+		time.Sleep(time.Minute)
+		videos, err := g.VideoModel.GetUnfingerprintedVideos()
+		if err != nil {
+			log.Errorf("could not fetch unfingerprinted videos. Err: %s", err)
+			continue
+		}
+
+		for _, v := range videos {
+			func(video models.UnencodedVideo) {
+				vid, err := g.Storage.Fetch(video.GetMPDUUID())
+				switch {
+				case errors.Is(err, storage.ErrNotFound):
+					// Don't retry these forever
+					log.Errorf("original for video %d is missing, skipping", video.ID)
+					if err = g.VideoModel.MarkVideoFingerprinted(int64(video.ID)); err != nil {
+						log.Errorf("could not mark video %d fingerprinted. Err: %s", video.ID, err)
+					}
+					return
+				case err != nil:
+					// Left for the next pass
+					log.Errorf("could not fetch original for video %d. Err: %s", video.ID, err)
+					return
+				}
+				defer func() {
+					vid.Close()
+					os.Remove(vid.Name())
+				}()
+
+				if err = g.fingerprintVideo(int64(video.ID), vid.Name()); err != nil {
+					log.Errorf("could not fingerprint video %d. Err: %s", video.ID, err)
+					return
+				}
+
+				log.Infof("Fingerprinted video %d", video.ID)
+			}(v)
+		}
+	}
+}
+
+// backfillRawMetadata imports raw metadata for videos uploaded before the import existed, or whose import failed
+func (g GRPCServer) backfillRawMetadata() {
+	for {
+		time.Sleep(time.Minute)
+		videos, err := g.VideoModel.GetVideosWithUnimportedMetadata()
+		if err != nil {
+			log.Errorf("could not fetch videos with unimported metadata. Err: %s", err)
+			continue
+		}
+
+		for _, v := range videos {
+			func(video models.UnencodedVideo) {
+				metaFile, err := g.Storage.Fetch(video.GetMPDUUID() + ".json")
+				switch {
+				case errors.Is(err, storage.ErrNotFound):
+					// Direct uploads and local mode have no metadata in storage, don't retry these forever
+					log.Infof("no raw metadata for video %d, skipping", video.ID)
+					if err = g.VideoModel.MarkRawMetadataImported(int64(video.ID)); err != nil {
+						log.Errorf("could not mark raw metadata imported for video %d. Err: %s", video.ID, err)
+					}
+					return
+				case err != nil:
+					// Left for the next pass
+					log.Errorf("could not fetch raw metadata for video %d. Err: %s", video.ID, err)
+					return
+				}
+				defer func() {
+					metaFile.Close()
+					os.Remove(metaFile.Name())
+				}()
+
+				if err = g.importRawMetadata(int64(video.ID), metaFile); err != nil {
+					log.Errorf("could not import raw metadata for video %d. Err: %s", video.ID, err)
+					return
+				}
+
+				log.Infof("Imported raw metadata for video %d", video.ID)
+			}(v)
+		}
+	}
+}
+
+// Registering a commenter takes a few user service calls, so this is kept to a modest number per pass
+const archivedCommentAuthorsPerPass = 100
+
+func (g GRPCServer) attributeArchivedComments() {
+	for {
+		time.Sleep(time.Minute)
+		if err := g.VideoModel.AttributeArchivedComments(context.Background(), archivedCommentAuthorsPerPass); err != nil {
+			log.Errorf("could not attribute archived comments. Err: %s", err)
+		}
+	}
+}
+
 // UploadMPDSet uploads the files to S3. Files may be overwritten (but they're versioned so they're safe).
 // Need to ensure as a precondition that the video hasn't been uploaded before and the temp file ID hasn't been
 // used.
@@ -365,6 +609,16 @@ func (g GRPCServer) DownloadVideo(req *proto.VideoRequest, outputStream proto.Vi
 }
 
 func (g GRPCServer) GetVideoList(ctx context.Context, queryConfig *proto.VideoQueryConfig) (*proto.VideoList, error) {
+	if queryConfig.ShowUnapproved {
+		if err := permissions.RequireCaller(ctx, queryConfig.ViewerID); err != nil {
+			return nil, err
+		}
+
+		if err := permissions.Require(ctx, g.UserClient, queryConfig.ViewerID, permissions.VideoApprove); err != nil {
+			return nil, err
+		}
+	}
+
 	switch queryConfig.OrderBy {
 	case proto.OrderCategory_rating, proto.OrderCategory_views, proto.OrderCategory_upload_date:
 		videos, err := g.VideoModel.GetVideoList(queryConfig.Direction, queryConfig.PageNumber,
@@ -400,8 +654,16 @@ func (g GRPCServer) RateVideo(ctx context.Context, rating *proto.VideoRating) (*
 	return &proto.Nothing{}, nil
 }
 
+func (g GRPCServer) RetractRating(ctx context.Context, req *proto.RatingRetraction) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.RetractRating(req.UserID, req.VideoID)
+}
+
 func (g GRPCServer) ViewVideo(ctx context.Context, videoInp *proto.VideoViewing) (*proto.Nothing, error) {
-	err := g.VideoModel.IncrementViewsForVideo(videoInp.VideoID)
+	if videoInp.UserID == 0 && videoInp.ClientIP == "" {
+		return nil, status.Error(codes.InvalidArgument, "either userID or clientIP is required")
+	}
+
+	_, err := g.ViewModel.RecordView(videoInp.VideoID, videoInp.UserID, videoInp.ClientIP)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +671,34 @@ func (g GRPCServer) ViewVideo(ctx context.Context, videoInp *proto.VideoViewing)
 	return &proto.Nothing{}, nil
 }
 
+const maxViewStatsDays = 366
+
+func (g GRPCServer) GetVideoViewStats(ctx context.Context, req *proto.VideoViewStatsRequest) (*proto.VideoViewStats, error) {
+	if req.NumDays <= 0 || req.NumDays > maxViewStatsDays {
+		return nil, status.Errorf(codes.InvalidArgument, "numDays must be between 1 and %d", maxViewStatsDays)
+	}
+
+	days, err := g.ViewModel.GetDailyViews(req.VideoID, int(req.NumDays))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.VideoViewStats{Days: days}, nil
+}
+
+func (g GRPCServer) flushViews(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		if err := g.ViewModel.FlushViews(); err != nil {
+			log.Errorf("could not flush views. Err: %s", err)
+		}
+
+		if err := g.ViewModel.ExpireTrendingViews(); err != nil {
+			log.Errorf("could not expire trending views. Err: %s", err)
+		}
+	}
+}
+
 func (g GRPCServer) GetVideo(ctx context.Context, req *proto.VideoRequest) (*proto.VideoMetadata, error) {
 	videoMetadata, err := g.VideoModel.GetVideoInfo(req.VideoID)
 	if err != nil {
@@ -419,13 +709,30 @@ func (g GRPCServer) GetVideo(ctx context.Context, req *proto.VideoRequest) (*pro
 }
 
 func (g GRPCServer) ApproveVideo(ctx context.Context, req *proto.VideoApproval) (*proto.Nothing, error) {
-	if err := g.VideoModel.ApproveVideo(int(req.UserID), int(req.VideoID)); err != nil {
+	if err := g.VideoModel.ApproveVideo(req.UserID, req.VideoID); err != nil {
 		return nil, err
 	}
 
 	return &proto.Nothing{}, nil
 }
 
+func (g GRPCServer) RejectVideo(ctx context.Context, req *proto.VideoRejection) (*proto.Nothing, error) {
+	if err := g.VideoModel.RejectVideo(req.UserID, req.VideoID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &proto.Nothing{}, nil
+}
+
+func (g GRPCServer) GetPendingVideos(ctx context.Context, req *proto.PendingVideosRequest) (*proto.PendingVideoList, error) {
+	videos, count, err := g.VideoModel.GetPendingVideos(req.UserID, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PendingVideoList{Videos: videos, NumberOfVideos: count}, nil
+}
+
 func (g GRPCServer) MakeComment(ctx context.Context, commentReq *proto.VideoComment) (*proto.Nothing, error) {
 	return &proto.Nothing{},
 		g.VideoModel.MakeComment(commentReq.UserId, commentReq.VideoId,
@@ -435,13 +742,288 @@ func (g GRPCServer) MakeComment(ctx context.Context, commentReq *proto.VideoComm
 
 func (g GRPCServer) MakeCommentUpvote(ctx context.Context, upvoteReq *proto.CommentUpvote) (*proto.Nothing, error) {
 	return &proto.Nothing{}, g.VideoModel.MakeUpvote(upvoteReq.UserId, upvoteReq.CommentId,
-		upvoteReq.IsUpvote)
+		upvoteReq.Vote)
 }
 
+// GetCommentsForVideo returns native and archived comments separately. Root requests return a page of each, while
+// replies come from either native or archived comments, since their IDs don't share a namespace.
 func (g GRPCServer) GetCommentsForVideo(ctx context.Context, commentListReq *proto.CommentRequest) (*proto.CommentListResponse, error) {
-	list, err := g.VideoModel.GetComments(commentListReq.VideoID, commentListReq.CurrUserID)
+	// The current user only decides which of the comments show as voted on, but it still has to be the caller
+	if commentListReq.CurrUserID != 0 {
+		if err := permissions.RequireCaller(ctx, commentListReq.CurrUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp proto.CommentListResponse
+	var err error
+
+	if !commentListReq.Archived {
+		resp.Comments, resp.NumberOfComments, err = g.VideoModel.GetComments(commentListReq.VideoID, commentListReq.CurrUserID,
+			commentListReq.ParentID, commentListReq.PageNumber, commentListReq.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if commentListReq.Archived || commentListReq.ParentID == 0 {
+		resp.ArchivedComments, resp.NumberOfArchivedComments, err = g.VideoModel.GetArchivedComments(commentListReq.VideoID,
+			commentListReq.ParentID, commentListReq.PageNumber, commentListReq.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &resp, nil
+}
+
+func (g GRPCServer) EditComment(ctx context.Context, editReq *proto.CommentEdit) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.EditComment(editReq.UserId, editReq.CommentId, editReq.Comment)
+}
+
+func (g GRPCServer) DeleteComment(ctx context.Context, deleteReq *proto.CommentDeletion) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.DeleteComment(deleteReq.UserId, deleteReq.CommentId)
+}
+
+func (g GRPCServer) ReportContent(ctx context.Context, req *proto.ContentReport) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.ReportContent(req.UserID, req.ContentType, req.ContentID, req.Reason, req.Details)
+}
+
+func (g GRPCServer) ListReports(ctx context.Context, req *proto.ListReportsRequest) (*proto.ReportList, error) {
+	items, count, err := g.VideoModel.ListReports(req.UserID, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ReportList{Items: items, NumberOfItems: count}, nil
+}
+
+func (g GRPCServer) ResolveReports(ctx context.Context, req *proto.ReportResolution) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.ResolveReports(ctx, req.UserID, req.ContentType, req.ContentID, req.Action, req.Note)
+}
+
+func (g GRPCServer) GetDanmaku(ctx context.Context, req *proto.DanmakuRequest) (*proto.DanmakuList, error) {
+	danmaku, err := g.Danmaku.GetDanmaku(req.VideoID, req.FromMs, req.ToMs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DanmakuList{Danmaku: danmaku}, nil
+}
+
+func (g GRPCServer) PostDanmaku(ctx context.Context, req *proto.DanmakuPost) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.Danmaku.PostDanmaku(req.UserID, req.VideoID, req.PositionMs, req.Content, req.Position, req.Color)
+}
+
+func (g GRPCServer) CreatePlaylist(ctx context.Context, req *proto.PlaylistCreation) (*proto.Playlist, error) {
+	return g.VideoModel.CreatePlaylist(req.UserID, req.Title, req.Description, req.Visibility)
+}
+
+func (g GRPCServer) UpdatePlaylist(ctx context.Context, req *proto.PlaylistUpdate) (*proto.Playlist, error) {
+	return g.VideoModel.UpdatePlaylist(req.UserID, req.PlaylistID, req.Title, req.Description, req.Visibility)
+}
+
+func (g GRPCServer) DeletePlaylist(ctx context.Context, req *proto.PlaylistDeletion) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.DeletePlaylist(req.UserID, req.PlaylistID)
+}
+
+func (g GRPCServer) AddVideoToPlaylist(ctx context.Context, req *proto.PlaylistVideoChange) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.AddVideoToPlaylist(req.UserID, req.PlaylistID, req.VideoID)
+}
+
+func (g GRPCServer) RemoveVideoFromPlaylist(ctx context.Context, req *proto.PlaylistVideoChange) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.RemoveVideoFromPlaylist(req.UserID, req.PlaylistID, req.VideoID)
+}
+
+func (g GRPCServer) MoveVideoInPlaylist(ctx context.Context, req *proto.PlaylistVideoMove) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.MoveVideoInPlaylist(req.UserID, req.PlaylistID, req.VideoID, req.Position)
+}
+
+func (g GRPCServer) GetPlaylist(ctx context.Context, req *proto.GetPlaylistRequest) (*proto.PlaylistPage, error) {
+	return g.VideoModel.GetPlaylist(req.PlaylistID, req.UserID, req.PageNumber)
+}
+
+func (g GRPCServer) GetUserPlaylists(ctx context.Context, req *proto.UserPlaylistsRequest) (*proto.PlaylistList, error) {
+	playlists, err := g.VideoModel.GetUserPlaylists(req.OwnerID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PlaylistList{Playlists: playlists}, nil
+}
+
+func (g GRPCServer) SyncMirroredPlaylist(ctx context.Context, req *proto.MirroredPlaylistSync) (*proto.Playlist, error) {
+	return g.VideoModel.SyncMirroredPlaylist(req.UserID, req.Site, req.PlaylistID, req.Title, req.ForeignVideoIDs)
+}
+
+func (g GRPCServer) FollowTag(ctx context.Context, req *proto.TagFollow) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.FollowTag(req.UserID, req.Tag)
+}
+
+func (g GRPCServer) UnfollowTag(ctx context.Context, req *proto.TagFollow) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.UnfollowTag(req.UserID, req.Tag)
+}
+
+func (g GRPCServer) GetFollowedTags(ctx context.Context, req *proto.FollowedTagsRequest) (*proto.FollowedTags, error) {
+	tags, err := g.VideoModel.GetFollowedTags(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.FollowedTags{Tags: tags}, nil
+}
+
+func (g GRPCServer) GetTag(ctx context.Context, req *proto.TagLookup) (*proto.TagInfo, error) {
+	return g.VideoModel.GetTag(req.Tag)
+}
+
+func (g GRPCServer) AutocompleteTags(ctx context.Context, req *proto.TagAutocompleteRequest) (*proto.TagList, error) {
+	tags, err := g.VideoModel.AutocompleteTags(req.Prefix, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.TagList{Tags: tags}, nil
+}
+
+func (g GRPCServer) GetTagCounts(ctx context.Context, req *proto.TagCountsRequest) (*proto.TagList, error) {
+	tags, err := g.VideoModel.GetTagCounts(req.Tags, req.Limit)
+	if err != nil {
+		return nil, err
+	}
 
-	return &proto.CommentListResponse{
-		Comments: list,
-	}, err
+	return &proto.TagList{Tags: tags}, nil
+}
+
+func (g GRPCServer) UpdateTag(ctx context.Context, req *proto.TagUpdate) (*proto.TagInfo, error) {
+	return g.VideoModel.UpdateTag(req.UserID, req.Tag, req.Category, req.Description)
+}
+
+func (g GRPCServer) AddTagAlias(ctx context.Context, req *proto.TagAliasChange) (*proto.TagInfo, error) {
+	return g.VideoModel.AddTagAlias(req.UserID, req.Tag, req.Alias)
+}
+
+func (g GRPCServer) RemoveTagAlias(ctx context.Context, req *proto.TagAliasChange) (*proto.TagInfo, error) {
+	return g.VideoModel.RemoveTagAlias(req.UserID, req.Tag, req.Alias)
+}
+
+func (g GRPCServer) AddTagImplication(ctx context.Context, req *proto.TagImplicationChange) (*proto.TagInfo, error) {
+	return g.VideoModel.AddTagImplication(req.UserID, req.Tag, req.ImpliedTag)
+}
+
+func (g GRPCServer) RemoveTagImplication(ctx context.Context, req *proto.TagImplicationChange) (*proto.TagInfo, error) {
+	return g.VideoModel.RemoveTagImplication(req.UserID, req.Tag, req.ImpliedTag)
+}
+
+func (g GRPCServer) GetTagHistory(ctx context.Context, req *proto.TagHistoryRequest) (*proto.TagHistory, error) {
+	edits, count, err := g.VideoModel.GetTagHistory(req.UserID, req.Tag, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.TagHistory{Edits: edits, NumberOfEdits: count}, nil
+}
+
+func (g GRPCServer) GetFeed(ctx context.Context, req *proto.FeedRequest) (*proto.Feed, error) {
+	videos, nextCursor, err := g.VideoModel.GetFeed(req.UserID, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Feed{Videos: videos, NextCursor: nextCursor}, nil
+}
+
+func (g GRPCServer) ListNotifications(ctx context.Context, req *proto.ListNotificationsRequest) (*proto.NotificationList, error) {
+	notifications, count, err := g.VideoModel.ListNotifications(req.UserID, req.PageNumber, req.AfterID, req.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.NotificationList{Notifications: notifications, NumberOfItems: count}, nil
+}
+
+func (g GRPCServer) MarkNotificationsRead(ctx context.Context, req *proto.NotificationsRead) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.MarkNotificationsRead(req.UserID, req.NotificationIDs)
+}
+
+func (g GRPCServer) GetUnreadNotificationCount(ctx context.Context, req *proto.UnreadNotificationsRequest) (*proto.UnreadNotificationCount, error) {
+	count, err := g.VideoModel.GetUnreadNotificationCount(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UnreadNotificationCount{Count: count}, nil
+}
+
+func (g GRPCServer) AddNotification(ctx context.Context, req *proto.NotificationCreation) (*proto.Nothing, error) {
+	return &proto.Nothing{}, g.VideoModel.AddNotification(req.UserIDs, req.VideoID, req.Kind, req.Message)
+}
+
+func (g GRPCServer) TransferVideos(ctx context.Context, req *proto.VideoTransfer) (*proto.VideoTransferResult, error) {
+	// The user service only shows claims to their claimant, who the interceptor checked is the caller
+	claim, err := g.UserClient.GetClaim(permissions.ForwardAccessToken(ctx), &userproto.GetClaimRequest{
+		ClaimID:    req.ClaimID,
+		ClaimantID: req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !claim.Completed || claim.Mode != userproto.ClaimMode_merge {
+		return nil, status.Error(codes.FailedPrecondition, "only the videos of completed merge claims can be transferred")
+	}
+
+	moved, err := g.VideoModel.TransferVideos(claim.ForeignAccountID, req.UserID)
+	if err != nil {
+		log.Errorf("Failed to transfer videos of user %d to user %d. Err: %s", claim.ForeignAccountID, req.UserID, err)
+		return nil, err
+	}
+
+	log.Infof("Transferred %d videos of user %d to user %d", moved, claim.ForeignAccountID, req.UserID)
+
+	return &proto.VideoTransferResult{NumberOfVideos: moved}, nil
+}
+
+func (g GRPCServer) GetRelatedVideos(ctx context.Context, req *proto.RelatedVideosRequest) (*proto.VideoList, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > models.NumRelatedVideos {
+		limit = models.NumRelatedVideos
+	}
+
+	videoIDs, err := g.Related.GetRelatedVideoIDs(req.VideoID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	videos, err := g.VideoModel.GetVideosByIDs(videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.VideoList{Videos: videos, NumberOfVideos: int64(len(videos))}, nil
+}
+
+// precomputeRelatedVideos keeps related videos up to date, so that they rarely need to be computed on request
+func (g GRPCServer) precomputeRelatedVideos() {
+	for {
+		n, err := g.Related.RefreshStaleRelated()
+		if err != nil {
+			log.Errorf("could not refresh related videos. Err: %s", err)
+		}
+
+		// Keep going while there's a backlog
+		if err != nil || n == 0 {
+			time.Sleep(time.Minute)
+		}
+	}
+}
+
+func (g GRPCServer) refreshRatingPrior() {
+	for {
+		if err := g.VideoModel.RefreshRatingPrior(); err != nil {
+			log.Errorf("could not refresh rating prior. Err: %s", err)
+		}
+
+		time.Sleep(time.Hour)
+	}
 }
@@ -1,11 +1,13 @@
 package grpcserver
 
 import (
+	"context"
 	"github.com/horahoradev/horahora/video_service/internal/config"
 	"io"
 	"os"
 	"testing"
 
+	"github.com/horahoradev/horahora/user_service/permissions"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -18,12 +20,19 @@ import (
 	proto "github.com/horahoradev/horahora/video_service/protocol"
 	mocks "github.com/horahoradev/horahora/video_service/protocol/mocks"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
 )
 
 // This is a really huge test... it'd probably be better if I split things up
 func TestGRPCUpload(t *testing.T) {
 	bucketName := "horahora-dev-otomads"
 
+	// Needs the same environment as the service itself (see kubernetes/run-tests.sh)
+	cfg, err := config.New()
+	if err != nil {
+		t.Skipf("video service environment not configured: %s", err)
+	}
+
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
 	defer db.Close()
@@ -44,10 +53,9 @@ func TestGRPCUpload(t *testing.T) {
 	mockClient := usermocks.NewMockUserServiceClient(mockCtrl)
 	mockClient.EXPECT().GetUserForForeignUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&mockResp, nil)
 
-	cfg, err := config.New()
-	assert.NoError(t, err)
-
-	g, err := initGRPCServer(bucketName, sqlxMock, mockClient, true, cfg.RedisConn, "http://localhost")
+	g, err := initGRPCServer(bucketName, sqlxMock, mockClient, true, cfg.RedisConn, "http://localhost",
+		cfg.StorageBackend, cfg.StorageAPIID, cfg.StorageAPIKey, cfg.ApprovalThreshold, cfg.MinioEndpoint,
+		cfg.ViewDedupWindow, cfg.ViewerHashSalt, cfg.ServiceSecret)
 	assert.NoError(t, err)
 
 	file, err := os.Open("../../test_files/NO.mp4")
@@ -79,6 +87,9 @@ func TestGRPCUpload(t *testing.T) {
 		},
 	}
 
+	// Archived videos are only uploaded by the scheduler
+	mockServ.EXPECT().Context().Return(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(permissions.ServiceSecretKey, cfg.ServiceSecret))).AnyTimes()
 	mockServ.EXPECT().Recv().Return(&metaPayload, nil).Times(1)
 	mockServ.EXPECT().Recv().Return(&payload, nil).Times(1)
 	mockServ.EXPECT().Recv().Return(nil, io.EOF).Times(1)
@@ -0,0 +1,325 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/horahoradev/horahora/user_service/verifier"
+	"github.com/horahoradev/horahora/video_service/internal/models"
+	proto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testServiceSecret = "secret"
+
+// serverMethod returns the server's method for the full method name. Some RPCs are named in lower camel case, but their
+// Go methods are exported.
+func serverMethod(fullMethod string) (reflect.Method, bool) {
+	server := reflect.TypeOf((*proto.VideoServiceServer)(nil)).Elem()
+	name := strings.TrimPrefix(fullMethod, "/proto.VideoService/")
+	return server.MethodByName(strings.ToUpper(name[:1]) + name[1:])
+}
+
+// setUserID sets the userID field of the request, which some messages spell user_id
+func setUserID(req reflect.Value, userID int64) {
+	field := req.Elem().FieldByName("UserID")
+	if !field.IsValid() {
+		field = req.Elem().FieldByName("UserId")
+	}
+	field.SetInt(userID)
+}
+
+// Every method with a rule must exist, and unless it's for services, its request must name the calling user, or the
+// interceptor fails the call
+func TestMethodPermissionsNameCallingUser(t *testing.T) {
+	userIDRequest := reflect.TypeOf((*interface{ GetUserID() int64 })(nil)).Elem()
+	userIdRequest := reflect.TypeOf((*interface{ GetUserId() int64 })(nil)).Elem()
+
+	for fullMethod, p := range methodPermissions {
+		assert.True(t, strings.HasPrefix(fullMethod, "/proto.VideoService/"), fullMethod)
+
+		method, ok := serverMethod(fullMethod)
+		if !assert.True(t, ok, fullMethod) {
+			continue
+		}
+
+		if p == permissions.Service {
+			continue
+		}
+
+		// (ctx, req)
+		req := method.Type.In(1)
+		assert.True(t, req.Implements(userIDRequest) || req.Implements(userIdRequest), fullMethod)
+	}
+}
+
+// newTestSigner returns a verifier, and a function which signs access tokens for users that it accepts
+func newTestSigner(t *testing.T) (*verifier.Verifier, func(uid int64) string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	public := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.PS512), Use: "sig"}
+	v := verifier.New(verifier.StaticKeySource(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{public}}))
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.PS512, Key: jose.JSONWebKey{Key: key, KeyID: "test"}}, nil)
+	assert.NoError(t, err)
+
+	return v, func(uid int64) string {
+		claims := verifier.Claims{
+			Claims: jwt.Claims{Issuer: verifier.Issuer, Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+			UID:    uid,
+		}
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		assert.NoError(t, err)
+		return token
+	}
+}
+
+// newInterceptorCall returns a function which calls the method through the interceptor, with the access token and
+// metadata if they aren't empty, and reports whether the call reached the handler
+func newInterceptorCall(t *testing.T, interceptor grpc.UnaryServerInterceptor, fullMethod string) func(token string, userID int64, md ...string) (bool, error) {
+	method, ok := serverMethod(fullMethod)
+	assert.True(t, ok, fullMethod)
+
+	return func(token string, userID int64, md ...string) (bool, error) {
+		if token != "" {
+			md = append(md, permissions.AccessTokenKey, token)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+
+		req := reflect.New(method.Type.In(1).Elem())
+		if userID != 0 {
+			setUserID(req, userID)
+		}
+
+		handled := false
+		_, err := interceptor(ctx, req.Interface(), &grpc.UnaryServerInfo{FullMethod: fullMethod},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				return &proto.Nothing{}, nil
+			})
+		return handled, err
+	}
+}
+
+// Runs every method with a permission through the interceptor, as a regular user, as a regular user claiming to be an
+// admin, as an admin without a token, and finally as an admin
+func TestMethodPermissionsRefuseUnprivilegedCallers(t *testing.T) {
+	const regularID, adminID = 1, 2
+	ranks := map[int64]userproto.UserRank{regularID: userproto.UserRank_regular, adminID: userproto.UserRank_admin}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+	client.EXPECT().
+		HasPermission(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *userproto.PermissionCheck, opts ...grpc.CallOption) (*userproto.PermissionCheckResult, error) {
+			return &userproto.PermissionCheckResult{Allowed: permissions.RankHas(ranks[req.UserID], permissions.Permission(req.Permission))}, nil
+		}).
+		AnyTimes()
+
+	v, sign := newTestSigner(t)
+	interceptor := permissions.UnaryServerInterceptor(client, v, testServiceSecret, methodPermissions)
+
+	for fullMethod, p := range methodPermissions {
+		if p == permissions.Self || p == permissions.SelfOrAnonymous || p == permissions.Service {
+			continue
+		}
+
+		call := newInterceptorCall(t, interceptor, fullMethod)
+		for _, refused := range []struct {
+			token  string
+			userID int64
+			code   codes.Code
+		}{
+			{sign(regularID), regularID, codes.PermissionDenied},
+			{sign(regularID), adminID, codes.PermissionDenied},
+			{"", adminID, codes.Unauthenticated},
+		} {
+			handled, err := call(refused.token, refused.userID)
+			assert.Equal(t, refused.code, status.Code(err), fullMethod)
+			assert.False(t, handled, fullMethod)
+		}
+
+		handled, err := call(sign(adminID), adminID)
+		assert.NoError(t, err, fullMethod)
+		assert.True(t, handled, fullMethod)
+	}
+}
+
+// Methods which act as the request's user only accept calls with that user's token, and service methods only accept
+// calls with the service secret
+func TestMethodPermissionsRefuseOtherCallers(t *testing.T) {
+	const userID, otherUserID = 1, 2
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// No permission is needed, so the user service isn't asked
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+
+	v, sign := newTestSigner(t)
+	interceptor := permissions.UnaryServerInterceptor(client, v, testServiceSecret, methodPermissions)
+
+	for fullMethod, p := range methodPermissions {
+		call := newInterceptorCall(t, interceptor, fullMethod)
+
+		switch p {
+		case permissions.Self, permissions.SelfOrAnonymous:
+			handled, err := call(sign(userID), otherUserID)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), fullMethod)
+			assert.False(t, handled, fullMethod)
+
+			handled, err = call("", otherUserID)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), fullMethod)
+			assert.False(t, handled, fullMethod)
+
+			handled, err = call(sign(userID), userID)
+			assert.NoError(t, err, fullMethod)
+			assert.True(t, handled, fullMethod)
+
+			// Logged out callers leave the user ID 0
+			handled, _ = call("", 0)
+			assert.Equal(t, p == permissions.SelfOrAnonymous, handled, fullMethod)
+
+		case permissions.Service:
+			handled, err := call(sign(userID), 0)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), fullMethod)
+			assert.False(t, handled, fullMethod)
+
+			handled, err = call("", 0, permissions.ServiceSecretKey, "guess")
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), fullMethod)
+			assert.False(t, handled, fullMethod)
+
+			handled, err = call("", 0, permissions.ServiceSecretKey, testServiceSecret)
+			assert.NoError(t, err, fullMethod)
+			assert.True(t, handled, fullMethod)
+		}
+	}
+}
+
+// Approvals count towards publishing a video, so a trusted user mustn't be able to vote under other users' IDs. The
+// approval goes through the interceptor into the real handler, and is only recorded for the user whose token it is.
+func TestApproveVideoRecordsVerifiedApprover(t *testing.T) {
+	const approverID, otherApproverID = 3, 4
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+	client.EXPECT().
+		HasPermission(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *userproto.PermissionCheck, opts ...grpc.CallOption) (*userproto.PermissionCheckResult, error) {
+			return &userproto.PermissionCheckResult{Allowed: permissions.RankHas(userproto.UserRank_trusted, permissions.Permission(req.Permission))}, nil
+		}).
+		AnyTimes()
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	videoModel, err := models.NewVideoModel(sqlx.NewDb(db, "postgres"), client, nil, 2)
+	assert.NoError(t, err)
+	g := GRPCServer{VideoModel: videoModel}
+
+	v, sign := newTestSigner(t)
+	interceptor := permissions.UnaryServerInterceptor(client, v, testServiceSecret, methodPermissions)
+	approve := func(token string, userID int64) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(permissions.AccessTokenKey, token))
+		_, err := interceptor(ctx, &proto.VideoApproval{UserID: userID, VideoID: 5},
+			&grpc.UnaryServerInfo{FullMethod: "/proto.VideoService/ApproveVideo"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return g.ApproveVideo(ctx, req.(*proto.VideoApproval))
+			})
+		return err
+	}
+
+	// Voting as another trusted user is refused before reaching the database
+	assert.Equal(t, codes.PermissionDenied, status.Code(approve(sign(approverID), otherApproverID)))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM videos WHERE id = $1 FOR UPDATE")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title", "is_approved", "is_rejected"}).AddRow(9, "wow", false, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO approvals")).
+		WithArgs(approverID, 5, true, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM approvals WHERE video_id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"approvals", "rejections"}).AddRow(1, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, approve(sign(approverID), approverID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// Videos can only be transferred by the claimant of a completed merge claim, and only from the claimed account
+func TestTransferVideosRequiresClaim(t *testing.T) {
+	const claimantID, otherUserID, foreignID = 3, 4, 7
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+	client.EXPECT().
+		GetClaim(gomock.Any(), &userproto.GetClaimRequest{ClaimID: 1, ClaimantID: claimantID}).
+		Return(&userproto.Claim{ClaimID: 1, ForeignAccountID: foreignID, Completed: true, Mode: userproto.ClaimMode_convert}, nil)
+	client.EXPECT().
+		GetClaim(gomock.Any(), &userproto.GetClaimRequest{ClaimID: 2, ClaimantID: claimantID}).
+		Return(&userproto.Claim{ClaimID: 2, ForeignAccountID: foreignID, Completed: true, Mode: userproto.ClaimMode_merge}, nil)
+	client.EXPECT().
+		GetUserFromID(gomock.Any(), &userproto.GetUserFromIDRequest{UserID: foreignID}).
+		Return(&userproto.UserResponse{UserID: foreignID, MergedInto: claimantID}, nil)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	videoModel, err := models.NewVideoModel(sqlx.NewDb(db, "postgres"), client, nil, 2)
+	assert.NoError(t, err)
+	g := GRPCServer{VideoModel: videoModel, UserClient: client}
+
+	v, sign := newTestSigner(t)
+	interceptor := permissions.UnaryServerInterceptor(client, v, testServiceSecret, methodPermissions)
+	transfer := func(token string, claimID, userID int64) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(permissions.AccessTokenKey, token))
+		_, err := interceptor(ctx, &proto.VideoTransfer{ClaimID: claimID, UserID: userID},
+			&grpc.UnaryServerInfo{FullMethod: "/proto.VideoService/TransferVideos"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return g.TransferVideos(ctx, req.(*proto.VideoTransfer))
+			})
+		return err
+	}
+
+	// Someone else can't move the videos to the claimant, or anywhere else
+	assert.Equal(t, codes.PermissionDenied, status.Code(transfer(sign(otherUserID), 2, claimantID)))
+
+	// Converted accounts keep their videos
+	assert.Equal(t, codes.FailedPrecondition, status.Code(transfer(sign(claimantID), 1, claimantID)))
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET userID = $1 WHERE userID = $2")).
+		WithArgs(claimantID, foreignID).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	assert.NoError(t, transfer(sign(claimantID), 2, claimantID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"context"
+	sql2 "database/sql"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/horahoradev/horahora/user_service/permissions"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRejectionReasonLength = 1024
+	NumPendingVideosPerPage  = 50
+)
+
+// requirePermission checks the user's permissions with the user service. RPCs which always need a permission are
+// authorized by the gRPC server's interceptor instead, see methodPermissions.
+func (v *VideoModel) requirePermission(userID int64, p permissions.Permission) error {
+	return permissions.Require(context.TODO(), v.grpcClient, userID, p)
+}
+
+// ApproveVideo records a trusted user's approval. The video is approved once ApprovalThreshold users have approved it.
+func (v *VideoModel) ApproveVideo(userID, videoID int64) error {
+	return v.voteOnVideo(userID, videoID, true, "")
+}
+
+// RejectVideo records a trusted user's rejection. The video is rejected once ApprovalThreshold users have rejected it,
+// and the uploader is sent the reasons.
+func (v *VideoModel) RejectVideo(userID, videoID int64, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > maxRejectionReasonLength {
+		return status.Errorf(codes.InvalidArgument, "rejection reason must be between 1 and %d characters", maxRejectionReasonLength)
+	}
+
+	return v.voteOnVideo(userID, videoID, false, reason)
+}
+
+// Each user has one vote per video, so voting again replaces the user's previous vote
+func (v *VideoModel) voteOnVideo(userID, videoID int64, approve bool, reason string) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock the video so that concurrent votes can't both cross the threshold
+	var uploaderID int64
+	var title string
+	var approved, rejected bool
+	row := tx.QueryRow("SELECT userid, title, is_approved, COALESCE(is_rejected, false) FROM videos WHERE id = $1 FOR UPDATE", videoID)
+	err = row.Scan(&uploaderID, &title, &approved, &rejected)
+	switch {
+	case err == sql2.ErrNoRows:
+		return status.Error(codes.NotFound, "video does not exist")
+	case err != nil:
+		return err
+	case approved:
+		return status.Error(codes.FailedPrecondition, "video has already been approved")
+	case rejected:
+		return status.Error(codes.FailedPrecondition, "video has already been rejected")
+	}
+
+	sql := "INSERT INTO approvals (user_id, video_id, approved, reason, creation_date) VALUES ($1, $2, $3, $4, Now()) " +
+		"ON CONFLICT (user_id, video_id) DO UPDATE SET approved = EXCLUDED.approved, reason = EXCLUDED.reason, " +
+		"creation_date = EXCLUDED.creation_date"
+	_, err = tx.Exec(sql, userID, videoID, approve, sql2.NullString{String: reason, Valid: !approve})
+	if err != nil {
+		return err
+	}
+
+	var numApprovals, numRejections int
+	sql = "SELECT count(*) FILTER (WHERE approved), count(*) FILTER (WHERE NOT approved) FROM approvals WHERE video_id = $1"
+	if err = tx.QueryRow(sql, videoID).Scan(&numApprovals, &numRejections); err != nil {
+		return err
+	}
+
+	switch {
+	case approve && numApprovals >= v.ApprovalThreshold:
+		if _, err = tx.Exec("UPDATE videos SET is_approved = TRUE WHERE id = $1", videoID); err != nil {
+			return err
+		}
+
+		msg := fmt.Sprintf("Your video \"%s\" has been approved", title)
+		if err = addNotification(tx, uploaderID, videoID, NotificationVideoApproved, msg); err != nil {
+			return err
+		}
+
+	case !approve && numRejections >= v.ApprovalThreshold:
+		if _, err = tx.Exec("UPDATE videos SET is_rejected = TRUE WHERE id = $1", videoID); err != nil {
+			return err
+		}
+
+		var reasons []string
+		if err = tx.Select(&reasons, "SELECT reason FROM approvals WHERE video_id = $1 AND NOT approved ORDER BY creation_date", videoID); err != nil {
+			return err
+		}
+
+		msg := fmt.Sprintf("Your video \"%s\" has been rejected. Reasons: %s", title, strings.Join(reasons, "; "))
+		if err = addNotification(tx, uploaderID, videoID, NotificationVideoRejected, msg); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetPendingVideos returns one page of the moderation queue, oldest first, with the votes cast so far.
+// The second return value is the total number of pending videos.
+func (v *VideoModel) GetPendingVideos(userID, pageNum int64) ([]*videoproto.PendingVideo, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	sql := "SELECT videos.id, videos.title, videos.userid, videos.upload_date, " +
+		"count(approvals.user_id) FILTER (WHERE approvals.approved), " +
+		"count(approvals.user_id) FILTER (WHERE NOT approvals.approved), " +
+		"array_remove(array_agg(approvals.reason ORDER BY approvals.creation_date) FILTER (WHERE NOT approvals.approved), NULL), " +
+		"COALESCE(bool_or(approvals.user_id = $1), false) " +
+		"FROM videos LEFT JOIN approvals ON videos.id = approvals.video_id " +
+		"WHERE videos.is_approved IS NOT TRUE AND videos.is_rejected IS NOT TRUE AND videos.transcoded IS TRUE " +
+		"GROUP BY videos.id ORDER BY videos.upload_date ASC, videos.id ASC LIMIT $2 OFFSET $3"
+
+	rows, err := v.db.Query(sql, userID, NumPendingVideosPerPage, (pageNum-1)*NumPendingVideosPerPage)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var videos []*videoproto.PendingVideo
+	var authorIDs []int64
+	for rows.Next() {
+		var video videoproto.PendingVideo
+		var reasons []string
+
+		err = rows.Scan(&video.VideoID, &video.Title, &video.AuthorID, &video.UploadDate, &video.Approvals,
+			&video.Rejections, pq.Array(&reasons), &video.CurrentUserVoted)
+		if err != nil {
+			log.Errorf("Failed to scan. Err: %s", err)
+			continue
+		}
+
+		video.RejectionReasons = reasons
+		videos = append(videos, &video)
+		authorIDs = append(authorIDs, video.AuthorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, video := range videos {
+		video.AuthorName = usernames[video.AuthorID]
+	}
+
+	var count int64
+	sql = "SELECT count(*) FROM videos WHERE is_approved IS NOT TRUE AND is_rejected IS NOT TRUE AND transcoded IS TRUE"
+	if err = v.db.QueryRow(sql).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	return videos, count, nil
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newApprovalTestModel(t *testing.T, rank userproto.UserRank) (*VideoModel, sqlmock.Sqlmock, func()) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := usermocks.NewMockUserServiceClient(mockCtrl)
+	mockClient.EXPECT().
+		GetUserFromID(gomock.Any(), &userproto.GetUserFromIDRequest{UserID: 1}).
+		Return(&userproto.UserResponse{UserID: 1, Rank: rank}, nil).
+		AnyTimes()
+	mockClient.EXPECT().
+		HasPermission(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *userproto.PermissionCheck, opts ...grpc.CallOption) (*userproto.PermissionCheckResult, error) {
+			allowed := req.UserID == 1 && permissions.RankHas(rank, permissions.Permission(req.Permission))
+			return &userproto.PermissionCheckResult{Allowed: allowed}, nil
+		}).
+		AnyTimes()
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	v := &VideoModel{db: sqlx.NewDb(db, "postgres"), grpcClient: mockClient, ApprovalThreshold: 2}
+	return v, mock, func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+		mockCtrl.Finish()
+	}
+}
+
+func TestApproveVideoBelowThreshold(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM videos WHERE id = $1 FOR UPDATE")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title", "is_approved", "is_rejected"}).AddRow(9, "wow", false, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO approvals")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM approvals WHERE video_id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"approvals", "rejections"}).AddRow(1, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.ApproveVideo(1, 5))
+}
+
+func TestApproveVideoAtThresholdNotifiesUploader(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_admin)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM videos WHERE id = $1 FOR UPDATE")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title", "is_approved", "is_rejected"}).AddRow(9, "wow", false, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO approvals")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM approvals WHERE video_id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"approvals", "rejections"}).AddRow(2, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET is_approved = TRUE WHERE id = $1")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO notifications")).
+		WithArgs(9, 5, NotificationVideoApproved, "Your video \"wow\" has been approved").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.ApproveVideo(1, 5))
+}
+
+func TestVoteOnDecidedVideoFails(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM videos WHERE id = $1 FOR UPDATE")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title", "is_approved", "is_rejected"}).AddRow(9, "wow", true, false))
+	mock.ExpectRollback()
+
+	err := v.RejectVideo(1, 5, "spam")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRejectVideoRequiresReason(t *testing.T) {
+	v := &VideoModel{}
+	assert.Equal(t, codes.InvalidArgument, status.Code(v.RejectVideo(1, 5, "  ")))
+}
+
+// notificationMessageFits matches messages that fit in notifications.message
+type notificationMessageFits struct{}
+
+func (notificationMessageFits) Match(v driver.Value) bool {
+	msg, ok := v.(string)
+	return ok && utf8.RuneCountInString(msg) <= maxNotificationMessageLength
+}
+
+// With a high enough threshold, quoting every rejection reason is longer than a notification can be
+func TestRejectVideoWithLongReasonsNotifiesUploader(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+	v.ApprovalThreshold = 4
+
+	reason := strings.Repeat("ん", maxRejectionReasonLength)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM videos WHERE id = $1 FOR UPDATE")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title", "is_approved", "is_rejected"}).AddRow(9, "wow", false, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO approvals")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM approvals WHERE video_id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"approvals", "rejections"}).AddRow(0, 4))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET is_rejected = TRUE WHERE id = $1")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT reason FROM approvals")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"reason"}).AddRow(reason).AddRow(reason).AddRow(reason).AddRow(reason))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO notifications")).
+		WithArgs(9, 5, NotificationVideoRejected, notificationMessageFits{}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.RejectVideo(1, 5, reason))
+}
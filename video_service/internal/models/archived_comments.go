@@ -0,0 +1,210 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/horahoradev/horahora/video_service/internal/rawmeta"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+)
+
+const archivedCommentInsertBatchSize = 1000
+
+// ImportArchivedComments replaces any previously imported comments for the video, so importing twice is harmless.
+// Threading is restored after insertion from the original site's parent IDs. Authors are attributed afterwards, see
+// AttributeArchivedComments.
+func (v *VideoModel) ImportArchivedComments(videoID int64, comments []rawmeta.ArchivedComment) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM archived_comments WHERE video_id = $1", videoID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	dialect := goqu.Dialect("postgres")
+	for start := 0; start < len(comments); start += archivedCommentInsertBatchSize {
+		end := start + archivedCommentInsertBatchSize
+		if end > len(comments) {
+			end = len(comments)
+		}
+
+		var rows []interface{}
+		for _, c := range comments[start:end] {
+			var foreignParentID interface{}
+			if c.ForeignParentID != "" {
+				foreignParentID = c.ForeignParentID
+			}
+
+			rows = append(rows, goqu.Record{
+				"video_id":          videoID,
+				"foreign_id":        c.ForeignID,
+				"foreign_parent_id": foreignParentID,
+				"foreign_author_id": c.ForeignAuthorID,
+				"author_name":       c.AuthorName,
+				"comment":           c.Content,
+				"like_count":        c.LikeCount,
+				"creation_date":     c.PostedAt,
+			})
+		}
+
+		// The same comment can show up twice if the original site's pagination shifted during the download
+		sql, args, err := dialect.Insert("archived_comments").Prepared(true).Rows(rows...).OnConflict(goqu.DoNothing()).ToSQL()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if _, err = tx.Exec(sql, args...); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	sql := "UPDATE archived_comments AS c SET parent_comment = p.id FROM archived_comments AS p " +
+		"WHERE c.video_id = $1 AND p.video_id = $1 AND c.foreign_parent_id = p.foreign_id"
+	if _, err = tx.Exec(sql, videoID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type archivedCommentAuthor struct {
+	Site            int32  `db:"site"`
+	ForeignAuthorID string `db:"foreign_author_id"`
+	AuthorName      string `db:"author_name"`
+}
+
+// AttributeArchivedComments attributes unattributed archived comments to the foreign users standing in for their
+// authors, like imported videos are, registering them if they don't exist yet. This is kept out of the import so that
+// a video with thousands of commenters doesn't wait on thousands of registrations, and so that one failed registration
+// only leaves that commenter's comments for a later pass. At most limit commenters are handled.
+func (v *VideoModel) AttributeArchivedComments(ctx context.Context, limit int) error {
+	// Picked at random so that commenters who can't be registered don't hold up everyone else
+	sql := "SELECT site, foreign_author_id, author_name FROM (" +
+		"SELECT DISTINCT ON (videos.originalSite, c.foreign_author_id) videos.originalSite AS site, " +
+		"c.foreign_author_id, COALESCE(c.author_name, '') AS author_name " +
+		"FROM archived_comments AS c JOIN videos ON videos.id = c.video_id " +
+		"WHERE c.author_id IS NULL AND c.foreign_author_id <> '') AS authors ORDER BY random() LIMIT $1"
+
+	var authors []archivedCommentAuthor
+	if err := v.db.Select(&authors, sql, limit); err != nil {
+		return err
+	}
+
+	for _, author := range authors {
+		uid, err := v.getOrCreateForeignUser(ctx, author.AuthorName, author.ForeignAuthorID, userproto.Site(author.Site))
+		if err != nil {
+			log.Errorf("could not get user for comment author %s, will retry. Err: %s", author.ForeignAuthorID, err)
+			continue
+		}
+
+		sql = "UPDATE archived_comments AS c SET author_id = $1 FROM videos WHERE videos.id = c.video_id " +
+			"AND videos.originalSite = $2 AND c.foreign_author_id = $3 AND c.author_id IS NULL"
+		if _, err = v.db.Exec(sql, uid, author.Site, author.ForeignAuthorID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetArchivedComments returns one page of archived comments at one level of a thread, like GetComments. The second
+// return value is the total number of archived comments at that level.
+func (v *VideoModel) GetArchivedComments(videoID, parentID, pageNum int64, sortBy videoproto.CommentSort) ([]*videoproto.Comment, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	sql, err := generateArchivedCommentListSQL(videoID, parentID, pageNum, sortBy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := v.db.Query(sql)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var comments []*videoproto.Comment
+	for rows.Next() {
+		comment := videoproto.Comment{Archived: true}
+
+		err = rows.Scan(&comment.CommentId, &comment.VoteScore, &comment.AuthorId, &comment.AuthorUsername,
+			&comment.CreationDate, &comment.Content, &comment.ParentId, &comment.ReplyCount)
+		if err != nil {
+			log.Errorf("Failed to scan. Err: %s", err)
+			continue
+		}
+
+		comment.AuthorProfileImageUrl = "/static/images/placeholder1.jpg"
+		comments = append(comments, &comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+	switch parentID {
+	case 0:
+		err = v.db.QueryRow("SELECT count(*) FROM archived_comments WHERE video_id = $1 AND parent_comment IS NULL", videoID).Scan(&count)
+	default:
+		err = v.db.QueryRow("SELECT count(*) FROM archived_comments WHERE video_id = $1 AND parent_comment = $2", videoID, parentID).Scan(&count)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, count, nil
+}
+
+func generateArchivedCommentListSQL(videoID, parentID, pageNum int64, sortBy videoproto.CommentSort) (string, error) {
+	minResultNum := (pageNum - 1) * NumCommentsPerPage
+	dialect := goqu.Dialect("postgres")
+
+	ds := dialect.
+		Select(
+			"archived_comments.id",
+			"archived_comments.like_count",
+			goqu.COALESCE(goqu.I("archived_comments.author_id"), 0),
+			goqu.COALESCE(goqu.I("archived_comments.author_name"), ""),
+			"archived_comments.creation_date",
+			"archived_comments.comment",
+			goqu.COALESCE(goqu.I("archived_comments.parent_comment"), 0),
+			goqu.L("(SELECT count(*) FROM archived_comments AS replies WHERE replies.parent_comment = archived_comments.id)"),
+		).
+		From(goqu.T("archived_comments")).
+		Where(goqu.I("archived_comments.video_id").Eq(videoID)).
+		Offset(uint(minResultNum)).
+		Limit(NumCommentsPerPage)
+
+	switch parentID {
+	case 0:
+		ds = ds.Where(goqu.I("archived_comments.parent_comment").IsNull())
+	default:
+		ds = ds.Where(goqu.I("archived_comments.parent_comment").Eq(parentID))
+	}
+
+	switch sortBy {
+	case videoproto.CommentSort_top:
+		ds = ds.Order(goqu.I("archived_comments.like_count").Desc(), goqu.I("archived_comments.creation_date").Desc())
+	case videoproto.CommentSort_newest:
+		ds = ds.Order(goqu.I("archived_comments.creation_date").Desc())
+	default:
+		return "", fmt.Errorf("unknown comment sort %d", sortBy)
+	}
+
+	sql, _, err := ds.ToSQL()
+
+	return sql, err
+}
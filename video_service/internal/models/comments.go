@@ -0,0 +1,284 @@
+package models
+
+import (
+	"context"
+	sql2 "database/sql"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/horahoradev/horahora/user_service/permissions"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	NumCommentsPerPage = 50
+	deletedCommentText = "[deleted]"
+)
+
+// MakeComment adds a comment and notifies the video's uploader, or the parent comment's author for replies.
+func (v *VideoModel) MakeComment(userID, videoID, parentID int64, content string) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var commentID int64
+	sql := "INSERT INTO comments (user_id, video_id, parent_comment, comment, creation_date)" +
+		" VALUES ($1, $2, NULLIF($3, 0), $4, Now()) RETURNING id"
+	if err = tx.QueryRow(sql, userID, videoID, parentID, content).Scan(&commentID); err != nil {
+		return err
+	}
+
+	if err = addHotScore(tx, videoID, hotCommentWeight); err != nil {
+		return err
+	}
+
+	var uploaderID int64
+	var title string
+	err = tx.QueryRow("SELECT userid, title FROM videos WHERE id = $1", videoID).Scan(&uploaderID, &title)
+	if err != nil {
+		return err
+	}
+
+	kind, recipientID := NotificationVideoComment, uploaderID
+	if parentID != 0 {
+		kind = NotificationCommentReply
+		if err = tx.QueryRow("SELECT user_id FROM comments WHERE id = $1", parentID).Scan(&recipientID); err != nil {
+			return err
+		}
+	}
+
+	if recipientID != userID {
+		usernames, err := v.getUsernames(context.TODO(), []int64{userID})
+		if err != nil {
+			return err
+		}
+
+		msg := fmt.Sprintf("%s commented on your video \"%s\"", usernames[userID], title)
+		if kind == NotificationCommentReply {
+			msg = fmt.Sprintf("%s replied to your comment on \"%s\"", usernames[userID], title)
+		}
+
+		if err = addCommentNotification(tx, recipientID, videoID, commentID, kind, msg); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// EditComment replaces the content of a comment. Only the author may edit, and deleted comments can't be edited.
+func (v *VideoModel) EditComment(userID, commentID int64, content string) error {
+	authorID, deleted, err := v.getCommentAuthor(commentID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case authorID != userID:
+		return status.Error(codes.PermissionDenied, "only the author can edit a comment")
+	case deleted:
+		return status.Error(codes.FailedPrecondition, "deleted comments can't be edited")
+	}
+
+	sql := "UPDATE comments SET comment = $1, edited_at = Now() WHERE id = $2"
+	_, err = v.db.Exec(sql, content, commentID)
+	return err
+}
+
+// DeleteComment soft deletes a comment so that its replies remain visible. Authors and admins may delete comments.
+func (v *VideoModel) DeleteComment(userID, commentID int64) error {
+	authorID, deleted, err := v.getCommentAuthor(commentID)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		return nil
+	}
+
+	if authorID != userID {
+		if err := v.requirePermission(userID, permissions.CommentDelete); err != nil {
+			return err
+		}
+	}
+
+	sql := "UPDATE comments SET deleted = TRUE WHERE id = $1"
+	_, err = v.db.Exec(sql, commentID)
+	return err
+}
+
+func (v *VideoModel) getCommentAuthor(commentID int64) (int64, bool, error) {
+	var authorID int64
+	var deleted bool
+
+	row := v.db.QueryRow("SELECT user_id, deleted FROM comments WHERE id = $1", commentID)
+	err := row.Scan(&authorID, &deleted)
+	switch {
+	case err == sql2.ErrNoRows:
+		return 0, false, status.Error(codes.NotFound, "comment does not exist")
+	case err != nil:
+		return 0, false, err
+	}
+
+	return authorID, deleted, nil
+}
+
+// MakeUpvote records a vote on a comment: 1 for an upvote, -1 for a downvote, and 0 to retract the user's vote.
+func (v *VideoModel) MakeUpvote(userID, commentID int64, vote int32) error {
+	switch vote {
+	case 0:
+		sql := "DELETE FROM comment_upvotes WHERE user_id = $1 AND comment_id = $2"
+		_, err := v.db.Exec(sql, userID, commentID)
+		return err
+
+	case 1, -1:
+		sql := "INSERT INTO comment_upvotes (user_id, comment_id, vote_score) VALUES ($1, $2, $3)" +
+			"ON CONFLICT (user_id, comment_id) DO update SET vote_score = $4"
+		_, err := v.db.Exec(sql, userID, commentID, vote, vote)
+		return err
+
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid vote %d, must be one of -1, 0 or 1", vote)
+	}
+}
+
+// GetComments returns one page of comments at one level of a thread, i.e. either root comments when parentID is 0, or
+// the direct replies to parentID. The second return value is the total number of comments at that level.
+func (v *VideoModel) GetComments(videoID, currUserID, parentID, pageNum int64, sortBy videoproto.CommentSort) ([]*videoproto.Comment, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	sql, err := generateCommentListSQL(videoID, currUserID, parentID, pageNum, sortBy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var comments []*videoproto.Comment
+	var authorIDs []int64
+
+	rows, err := v.db.Query(sql)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var comment videoproto.Comment
+		var editedDate sql2.NullString
+
+		err = rows.Scan(&comment.CommentId, &comment.VoteScore, &comment.AuthorId,
+			&comment.CreationDate, &comment.Content, &comment.ParentId, &comment.CurrentUserVote,
+			&comment.Deleted, &editedDate, &comment.ReplyCount)
+		if err != nil {
+			log.Errorf("Failed to scan. Err: %s", err)
+			continue
+		}
+
+		comment.EditedDate = editedDate.String
+		comment.CurrentUserHasUpvoted = comment.CurrentUserVote > 0
+
+		if comment.Deleted {
+			// Keep the comment's place in the thread, but nothing else
+			comment.Content = deletedCommentText
+			comment.AuthorId = 0
+		} else {
+			comment.AuthorProfileImageUrl = "/static/images/placeholder1.jpg"
+			authorIDs = append(authorIDs, comment.AuthorId)
+		}
+
+		comments = append(comments, &comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, comment := range comments {
+		if !comment.Deleted {
+			comment.AuthorUsername = usernames[comment.AuthorId]
+		}
+	}
+
+	numberOfComments, err := v.getNumberOfComments(videoID, parentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, numberOfComments, nil
+}
+
+func (v *VideoModel) getNumberOfComments(videoID, parentID int64) (int64, error) {
+	var count int64
+	var row *sql2.Row
+	switch parentID {
+	case 0:
+		row = v.db.QueryRow("SELECT count(*) FROM comments WHERE video_id = $1 AND parent_comment IS NULL", videoID)
+	default:
+		row = v.db.QueryRow("SELECT count(*) FROM comments WHERE video_id = $1 AND parent_comment = $2", videoID, parentID)
+	}
+
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func generateCommentListSQL(videoID, currUserID, parentID, pageNum int64, sortBy videoproto.CommentSort) (string, error) {
+	minResultNum := (pageNum - 1) * NumCommentsPerPage
+	dialect := goqu.Dialect("postgres")
+
+	// Vote totals, the current user's vote, and reply counts are all aggregated here rather than queried per comment
+	ds := dialect.
+		Select(
+			"comments.id",
+			goqu.COALESCE(goqu.SUM(goqu.I("comment_upvotes.vote_score")), 0).As("score"),
+			"comments.user_id",
+			"comments.creation_date",
+			"comments.comment",
+			goqu.COALESCE(goqu.I("comments.parent_comment"), 0),
+			goqu.L("COALESCE(max(CASE WHEN comment_upvotes.user_id = ? THEN comment_upvotes.vote_score END), 0)", currUserID),
+			"comments.deleted",
+			"comments.edited_at",
+			goqu.L("(SELECT count(*) FROM comments AS replies WHERE replies.parent_comment = comments.id)"),
+		).
+		From(goqu.T("comments")).
+		LeftJoin(
+			goqu.T("comment_upvotes"),
+			goqu.On(goqu.Ex{"comments.id": goqu.I("comment_upvotes.comment_id")})).
+		Where(goqu.I("comments.video_id").Eq(videoID)).
+		GroupBy("comments.id").
+		Offset(uint(minResultNum)).
+		Limit(NumCommentsPerPage)
+
+	switch parentID {
+	case 0:
+		ds = ds.Where(goqu.I("comments.parent_comment").IsNull())
+	default:
+		ds = ds.Where(goqu.I("comments.parent_comment").Eq(parentID))
+	}
+
+	switch sortBy {
+	case videoproto.CommentSort_top:
+		ds = ds.Order(goqu.I("score").Desc(), goqu.I("comments.creation_date").Desc())
+	case videoproto.CommentSort_newest:
+		ds = ds.Order(goqu.I("comments.creation_date").Desc())
+	default:
+		return "", fmt.Errorf("unknown comment sort %d", sortBy)
+	}
+
+	sql, _, err := ds.ToSQL()
+
+	return sql, err
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/horahoradev/horahora/video_service/internal/rawmeta"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCommentSQLGenerationRootTop(t *testing.T) {
+	sql, err := generateCommentListSQL(3, 7, 0, 2, videoproto.CommentSort_top)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT \"comments\".\"id\", COALESCE(SUM(\"comment_upvotes\".\"vote_score\"), 0) AS \"score\", \"comments\".\"user_id\", \"comments\".\"creation_date\", \"comments\".\"comment\", COALESCE(\"comments\".\"parent_comment\", 0), COALESCE(max(CASE WHEN comment_upvotes.user_id = 7 THEN comment_upvotes.vote_score END), 0), \"comments\".\"deleted\", \"comments\".\"edited_at\", (SELECT count(*) FROM comments AS replies WHERE replies.parent_comment = comments.id) FROM \"comments\" LEFT JOIN \"comment_upvotes\" ON (\"comments\".\"id\" = \"comment_upvotes\".\"comment_id\") WHERE ((\"comments\".\"video_id\" = 3) AND (\"comments\".\"parent_comment\" IS NULL)) GROUP BY \"comments\".\"id\" ORDER BY \"score\" DESC, \"comments\".\"creation_date\" DESC LIMIT 50 OFFSET 50", sql)
+}
+
+func TestCommentSQLGenerationRepliesNewest(t *testing.T) {
+	sql, err := generateCommentListSQL(3, 7, 9, 1, videoproto.CommentSort_newest)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT \"comments\".\"id\", COALESCE(SUM(\"comment_upvotes\".\"vote_score\"), 0) AS \"score\", \"comments\".\"user_id\", \"comments\".\"creation_date\", \"comments\".\"comment\", COALESCE(\"comments\".\"parent_comment\", 0), COALESCE(max(CASE WHEN comment_upvotes.user_id = 7 THEN comment_upvotes.vote_score END), 0), \"comments\".\"deleted\", \"comments\".\"edited_at\", (SELECT count(*) FROM comments AS replies WHERE replies.parent_comment = comments.id) FROM \"comments\" LEFT JOIN \"comment_upvotes\" ON (\"comments\".\"id\" = \"comment_upvotes\".\"comment_id\") WHERE ((\"comments\".\"video_id\" = 3) AND (\"comments\".\"parent_comment\" = 9)) GROUP BY \"comments\".\"id\" ORDER BY \"comments\".\"creation_date\" DESC LIMIT 50", sql)
+}
+
+func TestCommentSQLGenerationUnknownSort(t *testing.T) {
+	_, err := generateCommentListSQL(3, 7, 0, 1, videoproto.CommentSort(42))
+	assert.Error(t, err)
+}
+
+func TestMakeUpvoteRejectsInvalidVote(t *testing.T) {
+	v := &VideoModel{}
+	assert.Error(t, v.MakeUpvote(1, 1, 2))
+}
+
+func TestArchivedCommentSQLGenerationRootTop(t *testing.T) {
+	sql, err := generateArchivedCommentListSQL(3, 0, 1, videoproto.CommentSort_top)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT \"archived_comments\".\"id\", \"archived_comments\".\"like_count\", COALESCE(\"archived_comments\".\"author_id\", 0), COALESCE(\"archived_comments\".\"author_name\", ''), \"archived_comments\".\"creation_date\", \"archived_comments\".\"comment\", COALESCE(\"archived_comments\".\"parent_comment\", 0), (SELECT count(*) FROM archived_comments AS replies WHERE replies.parent_comment = archived_comments.id) FROM \"archived_comments\" WHERE ((\"archived_comments\".\"video_id\" = 3) AND (\"archived_comments\".\"parent_comment\" IS NULL)) ORDER BY \"archived_comments\".\"like_count\" DESC, \"archived_comments\".\"creation_date\" DESC LIMIT 50", sql)
+}
+
+// Comments are imported without waiting on the user service, and attributed to their authors afterwards
+func TestImportArchivedComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	v := &VideoModel{db: sqlx.NewDb(db, "postgres")}
+
+	posted := time.Unix(1600000000, 0).UTC()
+	comments := []rawmeta.ArchivedComment{
+		{ForeignID: "c1", AuthorName: "otomad fan", ForeignAuthorID: "UCabc", Content: "wow", PostedAt: posted},
+		{ForeignID: "c2", ForeignParentID: "c1", AuthorName: "otomad fan", ForeignAuthorID: "UCabc", Content: "again", PostedAt: posted},
+		{ForeignID: "c3", AuthorName: "someone", Content: "who am i", PostedAt: posted},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM archived_comments WHERE video_id = $1")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// Columns are in alphabetical order, starting with author_name
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "archived_comments"`)).
+		WithArgs(
+			"otomad fan", "wow", posted, "UCabc", "c1", nil, 0, 5,
+			"otomad fan", "again", posted, "UCabc", "c2", "c1", 0, 5,
+			"someone", "who am i", posted, "", "c3", nil, 0, 5,
+		).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE archived_comments AS c SET parent_comment = p.id")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.ImportArchivedComments(5, comments))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// A commenter who can't be registered is left for a later pass without holding up the others
+func TestAttributeArchivedCommentsSkipsFailures(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := usermocks.NewMockUserServiceClient(mockCtrl)
+	client.EXPECT().
+		GetUserForForeignUID(gomock.Any(), &userproto.GetForeignUserRequest{OriginalWebsite: userproto.Site_youtube, ForeignUserID: "UCbad"}).
+		Return(nil, status.Error(codes.Unavailable, "user service is down"))
+	client.EXPECT().
+		GetUserForForeignUID(gomock.Any(), &userproto.GetForeignUserRequest{OriginalWebsite: userproto.Site_youtube, ForeignUserID: "UCabc"}).
+		Return(&userproto.GetForeignUserResponse{NewUID: 7}, nil)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	v := &VideoModel{db: sqlx.NewDb(db, "postgres"), grpcClient: client}
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM archived_comments AS c JOIN videos")).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"site", "foreign_author_id", "author_name"}).
+			AddRow(int32(userproto.Site_youtube), "UCbad", "troll").
+			AddRow(int32(userproto.Site_youtube), "UCabc", "otomad fan"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE archived_comments AS c SET author_id = $1")).
+		WithArgs(7, int32(userproto.Site_youtube), "UCabc").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, v.AttributeArchivedComments(context.Background(), 100))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
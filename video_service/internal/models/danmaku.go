@@ -0,0 +1,152 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/horahoradev/horahora/video_service/internal/rawmeta"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Niconico allows 75 characters, bilibili 100
+	maxDanmakuLength = 100
+	// Upper bound on the number of danmaku returned for one request, regardless of the time range
+	maxDanmakuPerRequest   = 5000
+	danmakuInsertBatchSize = 1000
+)
+
+// DanmakuModel stores time-synced comments, both imported from the original site and posted by horahora users.
+type DanmakuModel struct {
+	db *sqlx.DB
+}
+
+func NewDanmakuModel(db *sqlx.DB) (*DanmakuModel, error) {
+	return &DanmakuModel{db: db}, nil
+}
+
+// ImportDanmaku replaces any previously imported danmaku for the video, so importing twice is harmless.
+func (m *DanmakuModel) ImportDanmaku(videoID int64, danmaku []rawmeta.Danmaku) error {
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM danmaku WHERE video_id = $1 AND user_id IS NULL", videoID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	dialect := goqu.Dialect("postgres")
+	for start := 0; start < len(danmaku); start += danmakuInsertBatchSize {
+		end := start + danmakuInsertBatchSize
+		if end > len(danmaku) {
+			end = len(danmaku)
+		}
+
+		var rows []interface{}
+		for _, d := range danmaku[start:end] {
+			rows = append(rows, goqu.Record{
+				"video_id":          videoID,
+				"foreign_author_id": d.ForeignAuthorID,
+				"position_ms":       d.PositionMs,
+				"content":           truncateRunes(d.Content, maxDanmakuLength),
+				"position":          d.Position,
+				"color":             d.Color,
+				"creation_date":     d.PostedAt,
+			})
+		}
+
+		sql, args, err := dialect.Insert("danmaku").Prepared(true).Rows(rows...).ToSQL()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if _, err = tx.Exec(sql, args...); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return string([]rune(s)[:n])
+}
+
+func (m *DanmakuModel) PostDanmaku(userID, videoID, positionMs int64, content string, position videoproto.DanmakuPosition, color int32) error {
+	switch {
+	case userID == 0:
+		return status.Error(codes.Unauthenticated, "must be logged in to post danmaku")
+	case positionMs < 0:
+		return status.Error(codes.InvalidArgument, "position must not be negative")
+	case content == "" || utf8.RuneCountInString(content) > maxDanmakuLength:
+		return status.Errorf(codes.InvalidArgument, "danmaku must be between 1 and %d characters", maxDanmakuLength)
+	case color < 0 || color > 0xFFFFFF:
+		return status.Error(codes.InvalidArgument, "color must be 0xRRGGBB")
+	}
+
+	if _, ok := videoproto.DanmakuPosition_name[int32(position)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown danmaku position %d", position)
+	}
+
+	sql := "INSERT INTO danmaku (video_id, user_id, position_ms, content, position, color, creation_date) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, Now())"
+	_, err := m.db.Exec(sql, videoID, userID, positionMs, content, position, color)
+	return err
+}
+
+type danmakuRow struct {
+	ID           int64     `db:"id"`
+	UserID       *int64    `db:"user_id"`
+	PositionMs   int64     `db:"position_ms"`
+	Content      string    `db:"content"`
+	Position     int32     `db:"position"`
+	Color        int32     `db:"color"`
+	CreationDate time.Time `db:"creation_date"`
+}
+
+// GetDanmaku returns the danmaku with playback positions in [fromMs, toMs), ordered by position.
+func (m *DanmakuModel) GetDanmaku(videoID, fromMs, toMs int64) ([]*videoproto.Danmaku, error) {
+	if toMs <= fromMs {
+		return nil, status.Error(codes.InvalidArgument, "toMs must be greater than fromMs")
+	}
+
+	var rows []danmakuRow
+	sql := "SELECT id, user_id, position_ms, content, position, color, creation_date FROM danmaku " +
+		"WHERE video_id = $1 AND position_ms >= $2 AND position_ms < $3 ORDER BY position_ms LIMIT $4"
+	if err := m.db.Select(&rows, sql, videoID, fromMs, toMs, maxDanmakuPerRequest); err != nil {
+		return nil, err
+	}
+
+	ret := make([]*videoproto.Danmaku, len(rows))
+	for i, row := range rows {
+		d := videoproto.Danmaku{
+			DanmakuID:    row.ID,
+			PositionMs:   row.PositionMs,
+			Content:      row.Content,
+			Position:     videoproto.DanmakuPosition(row.Position),
+			Color:        row.Color,
+			CreationDate: row.CreationDate.Format(time.RFC3339),
+		}
+
+		if row.UserID != nil {
+			d.AuthorID = *row.UserID
+		}
+
+		ret[i] = &d
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,300 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/horahoradev/horahora/video_service/internal/fingerprint"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Candidates must share at least this many frame hash bands with the new video. Unrelated videos share one or two
+	// by chance, while duplicates share roughly one per matching frame.
+	minSharedBands         = 8
+	maxDuplicateCandidates = 10
+
+	// Thresholds on fingerprint.FrameSimilarity and fingerprint.AudioSimilarity. Unrelated audio scores about 0.5.
+	likelyDuplicateFrameSimilarity  = 0.6
+	likelyDuplicateAudioSimilarity  = 0.65
+	certainDuplicateFrameSimilarity = 0.85
+	certainDuplicateAudioSimilarity = 0.8
+
+	// Reports filed by the duplicate detector rather than a user
+	systemReporterID = 0
+)
+
+type duplicateMatch int
+
+const (
+	notDuplicate duplicateMatch = iota
+	likelyDuplicate
+	certainDuplicate
+)
+
+// classifyDuplicate requires both signals to agree when the videos both have audio, since e.g. two videos of the same
+// game share frames, and two videos using the same song share audio. Videos without audio go on frames alone, but are
+// never certain.
+func classifyDuplicate(frameSimilarity, audioSimilarity float64) duplicateMatch {
+	switch {
+	case frameSimilarity < likelyDuplicateFrameSimilarity:
+		return notDuplicate
+	case audioSimilarity < 0:
+		return likelyDuplicate
+	case audioSimilarity < likelyDuplicateAudioSimilarity:
+		return notDuplicate
+	case frameSimilarity >= certainDuplicateFrameSimilarity && audioSimilarity >= certainDuplicateAudioSimilarity:
+		return certainDuplicate
+	default:
+		return likelyDuplicate
+	}
+}
+
+func (v *VideoModel) GetUnfingerprintedVideos() ([]UnencodedVideo, error) {
+	sql := "SELECT id, newLink FROM videos WHERE transcoded = true AND fingerprinted = false ORDER BY id LIMIT 100"
+	var videos []UnencodedVideo
+	err := v.db.Select(&videos, sql)
+	if err != nil {
+		return nil, err
+	}
+
+	return videos, nil
+}
+
+func (v *VideoModel) MarkVideoFingerprinted(videoID int64) error {
+	_, err := v.db.Exec("UPDATE videos SET fingerprinted = true WHERE id = $1", videoID)
+	return err
+}
+
+// SaveFingerprint stores the video's fingerprint and its lookup bands, replacing any previous fingerprint
+func (v *VideoModel) SaveFingerprint(videoID int64, fp *fingerprint.Fingerprint) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	frameHashes := make([]int64, len(fp.FrameHashes))
+	for i, h := range fp.FrameHashes {
+		frameHashes[i] = int64(h)
+	}
+
+	audioHashes := make([]int64, len(fp.AudioHashes))
+	for i, h := range fp.AudioHashes {
+		audioHashes[i] = int64(h)
+	}
+
+	sql := "INSERT INTO video_fingerprints (video_id, frame_hashes, audio_hashes, creation_date) VALUES ($1, $2, $3, Now()) " +
+		"ON CONFLICT (video_id) DO UPDATE SET frame_hashes = EXCLUDED.frame_hashes, audio_hashes = EXCLUDED.audio_hashes, " +
+		"creation_date = EXCLUDED.creation_date"
+	if _, err = tx.Exec(sql, videoID, pq.Array(frameHashes), pq.Array(audioHashes)); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM video_frame_hash_bands WHERE video_id = $1", videoID); err != nil {
+		return err
+	}
+
+	bands, values := frameHashBands(fp.FrameHashes)
+	sql = "INSERT INTO video_frame_hash_bands (video_id, band, value) " +
+		"SELECT $1, band, value FROM unnest($2::smallint[], $3::int[]) AS b(band, value) ON CONFLICT DO NOTHING"
+	if _, err = tx.Exec(sql, videoID, pq.Array(bands), pq.Array(values)); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("UPDATE videos SET fingerprinted = true WHERE id = $1", videoID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// frameHashBands returns the distinct (band, value) pairs of the hashes as parallel arrays
+func frameHashBands(hashes []uint64) ([]int64, []int64) {
+	type bandValue struct {
+		band  int
+		value uint16
+	}
+
+	seen := make(map[bandValue]bool)
+	var bands, values []int64
+	for _, h := range hashes {
+		for band, value := range fingerprint.Bands(h) {
+			bv := bandValue{band, value}
+			if seen[bv] {
+				continue
+			}
+			seen[bv] = true
+
+			bands = append(bands, int64(band))
+			values = append(values, int64(value))
+		}
+	}
+
+	return bands, values
+}
+
+func (v *VideoModel) getFingerprint(videoID int64) (*fingerprint.Fingerprint, error) {
+	var frameHashes, audioHashes []int64
+	sql := "SELECT frame_hashes, audio_hashes FROM video_fingerprints WHERE video_id = $1"
+	err := v.db.QueryRow(sql, videoID).Scan(pq.Array(&frameHashes), pq.Array(&audioHashes))
+	if err != nil {
+		return nil, err
+	}
+
+	fp := fingerprint.Fingerprint{
+		FrameHashes: make([]uint64, len(frameHashes)),
+		AudioHashes: make([]uint32, len(audioHashes)),
+	}
+	for i, h := range frameHashes {
+		fp.FrameHashes[i] = uint64(h)
+	}
+	for i, h := range audioHashes {
+		fp.AudioHashes[i] = uint32(h)
+	}
+
+	return &fp, nil
+}
+
+func (v *VideoModel) findDuplicateCandidates(videoID int64, fp *fingerprint.Fingerprint) ([]int64, error) {
+	bands, values := frameHashBands(fp.FrameHashes)
+	if len(bands) == 0 {
+		return nil, nil
+	}
+
+	sql := "SELECT video_id FROM video_frame_hash_bands " +
+		"JOIN unnest($1::smallint[], $2::int[]) AS b(band, value) USING (band, value) " +
+		"WHERE video_id != $3 GROUP BY video_id HAVING count(*) >= $4 ORDER BY count(*) DESC LIMIT $5"
+
+	var candidates []int64
+	err := v.db.Select(&candidates, sql, pq.Array(bands), pq.Array(values), videoID, minSharedBands, maxDuplicateCandidates)
+	return candidates, err
+}
+
+type videoSource struct {
+	OriginalSite int    `db:"originalsite"`
+	OriginalLink string `db:"originallink"`
+}
+
+func (v *VideoModel) getVideoSource(videoID int64) (*videoSource, error) {
+	var source videoSource
+	sql := "SELECT COALESCE(originalSite, 0) AS originalsite, COALESCE(originalLink, '') AS originallink FROM videos WHERE id = $1"
+	if err := v.db.Get(&source, sql, videoID); err != nil {
+		return nil, err
+	}
+
+	return &source, nil
+}
+
+// CheckForDuplicates compares the video against existing videos with similar frames. The same video archived from two
+// different sites is linked as a mirror when the match is certain; any other likely duplicate is reported to
+// moderators.
+func (v *VideoModel) CheckForDuplicates(videoID int64, fp *fingerprint.Fingerprint) error {
+	candidates, err := v.findDuplicateCandidates(videoID, fp)
+	if err != nil {
+		return err
+	}
+
+	source, err := v.getVideoSource(videoID)
+	if err != nil {
+		return err
+	}
+
+	var likely []duplicateCandidate
+	for _, candidateID := range candidates {
+		candidate, err := v.getFingerprint(candidateID)
+		if err != nil {
+			log.Errorf("Could not fetch fingerprint for video %d. Err: %s", candidateID, err)
+			continue
+		}
+
+		frameSimilarity := fingerprint.FrameSimilarity(fp.FrameHashes, candidate.FrameHashes)
+		audioSimilarity := fingerprint.AudioSimilarity(fp.AudioHashes, candidate.AudioHashes)
+
+		match := classifyDuplicate(frameSimilarity, audioSimilarity)
+		if match == notDuplicate {
+			continue
+		}
+
+		log.Infof("Video %d looks like a duplicate of %d (frames %.2f, audio %.2f)", videoID, candidateID,
+			frameSimilarity, audioSimilarity)
+
+		candidateSource, err := v.getVideoSource(candidateID)
+		if err != nil {
+			return err
+		}
+
+		crossSite := source.OriginalLink != "" && candidateSource.OriginalLink != "" &&
+			source.OriginalSite != candidateSource.OriginalSite
+
+		if match == certainDuplicate && crossSite {
+			if err = v.linkMirrors(videoID, candidateID, frameSimilarity); err != nil {
+				return err
+			}
+			continue
+		}
+
+		likely = append(likely, duplicateCandidate{VideoID: candidateID, FrameSimilarity: frameSimilarity,
+			AudioSimilarity: audioSimilarity})
+	}
+
+	if len(likely) == 0 {
+		return nil
+	}
+
+	// The detector only has one open report per video, so it names every candidate
+	sql := "INSERT INTO reports (reporter_id, content_type, content_id, reason, details, creation_date) " +
+		"VALUES ($1, $2, $3, $4, $5, Now()) " +
+		"ON CONFLICT (reporter_id, content_type, content_id) WHERE NOT resolved DO UPDATE SET details = EXCLUDED.details"
+	_, err = v.db.Exec(sql, systemReporterID, videoproto.ContentType_video_content, videoID,
+		videoproto.ReportReason_duplicate, duplicateReportDetails(likely))
+	return err
+}
+
+type duplicateCandidate struct {
+	VideoID         int64
+	FrameSimilarity float64
+	AudioSimilarity float64
+}
+
+// duplicateReportDetails describes the candidates, which must be non-empty, for moderators
+func duplicateReportDetails(candidates []duplicateCandidate) string {
+	described := make([]string, len(candidates))
+	for i, c := range candidates {
+		described[i] = fmt.Sprintf("video %d (frame similarity %.2f, audio similarity %.2f)", c.VideoID,
+			c.FrameSimilarity, c.AudioSimilarity)
+	}
+
+	return "Likely duplicate of " + strings.Join(described, ", ")
+}
+
+func (v *VideoModel) linkMirrors(videoID, mirrorID int64, similarity float64) error {
+	sql := "INSERT INTO video_mirrors (video_id, mirror_video_id, similarity) VALUES ($1, $2, $3), ($2, $1, $3) " +
+		"ON CONFLICT DO NOTHING"
+	_, err := v.db.Exec(sql, videoID, mirrorID, similarity)
+	return err
+}
+
+func (v *VideoModel) getMirrors(videoID int64) ([]*videoproto.VideoMirror, error) {
+	sql := "SELECT videos.id, COALESCE(videos.originalSite, 0), COALESCE(videos.originalLink, '') FROM video_mirrors " +
+		"JOIN videos ON videos.id = video_mirrors.mirror_video_id WHERE video_mirrors.video_id = $1 ORDER BY videos.id"
+
+	rows, err := v.db.Query(sql, videoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mirrors []*videoproto.VideoMirror
+	for rows.Next() {
+		var mirror videoproto.VideoMirror
+		if err = rows.Scan(&mirror.VideoID, &mirror.OriginalSite, &mirror.OriginalLink); err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, &mirror)
+	}
+
+	return mirrors, rows.Err()
+}
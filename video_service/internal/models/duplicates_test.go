@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyDuplicate(t *testing.T) {
+	assert.Equal(t, certainDuplicate, classifyDuplicate(0.9, 0.85))
+	assert.Equal(t, likelyDuplicate, classifyDuplicate(0.7, 0.85))
+	assert.Equal(t, likelyDuplicate, classifyDuplicate(0.95, 0.7))
+	// Same frames, different audio, e.g. two playthroughs of the same game
+	assert.Equal(t, notDuplicate, classifyDuplicate(0.95, 0.5))
+	// Same audio, different frames, e.g. two videos using the same song
+	assert.Equal(t, notDuplicate, classifyDuplicate(0.2, 0.95))
+	// No audio to compare
+	assert.Equal(t, likelyDuplicate, classifyDuplicate(0.95, -1))
+}
+
+func TestFrameHashBandsDeduplicates(t *testing.T) {
+	bands, values := frameHashBands([]uint64{0x0001000200030004, 0x0001000200030005})
+	assert.Equal(t, []int64{0, 1, 2, 3, 0}, bands)
+	assert.Equal(t, []int64{4, 3, 2, 1, 5}, values)
+}
+
+func TestDuplicateReportDetailsNamesEveryCandidate(t *testing.T) {
+	details := duplicateReportDetails([]duplicateCandidate{
+		{VideoID: 3, FrameSimilarity: 0.7, AudioSimilarity: 0.9},
+		{VideoID: 8, FrameSimilarity: 0.95, AudioSimilarity: -1},
+	})
+	assert.Equal(t, "Likely duplicate of video 3 (frame similarity 0.70, audio similarity 0.90), "+
+		"video 8 (frame similarity 0.95, audio similarity -1.00)", details)
+}
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const NumFeedVideosPerPage = 50
+
+func (v *VideoModel) FollowTag(userID int64, tag string) error {
+	if userID == 0 {
+		return status.Error(codes.Unauthenticated, "must be logged in to follow tags")
+	}
+
+	tag, err := resolveTag(v.db, tag)
+	if err != nil {
+		return err
+	}
+
+	if err = validateTag(tag); err != nil {
+		return err
+	}
+
+	sql := "INSERT INTO tag_follows (user_id, tag, creation_date) VALUES ($1, $2, Now()) ON CONFLICT DO NOTHING"
+	_, err = v.db.Exec(sql, userID, tag)
+	return err
+}
+
+func (v *VideoModel) UnfollowTag(userID int64, tag string) error {
+	tag, err := resolveTag(v.db, tag)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.db.Exec("DELETE FROM tag_follows WHERE user_id = $1 AND tag = $2", userID, tag)
+	return err
+}
+
+func (v *VideoModel) GetFollowedTags(userID int64) ([]string, error) {
+	var tags []string
+	err := v.db.Select(&tags, "SELECT tag FROM tag_follows WHERE user_id = $1 ORDER BY tag", userID)
+	return tags, err
+}
+
+// The feed is paginated on (upload_date, id) rather than by offset, so that new uploads don't shift later pages.
+// Cursors are the upload date in microseconds and the ID of the last video on the previous page.
+type feedCursor struct {
+	uploadDate time.Time
+	videoID    int64
+}
+
+func (c feedCursor) String() string {
+	return fmt.Sprintf("%d_%d", c.uploadDate.UnixNano()/int64(time.Microsecond), c.videoID)
+}
+
+func parseFeedCursor(cursor string) (*feedCursor, error) {
+	parts := strings.Split(cursor, "_")
+	if len(parts) != 2 {
+		return nil, status.Error(codes.InvalidArgument, "invalid feed cursor")
+	}
+
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid feed cursor")
+	}
+
+	videoID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid feed cursor")
+	}
+
+	return &feedCursor{
+		uploadDate: time.Unix(0, micros*int64(time.Microsecond)).UTC(),
+		videoID:    videoID,
+	}, nil
+}
+
+// Postgres timestamps have microsecond precision and no time zone
+const feedTimestampFormat = "2006-01-02 15:04:05.999999"
+
+func generateFeedSQL(withCursor bool) string {
+	sql := "SELECT videos.id, videos.title, videos.userid, videos.newlink, videos.views, videos.upload_date, " +
+		"COALESCE(videos.rating, 0) FROM videos " +
+		"WHERE videos.is_approved IS TRUE AND videos.transcoded IS TRUE AND videos.is_rejected IS NOT TRUE AND " +
+		"videos.upload_date IS NOT NULL AND (videos.userid = ANY($1) OR EXISTS(SELECT 1 FROM tags " +
+		"JOIN tag_follows ON tag_follows.tag = tags.tag WHERE tags.video_id = videos.id AND tag_follows.user_id = $2)) "
+
+	if withCursor {
+		sql += "AND (videos.upload_date, videos.id) < ($4::timestamp, $5) "
+	}
+
+	return sql + "ORDER BY videos.upload_date DESC, videos.id DESC LIMIT $3"
+}
+
+// GetFeed returns a page of recent approved videos uploaded by users the user follows, or tagged with tags they
+// follow, and the cursor for the next page.
+func (v *VideoModel) GetFeed(userID int64, cursor string) ([]*videoproto.Video, string, error) {
+	if userID == 0 {
+		return nil, "", status.Error(codes.Unauthenticated, "must be logged in to view your feed")
+	}
+
+	following, err := v.grpcClient.GetFollowing(context.TODO(), &userproto.GetFollowingRequest{UserID: userID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One extra video tells us whether there's another page
+	args := []interface{}{pq.Array(following.UserIDs), userID, NumFeedVideosPerPage + 1}
+	if cursor != "" {
+		c, err := parseFeedCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, c.uploadDate.Format(feedTimestampFormat), c.videoID)
+	}
+
+	rows, err := v.db.Query(generateFeedSQL(cursor != ""), args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var videos []*videoproto.Video
+	var uploadDates []time.Time
+	var authorIDs []int64
+	for rows.Next() {
+		var video videoproto.Video
+		var authorID, views int64
+		var mpdLoc string
+		var uploadDate time.Time
+		err = rows.Scan(&video.VideoID, &video.VideoTitle, &authorID, &mpdLoc, &views, &uploadDate, &video.Rating)
+		if err != nil {
+			return nil, "", err
+		}
+
+		video.Views = uint64(views)
+		video.ThumbnailLoc = strings.Replace(mpdLoc, ".mpd", ".jpg", 1)
+		video.UploadDate = uploadDate.Format(time.RFC3339Nano)
+
+		videos = append(videos, &video)
+		uploadDates = append(uploadDates, uploadDate)
+		authorIDs = append(authorIDs, authorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(videos) > NumFeedVideosPerPage {
+		videos = videos[:NumFeedVideosPerPage]
+		last := NumFeedVideosPerPage - 1
+		nextCursor = feedCursor{uploadDate: uploadDates[last], videoID: videos[last].VideoID}.String()
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, video := range videos {
+		video.AuthorName = usernames[authorIDs[i]]
+	}
+
+	return videos, nextCursor, nil
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFeedCursorRoundTrip(t *testing.T) {
+	c := feedCursor{uploadDate: time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC), videoID: 42}
+
+	parsed, err := parseFeedCursor(c.String())
+	assert.NoError(t, err)
+	assert.Equal(t, c, *parsed)
+	assert.Equal(t, "2021-03-04 05:06:07.123456", parsed.uploadDate.Format(feedTimestampFormat))
+
+	for _, cursor := range []string{"abc", "1_2_3", "x_2", "1_y"} {
+		_, err = parseFeedCursor(cursor)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), cursor)
+	}
+}
+
+func TestGetFeedPagination(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockClient := usermocks.NewMockUserServiceClient(mockCtrl)
+	mockClient.EXPECT().
+		GetFollowing(gomock.Any(), &userproto.GetFollowingRequest{UserID: 1}).
+		Return(&userproto.FollowingList{UserIDs: []int64{2}}, nil)
+	mockClient.EXPECT().
+		GetUsersByIDs(gomock.Any(), gomock.Any()).
+		Return(&userproto.UsersResponse{Users: []*userproto.UserResponse{{UserID: 2, Username: "otomad"}}}, nil)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	v := &VideoModel{db: sqlx.NewDb(db, "postgres"), grpcClient: mockClient}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "userid", "newlink", "views", "upload_date", "rating"})
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= NumFeedVideosPerPage; i++ {
+		rows.AddRow(100-i, "title", 2, "a.mpd", 0, start.Add(-time.Duration(i)*time.Minute), 0.0)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("AND (videos.upload_date, videos.id) < ($4::timestamp, $5) ORDER BY")).
+		WithArgs(sqlmock.AnyArg(), 1, NumFeedVideosPerPage+1, "2021-01-02 00:00:00", 7).
+		WillReturnRows(rows)
+
+	videos, nextCursor, err := v.GetFeed(1, feedCursor{uploadDate: start.Add(24 * time.Hour), videoID: 7}.String())
+	assert.NoError(t, err)
+	assert.Len(t, videos, NumFeedVideosPerPage)
+	assert.Equal(t, "otomad", videos[0].AuthorName)
+	assert.Equal(t, "a.jpg", videos[0].ThumbnailLoc)
+
+	last := videos[NumFeedVideosPerPage-1]
+	expected := feedCursor{uploadDate: start.Add(-time.Duration(NumFeedVideosPerPage-1) * time.Minute), videoID: last.VideoID}
+	assert.Equal(t, expected.String(), nextCursor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
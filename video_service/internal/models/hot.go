@@ -0,0 +1,39 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Hot scores decay by half every hotScoreHalfLife. Rather than decaying every score over time, each event's weight
+// is scaled up by how long after hotScoreEpoch it happened, which orders videos the same way. Scores are kept in log
+// space so they don't overflow; see hot_score_add in V17__trending.sql.
+var hotScoreEpoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const (
+	hotScoreHalfLife = 24 * time.Hour
+	// Weights are relative to a single view
+	hotViewWeight    = 1.0
+	hotCommentWeight = 5.0
+	// A 10/10 rating is worth this many views, lower ratings proportionally less
+	hotRatingWeight = 10.0
+)
+
+// hotScoreIncrement is the log-space score of an event with the given weight at time t.
+func hotScoreIncrement(weight float64, t time.Time) float64 {
+	return math.Log(weight) + t.Sub(hotScoreEpoch).Seconds()*math.Ln2/hotScoreHalfLife.Seconds()
+}
+
+// addHotScore adds an event to the video's hot score. It takes an Execer so that it can be part of the transaction
+// recording the event.
+func addHotScore(e sqlx.Execer, videoID int64, weight float64) error {
+	if weight <= 0 {
+		return nil
+	}
+
+	sql := "UPDATE videos SET hot_score = hot_score_add(hot_score, $1) WHERE id = $2"
+	_, err := e.Exec(sql, hotScoreIncrement(weight, time.Now()), videoID)
+	return err
+}
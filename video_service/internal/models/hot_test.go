@@ -0,0 +1,22 @@
+package models
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotScoreIncrementHalvesEachHalfLife(t *testing.T) {
+	assert.InDelta(t, 0, hotScoreIncrement(1, hotScoreEpoch), 1e-9)
+
+	// Two views at the epoch are worth one view a half life later
+	later := hotScoreEpoch.Add(hotScoreHalfLife)
+	assert.InDelta(t, hotScoreIncrement(2, hotScoreEpoch), hotScoreIncrement(1, later), 1e-9)
+
+	// A year on, scores are still far from overflowing since they're kept in log space
+	year := hotScoreIncrement(hotCommentWeight, hotScoreEpoch.Add(365*24*time.Hour))
+	assert.False(t, math.IsInf(year, 0))
+	assert.InDelta(t, math.Log(hotCommentWeight)+365*math.Ln2, year, 1e-6)
+}
@@ -4,15 +4,18 @@ package models
 
 import (
 	"context"
+	"fmt"
 	proto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/horahoradev/horahora/video_service/internal/config"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/stretchr/testify/assert"
 	"log"
 	"testing"
+	"time"
 )
 
 var v *VideoModel
+var videoID int64
 
 func init() {
 	cfg, err := config.New()
@@ -34,12 +37,12 @@ func init() {
 		}
 	}
 
-	v, err = NewVideoModel(cfg.SqlClient, cfg.UserClient, cfg.RedisConn)
+	v, err = NewVideoModel(cfg.SqlClient, cfg.UserClient, cfg.RedisConn, cfg.ApprovalThreshold)
 	if err != nil {
 		log.Panic(err)
 	}
 
-	_, err = v.SaveForeignVideo(context.Background(), "mytestvideo", "wow", "", "0",
+	videoID, err = v.SaveForeignVideo(context.Background(), "mytestvideo", "wow", "", "0",
 		0, "", "testlocation", "newLoc", []string{"test"}, 10)
 	if err != nil {
 		log.Panic(err)
@@ -48,7 +51,7 @@ func init() {
 
 func TestSaveForeignVideoAndObtainVideoList(t *testing.T) {
 
-	videoList, err := v.GetVideoList(videoproto.SortDirection_desc, 0)
+	videoList, err := v.GetVideoList(videoproto.SortDirection_desc, 1, 0, "", true, videoproto.OrderCategory_upload_date)
 	assert.NoError(t, err)
 
 	var videoListContainsVideo bool
@@ -63,24 +66,39 @@ func TestSaveForeignVideoAndObtainVideoList(t *testing.T) {
 
 func TestRating(t *testing.T) {
 	// Ensure that we can add ratings
-	err := v.AddRatingToVideoID("0", "0", 10.0)
+	err := v.AddRatingToVideoID(10, videoID, 10.0)
 	assert.NoError(t, err)
 
 	// Ensure that the video info reflects the rating that we added
-	info, err := v.getBasicVideoInfo(10, "0")
+	info, err := v.GetVideoInfo(fmt.Sprintf("%d", videoID))
 	assert.NoError(t, err)
-	assert.Equal(t, 10.0, info.rating)
+	assert.Equal(t, 10.0, info.Rating)
 }
 
 func TestViews(t *testing.T) {
-	info, err := v.getBasicVideoInfo(10, "0")
+	cfg, err := config.New()
+	assert.NoError(t, err)
+
+	views, err := NewViewModel(cfg.SqlClient, cfg.RedisConn, time.Hour, "")
+	assert.NoError(t, err)
+
+	info, err := v.GetVideoInfo(fmt.Sprintf("%d", videoID))
+	assert.NoError(t, err)
+	originalViews := info.Views
+
+	counted, err := views.RecordView(videoID, 10, "")
+	assert.NoError(t, err)
+	assert.True(t, counted)
+
+	// Repeat views are deduplicated
+	counted, err = views.RecordView(videoID, 10, "")
 	assert.NoError(t, err)
-	originalViews := info.views
+	assert.False(t, counted)
 
-	err = v.IncrementViewsForVideo("0")
+	err = views.FlushViews()
 	assert.NoError(t, err)
 
-	info, err = v.getBasicVideoInfo(10, "0")
+	info, err = v.GetVideoInfo(fmt.Sprintf("%d", videoID))
 	assert.NoError(t, err)
-	assert.Equal(t, originalViews+1, info.views)
+	assert.Equal(t, originalViews+1, info.Views)
 }
@@ -7,25 +7,43 @@ import (
 )
 
 func TestSQLGeneration(t *testing.T) {
-	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "", true)
+	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "", true, videoproto.OrderCategory_upload_date)
 	assert.NoError(t, err)
-	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\" FROM \"videos\" ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
+	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\", \"views\", COALESCE(\"rating\", 0) AS \"avg_rating\" FROM \"videos\" WHERE ((\"is_rejected\" IS NOT TRUE) AND (\"transcoded\" IS TRUE)) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
 }
 
 func TestSQLGenerationWithUser(t *testing.T) {
-	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 1, "", true)
+	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 1, "", true, videoproto.OrderCategory_upload_date)
 	assert.NoError(t, err)
-	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\" FROM \"videos\" WHERE (\"userid\" = 1) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
+	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\", \"views\", COALESCE(\"rating\", 0) AS \"avg_rating\" FROM \"videos\" WHERE ((\"userid\" = 1) AND (\"is_rejected\" IS NOT TRUE) AND (\"transcoded\" IS TRUE)) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
 }
 
 func TestSQLGenerationWithTag(t *testing.T) {
-	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "wow", true)
+	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "wow", true, videoproto.OrderCategory_upload_date)
 	assert.NoError(t, err)
-	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\" FROM \"videos\" INNER JOIN \"tags\" ON (\"videos\".\"id\" = \"tags\".\"video_id\") WHERE (\"tag\" = 'wow') ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
+	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\", \"views\", COALESCE(\"rating\", 0) AS \"avg_rating\" FROM \"videos\" INNER JOIN \"tags\" ON (\"videos\".\"id\" = \"tags\".\"video_id\") WHERE ((\"tag\" = 'wow') AND (\"is_rejected\" IS NOT TRUE) AND (\"transcoded\" IS TRUE)) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
 }
 
 func TestSQLGenerationWithTagApprovedOnly(t *testing.T) {
-	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "wow", false)
+	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "wow", false, videoproto.OrderCategory_upload_date)
 	assert.NoError(t, err)
-	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\" FROM \"videos\" INNER JOIN \"tags\" ON (\"videos\".\"id\" = \"tags\".\"video_id\") WHERE ((\"tag\" = 'wow') AND (\"is_approved\" IS TRUE)) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
+	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\", \"views\", COALESCE(\"rating\", 0) AS \"avg_rating\" FROM \"videos\" INNER JOIN \"tags\" ON (\"videos\".\"id\" = \"tags\".\"video_id\") WHERE ((\"tag\" = 'wow') AND (\"is_approved\" IS TRUE) AND (\"transcoded\" IS TRUE)) ORDER BY \"upload_date\" ASC LIMIT 50 OFFSET 50")
+}
+
+func TestSQLGenerationOrderByRating(t *testing.T) {
+	sql, err := generateVideoListSQL(videoproto.SortDirection_asc, 2, 0, "", true, videoproto.OrderCategory_rating)
+	assert.NoError(t, err)
+	assert.Equal(t, sql, "SELECT \"videos\".\"id\", \"title\", \"userid\", \"newlink\", \"views\", COALESCE(\"rating\", 0) AS \"avg_rating\" FROM \"videos\" WHERE ((\"is_rejected\" IS NOT TRUE) AND (\"transcoded\" IS TRUE)) ORDER BY \"bayesian_rating\" ASC LIMIT 50 OFFSET 50")
+}
+
+func TestSQLGenerationOrderByHot(t *testing.T) {
+	sql, err := generateVideoListSQL(videoproto.SortDirection_desc, 1, 0, "", false, videoproto.OrderCategory_hot)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "ORDER BY \"hot_score\" DESC, \"upload_date\" DESC LIMIT 50")
+}
+
+func TestSQLGenerationOrderByTrending(t *testing.T) {
+	sql, err := generateVideoListSQL(videoproto.SortDirection_desc, 1, 0, "", false, videoproto.OrderCategory_trending_week)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "ORDER BY \"views_week\" DESC, \"upload_date\" DESC LIMIT 50")
 }
@@ -0,0 +1,187 @@
+package models
+
+import (
+	sql2 "database/sql"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Notification kinds
+const (
+	NotificationVideoApproved = "video_approved"
+	NotificationVideoRejected = "video_rejected"
+	NotificationVideoHidden   = "video_hidden"
+	NotificationVideoEncoded  = "video_encoded"
+	NotificationVideoComment  = "video_comment"
+	NotificationCommentReply  = "comment_reply"
+)
+
+const (
+	NumNotificationsPerPage     = 50
+	maxNotificationKindLength   = 60
+	maxNotificationMessageBytes = 4096
+	// notifications.message is a varchar(4096), which counts characters rather than bytes
+	maxNotificationMessageLength = 4096
+)
+
+// addNotification queues a notification for the user. It takes an Execer so that the notification can be written in
+// the same transaction as whatever caused it. videoID may be 0 for notifications that aren't about a video.
+func addNotification(e sqlx.Execer, userID, videoID int64, kind, message string) error {
+	sql := "INSERT INTO notifications (user_id, video_id, kind, message, creation_date) " +
+		"VALUES ($1, NULLIF($2, 0), $3, $4, Now())"
+	_, err := e.Exec(sql, userID, videoID, kind, fitNotificationMessage(message))
+	return err
+}
+
+// fitNotificationMessage shortens messages that are built from user input, like a rejection quoting every moderator's
+// reason, so that they fit in the message column instead of failing whatever caused the notification.
+func fitNotificationMessage(message string) string {
+	if utf8.RuneCountInString(message) <= maxNotificationMessageLength {
+		return message
+	}
+
+	return truncateRunes(message, maxNotificationMessageLength-1) + "…"
+}
+
+func addCommentNotification(e sqlx.Execer, userID, videoID, commentID int64, kind, message string) error {
+	sql := "INSERT INTO notifications (user_id, video_id, comment_id, kind, message, creation_date) " +
+		"VALUES ($1, $2, $3, $4, $5, Now())"
+	_, err := e.Exec(sql, userID, videoID, commentID, kind, fitNotificationMessage(message))
+	return err
+}
+
+// AddNotification sends the same notification to each user. It's used for events from other services.
+func (v *VideoModel) AddNotification(userIDs []int64, videoID int64, kind, message string) error {
+	switch {
+	case kind == "" || len(kind) > maxNotificationKindLength:
+		return status.Errorf(codes.InvalidArgument, "notification kinds must be between 1 and %d bytes", maxNotificationKindLength)
+	case len(message) > maxNotificationMessageBytes:
+		return status.Errorf(codes.InvalidArgument, "notification messages must be at most %d bytes", maxNotificationMessageBytes)
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, userID := range userIDs {
+		if err = addNotification(tx, userID, videoID, kind, message); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListNotifications returns one page of the user's notifications, newest first, and the total number of matching
+// notifications. If afterID is set, it instead returns up to a page of notifications newer than afterID, oldest first.
+func (v *VideoModel) ListNotifications(userID, pageNum, afterID int64, unreadOnly bool) ([]*videoproto.Notification, int64, error) {
+	if userID == 0 {
+		return nil, 0, status.Error(codes.Unauthenticated, "must be logged in to view notifications")
+	}
+
+	cond := "user_id = $1"
+	if unreadOnly {
+		cond += " AND is_read IS NOT TRUE"
+	}
+
+	var sql string
+	var args []interface{}
+	if afterID > 0 {
+		sql = "SELECT id, kind, COALESCE(message, ''), COALESCE(video_id, 0), COALESCE(comment_id, 0), creation_date, " +
+			"COALESCE(is_read, false) FROM notifications WHERE " + cond + " AND id > $2 ORDER BY id LIMIT $3"
+		args = []interface{}{userID, afterID, NumNotificationsPerPage}
+	} else {
+		if pageNum < 1 {
+			pageNum = 1
+		}
+		sql = "SELECT id, kind, COALESCE(message, ''), COALESCE(video_id, 0), COALESCE(comment_id, 0), creation_date, " +
+			"COALESCE(is_read, false) FROM notifications WHERE " + cond + " ORDER BY id DESC LIMIT $2 OFFSET $3"
+		args = []interface{}{userID, NumNotificationsPerPage, (pageNum - 1) * NumNotificationsPerPage}
+	}
+
+	rows, err := v.db.Query(sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	notifications := []*videoproto.Notification{}
+	for rows.Next() {
+		var n videoproto.Notification
+		var creationDate time.Time
+		err = rows.Scan(&n.Id, &n.Kind, &n.Message, &n.VideoID, &n.CommentID, &creationDate, &n.IsRead)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		n.CreationDate = creationDate.Format(time.RFC3339)
+		notifications = append(notifications, &n)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if afterID > 0 {
+		return notifications, 0, nil
+	}
+
+	var count int64
+	if err = v.db.QueryRow("SELECT count(*) FROM notifications WHERE "+cond, userID).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, count, nil
+}
+
+// MarkNotificationsRead marks the given notifications as read, or all of them if none are given. IDs belonging to
+// other users are ignored.
+func (v *VideoModel) MarkNotificationsRead(userID int64, notificationIDs []int64) error {
+	if userID == 0 {
+		return status.Error(codes.Unauthenticated, "must be logged in to mark notifications read")
+	}
+
+	var err error
+	if len(notificationIDs) == 0 {
+		_, err = v.db.Exec("UPDATE notifications SET is_read = true WHERE user_id = $1 AND is_read IS NOT TRUE", userID)
+	} else {
+		sql := "UPDATE notifications SET is_read = true WHERE user_id = $1 AND id = ANY($2)"
+		_, err = v.db.Exec(sql, userID, pq.Array(notificationIDs))
+	}
+
+	return err
+}
+
+func (v *VideoModel) GetUnreadNotificationCount(userID int64) (int64, error) {
+	var count int64
+	sql := "SELECT count(*) FROM notifications WHERE user_id = $1 AND is_read IS NOT TRUE"
+	err := v.db.QueryRow(sql, userID).Scan(&count)
+	return count, err
+}
+
+// notifyEncoded tells the uploader that their video is ready. Archived videos are skipped, since their uploader is
+// a foreign user nobody logs in as.
+func (v *VideoModel) notifyEncoded(videoID int64) error {
+	var uploaderID int64
+	var title string
+	sql := "SELECT userid, title FROM videos WHERE id = $1 AND COALESCE(originalid, '') = ''"
+	err := v.db.QueryRow(sql, videoID).Scan(&uploaderID, &title)
+	switch {
+	case err == sql2.ErrNoRows:
+		return nil
+	case err != nil:
+		return err
+	}
+
+	msg := fmt.Sprintf("Your video \"%s\" has finished processing", title)
+	return addNotification(v.db, uploaderID, videoID, NotificationVideoEncoded, msg)
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMakeCommentReplyNotifiesParentAuthor(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	v.grpcClient.(*usermocks.MockUserServiceClient).EXPECT().
+		GetUsersByIDs(gomock.Any(), gomock.Any()).
+		Return(&userproto.UsersResponse{Users: []*userproto.UserResponse{{UserID: 1, Username: "otomad"}}}, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comments")).
+		WithArgs(1, 5, 3, "nice").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET hot_score = hot_score_add(hot_score, $1) WHERE id = $2")).
+		WithArgs(sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT userid, title FROM videos WHERE id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title"}).AddRow(9, "wow"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM comments WHERE id = $1")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO notifications")).
+		WithArgs(2, 5, 4, NotificationCommentReply, "otomad replied to your comment on \"wow\"").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.MakeComment(1, 5, 3, "nice"))
+}
+
+func TestMakeCommentOnOwnVideoDoesNotNotify(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comments")).
+		WithArgs(1, 5, 0, "first").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET hot_score = hot_score_add(hot_score, $1) WHERE id = $2")).
+		WithArgs(sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT userid, title FROM videos WHERE id = $1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"userid", "title"}).AddRow(1, "wow"))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.MakeComment(1, 5, 0, "first"))
+}
+
+func TestListNotificationsAfterID(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE user_id = $1 AND is_read IS NOT TRUE AND id > $2 ORDER BY id LIMIT $3")).
+		WithArgs(1, 10, NumNotificationsPerPage).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "kind", "message", "video_id", "comment_id", "creation_date", "is_read"}).
+			AddRow(11, NotificationVideoEncoded, "done", 5, 0, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), false))
+
+	notifications, count, err := v.ListNotifications(1, 0, 10, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, int64(11), notifications[0].Id)
+	assert.Equal(t, int64(5), notifications[0].VideoID)
+}
+
+func TestMarkAllNotificationsRead(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE notifications SET is_read = true WHERE user_id = $1 AND is_read IS NOT TRUE")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	assert.NoError(t, v.MarkNotificationsRead(1, nil))
+	assert.Equal(t, codes.Unauthenticated, status.Code(v.MarkNotificationsRead(0, nil)))
+}
@@ -0,0 +1,546 @@
+package models
+
+import (
+	"context"
+	sql2 "database/sql"
+	"strings"
+	"unicode/utf8"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxPlaylistTitleLength       = 256
+	maxPlaylistDescriptionLength = 4096
+	maxPlaylistLength            = 5000
+	NumPlaylistVideosPerPage     = 50
+)
+
+type playlistRow struct {
+	ID           int64           `db:"id"`
+	UserID       int64           `db:"user_id"`
+	Title        string          `db:"title"`
+	Description  string          `db:"description"`
+	Visibility   int32           `db:"visibility"`
+	MirrorSite   sql2.NullInt32  `db:"mirror_site"`
+	MirrorID     sql2.NullString `db:"mirror_id"`
+	CreationDate string          `db:"creation_date"`
+	LastUpdated  string          `db:"last_updated"`
+	NumVideos    int64           `db:"num_videos"`
+}
+
+func (p *playlistRow) isMirrored() bool {
+	return p.MirrorID.Valid
+}
+
+// visibleTo is whether the user may view the playlist. Unlisted playlists are viewable, just not listed.
+func (p *playlistRow) visibleTo(userID int64) bool {
+	return videoproto.PlaylistVisibility(p.Visibility) != videoproto.PlaylistVisibility_private_playlist ||
+		p.UserID == userID
+}
+
+func (p *playlistRow) toProto(userName string) *videoproto.Playlist {
+	return &videoproto.Playlist{
+		PlaylistID:     p.ID,
+		UserID:         p.UserID,
+		UserName:       userName,
+		Title:          p.Title,
+		Description:    p.Description,
+		Visibility:     videoproto.PlaylistVisibility(p.Visibility),
+		NumberOfVideos: p.NumVideos,
+		CreationDate:   p.CreationDate,
+		LastUpdated:    p.LastUpdated,
+		Mirrored:       p.isMirrored(),
+		MirrorSite:     videoproto.Website(p.MirrorSite.Int32),
+		MirrorID:       p.MirrorID.String,
+	}
+}
+
+// The number of videos is of those visible in the playlist, see playlistVideoCondition
+const selectPlaylistSQL = "SELECT id, user_id, title, description, visibility, mirror_site, mirror_id, " +
+	"creation_date, last_updated, (SELECT count(*) FROM playlist_videos " +
+	"JOIN videos ON videos.id = playlist_videos.video_id WHERE playlist_id = playlists.id AND " +
+	playlistVideoCondition + ") AS num_videos FROM playlists "
+
+// Videos in a playlist are left out until they're watchable, and if they're rejected. Unapproved videos are only shown
+// to the playlist's owner.
+const playlistVideoCondition = "videos.transcoded IS TRUE AND videos.is_rejected IS NOT TRUE AND " +
+	"(videos.is_approved IS TRUE OR playlists.user_id = $2)"
+
+func (v *VideoModel) getPlaylist(q sqlx.Queryer, playlistID, userID int64, forUpdate bool) (*playlistRow, error) {
+	sql := selectPlaylistSQL + "WHERE id = $1"
+	if forUpdate {
+		sql += " FOR UPDATE"
+	}
+
+	var p playlistRow
+	err := sqlx.Get(q, &p, sql, playlistID, userID)
+	switch {
+	case err == sql2.ErrNoRows:
+		return nil, status.Error(codes.NotFound, "playlist does not exist")
+	case err != nil:
+		return nil, err
+	}
+
+	if !p.visibleTo(userID) {
+		// Private playlists are indistinguishable from ones which don't exist
+		return nil, status.Error(codes.NotFound, "playlist does not exist")
+	}
+
+	return &p, nil
+}
+
+// getOwnedPlaylist fetches and locks the playlist for modification by its owner
+func (v *VideoModel) getOwnedPlaylist(tx *sqlx.Tx, playlistID, userID int64) (*playlistRow, error) {
+	if userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "must be logged in to modify playlists")
+	}
+
+	p, err := v.getPlaylist(tx, playlistID, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.UserID != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the playlist's owner can modify it")
+	}
+
+	return p, nil
+}
+
+func validatePlaylist(title, description string, visibility videoproto.PlaylistVisibility) error {
+	switch {
+	case strings.TrimSpace(title) == "":
+		return status.Error(codes.InvalidArgument, "playlist title is required")
+	case utf8.RuneCountInString(title) > maxPlaylistTitleLength:
+		return status.Errorf(codes.InvalidArgument, "playlist title must be at most %d characters", maxPlaylistTitleLength)
+	case utf8.RuneCountInString(description) > maxPlaylistDescriptionLength:
+		return status.Errorf(codes.InvalidArgument, "playlist description must be at most %d characters",
+			maxPlaylistDescriptionLength)
+	}
+
+	if _, ok := videoproto.PlaylistVisibility_name[int32(visibility)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown playlist visibility %d", visibility)
+	}
+
+	return nil
+}
+
+func (v *VideoModel) playlistToProto(p *playlistRow) (*videoproto.Playlist, error) {
+	usernames, err := v.getUsernames(context.TODO(), []int64{p.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.toProto(usernames[p.UserID]), nil
+}
+
+func (v *VideoModel) CreatePlaylist(userID int64, title, description string,
+	visibility videoproto.PlaylistVisibility) (*videoproto.Playlist, error) {
+	if userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "must be logged in to create playlists")
+	}
+
+	if err := validatePlaylist(title, description, visibility); err != nil {
+		return nil, err
+	}
+
+	sql := "INSERT INTO playlists (user_id, title, description, visibility, creation_date, last_updated) " +
+		"VALUES ($1, $2, $3, $4, Now(), Now()) RETURNING id"
+	var playlistID int64
+	if err := v.db.QueryRow(sql, userID, title, description, visibility).Scan(&playlistID); err != nil {
+		return nil, err
+	}
+
+	p, err := v.getPlaylist(v.db, playlistID, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.playlistToProto(p)
+}
+
+// UpdatePlaylist replaces the playlist's title, description and visibility. Mirrored playlists can be updated too, as
+// only their contents follow the original.
+func (v *VideoModel) UpdatePlaylist(userID, playlistID int64, title, description string,
+	visibility videoproto.PlaylistVisibility) (*videoproto.Playlist, error) {
+	if err := validatePlaylist(title, description, visibility); err != nil {
+		return nil, err
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err = v.getOwnedPlaylist(tx, playlistID, userID); err != nil {
+		return nil, err
+	}
+
+	sql := "UPDATE playlists SET title = $1, description = $2, visibility = $3, last_updated = Now() WHERE id = $4"
+	if _, err = tx.Exec(sql, title, description, visibility, playlistID); err != nil {
+		return nil, err
+	}
+
+	p, err := v.getPlaylist(tx, playlistID, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.playlistToProto(p)
+}
+
+func (v *VideoModel) DeletePlaylist(userID, playlistID int64) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = v.getOwnedPlaylist(tx, playlistID, userID); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM playlists WHERE id = $1", playlistID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// getEditablePlaylist is getOwnedPlaylist for changes to the playlist's contents, which mirrored playlists don't allow
+func (v *VideoModel) getEditablePlaylist(tx *sqlx.Tx, playlistID, userID int64) (*playlistRow, error) {
+	p, err := v.getOwnedPlaylist(tx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isMirrored() {
+		return nil, status.Error(codes.FailedPrecondition, "mirrored playlists follow the original playlist's contents")
+	}
+
+	return p, nil
+}
+
+// AddVideoToPlaylist appends the video to the end of the playlist
+func (v *VideoModel) AddVideoToPlaylist(userID, playlistID, videoID int64) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = v.getEditablePlaylist(tx, playlistID, userID); err != nil {
+		return err
+	}
+
+	var exists bool
+	if err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM videos WHERE id = $1)", videoID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "video does not exist")
+	}
+
+	// Counted without the visibility condition, since positions cover every video in the playlist
+	var length int64
+	if err = tx.QueryRow("SELECT count(*) FROM playlist_videos WHERE playlist_id = $1", playlistID).Scan(&length); err != nil {
+		return err
+	}
+	if length >= maxPlaylistLength {
+		return status.Errorf(codes.FailedPrecondition, "playlists can have at most %d videos", maxPlaylistLength)
+	}
+
+	sql := "INSERT INTO playlist_videos (playlist_id, video_id, position, added_date) VALUES ($1, $2, $3, Now()) " +
+		"ON CONFLICT DO NOTHING"
+	res, err := tx.Exec(sql, playlistID, videoID, length)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return status.Error(codes.AlreadyExists, "video is already in the playlist")
+	}
+
+	if err = touchPlaylist(tx, playlistID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (v *VideoModel) RemoveVideoFromPlaylist(userID, playlistID, videoID int64) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = v.getEditablePlaylist(tx, playlistID, userID); err != nil {
+		return err
+	}
+
+	sql := "DELETE FROM playlist_videos WHERE playlist_id = $1 AND video_id = $2 RETURNING position"
+	var position int64
+	err = tx.QueryRow(sql, playlistID, videoID).Scan(&position)
+	switch {
+	case err == sql2.ErrNoRows:
+		return status.Error(codes.NotFound, "video is not in the playlist")
+	case err != nil:
+		return err
+	}
+
+	// Close the gap
+	sql = "UPDATE playlist_videos SET position = position - 1 WHERE playlist_id = $1 AND position > $2"
+	if _, err = tx.Exec(sql, playlistID, position); err != nil {
+		return err
+	}
+
+	if err = touchPlaylist(tx, playlistID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MoveVideoInPlaylist moves the video to the given position, shifting the videos in between by one. Positions past the
+// end of the playlist move the video to the end.
+func (v *VideoModel) MoveVideoInPlaylist(userID, playlistID, videoID, position int64) error {
+	if position < 0 {
+		return status.Error(codes.InvalidArgument, "position must not be negative")
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = v.getEditablePlaylist(tx, playlistID, userID); err != nil {
+		return err
+	}
+
+	var oldPosition, length int64
+	sql := "SELECT position, (SELECT count(*) FROM playlist_videos WHERE playlist_id = $1) FROM playlist_videos " +
+		"WHERE playlist_id = $1 AND video_id = $2"
+	err = tx.QueryRow(sql, playlistID, videoID).Scan(&oldPosition, &length)
+	switch {
+	case err == sql2.ErrNoRows:
+		return status.Error(codes.NotFound, "video is not in the playlist")
+	case err != nil:
+		return err
+	}
+
+	if position >= length {
+		position = length - 1
+	}
+
+	switch {
+	case position == oldPosition:
+		return nil
+	case position > oldPosition:
+		sql = "UPDATE playlist_videos SET position = position - 1 WHERE playlist_id = $1 AND position > $2 AND position <= $3"
+		_, err = tx.Exec(sql, playlistID, oldPosition, position)
+	default:
+		sql = "UPDATE playlist_videos SET position = position + 1 WHERE playlist_id = $1 AND position >= $3 AND position < $2"
+		_, err = tx.Exec(sql, playlistID, oldPosition, position)
+	}
+	if err != nil {
+		return err
+	}
+
+	sql = "UPDATE playlist_videos SET position = $1 WHERE playlist_id = $2 AND video_id = $3"
+	if _, err = tx.Exec(sql, position, playlistID, videoID); err != nil {
+		return err
+	}
+
+	if err = touchPlaylist(tx, playlistID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func touchPlaylist(e sqlx.Execer, playlistID int64) error {
+	_, err := e.Exec("UPDATE playlists SET last_updated = Now() WHERE id = $1", playlistID)
+	return err
+}
+
+// GetPlaylist returns the playlist and one page of its videos, in order
+func (v *VideoModel) GetPlaylist(playlistID, userID, pageNum int64) (*videoproto.PlaylistPage, error) {
+	p, err := v.getPlaylist(v.db, playlistID, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	sql := "SELECT videos.id, videos.title, videos.userid, videos.newlink, videos.views, videos.upload_date, " +
+		"COALESCE(videos.rating, 0) " +
+		"FROM playlist_videos JOIN videos ON videos.id = playlist_videos.video_id " +
+		"JOIN playlists ON playlists.id = playlist_videos.playlist_id " +
+		"WHERE playlist_videos.playlist_id = $1 AND " + playlistVideoCondition + " " +
+		"ORDER BY playlist_videos.position LIMIT $3 OFFSET $4"
+
+	rows, err := v.db.Query(sql, playlistID, userID, NumPlaylistVideosPerPage, (pageNum-1)*NumPlaylistVideosPerPage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var videos []*videoproto.Video
+	authorIDs := []int64{p.UserID}
+	for rows.Next() {
+		var video videoproto.Video
+		var authorID, views int64
+		var mpdLoc string
+		err = rows.Scan(&video.VideoID, &video.VideoTitle, &authorID, &mpdLoc, &views, &video.UploadDate, &video.Rating)
+		if err != nil {
+			return nil, err
+		}
+
+		video.Views = uint64(views)
+		video.ThumbnailLoc = strings.Replace(mpdLoc, ".mpd", ".jpg", 1)
+
+		videos = append(videos, &video)
+		authorIDs = append(authorIDs, authorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, video := range videos {
+		video.AuthorName = usernames[authorIDs[i+1]]
+	}
+
+	return &videoproto.PlaylistPage{
+		Playlist: p.toProto(usernames[p.UserID]),
+		Videos:   videos,
+	}, nil
+}
+
+// GetUserPlaylists lists the owner's playlists, most recently updated first. Only public playlists are listed for
+// other users.
+func (v *VideoModel) GetUserPlaylists(ownerID, userID int64) ([]*videoproto.Playlist, error) {
+	sql := selectPlaylistSQL + "WHERE user_id = $1 AND (visibility = $3 OR user_id = $2) ORDER BY last_updated DESC"
+
+	var rows []playlistRow
+	err := v.db.Select(&rows, sql, ownerID, userID, videoproto.PlaylistVisibility_public_playlist)
+	if err != nil {
+		return nil, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), []int64{ownerID})
+	if err != nil {
+		return nil, err
+	}
+
+	playlists := make([]*videoproto.Playlist, len(rows))
+	for i := range rows {
+		playlists[i] = rows[i].toProto(usernames[ownerID])
+	}
+
+	return playlists, nil
+}
+
+// SyncMirroredPlaylist creates the user's mirror of a playlist on another site if it doesn't exist yet, and replaces
+// its contents with the given foreign videos in order. Videos which haven't been archived yet are added by
+// AddToMirroredPlaylists once they are.
+func (v *VideoModel) SyncMirroredPlaylist(userID int64, site videoproto.Website, foreignPlaylistID, title string,
+	foreignVideoIDs []string) (*videoproto.Playlist, error) {
+	if foreignPlaylistID == "" {
+		return nil, status.Error(codes.InvalidArgument, "playlist ID is required")
+	}
+
+	if strings.TrimSpace(title) == "" {
+		title = foreignPlaylistID
+	}
+	if utf8.RuneCountInString(title) > maxPlaylistTitleLength {
+		title = string([]rune(title)[:maxPlaylistTitleLength])
+	}
+
+	if len(foreignVideoIDs) > maxPlaylistLength {
+		foreignVideoIDs = foreignVideoIDs[:maxPlaylistLength]
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The title is only set on creation, so the owner can rename the playlist
+	sql := "INSERT INTO playlists (user_id, title, visibility, mirror_site, mirror_id, creation_date, last_updated) " +
+		"VALUES ($1, $2, $3, $4, $5, Now(), Now()) " +
+		"ON CONFLICT (user_id, mirror_site, mirror_id) DO UPDATE SET last_updated = Now() RETURNING id"
+	var playlistID int64
+	err = tx.QueryRow(sql, userID, title, videoproto.PlaylistVisibility_public_playlist, site, foreignPlaylistID).
+		Scan(&playlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec("DELETE FROM mirrored_playlist_entries WHERE playlist_id = $1", playlistID); err != nil {
+		return nil, err
+	}
+
+	sql = "INSERT INTO mirrored_playlist_entries (playlist_id, position, foreign_id) " +
+		"SELECT $1, entry.position - 1, entry.foreign_id FROM unnest($2::varchar[]) WITH ORDINALITY AS entry(foreign_id, position)"
+	if _, err = tx.Exec(sql, playlistID, pq.Array(foreignVideoIDs)); err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec("DELETE FROM playlist_videos WHERE playlist_id = $1", playlistID); err != nil {
+		return nil, err
+	}
+
+	// A video appearing more than once keeps its first position
+	sql = "INSERT INTO playlist_videos (playlist_id, video_id, position, added_date) " +
+		"SELECT DISTINCT ON (videos.id) $1, videos.id, entries.position, Now() FROM mirrored_playlist_entries entries " +
+		"JOIN videos ON videos.originalID = entries.foreign_id AND videos.originalSite = $2 " +
+		"WHERE entries.playlist_id = $1 ORDER BY videos.id, entries.position"
+	if _, err = tx.Exec(sql, playlistID, site); err != nil {
+		return nil, err
+	}
+
+	p, err := v.getPlaylist(tx, playlistID, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.playlistToProto(p)
+}
+
+// AddToMirroredPlaylists adds a newly archived video to every mirrored playlist which is waiting for it
+func (v *VideoModel) AddToMirroredPlaylists(videoID int64, site videoproto.Website, foreignID string) error {
+	sql := "INSERT INTO playlist_videos (playlist_id, video_id, position, added_date) " +
+		"SELECT entries.playlist_id, $1, min(entries.position), Now() FROM mirrored_playlist_entries entries " +
+		"JOIN playlists ON playlists.id = entries.playlist_id " +
+		"WHERE entries.foreign_id = $2 AND playlists.mirror_site = $3 GROUP BY entries.playlist_id " +
+		"ON CONFLICT DO NOTHING"
+	_, err := v.db.Exec(sql, videoID, foreignID, site)
+	return err
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var playlistColumns = []string{"id", "user_id", "title", "description", "visibility", "mirror_site", "mirror_id",
+	"creation_date", "last_updated", "num_videos"}
+
+func expectPlaylist(mock sqlmock.Sqlmock, ownerID int64, visibility videoproto.PlaylistVisibility, mirrorID interface{}) {
+	var mirrorSite interface{}
+	if mirrorID != nil {
+		mirrorSite = int64(videoproto.Website_youtube)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM playlists WHERE id = $1")).
+		WillReturnRows(sqlmock.NewRows(playlistColumns).
+			AddRow(3, ownerID, "mix", "", int64(visibility), mirrorSite, mirrorID, "2021-01-01", "2021-01-01", 4))
+}
+
+func TestValidatePlaylist(t *testing.T) {
+	assert.NoError(t, validatePlaylist("mix", "", videoproto.PlaylistVisibility_unlisted_playlist))
+	assert.Equal(t, codes.InvalidArgument, status.Code(validatePlaylist("  ", "", videoproto.PlaylistVisibility_public_playlist)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(validatePlaylist("mix", "", videoproto.PlaylistVisibility(7))))
+}
+
+func TestGetPrivatePlaylistHiddenFromOtherUsers(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	expectPlaylist(mock, 2, videoproto.PlaylistVisibility_private_playlist, nil)
+
+	_, err := v.GetPlaylist(3, 1, 1)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestModifyPlaylistRequiresOwner(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_admin)
+	defer done()
+
+	mock.ExpectBegin()
+	expectPlaylist(mock, 2, videoproto.PlaylistVisibility_public_playlist, nil)
+	mock.ExpectRollback()
+
+	err := v.AddVideoToPlaylist(1, 3, 5)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestMirroredPlaylistContentsCantBeEdited(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	expectPlaylist(mock, 1, videoproto.PlaylistVisibility_public_playlist, "PLabc")
+	mock.ExpectRollback()
+
+	err := v.MoveVideoInPlaylist(1, 3, 5, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestMoveVideoInPlaylistTowardsEnd(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	expectPlaylist(mock, 1, videoproto.PlaylistVisibility_public_playlist, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT position")).
+		WithArgs(3, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"position", "count"}).AddRow(1, 4))
+	// Past the end, so clamped to the last position
+	mock.ExpectExec(regexp.QuoteMeta("SET position = position - 1 WHERE playlist_id = $1 AND position > $2 AND position <= $3")).
+		WithArgs(3, 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE playlist_videos SET position = $1")).
+		WithArgs(3, 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE playlists SET last_updated")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.MoveVideoInPlaylist(1, 3, 5, 10))
+}
+
+func TestMoveVideoInPlaylistTowardsStart(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	expectPlaylist(mock, 1, videoproto.PlaylistVisibility_private_playlist, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT position")).
+		WithArgs(3, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"position", "count"}).AddRow(3, 4))
+	mock.ExpectExec(regexp.QuoteMeta("SET position = position + 1 WHERE playlist_id = $1 AND position >= $3 AND position < $2")).
+		WithArgs(3, 3, 0).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE playlist_videos SET position = $1")).
+		WithArgs(0, 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE playlists SET last_updated")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.MoveVideoInPlaylist(1, 3, 5, 0))
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	sql2 "database/sql"
+	"math"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validateRating(rating float64) error {
+	if math.IsNaN(rating) || rating < 0 || rating > maxRating {
+		return status.Errorf(codes.InvalidArgument, "ratings must be between 0 and %g", maxRating)
+	}
+
+	return nil
+}
+
+// adjustRatingAggregates applies a change to a video's rating count and sum, and updates the mean and Bayesian
+// rating to match. Nothing is recomputed from the ratings table.
+func adjustRatingAggregates(tx *sqlx.Tx, videoID int64, countDelta int, sumDelta float64) error {
+	// Values on the right hand side are from before the update
+	sql := "UPDATE videos SET rating_count = rating_count + $1, rating_sum = rating_sum + $2, " +
+		"rating = COALESCE((rating_sum + $2) / NULLIF(rating_count + $1, 0), 0), " +
+		"bayesian_rating = (rating_prior.votes * rating_prior.mean + rating_sum + $2) / " +
+		"(rating_prior.votes + rating_count + $1) FROM rating_prior WHERE videos.id = $3"
+	_, err := tx.Exec(sql, countDelta, sumDelta, videoID)
+	return err
+}
+
+// AddRatingToVideoID adds or replaces the user's rating of the video.
+func (v *VideoModel) AddRatingToVideoID(ratingUID, videoID int64, ratingValue float64) error {
+	if err := validateRating(ratingValue); err != nil {
+		return err
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previous float64
+	sql := "SELECT rating FROM ratings WHERE user_id = $1 AND video_id = $2 FOR UPDATE"
+	err = tx.QueryRow(sql, ratingUID, videoID).Scan(&previous)
+	switch {
+	case err == sql2.ErrNoRows:
+		sql = "INSERT INTO ratings (user_id, video_id, rating) VALUES ($1, $2, $3)"
+		if _, err = tx.Exec(sql, ratingUID, videoID, ratingValue); err != nil {
+			return err
+		}
+
+		if err = adjustRatingAggregates(tx, videoID, 1, ratingValue); err != nil {
+			return err
+		}
+
+		// Only a user's first rating counts towards the hot score
+		if err = addHotScore(tx, videoID, ratingValue/maxRating*hotRatingWeight); err != nil {
+			return err
+		}
+
+	case err != nil:
+		return err
+
+	default:
+		sql = "UPDATE ratings SET rating = $1 WHERE user_id = $2 AND video_id = $3"
+		if _, err = tx.Exec(sql, ratingValue, ratingUID, videoID); err != nil {
+			return err
+		}
+
+		if err = adjustRatingAggregates(tx, videoID, 0, ratingValue-previous); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// RetractRating removes the user's rating of the video, if they've rated it.
+func (v *VideoModel) RetractRating(userID, videoID int64) error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previous float64
+	sql := "DELETE FROM ratings WHERE user_id = $1 AND video_id = $2 RETURNING rating"
+	err = tx.QueryRow(sql, userID, videoID).Scan(&previous)
+	switch {
+	case err == sql2.ErrNoRows:
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err = adjustRatingAggregates(tx, videoID, -1, -previous); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAverageRatingForVideoID returns the mean rating of the video, or 0 if it hasn't been rated.
+func (v *VideoModel) GetAverageRatingForVideoID(videoID int64) (float64, error) {
+	var rating float64
+	err := v.db.QueryRow("SELECT COALESCE(rating, 0) FROM videos WHERE id = $1", videoID).Scan(&rating)
+	switch {
+	case err == sql2.ErrNoRows:
+		return 0, status.Error(codes.NotFound, "video does not exist")
+	case err != nil:
+		return 0, err
+	}
+
+	return rating, nil
+}
+
+// getRatingHistogram returns the number of ratings of the video rounding to each whole number from 0 to maxRating.
+func (v *VideoModel) getRatingHistogram(videoID int64) ([]int64, error) {
+	rows, err := v.db.Query("SELECT round(rating)::int, count(*) FROM ratings WHERE video_id = $1 GROUP BY 1", videoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	histogram := make([]int64, int(maxRating)+1)
+	for rows.Next() {
+		var bucket int
+		var count int64
+		if err = rows.Scan(&bucket, &count); err != nil {
+			return nil, err
+		}
+
+		if bucket >= 0 && bucket < len(histogram) {
+			histogram[bucket] = count
+		}
+	}
+
+	return histogram, rows.Err()
+}
+
+// RefreshRatingPrior recomputes the site-wide mean rating, and every video's Bayesian rating with it. The mean moves
+// slowly, so this only needs to run occasionally.
+func (v *VideoModel) RefreshRatingPrior() error {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sql := "UPDATE rating_prior SET mean = COALESCE((SELECT sum(rating_sum) / NULLIF(sum(rating_count), 0) FROM videos), mean)"
+	if _, err = tx.Exec(sql); err != nil {
+		return err
+	}
+
+	sql = "UPDATE videos SET bayesian_rating = scored.score FROM (SELECT videos.id, (rating_prior.votes * " +
+		"rating_prior.mean + rating_sum) / (rating_prior.votes + rating_count) AS score FROM videos, rating_prior) scored " +
+		"WHERE videos.id = scored.id AND videos.bayesian_rating IS DISTINCT FROM scored.score"
+	if _, err = tx.Exec(sql); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
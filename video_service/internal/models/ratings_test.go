@@ -0,0 +1,93 @@
+package models
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddRatingRejectsOutOfRangeRatings(t *testing.T) {
+	v, _, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	for _, rating := range []float64{-1, maxRating + 1, math.NaN()} {
+		err := v.AddRatingToVideoID(1, 5, rating)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "rating %g", rating)
+	}
+}
+
+func TestAddFirstRatingIncrementsAggregates(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT rating FROM ratings WHERE user_id = $1 AND video_id = $2 FOR UPDATE")).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO ratings")).
+		WithArgs(1, 5, 8.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET rating_count = rating_count + $1")).
+		WithArgs(1, 8.0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET hot_score")).
+		WithArgs(sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.AddRatingToVideoID(1, 5, 8))
+}
+
+func TestChangingRatingAdjustsSumByDifference(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT rating FROM ratings WHERE user_id = $1 AND video_id = $2 FOR UPDATE")).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}).AddRow(8.0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ratings SET rating = $1")).
+		WithArgs(3.0, 1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET rating_count = rating_count + $1")).
+		WithArgs(0, -5.0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.AddRatingToVideoID(1, 5, 3))
+}
+
+func TestRetractRatingDecrementsAggregates(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM ratings WHERE user_id = $1 AND video_id = $2 RETURNING rating")).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}).AddRow(6.0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET rating_count = rating_count + $1")).
+		WithArgs(-1, -6.0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, v.RetractRating(1, 5))
+}
+
+func TestRetractMissingRatingIsNoop(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM ratings WHERE user_id = $1 AND video_id = $2 RETURNING rating")).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}))
+	mock.ExpectRollback()
+
+	assert.NoError(t, v.RetractRating(1, 5))
+}
@@ -0,0 +1,190 @@
+package models
+
+import (
+	sql2 "database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Number of related videos stored per video
+	NumRelatedVideos = 20
+	// Number of candidates each signal may contribute before they're combined
+	relatedCandidatesPerSignal = 200
+	// Related videos are recomputed once they're older than this
+	relatedVideosMaxAge = 24 * time.Hour
+	// Number of videos refreshed per batch in the background
+	relatedRefreshBatchSize = 100
+)
+
+// Only approved videos are recommended
+const relatedCandidateCondition = "videos.is_approved IS TRUE AND videos.transcoded IS TRUE AND videos.is_rejected IS NOT TRUE"
+
+// RelatednessSignal scores candidate videos by how related they are to a video. Scores only need to be comparable
+// within a signal, since each signal's scores are scaled to [0, 1] before they're combined.
+type RelatednessSignal interface {
+	Name() string
+	// Scores returns up to limit candidates and their scores. The video itself must not be included.
+	Scores(videoID int64, limit int) (map[int64]float64, error)
+}
+
+type WeightedSignal struct {
+	Signal RelatednessSignal
+	Weight float64
+}
+
+// DefaultRelatednessSignals is the mix of signals used in production
+func DefaultRelatednessSignals(db *sqlx.DB) []WeightedSignal {
+	return []WeightedSignal{
+		{Signal: &TagSignal{db: db}, Weight: 1.0},
+		{Signal: &CoRatingSignal{db: db}, Weight: 0.75},
+		{Signal: &UploaderSignal{db: db}, Weight: 0.5},
+	}
+}
+
+type ScoredVideo struct {
+	VideoID int64
+	Score   float64
+}
+
+// RelatedModel computes related videos from a weighted set of signals, and caches the results in postgres.
+type RelatedModel struct {
+	db      *sqlx.DB
+	signals []WeightedSignal
+}
+
+func NewRelatedModel(db *sqlx.DB, signals []WeightedSignal) (*RelatedModel, error) {
+	return &RelatedModel{db: db, signals: signals}, nil
+}
+
+// ComputeRelated combines the signals for a video, and returns the top related videos, most related first.
+func (m *RelatedModel) ComputeRelated(videoID int64) ([]ScoredVideo, error) {
+	combined := make(map[int64]float64)
+	for _, ws := range m.signals {
+		scores, err := ws.Signal.Scores(videoID, relatedCandidatesPerSignal)
+		if err != nil {
+			return nil, fmt.Errorf("related video signal %s failed for video %d. Err: %s", ws.Signal.Name(), videoID, err)
+		}
+
+		var max float64
+		for _, score := range scores {
+			if score > max {
+				max = score
+			}
+		}
+
+		if max <= 0 {
+			continue
+		}
+
+		for candidateID, score := range scores {
+			if candidateID == videoID || score <= 0 {
+				continue
+			}
+			combined[candidateID] += ws.Weight * score / max
+		}
+	}
+
+	related := make([]ScoredVideo, 0, len(combined))
+	for candidateID, score := range combined {
+		related = append(related, ScoredVideo{VideoID: candidateID, Score: score})
+	}
+
+	// Ties go to the newer video
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].Score != related[j].Score {
+			return related[i].Score > related[j].Score
+		}
+		return related[i].VideoID > related[j].VideoID
+	})
+
+	if len(related) > NumRelatedVideos {
+		related = related[:NumRelatedVideos]
+	}
+
+	return related, nil
+}
+
+// RefreshRelated recomputes and stores the related videos for a video.
+func (m *RelatedModel) RefreshRelated(videoID int64) error {
+	related, err := m.ComputeRelated(videoID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec("DELETE FROM related_videos WHERE video_id = $1", videoID); err != nil {
+		return err
+	}
+
+	for _, r := range related {
+		sql := "INSERT INTO related_videos (video_id, related_video_id, score) VALUES ($1, $2, $3)"
+		if _, err = tx.Exec(sql, videoID, r.VideoID, r.Score); err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.Exec("UPDATE videos SET related_computed_at = Now() WHERE id = $1", videoID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RefreshStaleRelated refreshes a batch of approved videos whose related videos are missing or out of date, and
+// returns the number refreshed.
+func (m *RelatedModel) RefreshStaleRelated() (int, error) {
+	var videoIDs []int64
+	sql := "SELECT id FROM videos WHERE " + relatedCandidateCondition + " AND (related_computed_at IS NULL OR " +
+		"related_computed_at < $1) ORDER BY related_computed_at NULLS FIRST, id DESC LIMIT $2"
+	err := m.db.Select(&videoIDs, sql, time.Now().Add(-relatedVideosMaxAge), relatedRefreshBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, videoID := range videoIDs {
+		if err = m.RefreshRelated(videoID); err != nil {
+			return i, err
+		}
+	}
+
+	return len(videoIDs), nil
+}
+
+// GetRelatedVideoIDs returns the cached related videos that are still approved, most related first. Videos that
+// haven't been precomputed yet are computed on demand.
+func (m *RelatedModel) GetRelatedVideoIDs(videoID int64, limit int) ([]int64, error) {
+	var computed pq.NullTime
+	err := m.db.Get(&computed, "SELECT related_computed_at FROM videos WHERE id = $1", videoID)
+	switch {
+	case err == sql2.ErrNoRows:
+		return nil, status.Error(codes.NotFound, "video does not exist")
+	case err != nil:
+		return nil, err
+	}
+
+	if !computed.Valid {
+		if err = m.RefreshRelated(videoID); err != nil {
+			return nil, err
+		}
+	}
+
+	var videoIDs []int64
+	sql := "SELECT related_videos.related_video_id FROM related_videos " +
+		"JOIN videos ON videos.id = related_videos.related_video_id " +
+		"WHERE related_videos.video_id = $1 AND " + relatedCandidateCondition + " " +
+		"ORDER BY related_videos.score DESC, related_videos.related_video_id DESC LIMIT $2"
+	err = m.db.Select(&videoIDs, sql, videoID, limit)
+	return videoIDs, err
+}
@@ -0,0 +1,96 @@
+// +build integration
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Seeds videos that are related to a source video in different ways, and checks each signal against them
+func seedRelatedVideos(t *testing.T) map[string]int64 {
+	const uploaderID, otherUploaderID = 900001, 900002
+
+	videos := []struct {
+		name       string
+		uploaderID int64
+		approved   bool
+		tags       []string
+	}{
+		{"source", uploaderID, true, []string{"related-rare", "related-common"}},
+		{"rare", otherUploaderID, true, []string{"related-rare"}},
+		{"common", otherUploaderID, true, []string{"related-common"}},
+		{"common2", otherUploaderID, true, []string{"related-common"}},
+		{"common3", otherUploaderID, true, []string{"related-common"}},
+		{"unapproved", otherUploaderID, false, []string{"related-rare"}},
+		{"same uploader", uploaderID, true, nil},
+		{"co-rated", otherUploaderID, true, nil},
+	}
+
+	ids := make(map[string]int64)
+	for _, video := range videos {
+		var id int64
+		sql := "INSERT INTO videos (title, userid, upload_date, is_approved, transcoded, newlink) " +
+			"VALUES ($1, $2, Now(), $3, true, 'related.mpd') RETURNING id"
+		err := v.db.QueryRow(sql, video.name, video.uploaderID, video.approved).Scan(&id)
+		assert.NoError(t, err)
+		ids[video.name] = id
+
+		for _, tag := range video.tags {
+			_, err = v.db.Exec("INSERT INTO tags (video_id, tag) VALUES ($1, $2)", id, tag)
+			assert.NoError(t, err)
+		}
+	}
+
+	for _, rating := range []struct {
+		video  string
+		rating float64
+	}{{"source", 10}, {"co-rated", 9}, {"common", 1}} {
+		_, err := v.db.Exec("INSERT INTO ratings (user_id, video_id, rating) VALUES (900003, $1, $2)",
+			ids[rating.video], rating.rating)
+		assert.NoError(t, err)
+	}
+
+	return ids
+}
+
+func TestRelatedSignalsAgainstSeededDB(t *testing.T) {
+	ids := seedRelatedVideos(t)
+	source := ids["source"]
+
+	tagScores, err := (&TagSignal{db: v.db}).Scores(source, relatedCandidatesPerSignal)
+	assert.NoError(t, err)
+	assert.Greater(t, tagScores[ids["rare"]], tagScores[ids["common"]])
+	assert.NotContains(t, tagScores, ids["unapproved"])
+	assert.NotContains(t, tagScores, source)
+
+	uploaderScores, err := (&UploaderSignal{db: v.db}).Scores(source, relatedCandidatesPerSignal)
+	assert.NoError(t, err)
+	assert.Contains(t, uploaderScores, ids["same uploader"])
+	assert.NotContains(t, uploaderScores, ids["rare"])
+
+	coRatingScores, err := (&CoRatingSignal{db: v.db}).Scores(source, relatedCandidatesPerSignal)
+	assert.NoError(t, err)
+	assert.Greater(t, coRatingScores[ids["co-rated"]], coRatingScores[ids["common"]])
+}
+
+func TestGetRelatedVideoIDsComputesOnDemand(t *testing.T) {
+	ids := seedRelatedVideos(t)
+
+	m, err := NewRelatedModel(v.db, DefaultRelatednessSignals(v.db))
+	assert.NoError(t, err)
+
+	related, err := m.GetRelatedVideoIDs(ids["source"], NumRelatedVideos)
+	assert.NoError(t, err)
+	assert.Contains(t, related, ids["rare"])
+	assert.Contains(t, related, ids["same uploader"])
+	assert.Contains(t, related, ids["co-rated"])
+	assert.NotContains(t, related, ids["unapproved"])
+
+	// Cached now, so the stale refresh shouldn't pick the video up again
+	var computed bool
+	err = v.db.Get(&computed, "SELECT related_computed_at IS NOT NULL FROM videos WHERE id = $1", ids["source"])
+	assert.NoError(t, err)
+	assert.True(t, computed)
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"github.com/jmoiron/sqlx"
+)
+
+// TagSignal scores videos by the tags they share with the video. Each shared tag is weighted by its inverse
+// document frequency, so sharing a rare tag counts for more than sharing a common one.
+type TagSignal struct {
+	db *sqlx.DB
+}
+
+func (s *TagSignal) Name() string {
+	return "tags"
+}
+
+func (s *TagSignal) Scores(videoID int64, limit int) (map[int64]float64, error) {
+	sql := "WITH idf AS (SELECT tag, ln((SELECT count(DISTINCT video_id) FROM tags)::float / count(DISTINCT video_id)) " +
+		"AS weight FROM tags WHERE tag IN (SELECT tag FROM tags WHERE video_id = $1) GROUP BY tag) " +
+		"SELECT shared.video_id, sum(idf.weight) FROM (SELECT DISTINCT video_id, tag FROM tags) shared " +
+		"JOIN idf ON idf.tag = shared.tag JOIN videos ON videos.id = shared.video_id " +
+		"WHERE shared.video_id != $1 AND " + relatedCandidateCondition + " " +
+		"GROUP BY shared.video_id ORDER BY 2 DESC LIMIT $2"
+	return queryScores(s.db, sql, videoID, limit)
+}
+
+// UploaderSignal scores the uploader's other videos, newest first. Archived videos are attributed to a user per
+// original channel, so this also covers videos from the same original channel.
+type UploaderSignal struct {
+	db *sqlx.DB
+}
+
+func (s *UploaderSignal) Name() string {
+	return "uploader"
+}
+
+func (s *UploaderSignal) Scores(videoID int64, limit int) (map[int64]float64, error) {
+	// Scores fall off with rank so that recent uploads win ties with other signals
+	sql := "SELECT videos.id, 1.0 / row_number() OVER (ORDER BY videos.upload_date DESC NULLS LAST, videos.id DESC) " +
+		"FROM videos WHERE videos.userid = (SELECT userid FROM videos WHERE id = $1) AND videos.id != $1 AND " +
+		relatedCandidateCondition + " ORDER BY videos.upload_date DESC NULLS LAST, videos.id DESC LIMIT $2"
+	return queryScores(s.db, sql, videoID, limit)
+}
+
+// CoRatingSignal scores videos rated by the same users as the video. Each shared rater contributes the product of
+// their two ratings, so videos that the video's fans also liked score highest.
+type CoRatingSignal struct {
+	db *sqlx.DB
+}
+
+func (s *CoRatingSignal) Name() string {
+	return "co-rating"
+}
+
+func (s *CoRatingSignal) Scores(videoID int64, limit int) (map[int64]float64, error) {
+	sql := "SELECT other.video_id, sum(this.rating * other.rating) FROM ratings this " +
+		"JOIN ratings other ON other.user_id = this.user_id AND other.video_id != this.video_id " +
+		"JOIN videos ON videos.id = other.video_id " +
+		"WHERE this.video_id = $1 AND " + relatedCandidateCondition + " " +
+		"GROUP BY other.video_id ORDER BY 2 DESC LIMIT $2"
+	return queryScores(s.db, sql, videoID, limit)
+}
+
+func queryScores(db *sqlx.DB, sql string, videoID int64, limit int) (map[int64]float64, error) {
+	rows, err := db.Query(sql, videoID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make(map[int64]float64)
+	for rows.Next() {
+		var candidateID int64
+		var score float64
+		if err = rows.Scan(&candidateID, &score); err != nil {
+			return nil, err
+		}
+		scores[candidateID] = score
+	}
+
+	return scores, rows.Err()
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSignal struct {
+	scores map[int64]float64
+	err    error
+}
+
+func (s fakeSignal) Name() string {
+	return "fake"
+}
+
+func (s fakeSignal) Scores(videoID int64, limit int) (map[int64]float64, error) {
+	return s.scores, s.err
+}
+
+func TestComputeRelatedNormalisesAndWeightsSignals(t *testing.T) {
+	m, err := NewRelatedModel(nil, []WeightedSignal{
+		// Raw scores on very different scales
+		{Signal: fakeSignal{scores: map[int64]float64{2: 100, 3: 50}}, Weight: 1},
+		{Signal: fakeSignal{scores: map[int64]float64{3: 0.2, 4: 0.1}}, Weight: 0.5},
+	})
+	assert.NoError(t, err)
+
+	related, err := m.ComputeRelated(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []ScoredVideo{{VideoID: 3, Score: 1}, {VideoID: 2, Score: 1}, {VideoID: 4, Score: 0.25}}, related)
+}
+
+func TestComputeRelatedSkipsSourceVideoAndTruncates(t *testing.T) {
+	scores := map[int64]float64{1: 1000}
+	for i := int64(2); i < NumRelatedVideos+10; i++ {
+		scores[i] = float64(i)
+	}
+
+	m, err := NewRelatedModel(nil, []WeightedSignal{{Signal: fakeSignal{scores: scores}, Weight: 1}})
+	assert.NoError(t, err)
+
+	related, err := m.ComputeRelated(1)
+	assert.NoError(t, err)
+	assert.Len(t, related, NumRelatedVideos)
+	assert.Equal(t, int64(NumRelatedVideos+9), related[0].VideoID)
+	for _, r := range related {
+		assert.NotEqual(t, int64(1), r.VideoID)
+	}
+}
+
+func TestComputeRelatedSignalError(t *testing.T) {
+	m, err := NewRelatedModel(nil, []WeightedSignal{{Signal: fakeSignal{err: errors.New("oops")}, Weight: 1}})
+	assert.NoError(t, err)
+
+	_, err = m.ComputeRelated(1)
+	assert.Error(t, err)
+}
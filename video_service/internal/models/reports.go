@@ -0,0 +1,281 @@
+package models
+
+import (
+	"context"
+	sql2 "database/sql"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/horahoradev/horahora/user_service/permissions"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxReportDetailsLength = 1024
+	NumReportsPerPage      = 50
+)
+
+// getContentInfo returns the author of a video or comment, and the video which the content belongs to
+func (v *VideoModel) getContentInfo(contentType videoproto.ContentType, contentID int64) (int64, int64, error) {
+	var row *sql2.Row
+	switch contentType {
+	case videoproto.ContentType_video_content:
+		row = v.db.QueryRow("SELECT userid, id FROM videos WHERE id = $1", contentID)
+	case videoproto.ContentType_comment_content:
+		row = v.db.QueryRow("SELECT user_id, video_id FROM comments WHERE id = $1", contentID)
+	default:
+		return 0, 0, status.Errorf(codes.InvalidArgument, "unknown content type %d", contentType)
+	}
+
+	var authorID, videoID int64
+	err := row.Scan(&authorID, &videoID)
+	switch {
+	case err == sql2.ErrNoRows:
+		return 0, 0, status.Error(codes.NotFound, "content does not exist")
+	case err != nil:
+		return 0, 0, err
+	}
+
+	return authorID, videoID, nil
+}
+
+func (v *VideoModel) ReportContent(userID int64, contentType videoproto.ContentType, contentID int64,
+	reason videoproto.ReportReason, details string) error {
+	if userID == 0 {
+		return status.Error(codes.Unauthenticated, "must be logged in to report content")
+	}
+
+	if _, ok := videoproto.ReportReason_name[int32(reason)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown report reason %d", reason)
+	}
+
+	if utf8.RuneCountInString(details) > maxReportDetailsLength {
+		return status.Errorf(codes.InvalidArgument, "report details must be at most %d characters", maxReportDetailsLength)
+	}
+
+	if _, _, err := v.getContentInfo(contentType, contentID); err != nil {
+		return err
+	}
+
+	sql := "INSERT INTO reports (reporter_id, content_type, content_id, reason, details, creation_date) " +
+		"VALUES ($1, $2, $3, $4, $5, Now()) " +
+		"ON CONFLICT (reporter_id, content_type, content_id) WHERE NOT resolved DO NOTHING"
+	_, err := v.db.Exec(sql, userID, contentType, contentID, reason, details)
+	return err
+}
+
+// ListReports returns one page of the report queue. The second return value is the total number of reported items.
+func (v *VideoModel) ListReports(userID, pageNum int64) ([]*videoproto.ReportedContent, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	sql := "SELECT content_type, content_id, count(*), min(creation_date), " +
+		"array_agg(reason ORDER BY creation_date), " +
+		"array_remove(array_agg(NULLIF(details, '') ORDER BY creation_date), NULL) " +
+		"FROM reports WHERE NOT resolved GROUP BY content_type, content_id " +
+		"ORDER BY count(*) DESC, min(creation_date) ASC LIMIT $1 OFFSET $2"
+
+	rows, err := v.db.Query(sql, NumReportsPerPage, (pageNum-1)*NumReportsPerPage)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var items []*videoproto.ReportedContent
+	var videoIDs, commentIDs []int64
+	for rows.Next() {
+		var item videoproto.ReportedContent
+		var reasons []int64
+		var details []string
+
+		err = rows.Scan(&item.ContentType, &item.ContentID, &item.ReportCount, &item.FirstReported,
+			pq.Array(&reasons), pq.Array(&details))
+		if err != nil {
+			log.Errorf("Failed to scan. Err: %s", err)
+			continue
+		}
+
+		for _, reason := range reasons {
+			item.Reasons = append(item.Reasons, videoproto.ReportReason(reason))
+		}
+		item.Details = details
+
+		switch item.ContentType {
+		case videoproto.ContentType_video_content:
+			videoIDs = append(videoIDs, item.ContentID)
+		case videoproto.ContentType_comment_content:
+			commentIDs = append(commentIDs, item.ContentID)
+		}
+
+		items = append(items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err = v.addReportPreviews(items, videoIDs, commentIDs); err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+	sql = "SELECT count(DISTINCT (content_type, content_id)) FROM reports WHERE NOT resolved"
+	if err = v.db.QueryRow(sql).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	return items, count, nil
+}
+
+type reportPreview struct {
+	ID       int64  `db:"id"`
+	Preview  string `db:"preview"`
+	AuthorID int64  `db:"author_id"`
+	VideoID  int64  `db:"video_id"`
+}
+
+// addReportPreviews fills in what was reported and by whom, with a query per content type rather than per item
+func (v *VideoModel) addReportPreviews(items []*videoproto.ReportedContent, videoIDs, commentIDs []int64) error {
+	var videos, comments []reportPreview
+
+	sql := "SELECT id, title AS preview, userid AS author_id, id AS video_id FROM videos WHERE id = ANY($1)"
+	if err := v.db.Select(&videos, sql, pq.Array(videoIDs)); err != nil {
+		return err
+	}
+
+	sql = "SELECT id, comment AS preview, user_id AS author_id, video_id FROM comments WHERE id = ANY($1)"
+	if err := v.db.Select(&comments, sql, pq.Array(commentIDs)); err != nil {
+		return err
+	}
+
+	previews := map[videoproto.ContentType]map[int64]reportPreview{
+		videoproto.ContentType_video_content:   {},
+		videoproto.ContentType_comment_content: {},
+	}
+
+	var authorIDs []int64
+	for _, p := range videos {
+		previews[videoproto.ContentType_video_content][p.ID] = p
+		authorIDs = append(authorIDs, p.AuthorID)
+	}
+	for _, p := range comments {
+		previews[videoproto.ContentType_comment_content][p.ID] = p
+		authorIDs = append(authorIDs, p.AuthorID)
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		p, ok := previews[item.ContentType][item.ContentID]
+		if !ok {
+			continue
+		}
+
+		item.Preview = p.Preview
+		item.AuthorID = p.AuthorID
+		item.AuthorName = usernames[p.AuthorID]
+		item.VideoID = p.VideoID
+	}
+
+	return nil
+}
+
+// ResolveReports applies the moderator's action, closes all open reports on the content and records the action in the
+// moderation log. Bans are made with the access token of the call in ctx, since the user service checks that the
+// moderator is the caller.
+func (v *VideoModel) ResolveReports(ctx context.Context, userID int64, contentType videoproto.ContentType, contentID int64,
+	action videoproto.ModerationAction, note string) error {
+	actionName, ok := videoproto.ModerationAction_name[int32(action)]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown moderation action %d", action)
+	}
+
+	// Resolving reports is authorized by the gRPC server, but hiding content needs the permission to delete it, so
+	// that reports aren't a way around DeleteComment
+	if action == videoproto.ModerationAction_hide {
+		p := permissions.VideoDelete
+		if contentType == videoproto.ContentType_comment_content {
+			p = permissions.CommentDelete
+		}
+
+		if err := v.requirePermission(userID, p); err != nil {
+			return err
+		}
+	}
+
+	authorID, videoID, err := v.getContentInfo(contentType, contentID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if action == videoproto.ModerationAction_hide {
+		switch contentType {
+		case videoproto.ContentType_video_content:
+			// Rejected videos are left out of every list
+			sql := "UPDATE videos SET is_approved = FALSE, is_rejected = TRUE WHERE id = $1 RETURNING title"
+			var title string
+			if err = tx.QueryRow(sql, contentID).Scan(&title); err != nil {
+				return err
+			}
+
+			msg := fmt.Sprintf("Your video \"%s\" has been hidden by a moderator", title)
+			if err = addNotification(tx, authorID, videoID, NotificationVideoHidden, msg); err != nil {
+				return err
+			}
+
+		case videoproto.ContentType_comment_content:
+			if _, err = tx.Exec("UPDATE comments SET deleted = TRUE WHERE id = $1", contentID); err != nil {
+				return err
+			}
+		}
+	}
+
+	sql := "UPDATE reports SET resolved = TRUE WHERE content_type = $1 AND content_id = $2 AND NOT resolved"
+	if _, err = tx.Exec(sql, contentType, contentID); err != nil {
+		return err
+	}
+
+	if err = logModerationAction(tx, userID, actionName, contentType, contentID, authorID, note); err != nil {
+		return err
+	}
+
+	// The ban is the last thing before committing, so that the reports stay open if it's refused. It can't be rolled
+	// back if committing fails after it, but banning again when the reports are resolved again does no harm.
+	if action == videoproto.ModerationAction_ban_user {
+		// The user service checks that the moderator outranks the author
+		_, err = v.grpcClient.BanUser(permissions.ForwardAccessToken(ctx), &userproto.BanUserRequest{
+			ModeratorID: userID,
+			UserID:      authorID,
+			Reason:      note,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func logModerationAction(e sqlx.Execer, moderatorID int64, action string, contentType videoproto.ContentType, contentID,
+	targetUserID int64, note string) error {
+	sql := "INSERT INTO moderation_log (moderator_id, action, content_type, content_id, target_user_id, note, creation_date) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, Now())"
+	_, err := e.Exec(sql, moderatorID, action, contentType, contentID, targetUserID, note)
+	return err
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReportContentRequiresLogin(t *testing.T) {
+	v := &VideoModel{}
+	err := v.ReportContent(0, videoproto.ContentType_video_content, 5, videoproto.ReportReason_spam, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestReportContentRejectsUnknownReason(t *testing.T) {
+	v := &VideoModel{}
+	err := v.ReportContent(1, videoproto.ContentType_video_content, 5, videoproto.ReportReason(42), "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResolveReportsHideVideoRequiresVideoDelete(t *testing.T) {
+	v, _, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	err := v.ResolveReports(context.Background(), 1, videoproto.ContentType_video_content, 5, videoproto.ModerationAction_hide, "")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestResolveReportsHideCommentRequiresCommentDelete(t *testing.T) {
+	v, _, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+
+	err := v.ResolveReports(context.Background(), 1, videoproto.ContentType_comment_content, 12, videoproto.ModerationAction_hide, "rude")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestResolveReportsHideComment(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_admin)
+	defer done()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, video_id FROM comments WHERE id = $1")).
+		WithArgs(12).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "video_id"}).AddRow(9, 5))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE comments SET deleted = TRUE WHERE id = $1")).
+		WithArgs(12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reports SET resolved = TRUE")).
+		WithArgs(videoproto.ContentType_comment_content, 12).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO moderation_log")).
+		WithArgs(1, "hide", videoproto.ContentType_comment_content, 12, 9, "rude").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := v.ResolveReports(context.Background(), 1, videoproto.ContentType_comment_content, 12, videoproto.ModerationAction_hide, "rude")
+	assert.NoError(t, err)
+}
+
+func expectBanResolution(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, video_id FROM comments WHERE id = $1")).
+		WithArgs(12).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "video_id"}).AddRow(9, 5))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reports SET resolved = TRUE")).
+		WithArgs(videoproto.ContentType_comment_content, 12).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO moderation_log")).
+		WithArgs(1, "ban_user", videoproto.ContentType_comment_content, 12, 9, "spam").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func TestResolveReportsBanUser(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_admin)
+	defer done()
+
+	v.grpcClient.(*usermocks.MockUserServiceClient).EXPECT().
+		BanUser(gomock.Any(), &userproto.BanUserRequest{ModeratorID: 1, UserID: 9, Reason: "spam"}).
+		Return(&userproto.UserResponse{UserID: 9, Banned: true}, nil)
+
+	expectBanResolution(mock)
+	mock.ExpectCommit()
+
+	err := v.ResolveReports(context.Background(), 1, videoproto.ContentType_comment_content, 12, videoproto.ModerationAction_ban_user, "spam")
+	assert.NoError(t, err)
+}
+
+func TestResolveReportsRefusedBanLeavesReportsOpen(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_admin)
+	defer done()
+
+	v.grpcClient.(*usermocks.MockUserServiceClient).EXPECT().
+		BanUser(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.PermissionDenied, "moderators can only ban users of lower rank"))
+
+	expectBanResolution(mock)
+	mock.ExpectRollback()
+
+	err := v.ResolveReports(context.Background(), 1, videoproto.ContentType_comment_content, 12, videoproto.ModerationAction_ban_user, "spam")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,612 @@
+package models
+
+import (
+	sql2 "database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTagLength            = 60
+	maxTagDescriptionLength = 1024
+	defaultTagSuggestions   = 10
+	maxTagSuggestions       = 50
+	defaultTopTags          = 50
+	maxTopTags              = 200
+	NumTagEditsPerPage      = 50
+)
+
+// Tag edit history actions
+const (
+	TagEditUpdate            = "update"
+	TagEditAddAlias          = "add_alias"
+	TagEditRemoveAlias       = "remove_alias"
+	TagEditAddImplication    = "add_implication"
+	TagEditRemoveImplication = "remove_implication"
+	TagEditMerge             = "merge"
+)
+
+// normalizeTag lower cases the tag and collapses runs of whitespace. This must match the normalisation in the V19
+// migration.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+func validateTag(tag string) error {
+	if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+		return status.Errorf(codes.InvalidArgument, "tags must be between 1 and %d characters", maxTagLength)
+	}
+
+	return nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// resolveTag normalises the tag, and returns the tag it's an alias of if it's an alias
+func resolveTag(q sqlx.Queryer, tag string) (string, error) {
+	var resolved string
+	err := q.QueryRowx("SELECT COALESCE((SELECT tag FROM tag_aliases WHERE alias = $1), $1)", normalizeTag(tag)).
+		Scan(&resolved)
+	return resolved, err
+}
+
+// resolveTags normalises the tags, resolves aliases, and adds every tag which they imply. Invalid tags are dropped.
+func resolveTags(q sqlx.Queryer, tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if err := validateTag(tag); err != nil {
+			log.Warnf("Dropping invalid tag %q", tag)
+			continue
+		}
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+
+	rows, err := q.Query("SELECT alias, tag FROM tag_aliases WHERE alias = ANY($1)", pq.Array(normalized))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := make(map[string]string)
+	for rows.Next() {
+		var alias, tag string
+		if err = rows.Scan(&alias, &tag); err != nil {
+			return nil, err
+		}
+		aliases[alias] = tag
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var resolved []string
+	add := func(tag string) {
+		if !seen[tag] {
+			seen[tag] = true
+			resolved = append(resolved, tag)
+		}
+	}
+
+	for _, tag := range normalized {
+		if canonical, ok := aliases[tag]; ok {
+			tag = canonical
+		}
+		add(tag)
+	}
+
+	var implied []string
+	sql := "WITH RECURSIVE implied(tag) AS (SELECT unnest($1::varchar[]) UNION " +
+		"SELECT tag_implications.implied_tag FROM tag_implications JOIN implied ON tag_implications.tag = implied.tag) " +
+		"SELECT tag FROM implied ORDER BY tag"
+	if err = sqlx.Select(q, &implied, sql, pq.Array(resolved)); err != nil {
+		return nil, err
+	}
+
+	for _, tag := range implied {
+		add(tag)
+	}
+
+	return resolved, nil
+}
+
+// GetTag returns a tag or the tag an alias belongs to
+func (v *VideoModel) GetTag(tag string) (*videoproto.TagInfo, error) {
+	tag, err := resolveTag(v.db, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	info := videoproto.TagInfo{Name: tag}
+	sql := "SELECT category, description, video_count FROM tag_definitions WHERE name = $1"
+	err = v.db.QueryRow(sql, tag).Scan(&info.Category, &info.Description, &info.VideoCount)
+	switch {
+	case err == sql2.ErrNoRows:
+		return nil, status.Error(codes.NotFound, "tag does not exist")
+	case err != nil:
+		return nil, err
+	}
+
+	if err = v.db.Select(&info.Aliases, "SELECT alias FROM tag_aliases WHERE tag = $1 ORDER BY alias", tag); err != nil {
+		return nil, err
+	}
+
+	sql = "SELECT implied_tag FROM tag_implications WHERE tag = $1 ORDER BY implied_tag"
+	if err = v.db.Select(&info.Implies, sql, tag); err != nil {
+		return nil, err
+	}
+
+	sql = "SELECT tag FROM tag_implications WHERE implied_tag = $1 ORDER BY tag"
+	if err = v.db.Select(&info.ImpliedBy, sql, tag); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// AutocompleteTags returns the most used tags whose name or one of whose aliases starts with the prefix
+func (v *VideoModel) AutocompleteTags(prefix string, limit int64) ([]*videoproto.TagInfo, error) {
+	prefix = normalizeTag(prefix)
+	if prefix == "" {
+		return nil, nil
+	}
+
+	if limit <= 0 {
+		limit = defaultTagSuggestions
+	} else if limit > maxTagSuggestions {
+		limit = maxTagSuggestions
+	}
+
+	// A tag is only suggested once, preferring a match on its name to a match on an alias
+	sql := "SELECT name, category, video_count, matched_alias FROM (" +
+		"SELECT DISTINCT ON (d.name) d.name, d.category, d.video_count, COALESCE(m.alias, '') AS matched_alias " +
+		"FROM (SELECT name, NULL AS alias FROM tag_definitions WHERE name LIKE $1 " +
+		"UNION ALL SELECT tag, alias FROM tag_aliases WHERE alias LIKE $1) m " +
+		"JOIN tag_definitions d ON d.name = m.name ORDER BY d.name, m.alias NULLS FIRST) matches " +
+		"ORDER BY video_count DESC, name LIMIT $2"
+
+	rows, err := v.db.Query(sql, likeEscaper.Replace(prefix)+"%", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*videoproto.TagInfo
+	for rows.Next() {
+		var tag videoproto.TagInfo
+		if err = rows.Scan(&tag.Name, &tag.Category, &tag.VideoCount, &tag.MatchedAlias); err != nil {
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// GetTagCounts returns the number of videos with each tag, in the order given. Aliases are resolved, and unknown tags
+// have a count of 0. If no tags are given, the most used tags are returned instead.
+func (v *VideoModel) GetTagCounts(tags []string, limit int64) ([]*videoproto.TagInfo, error) {
+	var rows *sql2.Rows
+	var err error
+	if len(tags) == 0 {
+		if limit <= 0 {
+			limit = defaultTopTags
+		} else if limit > maxTopTags {
+			limit = maxTopTags
+		}
+
+		sql := "SELECT name, category, video_count FROM tag_definitions WHERE video_count > 0 " +
+			"ORDER BY video_count DESC, name LIMIT $1"
+		rows, err = v.db.Query(sql, limit)
+	} else {
+		if len(tags) > maxTopTags {
+			return nil, status.Errorf(codes.InvalidArgument, "at most %d tags can be counted at once", maxTopTags)
+		}
+
+		normalized := make([]string, len(tags))
+		for i, tag := range tags {
+			normalized[i] = normalizeTag(tag)
+		}
+
+		sql := "SELECT COALESCE(tag_aliases.tag, t.tag), COALESCE(d.category, 0), COALESCE(d.video_count, 0) " +
+			"FROM unnest($1::varchar[]) WITH ORDINALITY AS t(tag, ord) " +
+			"LEFT JOIN tag_aliases ON tag_aliases.alias = t.tag " +
+			"LEFT JOIN tag_definitions d ON d.name = COALESCE(tag_aliases.tag, t.tag) ORDER BY t.ord"
+		rows, err = v.db.Query(sql, pq.Array(normalized))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*videoproto.TagInfo
+	for rows.Next() {
+		var tag videoproto.TagInfo
+		if err = rows.Scan(&tag.Name, &tag.Category, &tag.VideoCount); err != nil {
+			return nil, err
+		}
+		counts = append(counts, &tag)
+	}
+
+	return counts, rows.Err()
+}
+
+// resolveTagForEdit resolves and validates the tag, creating it if it doesn't exist yet
+func resolveTagForEdit(tx *sqlx.Tx, tag string) (string, error) {
+	tag, err := resolveTag(tx, tag)
+	if err != nil {
+		return "", err
+	}
+
+	if err = validateTag(tag); err != nil {
+		return "", err
+	}
+
+	_, err = tx.Exec("INSERT INTO tag_definitions (name) VALUES ($1) ON CONFLICT DO NOTHING", tag)
+	return tag, err
+}
+
+// beginTagEdit starts a transaction for editing the tag
+func (v *VideoModel) beginTagEdit(userID int64, tag string) (*sqlx.Tx, string, error) {
+	tx, err := v.db.Beginx()
+	if err != nil {
+		return nil, "", err
+	}
+
+	tag, err = resolveTagForEdit(tx, tag)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	return tx, tag, nil
+}
+
+func logTagEdit(e sqlx.Execer, tag string, userID int64, action, details string) error {
+	sql := "INSERT INTO tag_edits (tag, user_id, action, details, creation_date) VALUES ($1, $2, $3, $4, Now())"
+	_, err := e.Exec(sql, tag, userID, action, details)
+	return err
+}
+
+func (v *VideoModel) UpdateTag(userID int64, tag string, category videoproto.TagCategory,
+	description string) (*videoproto.TagInfo, error) {
+	if _, ok := videoproto.TagCategory_name[int32(category)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tag category %d", category)
+	}
+
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxTagDescriptionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "tag descriptions must be at most %d characters",
+			maxTagDescriptionLength)
+	}
+
+	tx, tag, err := v.beginTagEdit(userID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var oldCategory videoproto.TagCategory
+	var oldDescription string
+	sql := "SELECT category, description FROM tag_definitions WHERE name = $1 FOR UPDATE"
+	if err = tx.QueryRow(sql, tag).Scan(&oldCategory, &oldDescription); err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	if category != oldCategory {
+		changes = append(changes, fmt.Sprintf("category: %s -> %s", oldCategory, category))
+	}
+	if description != oldDescription {
+		changes = append(changes, fmt.Sprintf("description: %q -> %q", oldDescription, description))
+	}
+
+	if len(changes) > 0 {
+		sql = "UPDATE tag_definitions SET category = $1, description = $2 WHERE name = $3"
+		if _, err = tx.Exec(sql, category, description, tag); err != nil {
+			return nil, err
+		}
+
+		if err = logTagEdit(tx, tag, userID, TagEditUpdate, strings.Join(changes, "; ")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.GetTag(tag)
+}
+
+// AddTagAlias makes alias resolve to tag. If alias is currently a tag, it's merged into tag: its videos, followers,
+// aliases and implications are moved over, and it's deleted.
+func (v *VideoModel) AddTagAlias(userID int64, tag, alias string) (*videoproto.TagInfo, error) {
+	alias = normalizeTag(alias)
+	if err := validateTag(alias); err != nil {
+		return nil, err
+	}
+
+	tx, tag, err := v.beginTagEdit(userID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if alias == tag {
+		return nil, status.Error(codes.InvalidArgument, "a tag can't be an alias of itself")
+	}
+
+	var existing string
+	err = tx.Get(&existing, "SELECT tag FROM tag_aliases WHERE alias = $1", alias)
+	switch {
+	case err == nil && existing == tag:
+		return v.GetTag(tag)
+	case err == nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "%q is already an alias of %q", alias, existing)
+	case err != sql2.ErrNoRows:
+		return nil, err
+	}
+
+	var isTag bool
+	if err = tx.Get(&isTag, "SELECT EXISTS(SELECT 1 FROM tag_definitions WHERE name = $1)", alias); err != nil {
+		return nil, err
+	}
+
+	if isTag {
+		if err = mergeTag(tx, alias, tag); err != nil {
+			return nil, err
+		}
+
+		if err = logTagEdit(tx, alias, userID, TagEditMerge, "merged into "+tag); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = tx.Exec("INSERT INTO tag_aliases (alias, tag) VALUES ($1, $2)", alias, tag); err != nil {
+		return nil, err
+	}
+
+	if err = logTagEdit(tx, tag, userID, TagEditAddAlias, alias); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.GetTag(tag)
+}
+
+// mergeTag moves everything from one tag to another, and deletes it
+func mergeTag(tx *sqlx.Tx, from, into string) error {
+	statements := []string{
+		// Videos and followers which already have both only keep into
+		"DELETE FROM tags WHERE tag = $1 AND video_id IN (SELECT video_id FROM tags WHERE tag = $2)",
+		"UPDATE tags SET tag = $2 WHERE tag = $1",
+		"DELETE FROM tag_follows WHERE tag = $1 AND user_id IN (SELECT user_id FROM tag_follows WHERE tag = $2)",
+		"UPDATE tag_follows SET tag = $2 WHERE tag = $1",
+		"UPDATE tag_aliases SET tag = $2 WHERE tag = $1",
+		"INSERT INTO tag_implications (tag, implied_tag) SELECT $2, implied_tag FROM tag_implications " +
+			"WHERE tag = $1 AND implied_tag != $2 ON CONFLICT DO NOTHING",
+		"INSERT INTO tag_implications (tag, implied_tag) SELECT tag, $2 FROM tag_implications " +
+			"WHERE implied_tag = $1 AND tag != $2 ON CONFLICT DO NOTHING",
+	}
+
+	for _, sql := range statements {
+		if _, err := tx.Exec(sql, from, into); err != nil {
+			return err
+		}
+	}
+
+	// Cascades to the rest of from's implications
+	if _, err := tx.Exec("DELETE FROM tag_definitions WHERE name = $1", from); err != nil {
+		return err
+	}
+
+	if err := checkImplicationCycle(tx, into); err != nil {
+		return err
+	}
+
+	return materializeImplications(tx, into)
+}
+
+const impliedTagsCTE = "WITH RECURSIVE implied(tag) AS (SELECT implied_tag FROM tag_implications WHERE tag = $1 UNION " +
+	"SELECT tag_implications.implied_tag FROM tag_implications JOIN implied ON tag_implications.tag = implied.tag) "
+
+// checkImplicationCycle returns an error if the tag implies itself
+func checkImplicationCycle(q sqlx.Queryer, tag string) error {
+	var cycle bool
+	err := q.QueryRowx(impliedTagsCTE+"SELECT EXISTS(SELECT 1 FROM implied WHERE tag = $1)", tag).Scan(&cycle)
+	switch {
+	case err != nil:
+		return err
+	case cycle:
+		return status.Errorf(codes.FailedPrecondition, "%q would imply itself", tag)
+	}
+
+	return nil
+}
+
+// materializeImplications tags every video tagged with tag with everything tag implies
+func materializeImplications(e sqlx.Execer, tag string) error {
+	sql := impliedTagsCTE + "INSERT INTO tags (video_id, tag) SELECT tags.video_id, implied.tag FROM tags, implied " +
+		"WHERE tags.tag = $1 ON CONFLICT DO NOTHING"
+	_, err := e.Exec(sql, tag)
+	return err
+}
+
+func (v *VideoModel) RemoveTagAlias(userID int64, tag, alias string) (*videoproto.TagInfo, error) {
+	tx, tag, err := v.beginTagEdit(userID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	alias = normalizeTag(alias)
+	res, err := tx.Exec("DELETE FROM tag_aliases WHERE alias = $1 AND tag = $2", alias, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, status.Errorf(codes.NotFound, "%q is not an alias of %q", alias, tag)
+	}
+
+	if err = logTagEdit(tx, tag, userID, TagEditRemoveAlias, alias); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.GetTag(tag)
+}
+
+// AddTagImplication makes tag imply impliedTag, and tags every video tagged with tag with impliedTag and everything
+// it implies in turn
+func (v *VideoModel) AddTagImplication(userID int64, tag, impliedTag string) (*videoproto.TagInfo, error) {
+	tx, tag, err := v.beginTagEdit(userID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	impliedTag, err = resolveTagForEdit(tx, impliedTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if impliedTag == tag {
+		return nil, status.Error(codes.InvalidArgument, "a tag can't imply itself")
+	}
+
+	sql := "INSERT INTO tag_implications (tag, implied_tag) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	res, err := tx.Exec(sql, tag, impliedTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return v.GetTag(tag)
+	}
+
+	if err = checkImplicationCycle(tx, tag); err != nil {
+		return nil, err
+	}
+
+	if err = materializeImplications(tx, tag); err != nil {
+		return nil, err
+	}
+
+	if err = logTagEdit(tx, tag, userID, TagEditAddImplication, impliedTag); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.GetTag(tag)
+}
+
+// RemoveTagImplication stops tag implying impliedTag for new videos. Videos which were already tagged through the
+// implication keep impliedTag, since they may also have been tagged with it directly.
+func (v *VideoModel) RemoveTagImplication(userID int64, tag, impliedTag string) (*videoproto.TagInfo, error) {
+	tx, tag, err := v.beginTagEdit(userID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	impliedTag, err = resolveTag(tx, impliedTag)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tx.Exec("DELETE FROM tag_implications WHERE tag = $1 AND implied_tag = $2", tag, impliedTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, status.Errorf(codes.NotFound, "%q does not imply %q", tag, impliedTag)
+	}
+
+	if err = logTagEdit(tx, tag, userID, TagEditRemoveImplication, impliedTag); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return v.GetTag(tag)
+}
+
+// GetTagHistory returns one page of edits to the tag, including edits to tags which have since been merged into it.
+// The second return value is the total number of edits.
+func (v *VideoModel) GetTagHistory(userID int64, tag string, pageNum int64) ([]*videoproto.TagEdit, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	tag, err := resolveTag(v.db, tag)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	const condition = "tag = $1 OR tag IN (SELECT alias FROM tag_aliases WHERE tag = $1)"
+	sql := "SELECT id, tag, user_id, action, details, creation_date FROM tag_edits WHERE " + condition +
+		" ORDER BY id DESC LIMIT $2 OFFSET $3"
+	rows, err := v.db.Query(sql, tag, NumTagEditsPerPage, (pageNum-1)*NumTagEditsPerPage)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var edits []*videoproto.TagEdit
+	for rows.Next() {
+		var edit videoproto.TagEdit
+		var creationDate time.Time
+		err = rows.Scan(&edit.Id, &edit.Tag, &edit.UserID, &edit.Action, &edit.Details, &creationDate)
+		if err != nil {
+			return nil, 0, err
+		}
+		edit.CreationDate = creationDate.Format(time.RFC3339)
+		edits = append(edits, &edit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+	if err = v.db.Get(&count, "SELECT count(*) FROM tag_edits WHERE "+condition, tag); err != nil {
+		return nil, 0, err
+	}
+
+	return edits, count, nil
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	assert.Equal(t, "hatsune miku", normalizeTag("  Hatsune\t  MIKU "))
+	assert.Equal(t, "初音ミク", normalizeTag("初音ミク　"))
+	assert.Equal(t, "", normalizeTag("   "))
+}
+
+func TestResolveTagsResolvesAliasesAndImplications(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_regular)
+	defer done()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT alias, tag FROM tag_aliases WHERE alias = ANY($1)")).
+		WithArgs(pq.Array([]string{"初音ミク", "hatsune miku", "meme"})).
+		WillReturnRows(sqlmock.NewRows([]string{"alias", "tag"}).AddRow("初音ミク", "hatsune miku"))
+	mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE implied(tag)")).
+		WithArgs(pq.Array([]string{"hatsune miku", "meme"})).
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("hatsune miku").AddRow("meme").AddRow("vocaloid"))
+
+	tags, err := resolveTags(v.db, []string{"初音ミク", "Hatsune Miku", "meme", " "})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hatsune miku", "meme", "vocaloid"}, tags)
+}
+
+func TestAddTagImplicationRejectsCycles(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT tag FROM tag_aliases WHERE alias = $1), $1)")).
+		WithArgs("vocaloid").
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("vocaloid"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO tag_definitions (name)")).
+		WithArgs("vocaloid").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT tag FROM tag_aliases WHERE alias = $1), $1)")).
+		WithArgs("hatsune miku").
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("hatsune miku"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO tag_definitions (name)")).
+		WithArgs("hatsune miku").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO tag_implications")).
+		WithArgs("vocaloid", "hatsune miku").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM implied WHERE tag = $1)")).
+		WithArgs("vocaloid").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	_, err := v.AddTagImplication(1, "Vocaloid", "Hatsune Miku")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAddTagAliasRejectsAliasOfAnotherTag(t *testing.T) {
+	v, mock, done := newApprovalTestModel(t, userproto.UserRank_trusted)
+	defer done()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT tag FROM tag_aliases WHERE alias = $1), $1)")).
+		WithArgs("vocaloid").
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("vocaloid"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO tag_definitions (name)")).
+		WithArgs("vocaloid").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT tag FROM tag_aliases WHERE alias = $1")).
+		WithArgs("miku").
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("hatsune miku"))
+	mock.ExpectRollback()
+
+	_, err := v.AddTagAlias(1, "vocaloid", "Miku")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
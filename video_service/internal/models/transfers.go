@@ -0,0 +1,30 @@
+package models
+
+import (
+	"context"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TransferVideos moves the videos of a foreign user, which was merged into another user after being claimed, to that
+// user. The user service is the authority on merges, so it's asked first. Doing this again moves any videos archived
+// in between.
+func (v *VideoModel) TransferVideos(fromUserID, toUserID int64) (int64, error) {
+	from, err := v.grpcClient.GetUserFromID(context.TODO(), &userproto.GetUserFromIDRequest{UserID: fromUserID})
+	if err != nil {
+		return 0, err
+	}
+
+	if toUserID == 0 || from.MergedInto != toUserID {
+		return 0, status.Errorf(codes.FailedPrecondition, "user %d wasn't merged into user %d", fromUserID, toUserID)
+	}
+
+	res, err := v.db.Exec("UPDATE videos SET userID = $1 WHERE userID = $2", toUserID, fromUserID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
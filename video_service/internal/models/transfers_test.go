@@ -0,0 +1,54 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTransferTestModel(t *testing.T, mergedInto int64) (*VideoModel, sqlmock.Sqlmock, func()) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := usermocks.NewMockUserServiceClient(mockCtrl)
+	mockClient.EXPECT().
+		GetUserFromID(gomock.Any(), &userproto.GetUserFromIDRequest{UserID: 7}).
+		Return(&userproto.UserResponse{UserID: 7, MergedInto: mergedInto}, nil)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	v := &VideoModel{db: sqlx.NewDb(db, "postgres"), grpcClient: mockClient}
+	return v, mock, func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+		mockCtrl.Finish()
+	}
+}
+
+func TestTransferVideosOfMergedUser(t *testing.T) {
+	v, mock, done := newTransferTestModel(t, 3)
+	defer done()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE videos SET userID = $1 WHERE userID = $2")).
+		WithArgs(3, 7).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	moved, err := v.TransferVideos(7, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), moved)
+}
+
+func TestTransferVideosRequiresMerge(t *testing.T) {
+	v, _, done := newTransferTestModel(t, 0)
+	defer done()
+
+	_, err := v.TransferVideos(7, 3)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
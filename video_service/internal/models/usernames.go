@@ -0,0 +1,101 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	usernameKeyPrefix = "authorname:"
+	// Display names can be edited, so they're only cached for a while
+	usernameCacheTTL = time.Minute * 10
+)
+
+func usernameKey(userID int64) string {
+	return fmt.Sprintf("%s%d", usernameKeyPrefix, userID)
+}
+
+// shownName is the name shown for a user: their display name, or their username if they haven't set one. Usernames
+// of foreign users can have suffixes which keep them unique, but their display names are their original names.
+func shownName(user *userproto.UserResponse) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+
+	return user.Username
+}
+
+// getUsernames resolves the names shown for userIDs (see shownName), first from redis, then with a single batched call
+// to the user service for whatever wasn't cached. Redis failures are logged and treated as cache misses.
+func (v *VideoModel) getUsernames(ctx context.Context, userIDs []int64) (map[int64]string, error) {
+	usernames := make(map[int64]string, len(userIDs))
+
+	// Dedupe, a page of videos or comments will usually have repeat authors
+	var ids []int64
+	seen := make(map[int64]bool, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return usernames, nil
+	}
+
+	missing := ids
+	if v.redisClient != nil {
+		missing = nil
+
+		keys := make([]string, len(ids))
+		for i, id := range ids {
+			keys[i] = usernameKey(id)
+		}
+
+		cached, err := v.redisClient.MGet(keys...).Result()
+		if err != nil {
+			log.Errorf("Failed to fetch cached usernames. Err: %s", err)
+			missing = ids
+		} else {
+			for i, val := range cached {
+				username, ok := val.(string)
+				if !ok {
+					missing = append(missing, ids[i])
+					continue
+				}
+				usernames[ids[i]] = username
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return usernames, nil
+	}
+
+	resp, err := v.grpcClient.GetUsersByIDs(ctx, &userproto.GetUsersByIDsRequest{UserIDs: missing})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range resp.Users {
+		usernames[user.UserID] = shownName(user)
+	}
+
+	if v.redisClient != nil {
+		pipe := v.redisClient.Pipeline()
+		for _, user := range resp.Users {
+			pipe.Set(usernameKey(user.UserID), shownName(user), usernameCacheTTL)
+		}
+
+		if _, err := pipe.Exec(); err != nil {
+			log.Errorf("Failed to cache usernames. Err: %s", err)
+		}
+	}
+
+	return usernames, nil
+}
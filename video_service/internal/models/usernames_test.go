@@ -0,0 +1,45 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	userproto "github.com/horahoradev/horahora/user_service/protocol"
+	usermocks "github.com/horahoradev/horahora/user_service/protocol/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUsernamesBatchesLookups(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockClient := usermocks.NewMockUserServiceClient(mockCtrl)
+	mockClient.EXPECT().
+		GetUsersByIDs(gomock.Any(), &userproto.GetUsersByIDsRequest{UserIDs: []int64{1, 2, 3}}).
+		Return(&userproto.UsersResponse{Users: []*userproto.UserResponse{
+			{UserID: 1, Username: "alice"},
+			{UserID: 2, Username: "bob"},
+			{UserID: 3, Username: "Music-3f2a1c", DisplayName: "Music"},
+		}}, nil).
+		Times(1)
+
+	// No redis client, so every id goes to the user service
+	v := &VideoModel{grpcClient: mockClient}
+
+	// Display names are shown when set
+	usernames, err := v.getUsernames(context.Background(), []int64{1, 2, 1, 1, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]string{1: "alice", 2: "bob", 3: "Music"}, usernames)
+}
+
+func TestGetUsernamesEmpty(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	v := &VideoModel{grpcClient: usermocks.NewMockUserServiceClient(mockCtrl)}
+
+	usernames, err := v.getUsernames(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, usernames)
+}
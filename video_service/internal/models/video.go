@@ -5,6 +5,7 @@ import (
 	sql2 "database/sql"
 	"fmt"
 	"github.com/go-redis/redis"
+	"github.com/lib/pq"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -17,7 +18,6 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	_ "github.com/horahoradev/horahora/user_service/protocol"
 	proto "github.com/horahoradev/horahora/user_service/protocol"
-	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	videoproto "github.com/horahoradev/horahora/video_service/protocol"
 	"github.com/jmoiron/sqlx"
 )
@@ -29,15 +29,21 @@ const (
 )
 
 type VideoModel struct {
-	db         *sqlx.DB
-	grpcClient proto.UserServiceClient
-	//redisClient *redis.Client
+	db                *sqlx.DB
+	grpcClient        proto.UserServiceClient
+	redisClient       *redis.Client
 	ApprovalThreshold int
 }
 
 func NewVideoModel(db *sqlx.DB, client proto.UserServiceClient, redisClient *redis.Client, approvalThreshold int) (*VideoModel, error) {
+	if approvalThreshold < 1 {
+		return nil, fmt.Errorf("approval threshold must be at least 1, got %d", approvalThreshold)
+	}
+
 	return &VideoModel{db: db,
-		grpcClient: client,
+		grpcClient:        client,
+		redisClient:       redisClient,
+		ApprovalThreshold: approvalThreshold,
 	}, nil
 }
 
@@ -48,70 +54,24 @@ func NewVideoModel(db *sqlx.DB, client proto.UserServiceClient, redisClient *red
 // If domesticAuthorID is 0, will interpret as foreign video from foreign user
 func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description string, foreignAuthorUsername string, foreignAuthorID string,
 	originalSite proto.Site, originalVideoLink, originalVideoID, newURI string, tags []string, domesticAuthorID int64) (int64, error) {
-	tx, err := v.db.BeginTx(ctx, nil)
+	tx, err := v.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return 0, err
 	}
 
-	req := proto.GetForeignUserRequest{
-		OriginalWebsite: originalSite,
-		ForeignUserID:   foreignAuthorID,
-	}
-
 	horahoraUID := domesticAuthorID
-
 	if horahoraUID == 0 {
-		resp, err := v.grpcClient.GetUserForForeignUID(ctx, &req)
-		grpcErr, ok := status.FromError(err)
-		if !ok {
-			return 0, fmt.Errorf("could not parse gRPC err")
-		}
-		switch {
-		case grpcErr.Message() == errors.UserDoesNotExistMessage:
-			// Create the user
-			log.Info("User does not exist for video, creating...")
-
-			regReq := proto.RegisterRequest{
-				Email:          "",
-				Username:       foreignAuthorUsername,
-				Password:       "",
-				ForeignUser:    true,
-				ForeignUserID:  foreignAuthorID,
-				ForeignWebsite: originalSite,
-			}
-			regResp, err := v.grpcClient.Register(ctx, &regReq)
-			if err != nil {
-				return 0, err
-			}
-
-			validateReq := proto.ValidateJWTRequest{
-				Jwt: regResp.Jwt,
-			}
-
-			// The validation is superfluous, but we need the claims
-			// FIXME: can probably optimize
-			validateResp, err := v.grpcClient.ValidateJWT(ctx, &validateReq)
-			if err != nil {
-				return 0, err
-			}
-
-			if !validateResp.IsValid {
-				return 0, fmt.Errorf("jwt invalid (this should never happen!)")
-			}
-
-			horahoraUID = validateResp.Uid
-
-		case err != nil:
+		horahoraUID, err = v.getOrCreateForeignUser(ctx, foreignAuthorUsername, foreignAuthorID, originalSite)
+		if err != nil {
 			return 0, err
-
-		case err == nil:
-			horahoraUID = resp.NewUID
 		}
 	}
 
+	// With no ratings yet, the Bayesian rating is the prior mean. Setting it now means new videos rank there straight
+	// away, rather than last until the prior is next refreshed.
 	sql := "INSERT INTO videos (title, description, userID, originalSite, " +
-		"originalLink, newLink, originalID, upload_date) " +
-		"VALUES ($1, $2, $3, $4, $5, $6, $7, Now())" +
+		"originalLink, newLink, originalID, upload_date, bayesian_rating) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, Now(), (SELECT mean FROM rating_prior))" +
 		"returning id"
 
 	// By this point the user should exist
@@ -127,6 +87,12 @@ func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description st
 		return 0, err
 	}
 
+	tags, err = resolveTags(tx, tags)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
 	tagSQL := "INSERT INTO tags (video_id, tag) VALUES ($1, $2)"
 	for _, tag := range tags {
 		_, err = tx.Exec(tagSQL, videoID, tag)
@@ -145,6 +111,62 @@ func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description st
 	return videoID, nil
 }
 
+// getOrCreateForeignUser returns the ID of the user standing in for an account on the original site, registering one if
+// it doesn't exist yet
+func (v *VideoModel) getOrCreateForeignUser(ctx context.Context, foreignUsername, foreignUserID string, site proto.Site) (int64, error) {
+	req := proto.GetForeignUserRequest{
+		OriginalWebsite: site,
+		ForeignUserID:   foreignUserID,
+	}
+
+	resp, err := v.grpcClient.GetUserForForeignUID(ctx, &req)
+	grpcErr, ok := status.FromError(err)
+	if !ok {
+		return 0, fmt.Errorf("could not parse gRPC err")
+	}
+	switch {
+	case grpcErr.Message() == errors.UserDoesNotExistMessage:
+		// Create the user
+		log.Infof("Foreign user %s does not exist, creating...", foreignUserID)
+
+		regReq := proto.RegisterRequest{
+			Email:          "",
+			Username:       foreignUsername,
+			Password:       "",
+			ForeignUser:    true,
+			ForeignUserID:  foreignUserID,
+			ForeignWebsite: site,
+		}
+		regResp, err := v.grpcClient.Register(ctx, &regReq)
+		if err != nil {
+			return 0, err
+		}
+
+		validateReq := proto.ValidateJWTRequest{
+			Jwt: regResp.Jwt,
+		}
+
+		// The validation is superfluous, but we need the claims
+		// FIXME: can probably optimize
+		validateResp, err := v.grpcClient.ValidateJWT(ctx, &validateReq)
+		if err != nil {
+			return 0, err
+		}
+
+		if !validateResp.IsValid {
+			return 0, fmt.Errorf("jwt invalid (this should never happen!)")
+		}
+
+		return validateResp.Uid, nil
+
+	case err != nil:
+		return 0, err
+
+	default:
+		return resp.NewUID, nil
+	}
+}
+
 func (v *VideoModel) ForeignVideoExists(foreignVideoID string, website videoproto.Website) (bool, error) {
 	sql := "SELECT id FROM videos WHERE originalSite=$1 AND originalID=$2"
 	var videoID int64
@@ -160,69 +182,40 @@ func (v *VideoModel) ForeignVideoExists(foreignVideoID string, website videoprot
 	}
 }
 
-func (v *VideoModel) IncrementViewsForVideo(videoID int64) error {
-	sql := "UPDATE videos SET views = views + 1 WHERE id = $1"
-	_, err := v.db.Exec(sql, videoID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (v *VideoModel) AddRatingToVideoID(ratingUID, videoID int64, ratingValue float64) error {
-	sql := "INSERT INTO ratings (user_id, video_id, rating) VALUES ($1, $2, $3)" +
-		"ON CONFLICT (user_id, video_id) DO update SET rating = $4"
-	_, err := v.db.Exec(sql, ratingUID, videoID, ratingValue, ratingValue)
-	if err != nil {
-		return err
-	}
-
-	rating, err := v.GetAverageRatingForVideoID(videoID)
-	if err != nil {
-		return err
-	}
-
-	_, err = v.db.Exec("UPDATE videos SET rating = $1 WHERE id = $2", rating, videoID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // For now, this only supports either fromUserID or withTag. Can support both in future, need to switch to
 // goqu and write better tests
 func (v *VideoModel) GetVideoList(direction videoproto.SortDirection, pageNum int64, fromUserID int64, withTag string, showUnapproved bool,
 	category videoproto.OrderCategory) ([]*videoproto.Video, error) {
+	if withTag != "" {
+		var err error
+		if withTag, err = resolveTag(v.db, withTag); err != nil {
+			return nil, err
+		}
+	}
+
 	sql, err := generateVideoListSQL(direction, pageNum, fromUserID, withTag, showUnapproved, category)
 	if err != nil {
 		return nil, err
 	}
 
 	var results []*videoproto.Video
+	var authorIDs []int64
 
 	rows, err := v.db.Query(sql)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var video videoproto.Video
 		var authorID, views int64
 		var mpdLoc string
-		err = rows.Scan(&video.VideoID, &video.VideoTitle, &authorID, &mpdLoc, &views)
-		if err != nil {
-			return nil, err
-		}
-
-		basicInfo, err := v.getBasicVideoInfo(authorID, video.VideoID)
+		err = rows.Scan(&video.VideoID, &video.VideoTitle, &authorID, &mpdLoc, &views, &video.Rating)
 		if err != nil {
 			return nil, err
 		}
 
-		video.Rating = basicInfo.rating
-		video.AuthorName = basicInfo.authorName
 		video.Views = uint64(views)
 
 		// FIXME: nothing is quite as dumb as this
@@ -231,6 +224,67 @@ func (v *VideoModel) GetVideoList(direction videoproto.SortDirection, pageNum in
 
 		// TODO: could alloc in advance
 		results = append(results, &video)
+		authorIDs = append(authorIDs, authorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, video := range results {
+		video.AuthorName = usernames[authorIDs[i]]
+	}
+
+	return results, nil
+}
+
+// GetVideosByIDs returns the approved videos among videoIDs, in the same order.
+func (v *VideoModel) GetVideosByIDs(videoIDs []int64) ([]*videoproto.Video, error) {
+	sql := "SELECT videos.id, videos.title, videos.userid, videos.newlink, videos.views, " +
+		"COALESCE(videos.rating, 0) FROM videos " +
+		"WHERE videos.id = ANY($1) AND videos.is_approved IS TRUE AND videos.is_rejected IS NOT TRUE " +
+		"ORDER BY array_position($1::bigint[], videos.id::bigint)"
+
+	rows, err := v.db.Query(sql, pq.Array(videoIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []*videoproto.Video{}
+	var authorIDs []int64
+	for rows.Next() {
+		var video videoproto.Video
+		var authorID, views int64
+		var mpdLoc string
+		err = rows.Scan(&video.VideoID, &video.VideoTitle, &authorID, &mpdLoc, &views, &video.Rating)
+		if err != nil {
+			return nil, err
+		}
+
+		video.Views = uint64(views)
+		video.ThumbnailLoc = strings.Replace(mpdLoc, ".mpd", ".jpg", 1)
+
+		results = append(results, &video)
+		authorIDs = append(authorIDs, authorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, video := range results {
+		video.AuthorName = usernames[authorIDs[i]]
 	}
 
 	return results, nil
@@ -245,8 +299,13 @@ func (v *VideoModel) GetNumberOfSearchResultsForQuery(fromUserID int64, withTag
 		sql = "SELECT COUNT(*) FROM videos WHERE userID = $1"
 		args = []interface{}{fromUserID}
 	case withTag != "":
+		tag, err := resolveTag(v.db, withTag)
+		if err != nil {
+			return 0, err
+		}
+
 		sql = "SELECT COUNT(DISTINCT video_id) FROM tags WHERE tag = $1"
-		args = []interface{}{withTag}
+		args = []interface{}{tag}
 	default:
 		sql = "SELECT COUNT(*) FROM videos"
 		args = []interface{}{}
@@ -272,8 +331,10 @@ func generateVideoListSQL(direction videoproto.SortDirection, pageNum, fromUserI
 	minResultNum := (pageNum - 1) * NumResultsPerPage
 	dialect := goqu.Dialect("postgres")
 
+	// Ratings are aggregated on the video as they come in, see ratings.go
 	ds := dialect.
-		Select("videos.id", "title", "userid", "newlink", "views").
+		Select("videos.id", "title", "userid", "newlink", "views",
+			goqu.COALESCE(goqu.I("rating"), 0).As("avg_rating")).
 		From(
 			goqu.T("videos"),
 		).
@@ -298,12 +359,27 @@ func generateVideoListSQL(direction videoproto.SortDirection, pageNum, fromUserI
 		}
 
 	case videoproto.OrderCategory_rating:
-		// could've done a WITH and inner join onto ratings table... but whatever, this is fine
+		switch direction {
+		// The Bayesian rating keeps videos with a few high votes from outranking well reviewed ones
+		case videoproto.SortDirection_asc:
+			ds = ds.Order(goqu.I("bayesian_rating").Asc())
+		case videoproto.SortDirection_desc:
+			ds = ds.Order(goqu.I("bayesian_rating").Desc())
+		}
+
+	// These are maintained incrementally as views, ratings and comments come in, see views.go and hot.go
+	case videoproto.OrderCategory_trending_day, videoproto.OrderCategory_trending_week, videoproto.OrderCategory_hot:
+		column := map[videoproto.OrderCategory]string{
+			videoproto.OrderCategory_trending_day:  "views_day",
+			videoproto.OrderCategory_trending_week: "views_week",
+			videoproto.OrderCategory_hot:           "hot_score",
+		}[orderCategory]
+
 		switch direction {
 		case videoproto.SortDirection_asc:
-			ds = ds.Order(goqu.I("rating").Asc())
+			ds = ds.Order(goqu.I(column).Asc(), goqu.I("upload_date").Asc())
 		case videoproto.SortDirection_desc:
-			ds = ds.Order(goqu.I("rating").Desc())
+			ds = ds.Order(goqu.I(column).Desc(), goqu.I("upload_date").Desc())
 		}
 	}
 
@@ -322,6 +398,9 @@ func generateVideoListSQL(direction videoproto.SortDirection, pageNum, fromUserI
 	if !showUnapproved {
 		// only show approved
 		ds = ds.Where(goqu.C("is_approved").Eq(true))
+	} else {
+		// Privileged users see the moderation queue, but rejected videos are out of it
+		ds = ds.Where(goqu.C("is_rejected").IsNotTrue())
 	}
 
 	// Only show transcoded videos
@@ -334,31 +413,32 @@ func generateVideoListSQL(direction videoproto.SortDirection, pageNum, fromUserI
 	return sql, err
 }
 
-type basicVideoInfo struct {
-	authorName string
-	rating     float64
-}
-
 // Information that isn't super straightforward to query for
 func (v *VideoModel) GetVideoInfo(videoID string) (*videoproto.VideoMetadata, error) {
-	sql := "SELECT id, title, description, upload_date, userID, newLink, views FROM videos WHERE id=$1"
+	sql := "SELECT id, title, description, upload_date, userID, newLink, views, COALESCE(rating, 0), rating_count, " +
+		"bayesian_rating FROM videos WHERE id=$1"
 	var video videoproto.VideoMetadata
 	var authorID, views int64
 
 	row := v.db.QueryRow(sql, videoID)
 
-	err := row.Scan(&video.VideoID, &video.VideoTitle, &video.Description, &video.UploadDate, &authorID, &video.VideoLoc, &views)
+	err := row.Scan(&video.VideoID, &video.VideoTitle, &video.Description, &video.UploadDate, &authorID, &video.VideoLoc, &views,
+		&video.Rating, &video.RatingCount, &video.BayesianRating)
+	if err != nil {
+		return nil, err
+	}
+
+	usernames, err := v.getUsernames(context.TODO(), []int64{authorID})
 	if err != nil {
 		return nil, err
 	}
 
-	basicInfo, err := v.getBasicVideoInfo(authorID, video.VideoID)
+	video.RatingHistogram, err = v.getRatingHistogram(video.VideoID)
 	if err != nil {
 		return nil, err
 	}
 
-	video.Rating = basicInfo.rating
-	video.AuthorName = basicInfo.authorName
+	video.AuthorName = usernames[authorID]
 	video.Views = uint64(views)
 	video.AuthorID = authorID
 
@@ -369,6 +449,11 @@ func (v *VideoModel) GetVideoInfo(videoID string) (*videoproto.VideoMetadata, er
 
 	video.Tags = tags
 
+	video.AlsoAvailableFrom, err = v.getMirrors(video.VideoID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &video, nil
 }
 
@@ -394,132 +479,6 @@ func (v *VideoModel) getVideoTags(videoID string) ([]string, error) {
 	return ret, nil
 }
 
-func (v *VideoModel) getUserInfo(authorID int64) (*userproto.UserResponse, error) {
-	// Given user id, look up author name
-	userReq := proto.GetUserFromIDRequest{
-		UserID: authorID,
-	}
-
-	userResp, err := v.grpcClient.GetUserFromID(context.TODO(), &userReq)
-	if err != nil {
-		// maybe we should skip if we can't look them up?
-		return nil, err
-	}
-
-	return userResp, nil
-}
-
-func (v *VideoModel) getBasicVideoInfo(authorID int64, videoID int64) (*basicVideoInfo, error) {
-	var videoInfo basicVideoInfo
-
-	var err error
-
-	resp, err := v.getUserInfo(authorID)
-	if err != nil {
-		return nil, err
-	}
-
-	videoInfo.authorName = resp.Username
-
-	// Look up ratings from redis
-	videoInfo.rating, err = v.GetAverageRatingForVideoID(videoID)
-	switch {
-	case err != nil && err.Error() == "redis: nil":
-		break
-	case err != nil:
-		return nil, err
-	}
-
-	return &videoInfo, nil
-}
-
-func (v *VideoModel) GetAverageRatingForVideoID(videoID int64) (float64, error) {
-	var rating float64
-	sql := "SELECT sum(rating)/count(*) FROM ratings WHERE video_id = $1"
-	res := v.db.QueryRow(sql, videoID)
-	if err := res.Scan(&rating); err != nil {
-		// LMAO FIXME
-		return 0.00, nil
-	}
-
-	return rating, nil
-}
-
-func (v *VideoModel) MarkVideoApproved(videoID string) error {
-	sql := "UPDATE videos SET is_approved = TRUE WHERE id = $1"
-	_, err := v.db.Exec(sql, videoID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// TODO: refactor into separate models by concern
-// e.g. approvalsmodel, viewmodel, etc
-
-// Individual trusted user approves of the video
-func (v *VideoModel) ApproveVideo(userID, videoID int) error {
-	sql := "INSERT INTO approvals (user_id, video_id) VALUES ($1, $2)"
-	_, err := v.db.Exec(sql, userID, videoID)
-	if err != nil {
-		return err
-	}
-
-	if err = v.MarkApprovals(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-type Approval struct {
-	VideoID string `db:"video_id"`
-}
-
-func (v *VideoModel) MarkApprovals() error {
-	var approvals []Approval
-	sql := "SELECT video_id FROM approvals GROUP BY video_id HAVING count(*) >= 1"
-
-	if err := v.db.Select(&approvals, sql); err != nil {
-		log.Errorf("Failed to retrieve video approvals. Err: %s", err)
-		return err
-	}
-
-	for _, approval := range approvals {
-		err := v.MarkVideoApproved(approval.VideoID)
-		if err != nil {
-			// Log and move on
-			log.Errorf("Could not mark video %s as approved. Err: %s", approval.VideoID, err)
-		}
-	}
-
-	return nil
-}
-
-// Comment stuff
-func (v *VideoModel) MakeComment(userID, videoID, parentID int64, content string) error {
-	switch parentID {
-	case 0:
-		sql := "INSERT INTO comments (user_id, video_id, comment, creation_date)" +
-			" VALUES ($1, $2, $3, Now())"
-		_, err := v.db.Exec(sql, userID, videoID, content)
-		if err != nil {
-			return err
-		}
-
-	default:
-		sql := "INSERT INTO comments (user_id, video_id, parent_comment, comment, creation_date)" +
-			" VALUES ($1, $2, $3, $4, Now())"
-		_, err := v.db.Exec(sql, userID, videoID, parentID, content)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 type UnencodedVideo struct {
 	ID      uint32 `db:"id"`
 	NewLink string `db:"newlink"`
@@ -550,67 +509,29 @@ func (v *VideoModel) MarkVideoAsEncoded(uv UnencodedVideo) error {
 		return err
 	}
 
-	return nil
-}
-
-func (v *VideoModel) MakeUpvote(userID, commentID int64, isUpvote bool) error {
-	// lol bruh moment
-	// this is a hack... I'll probably keep it this way until we have actual downvotes though
-	var voteScore = 0
-	if isUpvote {
-		voteScore = 1
-	}
-
-	sql := "INSERT INTO comment_upvotes (user_id, comment_id, vote_score) VALUES ($1, $2, $3)" +
-		"ON CONFLICT (user_id, comment_id) DO update SET vote_score = $4"
-	_, err := v.db.Exec(sql, userID, commentID, voteScore, voteScore)
-	if err != nil {
-		return err
+	// The video is usable either way, so a failed notification isn't worth failing the transcode over
+	if err = v.notifyEncoded(int64(uv.ID)); err != nil {
+		log.Errorf("Could not notify uploader of encoded video %d. Err: %s", uv.ID, err)
 	}
 
 	return nil
 }
 
-func (v *VideoModel) GetComments(videoID, currUserID int64) ([]*videoproto.Comment, error) {
-	var comments []*videoproto.Comment
-	sql := "SELECT id, sum(COALESCE(vote_score, 0)) as upvote_score, comments.user_id," +
-		" creation_date, comment, COALESCE(parent_comment, 0) " +
-		"FROM comments LEFT JOIN comment_upvotes ON id = comment_id GROUP BY id,comment_upvotes.comment_id HAVING video_id = $1"
-	rows, err := v.db.Query(sql, videoID)
+// GetVideosWithUnimportedMetadata returns videos whose raw metadata file hasn't been imported yet. UnencodedVideo is
+// reused since only the storage location is needed.
+func (v *VideoModel) GetVideosWithUnimportedMetadata() ([]UnencodedVideo, error) {
+	sql := "SELECT id, newLink FROM videos WHERE raw_metadata_imported = false ORDER BY id LIMIT 100"
+	var videos []UnencodedVideo
+	err := v.db.Select(&videos, sql)
 	if err != nil {
 		return nil, err
 	}
 
-	for rows.Next() {
-		var comment videoproto.Comment
-
-		err = rows.Scan(&comment.CommentId, &comment.VoteScore, &comment.AuthorId,
-			&comment.CreationDate, &comment.Content, &comment.ParentId)
-		if err != nil {
-			log.Errorf("Failed to scan. Err: %s", err)
-			continue
-		}
-		resp, err := v.getUserInfo(comment.AuthorId)
-		if err != nil {
-			log.Errorf("Failed to retrieve username for comment with author id %d. Err: %s",
-				comment.AuthorId, err)
-			continue
-		}
-
-		comment.AuthorUsername = resp.Username
-		comment.AuthorProfileImageUrl = "/static/images/placeholder1.jpg"
-
-		var score uint64
-		sqlTwo := "SELECT vote_score FROM comment_upvotes WHERE user_id = $1 AND comment_id = $2"
-
-		res := v.db.QueryRow(sqlTwo, currUserID, comment.CommentId)
-		err = res.Scan(&score)
-		if err == nil && score > 0 {
-			comment.CurrentUserHasUpvoted = true
-		}
-
-		comments = append(comments, &comment)
-	}
+	return videos, nil
+}
 
-	return comments, nil
+func (v *VideoModel) MarkRawMetadataImported(videoID int64) error {
+	sql := "UPDATE videos SET raw_metadata_imported = true WHERE id = $1"
+	_, err := v.db.Exec(sql, videoID)
+	return err
 }
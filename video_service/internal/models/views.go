@@ -0,0 +1,313 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	videoproto "github.com/horahoradev/horahora/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Hash of "<videoID>:<YYYY-MM-DD>" -> views recorded since the last flush
+	pendingViewsKey = "views:pending"
+	// pendingViewsKey is renamed to this while a flush is in progress, so views recorded during the flush aren't lost
+	flushingViewsKey = "views:flushing"
+	viewerKeyPrefix  = "views:seen:"
+	viewDayFormat    = "2006-01-02"
+)
+
+// ViewModel records video views in redis and periodically flushes them to postgres in batches.
+type ViewModel struct {
+	db          *sqlx.DB
+	redisClient *redis.Client
+	dedupWindow time.Duration
+	hashSalt    string
+}
+
+func NewViewModel(db *sqlx.DB, redisClient *redis.Client, dedupWindow time.Duration, hashSalt string) (*ViewModel, error) {
+	if dedupWindow <= 0 {
+		return nil, fmt.Errorf("view dedup window must be positive, got %s", dedupWindow)
+	}
+
+	return &ViewModel{
+		db:          db,
+		redisClient: redisClient,
+		dedupWindow: dedupWindow,
+		hashSalt:    hashSalt,
+	}, nil
+}
+
+// viewerID identifies the viewer for deduplication. Logged in users are identified by their user ID, everyone else by a
+// salted hash of their IP so that raw IPs never end up in redis.
+func (m *ViewModel) viewerID(userID int64, clientIP string) (string, error) {
+	switch {
+	case userID != 0:
+		return fmt.Sprintf("u%d", userID), nil
+	case clientIP != "":
+		h := sha256.Sum256([]byte(m.hashSalt + clientIP))
+		return "ip" + hex.EncodeToString(h[:]), nil
+	default:
+		return "", fmt.Errorf("either a user ID or client IP is required to record a view")
+	}
+}
+
+// RecordView counts a view of videoID, unless the same viewer already viewed it within the dedup window.
+// Returns whether the view was counted.
+func (m *ViewModel) RecordView(videoID, userID int64, clientIP string) (bool, error) {
+	viewer, err := m.viewerID(userID, clientIP)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err = m.db.QueryRow("SELECT EXISTS(SELECT 1 FROM videos WHERE id = $1)", videoID).Scan(&exists); err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, status.Error(codes.NotFound, "video does not exist")
+	}
+
+	seenKey := fmt.Sprintf("%s%d:%s", viewerKeyPrefix, videoID, viewer)
+	firstView, err := m.redisClient.SetNX(seenKey, 1, m.dedupWindow).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if !firstView {
+		return false, nil
+	}
+
+	field := fmt.Sprintf("%d:%s", videoID, time.Now().UTC().Format(viewDayFormat))
+	if err = m.redisClient.HIncrBy(pendingViewsKey, field, 1).Err(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// FlushViews moves the views recorded in redis since the last flush into postgres, in a single transaction.
+func (m *ViewModel) FlushViews() error {
+	// If the last flush failed partway, retry its batch before starting a new one
+	exists, err := m.redisClient.Exists(flushingViewsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if exists == 0 {
+		err = m.redisClient.Rename(pendingViewsKey, flushingViewsKey).Err()
+		switch {
+		case err != nil && strings.Contains(err.Error(), "no such key"):
+			// Nothing was viewed since the last flush
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+
+	counts, err := m.redisClient.HGetAll(flushingViewsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for field, countStr := range counts {
+		videoID, day, err := parseViewField(field)
+		if err != nil {
+			// Don't let one bad entry block the rest of the batch forever
+			log.Errorf("Skipping malformed pending view entry %s. Err: %s", field, err)
+			continue
+		}
+
+		count, err := strconv.ParseInt(countStr, 10, 64)
+		if err != nil {
+			log.Errorf("Skipping pending view entry %s with malformed count %s. Err: %s", field, countStr, err)
+			continue
+		}
+
+		sql := "UPDATE videos SET views = views + $1, views_day = views_day + $1, views_week = views_week + $1 WHERE id = $2"
+		if _, err = tx.Exec(sql, count, videoID); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		// The video may have been deleted since the view was recorded, in which case its views are dropped rather than
+		// failing the whole batch on the foreign key. Flushes are frequent, so bucketing by flush time is close enough.
+		_, err = tx.Exec("INSERT INTO video_views_hourly (video_id, hour, views) "+
+			"SELECT id, date_trunc('hour', Now()), $2::int FROM videos WHERE id = $1 "+
+			"ON CONFLICT (video_id, hour) DO UPDATE SET views = video_views_hourly.views + EXCLUDED.views", videoID, count)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err = addHotScore(tx, videoID, float64(count)*hotViewWeight); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO video_views_daily (video_id, day, views) SELECT id, $2::date, $3::int FROM videos WHERE id = $1 "+
+			"ON CONFLICT (video_id, day) DO UPDATE SET views = video_views_daily.views + EXCLUDED.views", videoID, day, count)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// If this fails the batch will be counted twice, which is preferable to dropping it
+	return m.redisClient.Del(flushingViewsKey).Err()
+}
+
+// ExpireTrendingViews subtracts views that have left the day and week windows from views_day and views_week, and
+// drops hourly counts older than a week. Each hour is only subtracted once, however often this runs.
+func (m *ViewModel) ExpireTrendingViews() error {
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var dayExpiredBefore, weekExpiredBefore time.Time
+	sql := "SELECT day_expired_before, week_expired_before FROM trending_windows FOR UPDATE"
+	if err = tx.QueryRow(sql).Scan(&dayExpiredBefore, &weekExpiredBefore); err != nil {
+		return err
+	}
+
+	// The windows include the current, partial hour
+	currentHour := time.Now().UTC().Truncate(time.Hour)
+	dayCutoff := currentHour.Add(-23 * time.Hour)
+	weekCutoff := currentHour.Add(-(7*24 - 1) * time.Hour)
+
+	if err = expireWindow(tx, "views_day", dayExpiredBefore, dayCutoff); err != nil {
+		return err
+	}
+
+	if err = expireWindow(tx, "views_week", weekExpiredBefore, weekCutoff); err != nil {
+		return err
+	}
+
+	sql = "UPDATE trending_windows SET day_expired_before = GREATEST(day_expired_before, $1), " +
+		"week_expired_before = GREATEST(week_expired_before, $2)"
+	if _, err = tx.Exec(sql, dayCutoff, weekCutoff); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("DELETE FROM video_views_hourly WHERE hour < $1", weekCutoff); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// expireWindow subtracts the hourly views in [from, to) from column. column is one of our own column names.
+func expireWindow(tx *sqlx.Tx, column string, from, to time.Time) error {
+	if !to.After(from) {
+		return nil
+	}
+
+	sql := fmt.Sprintf("UPDATE videos SET %[1]s = GREATEST(%[1]s - expired.views, 0) FROM "+
+		"(SELECT video_id, sum(views) AS views FROM video_views_hourly WHERE hour >= $1 AND hour < $2 GROUP BY video_id) expired "+
+		"WHERE videos.id = expired.video_id", column)
+	_, err := tx.Exec(sql, from, to)
+	return err
+}
+
+func parseViewField(field string) (int64, string, error) {
+	spl := strings.SplitN(field, ":", 2)
+	if len(spl) != 2 {
+		return 0, "", fmt.Errorf("expected <videoID>:<day>")
+	}
+
+	videoID, err := strconv.ParseInt(spl[0], 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if _, err = time.Parse(viewDayFormat, spl[1]); err != nil {
+		return 0, "", err
+	}
+
+	return videoID, spl[1], nil
+}
+
+type dailyViews struct {
+	Day   time.Time `db:"day"`
+	Views uint64    `db:"views"`
+}
+
+// GetDailyViews returns the view count for each of the last numDays days (including today), oldest first.
+// Views which haven't been flushed yet are included.
+func (m *ViewModel) GetDailyViews(videoID int64, numDays int) ([]*videoproto.DailyViewCount, error) {
+	if numDays <= 0 {
+		return nil, fmt.Errorf("number of days must be positive")
+	}
+
+	today := time.Now().UTC().Truncate(time.Hour * 24)
+	start := today.AddDate(0, 0, -(numDays - 1))
+
+	var rows []dailyViews
+	sql := "SELECT day, views FROM video_views_daily WHERE video_id = $1 AND day >= $2 ORDER BY day"
+	if err := m.db.Select(&rows, sql, videoID, start.Format(viewDayFormat)); err != nil {
+		return nil, err
+	}
+
+	viewsByDay := make(map[string]uint64, numDays)
+	for _, row := range rows {
+		viewsByDay[row.Day.Format(viewDayFormat)] = row.Views
+	}
+
+	days := make([]string, numDays)
+	fields := make([]string, numDays)
+	for i := range days {
+		days[i] = start.AddDate(0, 0, i).Format(viewDayFormat)
+		fields[i] = fmt.Sprintf("%d:%s", videoID, days[i])
+	}
+
+	for _, key := range []string{pendingViewsKey, flushingViewsKey} {
+		pending, err := m.redisClient.HMGet(key, fields...).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for i, val := range pending {
+			countStr, ok := val.(string)
+			if !ok {
+				continue
+			}
+
+			count, err := strconv.ParseUint(countStr, 10, 64)
+			if err != nil {
+				log.Errorf("Malformed pending view count %s for %s. Err: %s", countStr, fields[i], err)
+				continue
+			}
+
+			viewsByDay[days[i]] += count
+		}
+	}
+
+	ret := make([]*videoproto.DailyViewCount, numDays)
+	for i, day := range days {
+		ret[i] = &videoproto.DailyViewCount{
+			Date:  day,
+			Views: viewsByDay[day],
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestViewerID(t *testing.T) {
+	m, err := NewViewModel(nil, nil, time.Hour, "salt")
+	assert.NoError(t, err)
+
+	id, err := m.viewerID(5, "127.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "u5", id)
+
+	id, err = m.viewerID(0, "127.0.0.1")
+	assert.NoError(t, err)
+	assert.NotContains(t, id, "127.0.0.1")
+
+	sameID, err := m.viewerID(0, "127.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, id, sameID)
+
+	otherID, err := m.viewerID(0, "127.0.0.2")
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, otherID)
+
+	_, err = m.viewerID(0, "")
+	assert.Error(t, err)
+}
+
+func TestParseViewField(t *testing.T) {
+	videoID, day, err := parseViewField("12:2021-08-04")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), videoID)
+	assert.Equal(t, "2021-08-04", day)
+
+	for _, field := range []string{"12", "abc:2021-08-04", "12:yesterday"} {
+		_, _, err = parseViewField(field)
+		assert.Error(t, err, field)
+	}
+}
+
+func TestNewViewModelRejectsEmptyWindow(t *testing.T) {
+	_, err := NewViewModel(nil, nil, 0, "")
+	assert.Error(t, err)
+}
+
+// Views of videos that don't exist are refused before anything is queued in redis, since the flush couldn't store them
+func TestRecordViewRequiresVideo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	m, err := NewViewModel(sqlx.NewDb(db, "postgres"), nil, time.Hour, "salt")
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM videos WHERE id = $1)")).
+		WithArgs(12).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	counted, err := m.RecordView(12, 5, "")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.False(t, counted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,89 @@
+package rawmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Parent of top-level comments
+const rootParent = "root"
+
+// foreignID is a comment ID, which is a string from yt-dlp but was a number in older versions of youtube-dl
+type foreignID string
+
+func (f *foreignID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*f = ""
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*f = foreignID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*f = foreignID(n.String())
+	return nil
+}
+
+type comment struct {
+	ID        foreignID `json:"id"`
+	Parent    foreignID `json:"parent"`
+	Author    string    `json:"author"`
+	AuthorID  string    `json:"author_id"`
+	Text      string    `json:"text"`
+	Timestamp int64     `json:"timestamp"` // unix seconds
+	LikeCount int64     `json:"like_count"`
+}
+
+type ArchivedComment struct {
+	ForeignID       string
+	ForeignParentID string // Empty for top-level comments
+	AuthorName      string
+	ForeignAuthorID string
+	Content         string
+	PostedAt        time.Time
+	LikeCount       int64
+}
+
+// ArchivedComments returns the comments from the original site (youtube's --get-comments) in their original order.
+// Replies whose parent is missing from the file are kept as top-level comments rather than dropped.
+func (m *Metadata) ArchivedComments() []ArchivedComment {
+	seen := make(map[foreignID]bool, len(m.Comments))
+	for _, c := range m.Comments {
+		seen[c.ID] = true
+	}
+
+	var ret []ArchivedComment
+	for _, c := range m.Comments {
+		if c.ID == "" {
+			continue
+		}
+
+		ac := ArchivedComment{
+			ForeignID:       string(c.ID),
+			AuthorName:      c.Author,
+			ForeignAuthorID: c.AuthorID,
+			Content:         c.Text,
+			PostedAt:        time.Unix(c.Timestamp, 0).UTC(),
+			LikeCount:       c.LikeCount,
+		}
+
+		if c.Parent != "" && c.Parent != rootParent && c.Parent != c.ID && seen[c.Parent] {
+			ac.ForeignParentID = string(c.Parent)
+		}
+
+		ret = append(ret, ac)
+	}
+
+	return ret
+}
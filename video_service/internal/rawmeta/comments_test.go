@@ -0,0 +1,59 @@
+package rawmeta
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const youtubeMetadata = `{
+	"id": "dQw4w9WgXcQ",
+	"extractor": "youtube",
+	"comments": [
+		{"id": "Ugx1", "parent": "root", "author": "alice", "author_id": "UCa", "text": "first", "timestamp": 1600000000, "like_count": 12},
+		{"id": "Ugx1.r1", "parent": "Ugx1", "author": "bob", "author_id": "UCb", "text": "reply", "timestamp": 1600000100},
+		{"id": "Ugx2.r1", "parent": "Ugx2", "author": "carol", "author_id": "UCc", "text": "orphan", "timestamp": 1600000200},
+		{"id": 7, "parent": 5, "author": "dave", "author_id": "UCd", "text": "old youtube-dl", "timestamp": 1600000300},
+		{"parent": "root", "text": "no id"}
+	]
+}`
+
+func TestArchivedComments(t *testing.T) {
+	m, err := Parse(strings.NewReader(youtubeMetadata))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []ArchivedComment{
+		{
+			ForeignID:       "Ugx1",
+			AuthorName:      "alice",
+			ForeignAuthorID: "UCa",
+			Content:         "first",
+			PostedAt:        time.Unix(1600000000, 0).UTC(),
+			LikeCount:       12,
+		},
+		{
+			ForeignID:       "Ugx1.r1",
+			ForeignParentID: "Ugx1",
+			AuthorName:      "bob",
+			ForeignAuthorID: "UCb",
+			Content:         "reply",
+			PostedAt:        time.Unix(1600000100, 0).UTC(),
+		},
+		{
+			ForeignID:       "Ugx2.r1",
+			AuthorName:      "carol",
+			ForeignAuthorID: "UCc",
+			Content:         "orphan",
+			PostedAt:        time.Unix(1600000200, 0).UTC(),
+		},
+		{
+			ForeignID:       "7",
+			AuthorName:      "dave",
+			ForeignAuthorID: "UCd",
+			Content:         "old youtube-dl",
+			PostedAt:        time.Unix(1600000300, 0).UTC(),
+		},
+	}, m.ArchivedComments())
+}
@@ -0,0 +1,200 @@
+package rawmeta
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type Position int32
+
+// Values match videoproto.DanmakuPosition
+const (
+	Scrolling Position = iota
+	FixedTop
+	FixedBottom
+)
+
+const DefaultColor = 0xFFFFFF
+
+type Danmaku struct {
+	PositionMs      int64
+	Content         string
+	Position        Position
+	Color           int32 // 0xRRGGBB
+	ForeignAuthorID string
+	PostedAt        time.Time
+}
+
+// Danmaku returns the time-synced comments from both niconico and bilibili metadata, ordered by playback position.
+func (m *Metadata) Danmaku() []Danmaku {
+	var ret []Danmaku
+
+	for _, thread := range [][]nicoEntry{m.RawComments.En, m.RawComments.Jp, m.RawComments.Cn} {
+		for _, entry := range thread {
+			if entry.Chat == nil || entry.Chat.Content == "" || entry.Chat.Deleted != 0 {
+				continue
+			}
+
+			ret = append(ret, nicoChatToDanmaku(entry.Chat))
+		}
+	}
+
+	for _, subs := range [][]subtitle{m.Subtitles.DanmakuEn, m.Subtitles.DanmakuJp, m.Subtitles.DanmakuCn, m.Subtitles.Danmaku} {
+		for _, sub := range subs {
+			if sub.Ext != "xml" {
+				continue
+			}
+
+			d, err := parseBilibiliXML(sub.Data)
+			if err != nil {
+				log.Errorf("Failed to parse bilibili danmaku for %s. Err: %s", m.ID, err)
+				continue
+			}
+
+			ret = append(ret, d...)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].PositionMs < ret[j].PositionMs
+	})
+
+	return ret
+}
+
+var nicoColors = map[string]int32{
+	"white":  0xFFFFFF,
+	"red":    0xFF0000,
+	"pink":   0xFF8080,
+	"orange": 0xFFC000,
+	"yellow": 0xFFFF00,
+	"green":  0x00FF00,
+	"cyan":   0x00FFFF,
+	"blue":   0x0000FF,
+	"purple": 0xC000FF,
+	"black":  0x000000,
+}
+
+func nicoChatToDanmaku(chat *nicoChat) Danmaku {
+	d := Danmaku{
+		PositionMs:      chat.Vpos * 10,
+		Content:         chat.Content,
+		Position:        Scrolling,
+		Color:           DefaultColor,
+		ForeignAuthorID: chat.UserID,
+		PostedAt:        time.Unix(chat.Date, 0).UTC(),
+	}
+
+	for _, cmd := range strings.Fields(chat.Mail) {
+		switch {
+		case cmd == "ue":
+			d.Position = FixedTop
+		case cmd == "shita":
+			d.Position = FixedBottom
+		case strings.HasPrefix(cmd, "#") && len(cmd) == 7:
+			// premium users can use arbitrary colors
+			if c, err := strconv.ParseInt(cmd[1:], 16, 32); err == nil {
+				d.Color = int32(c)
+			}
+		default:
+			if c, ok := nicoColors[cmd]; ok {
+				d.Color = c
+			}
+		}
+	}
+
+	return d
+}
+
+type bilibiliDanmakuList struct {
+	Entries []struct {
+		// time (s), mode, font size, color, unix timestamp, pool, user hash, row id
+		P       string `xml:"p,attr"`
+		Content string `xml:",chardata"`
+	} `xml:"d"`
+}
+
+func parseBilibiliXML(data string) ([]Danmaku, error) {
+	var list bilibiliDanmakuList
+	if err := xml.Unmarshal([]byte(data), &list); err != nil {
+		return nil, err
+	}
+
+	var ret []Danmaku
+	for _, entry := range list.Entries {
+		d, err := bilibiliEntryToDanmaku(entry.P, entry.Content)
+		if err != nil {
+			// Don't throw away the whole file for one bad entry
+			log.Errorf("Skipping malformed bilibili danmaku %s. Err: %s", entry.P, err)
+			continue
+		}
+
+		if d != nil {
+			ret = append(ret, *d)
+		}
+	}
+
+	return ret, nil
+}
+
+// Returns nil for danmaku types which can't be displayed as plain text (advanced and code danmaku)
+func bilibiliEntryToDanmaku(p, content string) (*Danmaku, error) {
+	fields := strings.Split(p, ",")
+	if len(fields) < 5 {
+		return nil, fmt.Errorf("expected at least 5 fields, got %d", len(fields))
+	}
+
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := strconv.ParseInt(fields[3], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	postedAt, err := strconv.ParseInt(fields[4], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	d := Danmaku{
+		PositionMs: int64(seconds * 1000),
+		Content:    content,
+		Color:      int32(color),
+		PostedAt:   time.Unix(postedAt, 0).UTC(),
+	}
+
+	if len(fields) > 6 {
+		d.ForeignAuthorID = fields[6]
+	}
+
+	switch mode {
+	case 1, 2, 3, 6:
+		d.Position = Scrolling
+	case 4:
+		d.Position = FixedBottom
+	case 5:
+		d.Position = FixedTop
+	default:
+		return nil, nil
+	}
+
+	if content == "" {
+		return nil, nil
+	}
+
+	return &d, nil
+}
@@ -0,0 +1,89 @@
+package rawmeta
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const nicoMetadata = `{
+	"id": "sm9",
+	"extractor": "niconico",
+	"raw_comments": {
+		"jp": [
+			{"ping": {"content": "rs:0"}},
+			{"thread": {"resultcode": 0, "thread": "1173108780"}},
+			{"chat": {"thread": "1173108780", "no": 2, "vpos": 1234, "date": 1173112008, "user_id": "abc", "mail": "184 ue red", "content": "wwww"}},
+			{"chat": {"thread": "1173108780", "no": 1, "vpos": 100, "date": 1173112000, "user_id": "def", "mail": "#00FF00 shita", "content": "first"}},
+			{"chat": {"thread": "1173108780", "no": 3, "vpos": 5000, "date": 1173112009, "deleted": 1}}
+		]
+	}
+}`
+
+func TestNiconicoDanmaku(t *testing.T) {
+	m, err := Parse(strings.NewReader(nicoMetadata))
+	assert.NoError(t, err)
+
+	d := m.Danmaku()
+	assert.Equal(t, []Danmaku{
+		{
+			PositionMs:      1000,
+			Content:         "first",
+			Position:        FixedBottom,
+			Color:           0x00FF00,
+			ForeignAuthorID: "def",
+			PostedAt:        time.Unix(1173112000, 0).UTC(),
+		},
+		{
+			PositionMs:      12340,
+			Content:         "wwww",
+			Position:        FixedTop,
+			Color:           0xFF0000,
+			ForeignAuthorID: "abc",
+			PostedAt:        time.Unix(1173112008, 0).UTC(),
+		},
+	}, d)
+}
+
+const bilibiliMetadata = `{
+	"id": "BV1xx411c7mD",
+	"extractor": "BiliBili",
+	"subtitles": {
+		"danmaku": [
+			{"ext": "xml", "data": "<?xml version=\"1.0\" encoding=\"UTF-8\"?><i><chatid>1</chatid><d p=\"2.5,1,25,16777215,1312863760,0,eff85771,42759017\">hello</d><d p=\"0.5,5,25,255,1312863761,0,aaaa,1\">top</d><d p=\"1,7,25,255,1312863761,0,bbbb,2\">[advanced]</d><d p=\"bad\">bad</d></i>"}
+		]
+	}
+}`
+
+func TestBilibiliDanmaku(t *testing.T) {
+	m, err := Parse(strings.NewReader(bilibiliMetadata))
+	assert.NoError(t, err)
+
+	d := m.Danmaku()
+	assert.Equal(t, []Danmaku{
+		{
+			PositionMs:      500,
+			Content:         "top",
+			Position:        FixedTop,
+			Color:           255,
+			ForeignAuthorID: "aaaa",
+			PostedAt:        time.Unix(1312863761, 0).UTC(),
+		},
+		{
+			PositionMs:      2500,
+			Content:         "hello",
+			Position:        Scrolling,
+			Color:           DefaultColor,
+			ForeignAuthorID: "eff85771",
+			PostedAt:        time.Unix(1312863760, 0).UTC(),
+		},
+	}, d)
+}
+
+func TestParseEmpty(t *testing.T) {
+	m, err := Parse(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Empty(t, m.Danmaku())
+}
@@ -0,0 +1,71 @@
+// Package rawmeta reads the youtube-dl metadata file (<uuid>.json) which the scheduler uploads alongside each archived
+// video. Only the fields which the video service imports are parsed, everything else is ignored.
+package rawmeta
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+type Metadata struct {
+	ID          string      `json:"id"`
+	Extractor   string      `json:"extractor"`
+	RawComments rawComments `json:"raw_comments"`
+	Subtitles   subtitles   `json:"subtitles"`
+	Comments    []comment   `json:"comments"`
+}
+
+// Niconico comments, one list per language thread
+type rawComments struct {
+	En []nicoEntry `json:"en"`
+	Jp []nicoEntry `json:"jp"`
+	Cn []nicoEntry `json:"cn"`
+}
+
+// Each entry is one of ping, thread, leaf or chat. Only chat entries are actual comments.
+type nicoEntry struct {
+	Chat *nicoChat `json:"chat,omitempty"`
+}
+
+type nicoChat struct {
+	No      int64  `json:"no"`
+	Vpos    int64  `json:"vpos"` // hundredths of a second
+	Date    int64  `json:"date"` // unix seconds
+	UserID  string `json:"user_id"`
+	Mail    string `json:"mail"` // space separated commands, e.g. "184 ue red big"
+	Content string `json:"content"`
+	Deleted int    `json:"deleted"`
+}
+
+// Bilibili danmaku are stored as subtitles, with the comment XML in data
+type subtitles struct {
+	DanmakuEn []subtitle `json:"danmaku-en"`
+	DanmakuJp []subtitle `json:"danmaku-jp"`
+	DanmakuCn []subtitle `json:"danmaku-cn"`
+	Danmaku   []subtitle `json:"danmaku"`
+}
+
+type subtitle struct {
+	Ext  string `json:"ext"`
+	Data string `json:"data"`
+}
+
+// Parse reads a raw metadata file. An empty file (e.g. for direct uploads, which have no metadata) is not an error.
+func Parse(r io.Reader) (*Metadata, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Metadata
+	if len(data) == 0 {
+		return &m, nil
+	}
+
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -1,8 +0,0 @@
-package storage
-
-import "os"
-
-type Storage interface {
-	Fetch(path string) (*os.File, error)
-	Upload(path, desiredFilename string) error
-}
@@ -0,0 +1,18 @@
+-- approvals now records votes in either direction
+ALTER TABLE approvals ADD COLUMN approved bool NOT NULL DEFAULT true;
+ALTER TABLE approvals ADD COLUMN reason varchar(1024);
+ALTER TABLE approvals ADD COLUMN creation_date timestamp DEFAULT Now();
+
+ALTER TABLE videos ADD COLUMN is_rejected bool DEFAULT false;
+
+CREATE TABLE notifications (
+    id SERIAL primary key,
+    user_id int NOT NULL,
+    video_id int REFERENCES videos(id),
+    kind varchar(60) NOT NULL,
+    message varchar(4096),
+    creation_date timestamp DEFAULT Now(),
+    is_read bool DEFAULT false
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, creation_date);
@@ -0,0 +1,25 @@
+CREATE TABLE reports (
+    id SERIAL primary key,
+    reporter_id int NOT NULL,
+    content_type smallint NOT NULL, -- 0 video, 1 comment
+    content_id int NOT NULL,
+    reason smallint NOT NULL,
+    details varchar(1024),
+    creation_date timestamp DEFAULT Now(),
+    resolved bool DEFAULT false
+);
+
+-- One open report per user per piece of content
+CREATE UNIQUE INDEX reports_open_unique_idx ON reports (reporter_id, content_type, content_id) WHERE NOT resolved;
+CREATE INDEX reports_open_content_idx ON reports (content_type, content_id) WHERE NOT resolved;
+
+CREATE TABLE moderation_log (
+    id SERIAL primary key,
+    moderator_id int NOT NULL,
+    action varchar(60) NOT NULL,
+    content_type smallint,
+    content_id int,
+    target_user_id int,
+    note varchar(1024),
+    creation_date timestamp DEFAULT Now()
+);
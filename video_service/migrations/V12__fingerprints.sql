@@ -0,0 +1,26 @@
+-- uint32 audio sub-fingerprints are stored as bigint, uint64 frame hashes are stored as their int64 bit pattern
+CREATE TABLE video_fingerprints (
+    video_id int primary key REFERENCES videos(id),
+    frame_hashes bigint[],
+    audio_hashes bigint[],
+    creation_date timestamp DEFAULT Now()
+);
+
+-- Frame hashes split into 16 bit bands, for finding candidate duplicates without comparing against every video
+CREATE TABLE video_frame_hash_bands (
+    video_id int REFERENCES videos(id),
+    band smallint,
+    value int,
+    PRIMARY KEY (band, value, video_id)
+);
+
+-- "Also available from" links, stored in both directions
+CREATE TABLE video_mirrors (
+    video_id int REFERENCES videos(id),
+    mirror_video_id int REFERENCES videos(id),
+    similarity real,
+    PRIMARY KEY (video_id, mirror_video_id)
+);
+
+-- Existing videos are picked up by the backfill job
+ALTER TABLE videos ADD COLUMN fingerprinted bool DEFAULT false;
@@ -0,0 +1,36 @@
+CREATE TABLE playlists (
+    id SERIAL primary key,
+    user_id int NOT NULL,
+    title varchar(256) NOT NULL,
+    description varchar(4096) NOT NULL DEFAULT '',
+    visibility smallint NOT NULL DEFAULT 0, -- 0 public, 1 unlisted, 2 private
+    mirror_site smallint, -- Set for playlists mirrored from another site by the scheduler
+    mirror_id varchar(255),
+    creation_date timestamp DEFAULT Now(),
+    last_updated timestamp DEFAULT Now(),
+    UNIQUE (user_id, mirror_site, mirror_id)
+);
+
+CREATE INDEX playlists_user_idx ON playlists (user_id);
+
+-- Positions start at 0. They're contiguous for regular playlists, and follow the original playlist for mirrored
+-- playlists, with gaps for videos which haven't been archived yet.
+CREATE TABLE playlist_videos (
+    playlist_id int REFERENCES playlists(id) ON DELETE CASCADE,
+    video_id int REFERENCES videos(id),
+    position int NOT NULL,
+    added_date timestamp DEFAULT Now(),
+    PRIMARY KEY (playlist_id, video_id)
+);
+
+CREATE INDEX playlist_videos_position_idx ON playlist_videos (playlist_id, position);
+
+-- The full contents of mirrored playlists, including videos which haven't been archived yet
+CREATE TABLE mirrored_playlist_entries (
+    playlist_id int REFERENCES playlists(id) ON DELETE CASCADE,
+    position int NOT NULL,
+    foreign_id varchar(255) NOT NULL,
+    PRIMARY KEY (playlist_id, position)
+);
+
+CREATE INDEX mirrored_playlist_entries_foreign_idx ON mirrored_playlist_entries (foreign_id);
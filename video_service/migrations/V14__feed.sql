@@ -0,0 +1,11 @@
+CREATE TABLE tag_follows (
+    user_id int NOT NULL,
+    tag varchar(60) NOT NULL,
+    creation_date timestamp DEFAULT Now(),
+    PRIMARY KEY (user_id, tag)
+);
+
+-- The feed is paginated on (upload_date, id)
+CREATE INDEX videos_upload_date_id_idx ON videos (upload_date DESC, id DESC);
+CREATE INDEX videos_userid_upload_date_idx ON videos (userID, upload_date DESC);
+CREATE INDEX tags_tag_idx ON tags (tag);
@@ -0,0 +1,4 @@
+-- Replies link back to the comment that was replied to
+ALTER TABLE notifications ADD COLUMN comment_id int REFERENCES comments(id);
+
+CREATE INDEX notifications_unread_idx ON notifications (user_id) WHERE is_read IS NOT TRUE;
@@ -0,0 +1,14 @@
+-- Precomputed related videos, refreshed in the background
+CREATE TABLE related_videos (
+    video_id int REFERENCES videos(id) ON DELETE CASCADE,
+    related_video_id int REFERENCES videos(id) ON DELETE CASCADE,
+    score float NOT NULL,
+    PRIMARY KEY(video_id, related_video_id)
+);
+
+CREATE INDEX related_videos_score_idx ON related_videos (video_id, score DESC);
+
+ALTER TABLE videos ADD COLUMN related_computed_at timestamp;
+
+CREATE INDEX ratings_video_id_idx ON ratings (video_id);
+CREATE INDEX tags_video_id_idx ON tags (video_id);
@@ -0,0 +1,40 @@
+-- Views per hour, kept for a week. Views are bucketed by the hour they're flushed in.
+CREATE TABLE video_views_hourly (
+    video_id int REFERENCES videos(id),
+    hour timestamp,
+    views int DEFAULT 0,
+    PRIMARY KEY(video_id, hour)
+);
+
+CREATE INDEX video_views_hourly_hour_idx ON video_views_hourly (hour);
+
+-- Rolling view counts, incremented when views are flushed and decremented as hours leave the window. These start
+-- at zero, since there's no hourly history for existing views.
+ALTER TABLE videos ADD COLUMN views_day int NOT NULL DEFAULT 0;
+ALTER TABLE videos ADD COLUMN views_week int NOT NULL DEFAULT 0;
+
+-- Hours before these have been subtracted from views_day and views_week
+CREATE TABLE trending_windows (
+    id bool PRIMARY KEY DEFAULT true CHECK (id),
+    day_expired_before timestamp NOT NULL,
+    week_expired_before timestamp NOT NULL
+);
+
+INSERT INTO trending_windows (day_expired_before, week_expired_before) VALUES (date_trunc('hour', Now()), date_trunc('hour', Now()));
+
+-- The hot score is the log of sum(weight * 2^((t - epoch) / half life)) over views, ratings, comments and the upload
+-- itself, so that events can be added without ever recomputing it. See hot.go for the weights; the epoch
+-- (2021-01-01) and one day half life here must match.
+CREATE FUNCTION hot_score_add(score float, x float) RETURNS float AS $$
+    SELECT CASE WHEN score IS NULL THEN x ELSE GREATEST(score, x) + ln(1 + exp(-abs(score - x))) END
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE videos ADD COLUMN hot_score float
+    DEFAULT (extract(epoch FROM Now() - timestamp '2021-01-01') * ln(2) / 86400);
+
+UPDATE videos SET hot_score = ln(1 + COALESCE(views, 0)) +
+    extract(epoch FROM COALESCE(upload_date, Now()) - timestamp '2021-01-01') * ln(2) / 86400;
+
+CREATE INDEX videos_views_day_idx ON videos (views_day DESC);
+CREATE INDEX videos_views_week_idx ON videos (views_week DESC);
+CREATE INDEX videos_hot_score_idx ON videos (hot_score DESC);
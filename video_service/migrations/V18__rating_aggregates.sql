@@ -0,0 +1,30 @@
+-- Ratings used to accept any float
+UPDATE ratings SET rating = LEAST(GREATEST(rating, 0), 10) WHERE rating < 0 OR rating > 10;
+DELETE FROM ratings WHERE rating IS NULL OR rating = 'NaN';
+
+-- Maintained incrementally as ratings are added, changed and retracted. videos.rating remains the plain mean.
+ALTER TABLE videos ADD COLUMN rating_count int NOT NULL DEFAULT 0;
+ALTER TABLE videos ADD COLUMN rating_sum float NOT NULL DEFAULT 0;
+ALTER TABLE videos ADD COLUMN bayesian_rating float;
+
+-- Every video is treated as though it had this many extra votes at the site-wide mean, so that a handful of votes
+-- can't outrank hundreds. The mean is refreshed periodically.
+CREATE TABLE rating_prior (
+    id bool PRIMARY KEY DEFAULT true CHECK (id),
+    mean float NOT NULL,
+    votes float NOT NULL
+);
+
+UPDATE videos SET rating_count = r.n, rating_sum = r.total
+    FROM (SELECT video_id, count(*) AS n, sum(rating) AS total FROM ratings GROUP BY video_id) r
+    WHERE videos.id = r.video_id;
+
+INSERT INTO rating_prior (mean, votes)
+    SELECT COALESCE(sum(rating_sum) / NULLIF(sum(rating_count), 0), 5), 10 FROM videos;
+
+UPDATE videos SET rating = COALESCE(rating_sum / NULLIF(rating_count, 0), 0),
+    bayesian_rating = (rating_prior.votes * rating_prior.mean + rating_sum) / (rating_prior.votes + rating_count)
+    FROM rating_prior;
+
+ALTER TABLE videos ALTER COLUMN bayesian_rating SET DEFAULT 0;
+CREATE INDEX videos_bayesian_rating_idx ON videos (bayesian_rating DESC);
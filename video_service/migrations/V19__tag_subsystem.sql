@@ -0,0 +1,74 @@
+-- Tags are normalised to lower case with single spaces, so archived and user tags which only differ in case or
+-- spacing are the same tag
+DELETE FROM tags WHERE tag IS NULL OR btrim(tag) = '';
+UPDATE tags SET tag = lower(regexp_replace(btrim(tag), '\s+', ' ', 'g'));
+DELETE FROM tags a USING tags b WHERE a.video_id = b.video_id AND a.tag = b.tag AND a.id > b.id;
+ALTER TABLE tags ALTER COLUMN tag SET NOT NULL;
+CREATE UNIQUE INDEX tags_video_id_tag_idx ON tags (video_id, tag);
+
+DELETE FROM tag_follows a USING tag_follows b WHERE a.user_id = b.user_id AND a.tag > b.tag AND
+    lower(regexp_replace(btrim(a.tag), '\s+', ' ', 'g')) = lower(regexp_replace(btrim(b.tag), '\s+', ' ', 'g'));
+UPDATE tag_follows SET tag = lower(regexp_replace(btrim(tag), '\s+', ' ', 'g'));
+
+-- Category is a TagCategory: 0 general, 1 artist, 2 series, 3 character, 4 meta
+CREATE TABLE tag_definitions (
+    name varchar(60) primary key,
+    category smallint NOT NULL DEFAULT 0,
+    description varchar(1024) NOT NULL DEFAULT '',
+    video_count int NOT NULL DEFAULT 0
+);
+
+CREATE INDEX tag_definitions_name_prefix_idx ON tag_definitions (name varchar_pattern_ops);
+CREATE INDEX tag_definitions_video_count_idx ON tag_definitions (video_count DESC, name);
+
+-- e.g. the Japanese and English names for the same tag. An alias is never also a tag.
+CREATE TABLE tag_aliases (
+    alias varchar(60) primary key,
+    tag varchar(60) NOT NULL REFERENCES tag_definitions(name) ON DELETE CASCADE
+);
+
+CREATE INDEX tag_aliases_alias_prefix_idx ON tag_aliases (alias varchar_pattern_ops);
+CREATE INDEX tag_aliases_tag_idx ON tag_aliases (tag);
+
+-- Videos tagged with tag are also tagged with implied_tag
+CREATE TABLE tag_implications (
+    tag varchar(60) NOT NULL REFERENCES tag_definitions(name) ON DELETE CASCADE,
+    implied_tag varchar(60) NOT NULL REFERENCES tag_definitions(name) ON DELETE CASCADE,
+    PRIMARY KEY (tag, implied_tag),
+    CHECK (tag != implied_tag)
+);
+
+CREATE INDEX tag_implications_implied_tag_idx ON tag_implications (implied_tag);
+
+-- Not a foreign key, so that history outlives tags which are merged into others
+CREATE TABLE tag_edits (
+    id SERIAL primary key,
+    tag varchar(60) NOT NULL,
+    user_id int NOT NULL,
+    action varchar(60) NOT NULL,
+    details text NOT NULL DEFAULT '',
+    creation_date timestamp DEFAULT Now()
+);
+
+CREATE INDEX tag_edits_tag_idx ON tag_edits (tag, id DESC);
+
+INSERT INTO tag_definitions (name, video_count) SELECT tag, count(*) FROM tags GROUP BY tag;
+
+-- Keeps video_count up to date, and makes sure every tag in use has a definition
+CREATE FUNCTION count_tag_usage() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO tag_definitions (name, video_count) VALUES (NEW.tag, 1)
+        ON CONFLICT (name) DO UPDATE SET video_count = tag_definitions.video_count + 1;
+    END IF;
+
+    IF TG_OP IN ('DELETE', 'UPDATE') THEN
+        UPDATE tag_definitions SET video_count = video_count - 1 WHERE name = OLD.tag;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tags_count_usage AFTER INSERT OR DELETE OR UPDATE OF tag ON tags
+    FOR EACH ROW EXECUTE PROCEDURE count_tag_usage();
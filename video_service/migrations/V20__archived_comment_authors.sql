@@ -0,0 +1,8 @@
+-- Archived comments are attributed to foreign users, like imported videos are. Comments which have already been
+-- imported are attributed by the same background pass as new ones, so nothing needs to be re-imported.
+ALTER TABLE archived_comments ADD COLUMN author_id int;
+
+CREATE INDEX archived_comments_author_id_idx ON archived_comments (author_id);
+
+-- For finding comments which still need attributing
+CREATE INDEX archived_comments_unattributed_idx ON archived_comments (foreign_author_id) WHERE author_id IS NULL;
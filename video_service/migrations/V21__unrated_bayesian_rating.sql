@@ -0,0 +1,2 @@
+-- Videos uploaded since the rating aggregates were added started with a Bayesian rating of 0 instead of the prior mean
+UPDATE videos SET bayesian_rating = rating_prior.mean FROM rating_prior WHERE rating_count = 0;
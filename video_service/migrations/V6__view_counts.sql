@@ -0,0 +1,7 @@
+-- Views are recorded in redis and flushed here periodically, videos.views remains the all-time total
+CREATE TABLE video_views_daily (
+    video_id int REFERENCES videos(id),
+    day date,
+    views int DEFAULT 0,
+    PRIMARY KEY(video_id, day)
+);
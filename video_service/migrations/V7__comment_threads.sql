@@ -0,0 +1,8 @@
+ALTER TABLE comments ADD COLUMN edited_at timestamp;
+ALTER TABLE comments ADD COLUMN deleted bool DEFAULT false;
+
+-- vote_score is now 1 for an upvote or -1 for a downvote, retracted votes are removed instead of set to 0
+DELETE FROM comment_upvotes WHERE vote_score = 0;
+ALTER TABLE comment_upvotes ADD CONSTRAINT comment_upvotes_vote_score_check CHECK (vote_score IN (-1, 1));
+
+CREATE INDEX comments_video_id_parent_comment_idx ON comments (video_id, parent_comment);
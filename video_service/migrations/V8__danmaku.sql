@@ -0,0 +1,16 @@
+CREATE TABLE danmaku (
+    id SERIAL primary key,
+    video_id int REFERENCES videos(id),
+    user_id int, -- NULL if imported from the original site
+    foreign_author_id varchar(200),
+    position_ms int,
+    content varchar(1024),
+    position smallint DEFAULT 0,
+    color int DEFAULT 16777215,
+    creation_date timestamp
+);
+
+CREATE INDEX danmaku_video_id_position_ms_idx ON danmaku (video_id, position_ms);
+
+-- Existing videos are picked up by the backfill job
+ALTER TABLE videos ADD COLUMN raw_metadata_imported bool DEFAULT false;
@@ -0,0 +1,20 @@
+-- Comments imported from the original site. These are read-only, so they're kept apart from native comments rather
+-- than sharing their votes, edits and deletion
+CREATE TABLE archived_comments (
+    id SERIAL primary key,
+    video_id int REFERENCES videos(id),
+    foreign_id varchar(200) NOT NULL,
+    foreign_parent_id varchar(200),
+    parent_comment int REFERENCES archived_comments(id) ON DELETE CASCADE,
+    foreign_author_id varchar(200),
+    author_name varchar(200),
+    comment text,
+    like_count int DEFAULT 0,
+    creation_date timestamp,
+    UNIQUE (video_id, foreign_id)
+);
+
+CREATE INDEX archived_comments_video_id_parent_comment_idx ON archived_comments (video_id, parent_comment);
+
+-- Re-import raw metadata so that comments are picked up for videos which have already been imported
+UPDATE videos SET raw_metadata_imported = false;
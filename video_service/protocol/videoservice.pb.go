@@ -24,6 +24,202 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type CommentSort int32
+
+const (
+	CommentSort_top    CommentSort = 0
+	CommentSort_newest CommentSort = 1
+)
+
+var CommentSort_name = map[int32]string{
+	0: "top",
+	1: "newest",
+}
+
+var CommentSort_value = map[string]int32{
+	"top":    0,
+	"newest": 1,
+}
+
+func (x CommentSort) String() string {
+	return proto.EnumName(CommentSort_name, int32(x))
+}
+
+func (CommentSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{0}
+}
+
+type DanmakuPosition int32
+
+const (
+	DanmakuPosition_scrolling    DanmakuPosition = 0
+	DanmakuPosition_fixed_top    DanmakuPosition = 1
+	DanmakuPosition_fixed_bottom DanmakuPosition = 2
+)
+
+var DanmakuPosition_name = map[int32]string{
+	0: "scrolling",
+	1: "fixed_top",
+	2: "fixed_bottom",
+}
+
+var DanmakuPosition_value = map[string]int32{
+	"scrolling":    0,
+	"fixed_top":    1,
+	"fixed_bottom": 2,
+}
+
+func (x DanmakuPosition) String() string {
+	return proto.EnumName(DanmakuPosition_name, int32(x))
+}
+
+func (DanmakuPosition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{1}
+}
+
+type ContentType int32
+
+const (
+	ContentType_video_content   ContentType = 0
+	ContentType_comment_content ContentType = 1
+)
+
+var ContentType_name = map[int32]string{
+	0: "video_content",
+	1: "comment_content",
+}
+
+var ContentType_value = map[string]int32{
+	"video_content":   0,
+	"comment_content": 1,
+}
+
+func (x ContentType) String() string {
+	return proto.EnumName(ContentType_name, int32(x))
+}
+
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{2}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_spam           ReportReason = 0
+	ReportReason_rule_violation ReportReason = 1
+	ReportReason_duplicate      ReportReason = 2
+)
+
+var ReportReason_name = map[int32]string{
+	0: "spam",
+	1: "rule_violation",
+	2: "duplicate",
+}
+
+var ReportReason_value = map[string]int32{
+	"spam":           0,
+	"rule_violation": 1,
+	"duplicate":      2,
+}
+
+func (x ReportReason) String() string {
+	return proto.EnumName(ReportReason_name, int32(x))
+}
+
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{3}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_dismiss  ModerationAction = 0
+	ModerationAction_hide     ModerationAction = 1
+	ModerationAction_ban_user ModerationAction = 2
+)
+
+var ModerationAction_name = map[int32]string{
+	0: "dismiss",
+	1: "hide",
+	2: "ban_user",
+}
+
+var ModerationAction_value = map[string]int32{
+	"dismiss":  0,
+	"hide":     1,
+	"ban_user": 2,
+}
+
+func (x ModerationAction) String() string {
+	return proto.EnumName(ModerationAction_name, int32(x))
+}
+
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{4}
+}
+
+type PlaylistVisibility int32
+
+const (
+	PlaylistVisibility_public_playlist   PlaylistVisibility = 0
+	PlaylistVisibility_unlisted_playlist PlaylistVisibility = 1
+	PlaylistVisibility_private_playlist  PlaylistVisibility = 2
+)
+
+var PlaylistVisibility_name = map[int32]string{
+	0: "public_playlist",
+	1: "unlisted_playlist",
+	2: "private_playlist",
+}
+
+var PlaylistVisibility_value = map[string]int32{
+	"public_playlist":   0,
+	"unlisted_playlist": 1,
+	"private_playlist":  2,
+}
+
+func (x PlaylistVisibility) String() string {
+	return proto.EnumName(PlaylistVisibility_name, int32(x))
+}
+
+func (PlaylistVisibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{5}
+}
+
+type TagCategory int32
+
+const (
+	TagCategory_general   TagCategory = 0
+	TagCategory_artist    TagCategory = 1
+	TagCategory_series    TagCategory = 2
+	TagCategory_character TagCategory = 3
+	TagCategory_meta      TagCategory = 4
+)
+
+var TagCategory_name = map[int32]string{
+	0: "general",
+	1: "artist",
+	2: "series",
+	3: "character",
+	4: "meta",
+}
+
+var TagCategory_value = map[string]int32{
+	"general":   0,
+	"artist":    1,
+	"series":    2,
+	"character": 3,
+	"meta":      4,
+}
+
+func (x TagCategory) String() string {
+	return proto.EnumName(TagCategory_name, int32(x))
+}
+
+func (TagCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{6}
+}
+
 type Website int32
 
 const (
@@ -49,27 +245,36 @@ func (x Website) String() string {
 }
 
 func (Website) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{0}
+	return fileDescriptor_673ac1e0917b87c1, []int{7}
 }
 
 type OrderCategory int32
 
 const (
-	OrderCategory_views       OrderCategory = 0
-	OrderCategory_rating      OrderCategory = 1
-	OrderCategory_upload_date OrderCategory = 2
+	OrderCategory_views         OrderCategory = 0
+	OrderCategory_rating        OrderCategory = 1
+	OrderCategory_upload_date   OrderCategory = 2
+	OrderCategory_trending_day  OrderCategory = 3
+	OrderCategory_trending_week OrderCategory = 4
+	OrderCategory_hot           OrderCategory = 5
 )
 
 var OrderCategory_name = map[int32]string{
 	0: "views",
 	1: "rating",
 	2: "upload_date",
+	3: "trending_day",
+	4: "trending_week",
+	5: "hot",
 }
 
 var OrderCategory_value = map[string]int32{
-	"views":       0,
-	"rating":      1,
-	"upload_date": 2,
+	"views":         0,
+	"rating":        1,
+	"upload_date":   2,
+	"trending_day":  3,
+	"trending_week": 4,
+	"hot":           5,
 }
 
 func (x OrderCategory) String() string {
@@ -77,7 +282,7 @@ func (x OrderCategory) String() string {
 }
 
 func (OrderCategory) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{1}
+	return fileDescriptor_673ac1e0917b87c1, []int{8}
 }
 
 type SortDirection int32
@@ -102,7 +307,7 @@ func (x SortDirection) String() string {
 }
 
 func (SortDirection) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{2}
+	return fileDescriptor_673ac1e0917b87c1, []int{9}
 }
 
 type Nothing struct {
@@ -136,6 +341,92 @@ func (m *Nothing) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Nothing proto.InternalMessageInfo
 
+type VideoTransfer struct {
+	ClaimID              int64    `protobuf:"varint,3,opt,name=claimID,proto3" json:"claimID,omitempty"`
+	UserID               int64    `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoTransfer) Reset()         { *m = VideoTransfer{} }
+func (m *VideoTransfer) String() string { return proto.CompactTextString(m) }
+func (*VideoTransfer) ProtoMessage()    {}
+func (*VideoTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{1}
+}
+
+func (m *VideoTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoTransfer.Unmarshal(m, b)
+}
+func (m *VideoTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoTransfer.Marshal(b, m, deterministic)
+}
+func (m *VideoTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoTransfer.Merge(m, src)
+}
+func (m *VideoTransfer) XXX_Size() int {
+	return xxx_messageInfo_VideoTransfer.Size(m)
+}
+func (m *VideoTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoTransfer proto.InternalMessageInfo
+
+func (m *VideoTransfer) GetClaimID() int64 {
+	if m != nil {
+		return m.ClaimID
+	}
+	return 0
+}
+
+func (m *VideoTransfer) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type VideoTransferResult struct {
+	NumberOfVideos       int64    `protobuf:"varint,1,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoTransferResult) Reset()         { *m = VideoTransferResult{} }
+func (m *VideoTransferResult) String() string { return proto.CompactTextString(m) }
+func (*VideoTransferResult) ProtoMessage()    {}
+func (*VideoTransferResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{2}
+}
+
+func (m *VideoTransferResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoTransferResult.Unmarshal(m, b)
+}
+func (m *VideoTransferResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoTransferResult.Marshal(b, m, deterministic)
+}
+func (m *VideoTransferResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoTransferResult.Merge(m, src)
+}
+func (m *VideoTransferResult) XXX_Size() int {
+	return xxx_messageInfo_VideoTransferResult.Size(m)
+}
+func (m *VideoTransferResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoTransferResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoTransferResult proto.InternalMessageInfo
+
+func (m *VideoTransferResult) GetNumberOfVideos() int64 {
+	if m != nil {
+		return m.NumberOfVideos
+	}
+	return 0
+}
+
 type VideoComment struct {
 	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	VideoId              int64    `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -150,7 +441,7 @@ func (m *VideoComment) Reset()         { *m = VideoComment{} }
 func (m *VideoComment) String() string { return proto.CompactTextString(m) }
 func (*VideoComment) ProtoMessage()    {}
 func (*VideoComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{1}
+	return fileDescriptor_673ac1e0917b87c1, []int{3}
 }
 
 func (m *VideoComment) XXX_Unmarshal(b []byte) error {
@@ -199,20 +490,24 @@ func (m *VideoComment) GetParentComment() int64 {
 	return 0
 }
 
-// Can add pagination in the future if needed (probably fine without it)
+// Returns one page of comments at a single level of the thread: either root comments, or direct replies to parentID
 type CommentRequest struct {
-	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	CurrUserID           int64    `protobuf:"varint,2,opt,name=currUserID,proto3" json:"currUserID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	VideoID              int64       `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	CurrUserID           int64       `protobuf:"varint,2,opt,name=currUserID,proto3" json:"currUserID,omitempty"`
+	SortBy               CommentSort `protobuf:"varint,3,opt,name=sortBy,proto3,enum=proto.CommentSort" json:"sortBy,omitempty"`
+	ParentID             int64       `protobuf:"varint,4,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	PageNumber           int64       `protobuf:"varint,5,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	Archived             bool        `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *CommentRequest) Reset()         { *m = CommentRequest{} }
 func (m *CommentRequest) String() string { return proto.CompactTextString(m) }
 func (*CommentRequest) ProtoMessage()    {}
 func (*CommentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{2}
+	return fileDescriptor_673ac1e0917b87c1, []int{4}
 }
 
 func (m *CommentRequest) XXX_Unmarshal(b []byte) error {
@@ -247,10 +542,38 @@ func (m *CommentRequest) GetCurrUserID() int64 {
 	return 0
 }
 
+func (m *CommentRequest) GetSortBy() CommentSort {
+	if m != nil {
+		return m.SortBy
+	}
+	return CommentSort_top
+}
+
+func (m *CommentRequest) GetParentID() int64 {
+	if m != nil {
+		return m.ParentID
+	}
+	return 0
+}
+
+func (m *CommentRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *CommentRequest) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 type CommentUpvote struct {
 	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CommentId            int64    `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	IsUpvote             bool     `protobuf:"varint,3,opt,name=is_upvote,json=isUpvote,proto3" json:"is_upvote,omitempty"`
+	Vote                 int32    `protobuf:"varint,4,opt,name=vote,proto3" json:"vote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -260,7 +583,7 @@ func (m *CommentUpvote) Reset()         { *m = CommentUpvote{} }
 func (m *CommentUpvote) String() string { return proto.CompactTextString(m) }
 func (*CommentUpvote) ProtoMessage()    {}
 func (*CommentUpvote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{3}
+	return fileDescriptor_673ac1e0917b87c1, []int{5}
 }
 
 func (m *CommentUpvote) XXX_Unmarshal(b []byte) error {
@@ -295,25 +618,133 @@ func (m *CommentUpvote) GetCommentId() int64 {
 	return 0
 }
 
-func (m *CommentUpvote) GetIsUpvote() bool {
+func (m *CommentUpvote) GetVote() int32 {
 	if m != nil {
-		return m.IsUpvote
+		return m.Vote
 	}
-	return false
+	return 0
+}
+
+// Only the author can edit a comment
+type CommentEdit struct {
+	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId            int64    `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommentEdit) Reset()         { *m = CommentEdit{} }
+func (m *CommentEdit) String() string { return proto.CompactTextString(m) }
+func (*CommentEdit) ProtoMessage()    {}
+func (*CommentEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{6}
+}
+
+func (m *CommentEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommentEdit.Unmarshal(m, b)
+}
+func (m *CommentEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommentEdit.Marshal(b, m, deterministic)
+}
+func (m *CommentEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentEdit.Merge(m, src)
+}
+func (m *CommentEdit) XXX_Size() int {
+	return xxx_messageInfo_CommentEdit.Size(m)
+}
+func (m *CommentEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentEdit proto.InternalMessageInfo
+
+func (m *CommentEdit) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *CommentEdit) GetCommentId() int64 {
+	if m != nil {
+		return m.CommentId
+	}
+	return 0
+}
+
+func (m *CommentEdit) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+// Authors and users with the comment.delete permission can delete comments. Deleted comments keep their place in the thread so that replies stay visible
+type CommentDeletion struct {
+	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId            int64    `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommentDeletion) Reset()         { *m = CommentDeletion{} }
+func (m *CommentDeletion) String() string { return proto.CompactTextString(m) }
+func (*CommentDeletion) ProtoMessage()    {}
+func (*CommentDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{7}
+}
+
+func (m *CommentDeletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommentDeletion.Unmarshal(m, b)
+}
+func (m *CommentDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommentDeletion.Marshal(b, m, deterministic)
+}
+func (m *CommentDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentDeletion.Merge(m, src)
+}
+func (m *CommentDeletion) XXX_Size() int {
+	return xxx_messageInfo_CommentDeletion.Size(m)
+}
+func (m *CommentDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentDeletion proto.InternalMessageInfo
+
+func (m *CommentDeletion) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *CommentDeletion) GetCommentId() int64 {
+	if m != nil {
+		return m.CommentId
+	}
+	return 0
 }
 
 type CommentListResponse struct {
-	Comments             []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Comments         []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NumberOfComments int64      `protobuf:"varint,2,opt,name=numberOfComments,proto3" json:"numberOfComments,omitempty"`
+	// Read-only comments imported from the original site, kept separate from native comments
+	ArchivedComments         []*Comment `protobuf:"bytes,3,rep,name=archivedComments,proto3" json:"archivedComments,omitempty"`
+	NumberOfArchivedComments int64      `protobuf:"varint,4,opt,name=numberOfArchivedComments,proto3" json:"numberOfArchivedComments,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}   `json:"-"`
+	XXX_unrecognized         []byte     `json:"-"`
+	XXX_sizecache            int32      `json:"-"`
 }
 
 func (m *CommentListResponse) Reset()         { *m = CommentListResponse{} }
 func (m *CommentListResponse) String() string { return proto.CompactTextString(m) }
 func (*CommentListResponse) ProtoMessage()    {}
 func (*CommentListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{4}
+	return fileDescriptor_673ac1e0917b87c1, []int{8}
 }
 
 func (m *CommentListResponse) XXX_Unmarshal(b []byte) error {
@@ -341,27 +772,56 @@ func (m *CommentListResponse) GetComments() []*Comment {
 	return nil
 }
 
-type Comment struct {
-	CommentId             int64    `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	CreationDate          string   `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	Content               string   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorUsername        string   `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	AuthorProfileImageUrl string   `protobuf:"bytes,5,opt,name=author_profile_image_url,json=authorProfileImageUrl,proto3" json:"author_profile_image_url,omitempty"`
-	VoteScore             int64    `protobuf:"varint,6,opt,name=vote_score,json=voteScore,proto3" json:"vote_score,omitempty"`
-	CurrentUserHasUpvoted bool     `protobuf:"varint,7,opt,name=current_user_has_upvoted,json=currentUserHasUpvoted,proto3" json:"current_user_has_upvoted,omitempty"`
-	AuthorId              int64    `protobuf:"varint,8,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ParentId              int64    `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+func (m *CommentListResponse) GetNumberOfComments() int64 {
+	if m != nil {
+		return m.NumberOfComments
+	}
+	return 0
 }
 
-func (m *Comment) Reset()         { *m = Comment{} }
-func (m *Comment) String() string { return proto.CompactTextString(m) }
-func (*Comment) ProtoMessage()    {}
-func (*Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{5}
-}
+func (m *CommentListResponse) GetArchivedComments() []*Comment {
+	if m != nil {
+		return m.ArchivedComments
+	}
+	return nil
+}
+
+func (m *CommentListResponse) GetNumberOfArchivedComments() int64 {
+	if m != nil {
+		return m.NumberOfArchivedComments
+	}
+	return 0
+}
+
+type Comment struct {
+	CommentId             int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	CreationDate          string `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Content               string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorUsername        string `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	AuthorProfileImageUrl string `protobuf:"bytes,5,opt,name=author_profile_image_url,json=authorProfileImageUrl,proto3" json:"author_profile_image_url,omitempty"`
+	VoteScore             int64  `protobuf:"varint,6,opt,name=vote_score,json=voteScore,proto3" json:"vote_score,omitempty"`
+	CurrentUserHasUpvoted bool   `protobuf:"varint,7,opt,name=current_user_has_upvoted,json=currentUserHasUpvoted,proto3" json:"current_user_has_upvoted,omitempty"`
+	AuthorId              int64  `protobuf:"varint,8,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ParentId              int64  `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CurrentUserVote       int32  `protobuf:"varint,10,opt,name=current_user_vote,json=currentUserVote,proto3" json:"current_user_vote,omitempty"`
+	Deleted               bool   `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	EditedDate            string `protobuf:"bytes,12,opt,name=edited_date,json=editedDate,proto3" json:"edited_date,omitempty"`
+	ReplyCount            int64  `protobuf:"varint,13,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// Imported from the original site. author_id is the foreign user standing in for the original author, or 0 if the
+	// original site didn't say who it was, author_username is the original author's name, and vote_score is the
+	// original like count
+	Archived             bool     `protobuf:"varint,14,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{9}
+}
 
 func (m *Comment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Comment.Unmarshal(m, b)
@@ -444,27 +904,66 @@ func (m *Comment) GetParentId() int64 {
 	return 0
 }
 
+func (m *Comment) GetCurrentUserVote() int32 {
+	if m != nil {
+		return m.CurrentUserVote
+	}
+	return 0
+}
+
+func (m *Comment) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *Comment) GetEditedDate() string {
+	if m != nil {
+		return m.EditedDate
+	}
+	return ""
+}
+
+func (m *Comment) GetReplyCount() int64 {
+	if m != nil {
+		return m.ReplyCount
+	}
+	return 0
+}
+
+func (m *Comment) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 type VideoMetadata struct {
-	VideoLoc             string   `protobuf:"bytes,1,opt,name=videoLoc,proto3" json:"videoLoc,omitempty"`
-	VideoTitle           string   `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
-	Rating               float64  `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	AuthorName           string   `protobuf:"bytes,4,opt,name=authorName,proto3" json:"authorName,omitempty"`
-	Views                uint64   `protobuf:"varint,5,opt,name=views,proto3" json:"views,omitempty"`
-	VideoID              int64    `protobuf:"varint,6,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	UploadDate           string   `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
-	Description          string   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	AuthorID             int64    `protobuf:"varint,9,opt,name=authorID,proto3" json:"authorID,omitempty"`
-	Tags                 []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	VideoLoc             string         `protobuf:"bytes,1,opt,name=videoLoc,proto3" json:"videoLoc,omitempty"`
+	VideoTitle           string         `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
+	Rating               float64        `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	AuthorName           string         `protobuf:"bytes,4,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	Views                uint64         `protobuf:"varint,5,opt,name=views,proto3" json:"views,omitempty"`
+	VideoID              int64          `protobuf:"varint,6,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	UploadDate           string         `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
+	Description          string         `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorID             int64          `protobuf:"varint,9,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	Tags                 []string       `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	AlsoAvailableFrom    []*VideoMirror `protobuf:"bytes,11,rep,name=alsoAvailableFrom,proto3" json:"alsoAvailableFrom,omitempty"`
+	RatingCount          int64          `protobuf:"varint,12,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	RatingHistogram      []int64        `protobuf:"varint,13,rep,packed,name=ratingHistogram,proto3" json:"ratingHistogram,omitempty"`
+	BayesianRating       float64        `protobuf:"fixed64,14,opt,name=bayesianRating,proto3" json:"bayesianRating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *VideoMetadata) Reset()         { *m = VideoMetadata{} }
 func (m *VideoMetadata) String() string { return proto.CompactTextString(m) }
 func (*VideoMetadata) ProtoMessage()    {}
 func (*VideoMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{6}
+	return fileDescriptor_673ac1e0917b87c1, []int{10}
 }
 
 func (m *VideoMetadata) XXX_Unmarshal(b []byte) error {
@@ -555,6 +1054,89 @@ func (m *VideoMetadata) GetTags() []string {
 	return nil
 }
 
+func (m *VideoMetadata) GetAlsoAvailableFrom() []*VideoMirror {
+	if m != nil {
+		return m.AlsoAvailableFrom
+	}
+	return nil
+}
+
+func (m *VideoMetadata) GetRatingCount() int64 {
+	if m != nil {
+		return m.RatingCount
+	}
+	return 0
+}
+
+func (m *VideoMetadata) GetRatingHistogram() []int64 {
+	if m != nil {
+		return m.RatingHistogram
+	}
+	return nil
+}
+
+func (m *VideoMetadata) GetBayesianRating() float64 {
+	if m != nil {
+		return m.BayesianRating
+	}
+	return 0
+}
+
+type VideoMirror struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	OriginalSite         Website  `protobuf:"varint,2,opt,name=originalSite,proto3,enum=proto.Website" json:"originalSite,omitempty"`
+	OriginalLink         string   `protobuf:"bytes,3,opt,name=originalLink,proto3" json:"originalLink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoMirror) Reset()         { *m = VideoMirror{} }
+func (m *VideoMirror) String() string { return proto.CompactTextString(m) }
+func (*VideoMirror) ProtoMessage()    {}
+func (*VideoMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{11}
+}
+
+func (m *VideoMirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoMirror.Unmarshal(m, b)
+}
+func (m *VideoMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoMirror.Marshal(b, m, deterministic)
+}
+func (m *VideoMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoMirror.Merge(m, src)
+}
+func (m *VideoMirror) XXX_Size() int {
+	return xxx_messageInfo_VideoMirror.Size(m)
+}
+func (m *VideoMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoMirror proto.InternalMessageInfo
+
+func (m *VideoMirror) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *VideoMirror) GetOriginalSite() Website {
+	if m != nil {
+		return m.OriginalSite
+	}
+	return Website_niconico
+}
+
+func (m *VideoMirror) GetOriginalLink() string {
+	if m != nil {
+		return m.OriginalLink
+	}
+	return ""
+}
+
 type VideoList struct {
 	Videos               []*Video `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
 	NumberOfVideos       int64    `protobuf:"varint,2,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
@@ -567,7 +1149,7 @@ func (m *VideoList) Reset()         { *m = VideoList{} }
 func (m *VideoList) String() string { return proto.CompactTextString(m) }
 func (*VideoList) ProtoMessage()    {}
 func (*VideoList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{7}
+	return fileDescriptor_673ac1e0917b87c1, []int{12}
 }
 
 func (m *VideoList) XXX_Unmarshal(b []byte) error {
@@ -619,7 +1201,7 @@ func (m *Video) Reset()         { *m = Video{} }
 func (m *Video) String() string { return proto.CompactTextString(m) }
 func (*Video) ProtoMessage()    {}
 func (*Video) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{8}
+	return fileDescriptor_673ac1e0917b87c1, []int{13}
 }
 
 func (m *Video) XXX_Unmarshal(b []byte) error {
@@ -702,7 +1284,7 @@ func (m *VideoRating) Reset()         { *m = VideoRating{} }
 func (m *VideoRating) String() string { return proto.CompactTextString(m) }
 func (*VideoRating) ProtoMessage()    {}
 func (*VideoRating) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{9}
+	return fileDescriptor_673ac1e0917b87c1, []int{14}
 }
 
 func (m *VideoRating) XXX_Unmarshal(b []byte) error {
@@ -744,8 +1326,58 @@ func (m *VideoRating) GetRating() float32 {
 	return 0
 }
 
+type RatingRetraction struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID              int64    `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RatingRetraction) Reset()         { *m = RatingRetraction{} }
+func (m *RatingRetraction) String() string { return proto.CompactTextString(m) }
+func (*RatingRetraction) ProtoMessage()    {}
+func (*RatingRetraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{15}
+}
+
+func (m *RatingRetraction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingRetraction.Unmarshal(m, b)
+}
+func (m *RatingRetraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingRetraction.Marshal(b, m, deterministic)
+}
+func (m *RatingRetraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingRetraction.Merge(m, src)
+}
+func (m *RatingRetraction) XXX_Size() int {
+	return xxx_messageInfo_RatingRetraction.Size(m)
+}
+func (m *RatingRetraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingRetraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingRetraction proto.InternalMessageInfo
+
+func (m *RatingRetraction) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *RatingRetraction) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+// Views are deduplicated per viewer, so at least one of userID or clientIP must be set
 type VideoViewing struct {
 	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	ClientIP             string   `protobuf:"bytes,3,opt,name=clientIP,proto3" json:"clientIP,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -755,7 +1387,7 @@ func (m *VideoViewing) Reset()         { *m = VideoViewing{} }
 func (m *VideoViewing) String() string { return proto.CompactTextString(m) }
 func (*VideoViewing) ProtoMessage()    {}
 func (*VideoViewing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{10}
+	return fileDescriptor_673ac1e0917b87c1, []int{16}
 }
 
 func (m *VideoViewing) XXX_Unmarshal(b []byte) error {
@@ -783,1293 +1415,5787 @@ func (m *VideoViewing) GetVideoID() int64 {
 	return 0
 }
 
-type VideoApproval struct {
-	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	VideoID              int64    `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+func (m *VideoViewing) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *VideoViewing) GetClientIP() string {
+	if m != nil {
+		return m.ClientIP
+	}
+	return ""
+}
+
+type VideoViewStatsRequest struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	NumDays              int64    `protobuf:"varint,2,opt,name=numDays,proto3" json:"numDays,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VideoApproval) Reset()         { *m = VideoApproval{} }
-func (m *VideoApproval) String() string { return proto.CompactTextString(m) }
-func (*VideoApproval) ProtoMessage()    {}
-func (*VideoApproval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{11}
+func (m *VideoViewStatsRequest) Reset()         { *m = VideoViewStatsRequest{} }
+func (m *VideoViewStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*VideoViewStatsRequest) ProtoMessage()    {}
+func (*VideoViewStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{17}
 }
 
-func (m *VideoApproval) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VideoApproval.Unmarshal(m, b)
+func (m *VideoViewStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoViewStatsRequest.Unmarshal(m, b)
 }
-func (m *VideoApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VideoApproval.Marshal(b, m, deterministic)
+func (m *VideoViewStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoViewStatsRequest.Marshal(b, m, deterministic)
 }
-func (m *VideoApproval) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoApproval.Merge(m, src)
+func (m *VideoViewStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoViewStatsRequest.Merge(m, src)
 }
-func (m *VideoApproval) XXX_Size() int {
-	return xxx_messageInfo_VideoApproval.Size(m)
+func (m *VideoViewStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_VideoViewStatsRequest.Size(m)
 }
-func (m *VideoApproval) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoApproval.DiscardUnknown(m)
+func (m *VideoViewStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoViewStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoApproval proto.InternalMessageInfo
+var xxx_messageInfo_VideoViewStatsRequest proto.InternalMessageInfo
 
-func (m *VideoApproval) GetUserID() int64 {
+func (m *VideoViewStatsRequest) GetVideoID() int64 {
 	if m != nil {
-		return m.UserID
+		return m.VideoID
 	}
 	return 0
 }
 
-func (m *VideoApproval) GetVideoID() int64 {
+func (m *VideoViewStatsRequest) GetNumDays() int64 {
 	if m != nil {
-		return m.VideoID
+		return m.NumDays
 	}
 	return 0
 }
 
-type VideoQueryConfig struct {
-	OrderBy              OrderCategory `protobuf:"varint,1,opt,name=orderBy,proto3,enum=proto.OrderCategory" json:"orderBy,omitempty"`
-	Direction            SortDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.SortDirection" json:"direction,omitempty"`
-	PageNumber           int64         `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
-	ContainsTag          string        `protobuf:"bytes,4,opt,name=containsTag,proto3" json:"containsTag,omitempty"`
-	FromUserID           int64         `protobuf:"varint,5,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
-	ShowUnapproved       bool          `protobuf:"varint,6,opt,name=showUnapproved,proto3" json:"showUnapproved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type VideoViewStats struct {
+	Days                 []*DailyViewCount `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *VideoQueryConfig) Reset()         { *m = VideoQueryConfig{} }
-func (m *VideoQueryConfig) String() string { return proto.CompactTextString(m) }
-func (*VideoQueryConfig) ProtoMessage()    {}
-func (*VideoQueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{12}
+func (m *VideoViewStats) Reset()         { *m = VideoViewStats{} }
+func (m *VideoViewStats) String() string { return proto.CompactTextString(m) }
+func (*VideoViewStats) ProtoMessage()    {}
+func (*VideoViewStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{18}
 }
 
-func (m *VideoQueryConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VideoQueryConfig.Unmarshal(m, b)
+func (m *VideoViewStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoViewStats.Unmarshal(m, b)
 }
-func (m *VideoQueryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VideoQueryConfig.Marshal(b, m, deterministic)
+func (m *VideoViewStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoViewStats.Marshal(b, m, deterministic)
 }
-func (m *VideoQueryConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoQueryConfig.Merge(m, src)
+func (m *VideoViewStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoViewStats.Merge(m, src)
 }
-func (m *VideoQueryConfig) XXX_Size() int {
-	return xxx_messageInfo_VideoQueryConfig.Size(m)
+func (m *VideoViewStats) XXX_Size() int {
+	return xxx_messageInfo_VideoViewStats.Size(m)
 }
-func (m *VideoQueryConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoQueryConfig.DiscardUnknown(m)
+func (m *VideoViewStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoViewStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoQueryConfig proto.InternalMessageInfo
+var xxx_messageInfo_VideoViewStats proto.InternalMessageInfo
 
-func (m *VideoQueryConfig) GetOrderBy() OrderCategory {
+func (m *VideoViewStats) GetDays() []*DailyViewCount {
 	if m != nil {
-		return m.OrderBy
+		return m.Days
 	}
-	return OrderCategory_views
+	return nil
 }
 
-func (m *VideoQueryConfig) GetDirection() SortDirection {
-	if m != nil {
-		return m.Direction
-	}
-	return SortDirection_asc
+type DailyViewCount struct {
+	Date                 string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views                uint64   `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VideoQueryConfig) GetPageNumber() int64 {
-	if m != nil {
-		return m.PageNumber
-	}
-	return 0
+func (m *DailyViewCount) Reset()         { *m = DailyViewCount{} }
+func (m *DailyViewCount) String() string { return proto.CompactTextString(m) }
+func (*DailyViewCount) ProtoMessage()    {}
+func (*DailyViewCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{19}
 }
 
-func (m *VideoQueryConfig) GetContainsTag() string {
-	if m != nil {
-		return m.ContainsTag
-	}
-	return ""
+func (m *DailyViewCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DailyViewCount.Unmarshal(m, b)
+}
+func (m *DailyViewCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DailyViewCount.Marshal(b, m, deterministic)
+}
+func (m *DailyViewCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyViewCount.Merge(m, src)
+}
+func (m *DailyViewCount) XXX_Size() int {
+	return xxx_messageInfo_DailyViewCount.Size(m)
+}
+func (m *DailyViewCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyViewCount.DiscardUnknown(m)
 }
 
-func (m *VideoQueryConfig) GetFromUserID() int64 {
+var xxx_messageInfo_DailyViewCount proto.InternalMessageInfo
+
+func (m *DailyViewCount) GetDate() string {
 	if m != nil {
-		return m.FromUserID
+		return m.Date
 	}
-	return 0
+	return ""
 }
 
-func (m *VideoQueryConfig) GetShowUnapproved() bool {
+func (m *DailyViewCount) GetViews() uint64 {
 	if m != nil {
-		return m.ShowUnapproved
+		return m.Views
 	}
-	return false
+	return 0
 }
 
-type VideoExistenceResponse struct {
-	Exists               bool     `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
+// Time-synced comments which are overlaid on the video during playback
+type DanmakuRequest struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	FromMs               int64    `protobuf:"varint,2,opt,name=fromMs,proto3" json:"fromMs,omitempty"`
+	ToMs                 int64    `protobuf:"varint,3,opt,name=toMs,proto3" json:"toMs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VideoExistenceResponse) Reset()         { *m = VideoExistenceResponse{} }
-func (m *VideoExistenceResponse) String() string { return proto.CompactTextString(m) }
-func (*VideoExistenceResponse) ProtoMessage()    {}
-func (*VideoExistenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{13}
+func (m *DanmakuRequest) Reset()         { *m = DanmakuRequest{} }
+func (m *DanmakuRequest) String() string { return proto.CompactTextString(m) }
+func (*DanmakuRequest) ProtoMessage()    {}
+func (*DanmakuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{20}
 }
 
-func (m *VideoExistenceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VideoExistenceResponse.Unmarshal(m, b)
+func (m *DanmakuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DanmakuRequest.Unmarshal(m, b)
 }
-func (m *VideoExistenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VideoExistenceResponse.Marshal(b, m, deterministic)
+func (m *DanmakuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DanmakuRequest.Marshal(b, m, deterministic)
 }
-func (m *VideoExistenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoExistenceResponse.Merge(m, src)
+func (m *DanmakuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DanmakuRequest.Merge(m, src)
 }
-func (m *VideoExistenceResponse) XXX_Size() int {
-	return xxx_messageInfo_VideoExistenceResponse.Size(m)
+func (m *DanmakuRequest) XXX_Size() int {
+	return xxx_messageInfo_DanmakuRequest.Size(m)
 }
-func (m *VideoExistenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoExistenceResponse.DiscardUnknown(m)
+func (m *DanmakuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DanmakuRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoExistenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_DanmakuRequest proto.InternalMessageInfo
 
-func (m *VideoExistenceResponse) GetExists() bool {
+func (m *DanmakuRequest) GetVideoID() int64 {
 	if m != nil {
-		return m.Exists
+		return m.VideoID
 	}
-	return false
-}
-
-type ForeignVideoCheck struct {
-	ForeignVideoID       string   `protobuf:"bytes,1,opt,name=ForeignVideoID,proto3" json:"ForeignVideoID,omitempty"`
-	ForeignWebsite       Website  `protobuf:"varint,2,opt,name=ForeignWebsite,proto3,enum=proto.Website" json:"ForeignWebsite,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ForeignVideoCheck) Reset()         { *m = ForeignVideoCheck{} }
-func (m *ForeignVideoCheck) String() string { return proto.CompactTextString(m) }
-func (*ForeignVideoCheck) ProtoMessage()    {}
-func (*ForeignVideoCheck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{14}
-}
-
-func (m *ForeignVideoCheck) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForeignVideoCheck.Unmarshal(m, b)
-}
-func (m *ForeignVideoCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForeignVideoCheck.Marshal(b, m, deterministic)
-}
-func (m *ForeignVideoCheck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForeignVideoCheck.Merge(m, src)
-}
-func (m *ForeignVideoCheck) XXX_Size() int {
-	return xxx_messageInfo_ForeignVideoCheck.Size(m)
-}
-func (m *ForeignVideoCheck) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForeignVideoCheck.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_ForeignVideoCheck proto.InternalMessageInfo
-
-func (m *ForeignVideoCheck) GetForeignVideoID() string {
+func (m *DanmakuRequest) GetFromMs() int64 {
 	if m != nil {
-		return m.ForeignVideoID
+		return m.FromMs
 	}
-	return ""
+	return 0
 }
 
-func (m *ForeignVideoCheck) GetForeignWebsite() Website {
+func (m *DanmakuRequest) GetToMs() int64 {
 	if m != nil {
-		return m.ForeignWebsite
+		return m.ToMs
 	}
-	return Website_niconico
+	return 0
 }
 
-type VideoRequest struct {
-	VideoID              string   `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DanmakuList struct {
+	Danmaku              []*Danmaku `protobuf:"bytes,1,rep,name=danmaku,proto3" json:"danmaku,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *VideoRequest) Reset()         { *m = VideoRequest{} }
-func (m *VideoRequest) String() string { return proto.CompactTextString(m) }
-func (*VideoRequest) ProtoMessage()    {}
-func (*VideoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{15}
+func (m *DanmakuList) Reset()         { *m = DanmakuList{} }
+func (m *DanmakuList) String() string { return proto.CompactTextString(m) }
+func (*DanmakuList) ProtoMessage()    {}
+func (*DanmakuList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{21}
 }
 
-func (m *VideoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VideoRequest.Unmarshal(m, b)
+func (m *DanmakuList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DanmakuList.Unmarshal(m, b)
 }
-func (m *VideoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VideoRequest.Marshal(b, m, deterministic)
+func (m *DanmakuList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DanmakuList.Marshal(b, m, deterministic)
 }
-func (m *VideoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRequest.Merge(m, src)
+func (m *DanmakuList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DanmakuList.Merge(m, src)
 }
-func (m *VideoRequest) XXX_Size() int {
-	return xxx_messageInfo_VideoRequest.Size(m)
+func (m *DanmakuList) XXX_Size() int {
+	return xxx_messageInfo_DanmakuList.Size(m)
 }
-func (m *VideoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRequest.DiscardUnknown(m)
+func (m *DanmakuList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DanmakuList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRequest proto.InternalMessageInfo
+var xxx_messageInfo_DanmakuList proto.InternalMessageInfo
 
-func (m *VideoRequest) GetVideoID() string {
+func (m *DanmakuList) GetDanmaku() []*Danmaku {
 	if m != nil {
-		return m.VideoID
+		return m.Danmaku
 	}
-	return ""
+	return nil
 }
 
-type InputVideoChunk struct {
-	// Types that are valid to be assigned to Payload:
-	//	*InputVideoChunk_Content
-	//	*InputVideoChunk_Meta
-	//	*InputVideoChunk_Rawmeta
-	Payload              isInputVideoChunk_Payload `protobuf_oneof:"Payload"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type Danmaku struct {
+	DanmakuID            int64           `protobuf:"varint,1,opt,name=danmakuID,proto3" json:"danmakuID,omitempty"`
+	PositionMs           int64           `protobuf:"varint,2,opt,name=positionMs,proto3" json:"positionMs,omitempty"`
+	Content              string          `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Position             DanmakuPosition `protobuf:"varint,4,opt,name=position,proto3,enum=proto.DanmakuPosition" json:"position,omitempty"`
+	Color                int32           `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
+	AuthorID             int64           `protobuf:"varint,6,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	CreationDate         string          `protobuf:"bytes,7,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *InputVideoChunk) Reset()         { *m = InputVideoChunk{} }
-func (m *InputVideoChunk) String() string { return proto.CompactTextString(m) }
-func (*InputVideoChunk) ProtoMessage()    {}
-func (*InputVideoChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{16}
+func (m *Danmaku) Reset()         { *m = Danmaku{} }
+func (m *Danmaku) String() string { return proto.CompactTextString(m) }
+func (*Danmaku) ProtoMessage()    {}
+func (*Danmaku) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{22}
 }
 
-func (m *InputVideoChunk) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InputVideoChunk.Unmarshal(m, b)
-}
-func (m *InputVideoChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InputVideoChunk.Marshal(b, m, deterministic)
+func (m *Danmaku) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Danmaku.Unmarshal(m, b)
 }
-func (m *InputVideoChunk) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputVideoChunk.Merge(m, src)
+func (m *Danmaku) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Danmaku.Marshal(b, m, deterministic)
 }
-func (m *InputVideoChunk) XXX_Size() int {
-	return xxx_messageInfo_InputVideoChunk.Size(m)
+func (m *Danmaku) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Danmaku.Merge(m, src)
 }
-func (m *InputVideoChunk) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputVideoChunk.DiscardUnknown(m)
+func (m *Danmaku) XXX_Size() int {
+	return xxx_messageInfo_Danmaku.Size(m)
 }
-
-var xxx_messageInfo_InputVideoChunk proto.InternalMessageInfo
-
-type isInputVideoChunk_Payload interface {
-	isInputVideoChunk_Payload()
+func (m *Danmaku) XXX_DiscardUnknown() {
+	xxx_messageInfo_Danmaku.DiscardUnknown(m)
 }
 
-type InputVideoChunk_Content struct {
-	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
-}
+var xxx_messageInfo_Danmaku proto.InternalMessageInfo
 
-type InputVideoChunk_Meta struct {
-	Meta *InputFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+func (m *Danmaku) GetDanmakuID() int64 {
+	if m != nil {
+		return m.DanmakuID
+	}
+	return 0
 }
 
-type InputVideoChunk_Rawmeta struct {
-	Rawmeta *RawMetadata `protobuf:"bytes,3,opt,name=rawmeta,proto3,oneof"`
+func (m *Danmaku) GetPositionMs() int64 {
+	if m != nil {
+		return m.PositionMs
+	}
+	return 0
 }
 
-func (*InputVideoChunk_Content) isInputVideoChunk_Payload() {}
-
-func (*InputVideoChunk_Meta) isInputVideoChunk_Payload() {}
-
-func (*InputVideoChunk_Rawmeta) isInputVideoChunk_Payload() {}
-
-func (m *InputVideoChunk) GetPayload() isInputVideoChunk_Payload {
+func (m *Danmaku) GetContent() string {
 	if m != nil {
-		return m.Payload
+		return m.Content
 	}
-	return nil
+	return ""
 }
 
-func (m *InputVideoChunk) GetContent() *FileContent {
-	if x, ok := m.GetPayload().(*InputVideoChunk_Content); ok {
-		return x.Content
+func (m *Danmaku) GetPosition() DanmakuPosition {
+	if m != nil {
+		return m.Position
 	}
-	return nil
+	return DanmakuPosition_scrolling
 }
 
-func (m *InputVideoChunk) GetMeta() *InputFileMetadata {
-	if x, ok := m.GetPayload().(*InputVideoChunk_Meta); ok {
-		return x.Meta
+func (m *Danmaku) GetColor() int32 {
+	if m != nil {
+		return m.Color
 	}
-	return nil
+	return 0
 }
 
-func (m *InputVideoChunk) GetRawmeta() *RawMetadata {
-	if x, ok := m.GetPayload().(*InputVideoChunk_Rawmeta); ok {
-		return x.Rawmeta
+func (m *Danmaku) GetAuthorID() int64 {
+	if m != nil {
+		return m.AuthorID
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*InputVideoChunk) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*InputVideoChunk_Content)(nil),
-		(*InputVideoChunk_Meta)(nil),
-		(*InputVideoChunk_Rawmeta)(nil),
+func (m *Danmaku) GetCreationDate() string {
+	if m != nil {
+		return m.CreationDate
 	}
+	return ""
 }
 
-type ResponseVideoChunk struct {
-	// Types that are valid to be assigned to Payload:
-	//	*ResponseVideoChunk_Content
-	//	*ResponseVideoChunk_Meta
-	Payload              isResponseVideoChunk_Payload `protobuf_oneof:"Payload"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type DanmakuPost struct {
+	UserID               int64           `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID              int64           `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	PositionMs           int64           `protobuf:"varint,3,opt,name=positionMs,proto3" json:"positionMs,omitempty"`
+	Content              string          `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Position             DanmakuPosition `protobuf:"varint,5,opt,name=position,proto3,enum=proto.DanmakuPosition" json:"position,omitempty"`
+	Color                int32           `protobuf:"varint,6,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ResponseVideoChunk) Reset()         { *m = ResponseVideoChunk{} }
-func (m *ResponseVideoChunk) String() string { return proto.CompactTextString(m) }
-func (*ResponseVideoChunk) ProtoMessage()    {}
-func (*ResponseVideoChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{17}
+func (m *DanmakuPost) Reset()         { *m = DanmakuPost{} }
+func (m *DanmakuPost) String() string { return proto.CompactTextString(m) }
+func (*DanmakuPost) ProtoMessage()    {}
+func (*DanmakuPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{23}
 }
 
-func (m *ResponseVideoChunk) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResponseVideoChunk.Unmarshal(m, b)
+func (m *DanmakuPost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DanmakuPost.Unmarshal(m, b)
 }
-func (m *ResponseVideoChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResponseVideoChunk.Marshal(b, m, deterministic)
+func (m *DanmakuPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DanmakuPost.Marshal(b, m, deterministic)
 }
-func (m *ResponseVideoChunk) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResponseVideoChunk.Merge(m, src)
+func (m *DanmakuPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DanmakuPost.Merge(m, src)
 }
-func (m *ResponseVideoChunk) XXX_Size() int {
-	return xxx_messageInfo_ResponseVideoChunk.Size(m)
+func (m *DanmakuPost) XXX_Size() int {
+	return xxx_messageInfo_DanmakuPost.Size(m)
 }
-func (m *ResponseVideoChunk) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResponseVideoChunk.DiscardUnknown(m)
+func (m *DanmakuPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_DanmakuPost.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResponseVideoChunk proto.InternalMessageInfo
-
-type isResponseVideoChunk_Payload interface {
-	isResponseVideoChunk_Payload()
-}
+var xxx_messageInfo_DanmakuPost proto.InternalMessageInfo
 
-type ResponseVideoChunk_Content struct {
-	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+func (m *DanmakuPost) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
 
-type ResponseVideoChunk_Meta struct {
-	Meta *ResponseFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+func (m *DanmakuPost) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
 }
 
-func (*ResponseVideoChunk_Content) isResponseVideoChunk_Payload() {}
-
-func (*ResponseVideoChunk_Meta) isResponseVideoChunk_Payload() {}
-
-func (m *ResponseVideoChunk) GetPayload() isResponseVideoChunk_Payload {
+func (m *DanmakuPost) GetPositionMs() int64 {
 	if m != nil {
-		return m.Payload
+		return m.PositionMs
 	}
-	return nil
+	return 0
 }
 
-func (m *ResponseVideoChunk) GetContent() *FileContent {
-	if x, ok := m.GetPayload().(*ResponseVideoChunk_Content); ok {
-		return x.Content
+func (m *DanmakuPost) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return nil
+	return ""
 }
 
-func (m *ResponseVideoChunk) GetMeta() *ResponseFileMetadata {
-	if x, ok := m.GetPayload().(*ResponseVideoChunk_Meta); ok {
-		return x.Meta
+func (m *DanmakuPost) GetPosition() DanmakuPosition {
+	if m != nil {
+		return m.Position
 	}
-	return nil
+	return DanmakuPosition_scrolling
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ResponseVideoChunk) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ResponseVideoChunk_Content)(nil),
-		(*ResponseVideoChunk_Meta)(nil),
+func (m *DanmakuPost) GetColor() int32 {
+	if m != nil {
+		return m.Color
 	}
+	return 0
 }
 
-type FileContent struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Each user can have one open report per piece of content, further reports are ignored
+type ContentReport struct {
+	UserID               int64        `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ContentType          ContentType  `protobuf:"varint,2,opt,name=contentType,proto3,enum=proto.ContentType" json:"contentType,omitempty"`
+	ContentID            int64        `protobuf:"varint,3,opt,name=contentID,proto3" json:"contentID,omitempty"`
+	Reason               ReportReason `protobuf:"varint,4,opt,name=reason,proto3,enum=proto.ReportReason" json:"reason,omitempty"`
+	Details              string       `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *FileContent) Reset()         { *m = FileContent{} }
-func (m *FileContent) String() string { return proto.CompactTextString(m) }
-func (*FileContent) ProtoMessage()    {}
-func (*FileContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{18}
+func (m *ContentReport) Reset()         { *m = ContentReport{} }
+func (m *ContentReport) String() string { return proto.CompactTextString(m) }
+func (*ContentReport) ProtoMessage()    {}
+func (*ContentReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{24}
 }
 
-func (m *FileContent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FileContent.Unmarshal(m, b)
+func (m *ContentReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContentReport.Unmarshal(m, b)
 }
-func (m *FileContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FileContent.Marshal(b, m, deterministic)
+func (m *ContentReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContentReport.Marshal(b, m, deterministic)
 }
-func (m *FileContent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FileContent.Merge(m, src)
+func (m *ContentReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentReport.Merge(m, src)
 }
-func (m *FileContent) XXX_Size() int {
-	return xxx_messageInfo_FileContent.Size(m)
+func (m *ContentReport) XXX_Size() int {
+	return xxx_messageInfo_ContentReport.Size(m)
 }
-func (m *FileContent) XXX_DiscardUnknown() {
-	xxx_messageInfo_FileContent.DiscardUnknown(m)
+func (m *ContentReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FileContent proto.InternalMessageInfo
+var xxx_messageInfo_ContentReport proto.InternalMessageInfo
 
-func (m *FileContent) GetData() []byte {
+func (m *ContentReport) GetUserID() int64 {
 	if m != nil {
-		return m.Data
+		return m.UserID
 	}
-	return nil
+	return 0
 }
 
-type RawMetadata struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+func (m *ContentReport) GetContentType() ContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return ContentType_video_content
+}
+
+func (m *ContentReport) GetContentID() int64 {
+	if m != nil {
+		return m.ContentID
+	}
+	return 0
+}
+
+func (m *ContentReport) GetReason() ReportReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ReportReason_spam
+}
+
+func (m *ContentReport) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type ListReportsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PageNumber           int64    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RawMetadata) Reset()         { *m = RawMetadata{} }
-func (m *RawMetadata) String() string { return proto.CompactTextString(m) }
-func (*RawMetadata) ProtoMessage()    {}
-func (*RawMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{19}
+func (m *ListReportsRequest) Reset()         { *m = ListReportsRequest{} }
+func (m *ListReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReportsRequest) ProtoMessage()    {}
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{25}
 }
 
-func (m *RawMetadata) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RawMetadata.Unmarshal(m, b)
+func (m *ListReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportsRequest.Unmarshal(m, b)
 }
-func (m *RawMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RawMetadata.Marshal(b, m, deterministic)
+func (m *ListReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportsRequest.Marshal(b, m, deterministic)
 }
-func (m *RawMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawMetadata.Merge(m, src)
+func (m *ListReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportsRequest.Merge(m, src)
 }
-func (m *RawMetadata) XXX_Size() int {
-	return xxx_messageInfo_RawMetadata.Size(m)
+func (m *ListReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReportsRequest.Size(m)
 }
-func (m *RawMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawMetadata.DiscardUnknown(m)
+func (m *ListReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawMetadata proto.InternalMessageInfo
+var xxx_messageInfo_ListReportsRequest proto.InternalMessageInfo
 
-func (m *RawMetadata) GetData() []byte {
+func (m *ListReportsRequest) GetUserID() int64 {
 	if m != nil {
-		return m.Data
+		return m.UserID
 	}
-	return nil
+	return 0
 }
 
-// Could make the reupload fields optional
-type InputFileMetadata struct {
-	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AuthorUID            string   `protobuf:"bytes,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`
-	OriginalVideoLink    string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"`
-	AuthorUsername       string   `protobuf:"bytes,5,opt,name=authorUsername,proto3" json:"authorUsername,omitempty"`
-	OriginalSite         Website  `protobuf:"varint,6,opt,name=originalSite,proto3,enum=proto.Website" json:"originalSite,omitempty"`
-	OriginalID           string   `protobuf:"bytes,7,opt,name=originalID,proto3" json:"originalID,omitempty"`
-	DomesticAuthorID     int64    `protobuf:"varint,8,opt,name=domesticAuthorID,proto3" json:"domesticAuthorID,omitempty"`
-	Tags                 []string `protobuf:"bytes,9,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	Thumbnail            []byte   `protobuf:"bytes,10,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListReportsRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
 }
 
-func (m *InputFileMetadata) Reset()         { *m = InputFileMetadata{} }
-func (m *InputFileMetadata) String() string { return proto.CompactTextString(m) }
-func (*InputFileMetadata) ProtoMessage()    {}
-func (*InputFileMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{20}
+// Unresolved reports grouped by content, most reported first, then oldest first
+type ReportList struct {
+	Items                []*ReportedContent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NumberOfItems        int64              `protobuf:"varint,2,opt,name=numberOfItems,proto3" json:"numberOfItems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *InputFileMetadata) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InputFileMetadata.Unmarshal(m, b)
+func (m *ReportList) Reset()         { *m = ReportList{} }
+func (m *ReportList) String() string { return proto.CompactTextString(m) }
+func (*ReportList) ProtoMessage()    {}
+func (*ReportList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{26}
 }
-func (m *InputFileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InputFileMetadata.Marshal(b, m, deterministic)
+
+func (m *ReportList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportList.Unmarshal(m, b)
 }
-func (m *InputFileMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputFileMetadata.Merge(m, src)
+func (m *ReportList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportList.Marshal(b, m, deterministic)
 }
-func (m *InputFileMetadata) XXX_Size() int {
-	return xxx_messageInfo_InputFileMetadata.Size(m)
+func (m *ReportList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportList.Merge(m, src)
 }
-func (m *InputFileMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputFileMetadata.DiscardUnknown(m)
+func (m *ReportList) XXX_Size() int {
+	return xxx_messageInfo_ReportList.Size(m)
+}
+func (m *ReportList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InputFileMetadata proto.InternalMessageInfo
+var xxx_messageInfo_ReportList proto.InternalMessageInfo
 
-func (m *InputFileMetadata) GetTitle() string {
+func (m *ReportList) GetItems() []*ReportedContent {
 	if m != nil {
-		return m.Title
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *InputFileMetadata) GetDescription() string {
+func (m *ReportList) GetNumberOfItems() int64 {
 	if m != nil {
-		return m.Description
+		return m.NumberOfItems
 	}
-	return ""
+	return 0
 }
 
-func (m *InputFileMetadata) GetAuthorUID() string {
+type ReportedContent struct {
+	ContentType          ContentType    `protobuf:"varint,1,opt,name=contentType,proto3,enum=proto.ContentType" json:"contentType,omitempty"`
+	ContentID            int64          `protobuf:"varint,2,opt,name=contentID,proto3" json:"contentID,omitempty"`
+	VideoID              int64          `protobuf:"varint,3,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Preview              string         `protobuf:"bytes,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	AuthorID             int64          `protobuf:"varint,5,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	AuthorName           string         `protobuf:"bytes,6,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	ReportCount          int64          `protobuf:"varint,7,opt,name=reportCount,proto3" json:"reportCount,omitempty"`
+	FirstReported        string         `protobuf:"bytes,8,opt,name=firstReported,proto3" json:"firstReported,omitempty"`
+	Reasons              []ReportReason `protobuf:"varint,9,rep,packed,name=reasons,proto3,enum=proto.ReportReason" json:"reasons,omitempty"`
+	Details              []string       `protobuf:"bytes,10,rep,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReportedContent) Reset()         { *m = ReportedContent{} }
+func (m *ReportedContent) String() string { return proto.CompactTextString(m) }
+func (*ReportedContent) ProtoMessage()    {}
+func (*ReportedContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{27}
+}
+
+func (m *ReportedContent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportedContent.Unmarshal(m, b)
+}
+func (m *ReportedContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportedContent.Marshal(b, m, deterministic)
+}
+func (m *ReportedContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportedContent.Merge(m, src)
+}
+func (m *ReportedContent) XXX_Size() int {
+	return xxx_messageInfo_ReportedContent.Size(m)
+}
+func (m *ReportedContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportedContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportedContent proto.InternalMessageInfo
+
+func (m *ReportedContent) GetContentType() ContentType {
 	if m != nil {
-		return m.AuthorUID
+		return m.ContentType
 	}
-	return ""
+	return ContentType_video_content
 }
 
-func (m *InputFileMetadata) GetOriginalVideoLink() string {
+func (m *ReportedContent) GetContentID() int64 {
 	if m != nil {
-		return m.OriginalVideoLink
+		return m.ContentID
 	}
-	return ""
+	return 0
 }
 
-func (m *InputFileMetadata) GetAuthorUsername() string {
+func (m *ReportedContent) GetVideoID() int64 {
 	if m != nil {
-		return m.AuthorUsername
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *ReportedContent) GetPreview() string {
+	if m != nil {
+		return m.Preview
 	}
 	return ""
 }
 
-func (m *InputFileMetadata) GetOriginalSite() Website {
+func (m *ReportedContent) GetAuthorID() int64 {
 	if m != nil {
-		return m.OriginalSite
+		return m.AuthorID
 	}
-	return Website_niconico
+	return 0
 }
 
-func (m *InputFileMetadata) GetOriginalID() string {
+func (m *ReportedContent) GetAuthorName() string {
 	if m != nil {
-		return m.OriginalID
+		return m.AuthorName
 	}
 	return ""
 }
 
-func (m *InputFileMetadata) GetDomesticAuthorID() int64 {
+func (m *ReportedContent) GetReportCount() int64 {
 	if m != nil {
-		return m.DomesticAuthorID
+		return m.ReportCount
 	}
 	return 0
 }
 
-func (m *InputFileMetadata) GetTags() []string {
+func (m *ReportedContent) GetFirstReported() string {
 	if m != nil {
-		return m.Tags
+		return m.FirstReported
+	}
+	return ""
+}
+
+func (m *ReportedContent) GetReasons() []ReportReason {
+	if m != nil {
+		return m.Reasons
 	}
 	return nil
 }
 
-func (m *InputFileMetadata) GetThumbnail() []byte {
+func (m *ReportedContent) GetDetails() []string {
 	if m != nil {
-		return m.Thumbnail
+		return m.Details
 	}
 	return nil
 }
 
-// For now, these two are the same, but may deviate in future
-type ResponseFileMetadata struct {
-	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AuthorUID            int64    `protobuf:"varint,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`
-	OriginalVideoLink    string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Resolves all open reports for the content. Every resolution is recorded in the moderation log
+type ReportResolution struct {
+	UserID               int64            `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ContentType          ContentType      `protobuf:"varint,2,opt,name=contentType,proto3,enum=proto.ContentType" json:"contentType,omitempty"`
+	ContentID            int64            `protobuf:"varint,3,opt,name=contentID,proto3" json:"contentID,omitempty"`
+	Action               ModerationAction `protobuf:"varint,4,opt,name=action,proto3,enum=proto.ModerationAction" json:"action,omitempty"`
+	Note                 string           `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ResponseFileMetadata) Reset()         { *m = ResponseFileMetadata{} }
-func (m *ResponseFileMetadata) String() string { return proto.CompactTextString(m) }
-func (*ResponseFileMetadata) ProtoMessage()    {}
-func (*ResponseFileMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{21}
+func (m *ReportResolution) Reset()         { *m = ReportResolution{} }
+func (m *ReportResolution) String() string { return proto.CompactTextString(m) }
+func (*ReportResolution) ProtoMessage()    {}
+func (*ReportResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{28}
 }
 
-func (m *ResponseFileMetadata) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResponseFileMetadata.Unmarshal(m, b)
+func (m *ReportResolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportResolution.Unmarshal(m, b)
 }
-func (m *ResponseFileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResponseFileMetadata.Marshal(b, m, deterministic)
+func (m *ReportResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportResolution.Marshal(b, m, deterministic)
 }
-func (m *ResponseFileMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResponseFileMetadata.Merge(m, src)
+func (m *ReportResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportResolution.Merge(m, src)
 }
-func (m *ResponseFileMetadata) XXX_Size() int {
-	return xxx_messageInfo_ResponseFileMetadata.Size(m)
+func (m *ReportResolution) XXX_Size() int {
+	return xxx_messageInfo_ReportResolution.Size(m)
 }
-func (m *ResponseFileMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResponseFileMetadata.DiscardUnknown(m)
+func (m *ReportResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportResolution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResponseFileMetadata proto.InternalMessageInfo
+var xxx_messageInfo_ReportResolution proto.InternalMessageInfo
 
-func (m *ResponseFileMetadata) GetTitle() string {
+func (m *ReportResolution) GetUserID() int64 {
 	if m != nil {
-		return m.Title
+		return m.UserID
 	}
-	return ""
+	return 0
 }
 
-func (m *ResponseFileMetadata) GetDescription() string {
+func (m *ReportResolution) GetContentType() ContentType {
 	if m != nil {
-		return m.Description
+		return m.ContentType
 	}
-	return ""
+	return ContentType_video_content
 }
 
-func (m *ResponseFileMetadata) GetAuthorUID() int64 {
+func (m *ReportResolution) GetContentID() int64 {
 	if m != nil {
-		return m.AuthorUID
+		return m.ContentID
 	}
 	return 0
 }
 
-func (m *ResponseFileMetadata) GetOriginalVideoLink() string {
+func (m *ReportResolution) GetAction() ModerationAction {
 	if m != nil {
-		return m.OriginalVideoLink
+		return m.Action
+	}
+	return ModerationAction_dismiss
+}
+
+func (m *ReportResolution) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
 	return ""
 }
 
-type UploadResponse struct {
-	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Playlist struct {
+	PlaylistID           int64              `protobuf:"varint,1,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	UserID               int64              `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserName             string             `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Title                string             `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string             `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility           PlaylistVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=proto.PlaylistVisibility" json:"visibility,omitempty"`
+	NumberOfVideos       int64              `protobuf:"varint,7,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
+	CreationDate         string             `protobuf:"bytes,8,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	LastUpdated          string             `protobuf:"bytes,9,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	Mirrored             bool               `protobuf:"varint,10,opt,name=mirrored,proto3" json:"mirrored,omitempty"`
+	MirrorSite           Website            `protobuf:"varint,11,opt,name=mirrorSite,proto3,enum=proto.Website" json:"mirrorSite,omitempty"`
+	MirrorID             string             `protobuf:"bytes,12,opt,name=mirrorID,proto3" json:"mirrorID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *UploadResponse) Reset()         { *m = UploadResponse{} }
-func (m *UploadResponse) String() string { return proto.CompactTextString(m) }
-func (*UploadResponse) ProtoMessage()    {}
-func (*UploadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673ac1e0917b87c1, []int{22}
+func (m *Playlist) Reset()         { *m = Playlist{} }
+func (m *Playlist) String() string { return proto.CompactTextString(m) }
+func (*Playlist) ProtoMessage()    {}
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{29}
 }
 
-func (m *UploadResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UploadResponse.Unmarshal(m, b)
+func (m *Playlist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Playlist.Unmarshal(m, b)
 }
-func (m *UploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UploadResponse.Marshal(b, m, deterministic)
+func (m *Playlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Playlist.Marshal(b, m, deterministic)
 }
-func (m *UploadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UploadResponse.Merge(m, src)
+func (m *Playlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Playlist.Merge(m, src)
 }
-func (m *UploadResponse) XXX_Size() int {
-	return xxx_messageInfo_UploadResponse.Size(m)
+func (m *Playlist) XXX_Size() int {
+	return xxx_messageInfo_Playlist.Size(m)
 }
-func (m *UploadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UploadResponse.DiscardUnknown(m)
+func (m *Playlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_Playlist.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UploadResponse proto.InternalMessageInfo
+var xxx_messageInfo_Playlist proto.InternalMessageInfo
 
-func (m *UploadResponse) GetVideoID() int64 {
+func (m *Playlist) GetPlaylistID() int64 {
 	if m != nil {
-		return m.VideoID
+		return m.PlaylistID
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("proto.Website", Website_name, Website_value)
-	proto.RegisterEnum("proto.OrderCategory", OrderCategory_name, OrderCategory_value)
-	proto.RegisterEnum("proto.SortDirection", SortDirection_name, SortDirection_value)
-	proto.RegisterType((*Nothing)(nil), "proto.Nothing")
-	proto.RegisterType((*VideoComment)(nil), "proto.videoComment")
-	proto.RegisterType((*CommentRequest)(nil), "proto.commentRequest")
-	proto.RegisterType((*CommentUpvote)(nil), "proto.commentUpvote")
-	proto.RegisterType((*CommentListResponse)(nil), "proto.CommentListResponse")
-	proto.RegisterType((*Comment)(nil), "proto.Comment")
-	proto.RegisterType((*VideoMetadata)(nil), "proto.videoMetadata")
-	proto.RegisterType((*VideoList)(nil), "proto.VideoList")
-	proto.RegisterType((*Video)(nil), "proto.Video")
-	proto.RegisterType((*VideoRating)(nil), "proto.videoRating")
-	proto.RegisterType((*VideoViewing)(nil), "proto.videoViewing")
-	proto.RegisterType((*VideoApproval)(nil), "proto.videoApproval")
-	proto.RegisterType((*VideoQueryConfig)(nil), "proto.VideoQueryConfig")
-	proto.RegisterType((*VideoExistenceResponse)(nil), "proto.VideoExistenceResponse")
-	proto.RegisterType((*ForeignVideoCheck)(nil), "proto.ForeignVideoCheck")
-	proto.RegisterType((*VideoRequest)(nil), "proto.VideoRequest")
-	proto.RegisterType((*InputVideoChunk)(nil), "proto.InputVideoChunk")
-	proto.RegisterType((*ResponseVideoChunk)(nil), "proto.ResponseVideoChunk")
-	proto.RegisterType((*FileContent)(nil), "proto.FileContent")
-	proto.RegisterType((*RawMetadata)(nil), "proto.RawMetadata")
-	proto.RegisterType((*InputFileMetadata)(nil), "proto.InputFileMetadata")
-	proto.RegisterType((*ResponseFileMetadata)(nil), "proto.ResponseFileMetadata")
-	proto.RegisterType((*UploadResponse)(nil), "proto.uploadResponse")
+func (m *Playlist) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("videoservice.proto", fileDescriptor_673ac1e0917b87c1) }
+func (m *Playlist) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
 
-var fileDescriptor_673ac1e0917b87c1 = []byte{
-	// 1446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xd6, 0x4a, 0xb6, 0xa4, 0x1d, 0xfd, 0x44, 0x66, 0x62, 0x47, 0x51, 0x9a, 0xc2, 0xdd, 0xfe,
-	0x19, 0x46, 0x61, 0x24, 0x6a, 0x91, 0x1c, 0x72, 0xa9, 0x63, 0x35, 0x8d, 0x82, 0xfc, 0x95, 0x89,
-	0x13, 0xf4, 0x24, 0xd0, 0x5a, 0x5a, 0x26, 0x2c, 0x2d, 0x55, 0x2e, 0xd7, 0xaa, 0x6f, 0x45, 0xfb,
-	0x16, 0xbd, 0xf7, 0xd6, 0x27, 0xe8, 0x6b, 0xf4, 0xd8, 0xa7, 0xe8, 0x1b, 0x14, 0xfc, 0x93, 0xb8,
-	0x92, 0x8c, 0xa2, 0x3f, 0x07, 0x41, 0xcb, 0x8f, 0x33, 0xc3, 0xe1, 0x37, 0xc3, 0x19, 0x12, 0xd0,
-	0x05, 0x8b, 0x29, 0x4f, 0xa9, 0xb8, 0x60, 0x43, 0x7a, 0x30, 0x15, 0x5c, 0x72, 0xb4, 0xa9, 0xff,
-	0xa2, 0x10, 0x2a, 0x2f, 0xb8, 0x3c, 0x63, 0xc9, 0x28, 0xfa, 0x29, 0x80, 0xba, 0x16, 0x3c, 0xe2,
-	0x93, 0x09, 0x4d, 0x24, 0xba, 0x09, 0x95, 0x2c, 0xa5, 0x62, 0xc0, 0xe2, 0x76, 0xb0, 0x1b, 0xec,
-	0x95, 0x70, 0x59, 0x0d, 0xfb, 0x31, 0xba, 0x05, 0x55, 0x2d, 0xa8, 0x66, 0x8a, 0x7a, 0xa6, 0xa2,
-	0xc7, 0xfd, 0x18, 0xb5, 0xa1, 0x32, 0x34, 0xea, 0xed, 0xd2, 0x6e, 0xb0, 0x17, 0x62, 0x37, 0x44,
-	0x1f, 0x43, 0x73, 0x4a, 0x04, 0x4d, 0xe4, 0xc0, 0x09, 0x6c, 0x68, 0xd5, 0x86, 0x41, 0xed, 0xa2,
-	0xd1, 0x53, 0x68, 0xda, 0x79, 0x4c, 0xbf, 0xcb, 0x68, 0x2a, 0x95, 0x49, 0x63, 0xbd, 0x67, 0xdd,
-	0x70, 0x43, 0xf4, 0x3e, 0xc0, 0x30, 0x13, 0xe2, 0x58, 0x79, 0xd5, 0xb3, 0x9e, 0x78, 0x48, 0x14,
-	0x43, 0xc3, 0xda, 0x3a, 0x9e, 0x5e, 0x70, 0x49, 0xaf, 0xde, 0xd1, 0x1d, 0x00, 0x2b, 0xb9, 0xd8,
-	0x53, 0x68, 0x91, 0x7e, 0x8c, 0x6e, 0x43, 0xc8, 0xd2, 0x41, 0xa6, 0x8d, 0xe8, 0x7d, 0x55, 0x71,
-	0x95, 0xa5, 0xc6, 0x68, 0x74, 0x08, 0xd7, 0xad, 0xf3, 0xcf, 0x58, 0x2a, 0x31, 0x4d, 0xa7, 0x3c,
-	0x49, 0x29, 0xda, 0x87, 0xaa, 0x35, 0x90, 0xb6, 0x83, 0xdd, 0xd2, 0x5e, 0xad, 0xdb, 0x34, 0xd4,
-	0x1f, 0x58, 0x69, 0x3c, 0x9f, 0x8f, 0xfe, 0x28, 0x42, 0xc5, 0xb1, 0x9e, 0x77, 0x25, 0x58, 0x76,
-	0xe5, 0x43, 0x68, 0x0c, 0x05, 0x25, 0x92, 0xf1, 0x64, 0x10, 0x13, 0x49, 0xb5, 0xb3, 0x21, 0xae,
-	0x3b, 0xb0, 0x47, 0x24, 0x35, 0x51, 0x48, 0x64, 0x2e, 0x0a, 0x7a, 0x88, 0x3e, 0x85, 0x6b, 0x24,
-	0x93, 0x67, 0x5c, 0x0c, 0xd4, 0xce, 0x13, 0x32, 0xa1, 0x3a, 0x0c, 0x21, 0x6e, 0x1a, 0xf8, 0xd8,
-	0xa2, 0xe8, 0x01, 0xb4, 0xad, 0xe0, 0x54, 0xf0, 0x53, 0x36, 0xa6, 0x03, 0x36, 0x21, 0x23, 0x3a,
-	0xc8, 0xc4, 0xb8, 0xbd, 0xa9, 0x35, 0xb6, 0xcd, 0xfc, 0x2b, 0x33, 0xdd, 0x57, 0xb3, 0xc7, 0x62,
-	0xac, 0xfc, 0x57, 0xb4, 0x0c, 0xd2, 0x21, 0x17, 0xb4, 0x5d, 0x36, 0xfe, 0x2b, 0xe4, 0xb5, 0x02,
-	0x94, 0x5d, 0x15, 0x21, 0xb5, 0x3d, 0x1d, 0x8a, 0x33, 0xe2, 0x88, 0x8d, 0xdb, 0x15, 0xcd, 0xec,
-	0xb6, 0x9d, 0x57, 0xae, 0x3c, 0x21, 0x96, 0x65, 0x1d, 0x03, 0xeb, 0x10, 0x8b, 0xdb, 0x55, 0x6d,
-	0xb6, 0x6a, 0x00, 0x13, 0x20, 0x9b, 0x5c, 0x2c, 0x6e, 0x87, 0x66, 0xd2, 0x00, 0xfd, 0x38, 0xfa,
-	0xa5, 0x08, 0x0d, 0x9d, 0x32, 0xcf, 0xa9, 0x24, 0x31, 0x91, 0x04, 0x75, 0x6c, 0x02, 0x3f, 0xe3,
-	0x43, 0xcd, 0x70, 0x88, 0xe7, 0x63, 0x95, 0x54, 0xfa, 0xfb, 0x0d, 0x93, 0x63, 0xc7, 0xae, 0x87,
-	0xa0, 0x1d, 0x28, 0x0b, 0x22, 0x59, 0x32, 0xd2, 0xd4, 0x06, 0xd8, 0x8e, 0x94, 0x9e, 0x71, 0xe7,
-	0xc5, 0x82, 0x54, 0x0f, 0x41, 0x37, 0x60, 0xf3, 0x82, 0xd1, 0x59, 0xaa, 0xd9, 0xdb, 0xc0, 0x66,
-	0xe0, 0x27, 0x77, 0x79, 0x25, 0xb9, 0xb3, 0xe9, 0x98, 0x93, 0x58, 0x45, 0x54, 0x53, 0x13, 0x62,
-	0x0f, 0x41, 0xbb, 0x50, 0x8b, 0x69, 0x3a, 0x14, 0x6c, 0xaa, 0xc2, 0xae, 0x19, 0x09, 0xb1, 0x0f,
-	0xa9, 0x5d, 0x5a, 0x82, 0x7a, 0x8e, 0x13, 0x37, 0x46, 0x08, 0x36, 0x24, 0x19, 0xa5, 0x6d, 0xd8,
-	0x2d, 0xed, 0x85, 0x58, 0x7f, 0x47, 0xdf, 0x42, 0xf8, 0x56, 0xb3, 0xc0, 0x52, 0x89, 0x3e, 0x82,
-	0xb2, 0xa9, 0x1a, 0x36, 0x79, 0xeb, 0x36, 0x79, 0xb5, 0x04, 0xb6, 0x73, 0xe8, 0x13, 0x68, 0x26,
-	0xd9, 0xe4, 0x84, 0x8a, 0x97, 0xa7, 0x6f, 0x8d, 0xb4, 0x39, 0x3b, 0x4b, 0x68, 0xf4, 0x7b, 0x00,
-	0x9b, 0xfa, 0x73, 0x89, 0xde, 0x60, 0x85, 0xde, 0x39, 0x4d, 0x45, 0x9f, 0xa6, 0xab, 0x48, 0x8f,
-	0xa0, 0x2e, 0xcf, 0xb2, 0xc9, 0x49, 0x42, 0xd8, 0x58, 0x05, 0xd3, 0xd0, 0x9e, 0xc3, 0x7c, 0x8a,
-	0x37, 0x57, 0x28, 0xf6, 0x42, 0x56, 0x5e, 0x09, 0xd9, 0xdf, 0x84, 0x20, 0x7a, 0x07, 0x35, 0x6d,
-	0x0a, 0x1b, 0x67, 0x76, 0xc0, 0x94, 0x93, 0x5e, 0xae, 0xb8, 0xf4, 0x7c, 0x07, 0x8a, 0x79, 0x07,
-	0xf2, 0xdb, 0x2a, 0xba, 0x6d, 0x45, 0x7b, 0xb6, 0x12, 0xbf, 0x65, 0x74, 0xa6, 0x2c, 0x5f, 0x59,
-	0x02, 0xa3, 0x43, 0x9b, 0xda, 0x87, 0xd3, 0xa9, 0xe0, 0x17, 0x64, 0xfc, 0xcf, 0x9d, 0x88, 0x7e,
-	0x28, 0x42, 0x4b, 0xc7, 0xe6, 0x9b, 0x8c, 0x8a, 0xcb, 0x23, 0x9e, 0x9c, 0xb2, 0x11, 0x3a, 0x80,
-	0x0a, 0x17, 0x31, 0x15, 0x8f, 0x2e, 0xb5, 0x9d, 0x66, 0xf7, 0x86, 0x8d, 0xbf, 0x46, 0x8f, 0x88,
-	0xa4, 0x23, 0x2e, 0x2e, 0xb1, 0x13, 0x42, 0x5d, 0x08, 0x63, 0x26, 0xe8, 0x50, 0xe7, 0x62, 0x31,
-	0xa7, 0x91, 0x72, 0x21, 0x7b, 0x6e, 0x0e, 0x2f, 0xc4, 0x14, 0xbd, 0x53, 0x32, 0xa2, 0x2f, 0x74,
-	0xaa, 0x68, 0x06, 0x4a, 0xd8, 0x43, 0x54, 0x86, 0xab, 0xb2, 0x45, 0x58, 0x92, 0xbe, 0x21, 0x23,
-	0x1b, 0x5b, 0x1f, 0x52, 0x16, 0x4e, 0x05, 0x9f, 0xd8, 0x06, 0x60, 0xa2, 0xeb, 0x21, 0x2a, 0x3d,
-	0xd3, 0x33, 0x3e, 0x3b, 0x4e, 0x88, 0xa6, 0x87, 0xc6, 0x3a, 0xc8, 0x55, 0xbc, 0x84, 0x46, 0x77,
-	0x61, 0x47, 0x33, 0xf0, 0xd5, 0xf7, 0x2c, 0x95, 0x34, 0x19, 0xd2, 0x79, 0x15, 0xdf, 0x81, 0xb2,
-	0x06, 0x53, 0x4d, 0x43, 0x15, 0xdb, 0x51, 0x94, 0xc2, 0xd6, 0x63, 0x2e, 0x28, 0x1b, 0x25, 0x5a,
-	0xf1, 0xe8, 0x8c, 0x0e, 0xcf, 0xd5, 0x72, 0x3e, 0x68, 0x63, 0x10, 0xe2, 0x25, 0x14, 0xdd, 0x9f,
-	0xcb, 0xbd, 0xa3, 0x27, 0x29, 0xb3, 0x45, 0xbc, 0x39, 0x6f, 0x10, 0x33, 0x83, 0xe2, 0x25, 0x29,
-	0x95, 0x16, 0xe6, 0xf8, 0xad, 0xef, 0x8c, 0xe1, 0x22, 0xa6, 0xbf, 0x06, 0x70, 0xad, 0x9f, 0x4c,
-	0x33, 0x69, 0xbd, 0xcb, 0x92, 0x73, 0x15, 0x52, 0xd7, 0x14, 0x94, 0x74, 0xad, 0x8b, 0xec, 0x72,
-	0x8f, 0xd9, 0x98, 0x1e, 0x99, 0x99, 0x27, 0x85, 0x45, 0xab, 0x38, 0x80, 0x8d, 0x09, 0x95, 0x44,
-	0xfb, 0x56, 0xeb, 0xb6, 0xad, 0xb0, 0xb6, 0xaa, 0x34, 0x5c, 0x31, 0x7d, 0x52, 0xc0, 0x5a, 0x4e,
-	0xd9, 0x17, 0x64, 0xa6, 0x55, 0x4a, 0x39, 0xfb, 0x98, 0xcc, 0x3c, 0x61, 0x27, 0xf4, 0x28, 0x84,
-	0xca, 0x2b, 0x72, 0xa9, 0x0e, 0x53, 0xf4, 0x63, 0x00, 0xc8, 0x51, 0xfe, 0x1f, 0x3c, 0xbe, 0x97,
-	0xf3, 0xf8, 0xb6, 0x5b, 0xde, 0x1a, 0x5e, 0xe7, 0xb4, 0xef, 0xc4, 0x07, 0x50, 0xf3, 0xec, 0xaa,
-	0x0a, 0xd9, 0x23, 0x92, 0xe8, 0x95, 0xeb, 0x58, 0x7f, 0x2b, 0x11, 0x6f, 0x33, 0x6b, 0x45, 0xfe,
-	0x2c, 0xc2, 0xd6, 0x0a, 0x47, 0xaa, 0xaa, 0x49, 0xaf, 0xe0, 0x99, 0xc1, 0x72, 0x09, 0x2f, 0xae,
-	0x96, 0xf0, 0xf7, 0x5c, 0xd3, 0x3b, 0xee, 0xf7, 0x6c, 0x2b, 0x5f, 0x00, 0xe8, 0x33, 0xd8, 0xe2,
-	0x82, 0x8d, 0x58, 0x42, 0xc6, 0xb6, 0x70, 0x27, 0xe7, 0xf6, 0x98, 0xac, 0x4e, 0xa8, 0xec, 0xcc,
-	0xf7, 0x78, 0xdb, 0xc7, 0x97, 0x3b, 0x7f, 0x17, 0xea, 0x4e, 0xf9, 0xb5, 0xca, 0xcd, 0xf2, 0xda,
-	0xdc, 0xcc, 0xc9, 0xa8, 0x83, 0xe8, 0xc6, 0xfd, 0x9e, 0xab, 0x94, 0x0b, 0x04, 0xed, 0x43, 0x2b,
-	0xe6, 0x13, 0x9a, 0x4a, 0x36, 0x3c, 0x74, 0x2d, 0xc9, 0xf4, 0xf0, 0x15, 0x5c, 0xb1, 0xfa, 0x46,
-	0xb5, 0xa6, 0xd0, 0xb4, 0x26, 0xf5, 0xad, 0x78, 0x98, 0xd7, 0xf4, 0x36, 0x68, 0xba, 0x17, 0x40,
-	0xf4, 0x73, 0x00, 0x37, 0xd6, 0x45, 0xf9, 0xff, 0xa3, 0xbd, 0xf4, 0xaf, 0x69, 0x8f, 0xf6, 0xa1,
-	0x69, 0x5a, 0xc6, 0xbc, 0xa6, 0x5c, 0x59, 0xcd, 0xf7, 0xbb, 0x50, 0xb1, 0xfc, 0xa2, 0x3a, 0x54,
-	0x13, 0x36, 0xe4, 0xea, 0xd7, 0x2a, 0xa8, 0xd1, 0x09, 0x1b, 0x33, 0xf5, 0x6b, 0x05, 0xa8, 0x06,
-	0x95, 0x4b, 0x9e, 0xc9, 0xec, 0x84, 0xb6, 0x8a, 0xfb, 0x0f, 0xa0, 0x91, 0xab, 0xc9, 0x28, 0xb4,
-	0x1d, 0xb4, 0x55, 0x40, 0xe0, 0xfa, 0x4b, 0x2b, 0x40, 0xd7, 0xa0, 0x66, 0xfc, 0xd0, 0xd7, 0xc6,
-	0x56, 0x71, 0x3f, 0x82, 0x46, 0xae, 0x34, 0xa3, 0x0a, 0x94, 0x48, 0x3a, 0x6c, 0x15, 0x50, 0x15,
-	0x36, 0x14, 0x1b, 0xad, 0x62, 0xf7, 0xb7, 0x4d, 0x5b, 0x72, 0x5e, 0x9b, 0xc7, 0x03, 0xfa, 0xd2,
-	0x59, 0x31, 0xdd, 0x7c, 0xc7, 0xaf, 0x0a, 0x8b, 0x93, 0xdb, 0xd9, 0xb6, 0x78, 0x7e, 0xe7, 0x51,
-	0x61, 0x2f, 0x40, 0x47, 0xd0, 0x88, 0xf9, 0x2c, 0x59, 0xd8, 0xb8, 0x9e, 0xbb, 0x59, 0x98, 0xd2,
-	0xd6, 0xb9, 0xb5, 0x74, 0x78, 0x17, 0xb6, 0xa3, 0xc2, 0xdd, 0x00, 0xbd, 0x04, 0x74, 0xea, 0xd5,
-	0x54, 0x53, 0x94, 0x91, 0xab, 0x51, 0x2b, 0x95, 0xb9, 0x73, 0xc7, 0x5f, 0x63, 0xa5, 0xca, 0x47,
-	0x05, 0xf4, 0x10, 0xea, 0x23, 0x2a, 0x17, 0xd7, 0x9f, 0x9b, 0xbe, 0x82, 0xd7, 0x18, 0x3b, 0x2d,
-	0x7f, 0x42, 0x89, 0x46, 0x05, 0xf4, 0x00, 0xaa, 0x4e, 0x79, 0xfd, 0x6e, 0x5c, 0x2b, 0xcc, 0xdd,
-	0x42, 0xa3, 0x02, 0xba, 0x07, 0xa1, 0x20, 0xd2, 0x6c, 0x0e, 0x21, 0x5f, 0xc8, 0x5c, 0x29, 0x3a,
-	0xee, 0xd4, 0xb9, 0x37, 0x5a, 0x41, 0x35, 0x5a, 0x15, 0xdd, 0xfc, 0x62, 0xfe, 0x65, 0x61, 0x8d,
-	0xce, 0x17, 0x50, 0x7b, 0x4e, 0xce, 0xa9, 0x7b, 0x61, 0xe4, 0xb4, 0x2c, 0xb8, 0x46, 0xeb, 0x21,
-	0x6c, 0x79, 0x5a, 0xf6, 0x05, 0xe5, 0x76, 0x92, 0x7b, 0x57, 0xad, 0x51, 0x7e, 0x0a, 0xd7, 0xbf,
-	0xa6, 0xee, 0x51, 0x97, 0x3e, 0xe6, 0xc2, 0x38, 0xbc, 0x9d, 0x57, 0x77, 0xfc, 0x74, 0xf2, 0x2f,
-	0x23, 0xff, 0x1d, 0x15, 0x15, 0xd0, 0x7d, 0xa8, 0x9b, 0xeb, 0x8d, 0x25, 0x2a, 0xc7, 0xa6, 0xbb,
-	0xf8, 0xac, 0xfa, 0x70, 0x52, 0xd6, 0xc0, 0xe7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x13,
-	0xc6, 0x25, 0xff, 0x0e, 0x00, 0x00,
+func (m *Playlist) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (m *Playlist) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *Playlist) GetVisibility() PlaylistVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return PlaylistVisibility_public_playlist
+}
 
-// VideoServiceClient is the client API for VideoService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type VideoServiceClient interface {
-	UploadVideo(ctx context.Context, opts ...grpc.CallOption) (VideoService_UploadVideoClient, error)
-	DownloadVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (VideoService_DownloadVideoClient, error)
-	ForeignVideoExists(ctx context.Context, in *ForeignVideoCheck, opts ...grpc.CallOption) (*VideoExistenceResponse, error)
-	GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error)
-	GetVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*VideoMetadata, error)
-	RateVideo(ctx context.Context, in *VideoRating, opts ...grpc.CallOption) (*Nothing, error)
-	ViewVideo(ctx context.Context, in *VideoViewing, opts ...grpc.CallOption) (*Nothing, error)
-	MakeComment(ctx context.Context, in *VideoComment, opts ...grpc.CallOption) (*Nothing, error)
-	MakeCommentUpvote(ctx context.Context, in *CommentUpvote, opts ...grpc.CallOption) (*Nothing, error)
-	GetCommentsForVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentListResponse, error)
-	ApproveVideo(ctx context.Context, in *VideoApproval, opts ...grpc.CallOption) (*Nothing, error)
+func (m *Playlist) GetNumberOfVideos() int64 {
+	if m != nil {
+		return m.NumberOfVideos
+	}
+	return 0
 }
 
-type videoServiceClient struct {
-	cc grpc.ClientConnInterface
+func (m *Playlist) GetCreationDate() string {
+	if m != nil {
+		return m.CreationDate
+	}
+	return ""
 }
 
-func NewVideoServiceClient(cc grpc.ClientConnInterface) VideoServiceClient {
-	return &videoServiceClient{cc}
+func (m *Playlist) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return ""
 }
 
-func (c *videoServiceClient) UploadVideo(ctx context.Context, opts ...grpc.CallOption) (VideoService_UploadVideoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_VideoService_serviceDesc.Streams[0], "/proto.VideoService/uploadVideo", opts...)
-	if err != nil {
-		return nil, err
+func (m *Playlist) GetMirrored() bool {
+	if m != nil {
+		return m.Mirrored
 	}
-	x := &videoServiceUploadVideoClient{stream}
-	return x, nil
+	return false
 }
 
-type VideoService_UploadVideoClient interface {
-	Send(*InputVideoChunk) error
-	CloseAndRecv() (*UploadResponse, error)
-	grpc.ClientStream
+func (m *Playlist) GetMirrorSite() Website {
+	if m != nil {
+		return m.MirrorSite
+	}
+	return Website_niconico
 }
 
-type videoServiceUploadVideoClient struct {
-	grpc.ClientStream
+func (m *Playlist) GetMirrorID() string {
+	if m != nil {
+		return m.MirrorID
+	}
+	return ""
 }
 
-func (x *videoServiceUploadVideoClient) Send(m *InputVideoChunk) error {
-	return x.ClientStream.SendMsg(m)
+type PlaylistCreation struct {
+	UserID               int64              `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Title                string             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility           PlaylistVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=proto.PlaylistVisibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (x *videoServiceUploadVideoClient) CloseAndRecv() (*UploadResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(UploadResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PlaylistCreation) Reset()         { *m = PlaylistCreation{} }
+func (m *PlaylistCreation) String() string { return proto.CompactTextString(m) }
+func (*PlaylistCreation) ProtoMessage()    {}
+func (*PlaylistCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{30}
+}
+
+func (m *PlaylistCreation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistCreation.Unmarshal(m, b)
+}
+func (m *PlaylistCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistCreation.Marshal(b, m, deterministic)
+}
+func (m *PlaylistCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistCreation.Merge(m, src)
+}
+func (m *PlaylistCreation) XXX_Size() int {
+	return xxx_messageInfo_PlaylistCreation.Size(m)
+}
+func (m *PlaylistCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaylistCreation proto.InternalMessageInfo
+
+func (m *PlaylistCreation) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
 	}
-	return m, nil
+	return 0
 }
 
-func (c *videoServiceClient) DownloadVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (VideoService_DownloadVideoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_VideoService_serviceDesc.Streams[1], "/proto.VideoService/downloadVideo", opts...)
-	if err != nil {
-		return nil, err
+func (m *PlaylistCreation) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	x := &videoServiceDownloadVideoClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *PlaylistCreation) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *PlaylistCreation) GetVisibility() PlaylistVisibility {
+	if m != nil {
+		return m.Visibility
 	}
-	return x, nil
+	return PlaylistVisibility_public_playlist
 }
 
-type VideoService_DownloadVideoClient interface {
-	Recv() (*ResponseVideoChunk, error)
-	grpc.ClientStream
+type PlaylistUpdate struct {
+	UserID               int64              `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID           int64              `protobuf:"varint,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Title                string             `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility           PlaylistVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=proto.PlaylistVisibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-type videoServiceDownloadVideoClient struct {
-	grpc.ClientStream
+func (m *PlaylistUpdate) Reset()         { *m = PlaylistUpdate{} }
+func (m *PlaylistUpdate) String() string { return proto.CompactTextString(m) }
+func (*PlaylistUpdate) ProtoMessage()    {}
+func (*PlaylistUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{31}
 }
 
-func (x *videoServiceDownloadVideoClient) Recv() (*ResponseVideoChunk, error) {
-	m := new(ResponseVideoChunk)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *PlaylistUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistUpdate.Unmarshal(m, b)
+}
+func (m *PlaylistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistUpdate.Marshal(b, m, deterministic)
+}
+func (m *PlaylistUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistUpdate.Merge(m, src)
+}
+func (m *PlaylistUpdate) XXX_Size() int {
+	return xxx_messageInfo_PlaylistUpdate.Size(m)
+}
+func (m *PlaylistUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistUpdate.DiscardUnknown(m)
 }
 
-func (c *videoServiceClient) ForeignVideoExists(ctx context.Context, in *ForeignVideoCheck, opts ...grpc.CallOption) (*VideoExistenceResponse, error) {
-	out := new(VideoExistenceResponse)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/foreignVideoExists", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlaylistUpdate proto.InternalMessageInfo
+
+func (m *PlaylistUpdate) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *videoServiceClient) GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error) {
-	out := new(VideoList)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/getVideoList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlaylistUpdate) GetPlaylistID() int64 {
+	if m != nil {
+		return m.PlaylistID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *videoServiceClient) GetVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*VideoMetadata, error) {
-	out := new(VideoMetadata)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/getVideo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlaylistUpdate) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return out, nil
+	return ""
 }
 
-func (c *videoServiceClient) RateVideo(ctx context.Context, in *VideoRating, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/rateVideo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlaylistUpdate) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return out, nil
+	return ""
 }
 
-func (c *videoServiceClient) ViewVideo(ctx context.Context, in *VideoViewing, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/viewVideo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlaylistUpdate) GetVisibility() PlaylistVisibility {
+	if m != nil {
+		return m.Visibility
 	}
-	return out, nil
+	return PlaylistVisibility_public_playlist
 }
 
-func (c *videoServiceClient) MakeComment(ctx context.Context, in *VideoComment, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/MakeComment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PlaylistDeletion struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID           int64    `protobuf:"varint,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *videoServiceClient) MakeCommentUpvote(ctx context.Context, in *CommentUpvote, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/MakeCommentUpvote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PlaylistDeletion) Reset()         { *m = PlaylistDeletion{} }
+func (m *PlaylistDeletion) String() string { return proto.CompactTextString(m) }
+func (*PlaylistDeletion) ProtoMessage()    {}
+func (*PlaylistDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{32}
 }
 
-func (c *videoServiceClient) GetCommentsForVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentListResponse, error) {
-	out := new(CommentListResponse)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/GetCommentsForVideo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PlaylistDeletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistDeletion.Unmarshal(m, b)
+}
+func (m *PlaylistDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistDeletion.Marshal(b, m, deterministic)
+}
+func (m *PlaylistDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistDeletion.Merge(m, src)
+}
+func (m *PlaylistDeletion) XXX_Size() int {
+	return xxx_messageInfo_PlaylistDeletion.Size(m)
+}
+func (m *PlaylistDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistDeletion.DiscardUnknown(m)
 }
 
-func (c *videoServiceClient) ApproveVideo(ctx context.Context, in *VideoApproval, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := c.cc.Invoke(ctx, "/proto.VideoService/ApproveVideo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlaylistDeletion proto.InternalMessageInfo
+
+func (m *PlaylistDeletion) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
 	}
-	return out, nil
+	return 0
 }
 
-// VideoServiceServer is the server API for VideoService service.
-type VideoServiceServer interface {
-	UploadVideo(VideoService_UploadVideoServer) error
-	DownloadVideo(*VideoRequest, VideoService_DownloadVideoServer) error
-	ForeignVideoExists(context.Context, *ForeignVideoCheck) (*VideoExistenceResponse, error)
-	GetVideoList(context.Context, *VideoQueryConfig) (*VideoList, error)
-	GetVideo(context.Context, *VideoRequest) (*VideoMetadata, error)
-	RateVideo(context.Context, *VideoRating) (*Nothing, error)
-	ViewVideo(context.Context, *VideoViewing) (*Nothing, error)
-	MakeComment(context.Context, *VideoComment) (*Nothing, error)
-	MakeCommentUpvote(context.Context, *CommentUpvote) (*Nothing, error)
-	GetCommentsForVideo(context.Context, *CommentRequest) (*CommentListResponse, error)
-	ApproveVideo(context.Context, *VideoApproval) (*Nothing, error)
+func (m *PlaylistDeletion) GetPlaylistID() int64 {
+	if m != nil {
+		return m.PlaylistID
+	}
+	return 0
 }
 
-// UnimplementedVideoServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedVideoServiceServer struct {
+type PlaylistVideoChange struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID           int64    `protobuf:"varint,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	VideoID              int64    `protobuf:"varint,3,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedVideoServiceServer) UploadVideo(srv VideoService_UploadVideoServer) error {
-	return status.Errorf(codes.Unimplemented, "method UploadVideo not implemented")
+func (m *PlaylistVideoChange) Reset()         { *m = PlaylistVideoChange{} }
+func (m *PlaylistVideoChange) String() string { return proto.CompactTextString(m) }
+func (*PlaylistVideoChange) ProtoMessage()    {}
+func (*PlaylistVideoChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{33}
 }
-func (*UnimplementedVideoServiceServer) DownloadVideo(req *VideoRequest, srv VideoService_DownloadVideoServer) error {
-	return status.Errorf(codes.Unimplemented, "method DownloadVideo not implemented")
+
+func (m *PlaylistVideoChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistVideoChange.Unmarshal(m, b)
 }
-func (*UnimplementedVideoServiceServer) ForeignVideoExists(ctx context.Context, req *ForeignVideoCheck) (*VideoExistenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForeignVideoExists not implemented")
+func (m *PlaylistVideoChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistVideoChange.Marshal(b, m, deterministic)
 }
-func (*UnimplementedVideoServiceServer) GetVideoList(ctx context.Context, req *VideoQueryConfig) (*VideoList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideoList not implemented")
+func (m *PlaylistVideoChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistVideoChange.Merge(m, src)
 }
-func (*UnimplementedVideoServiceServer) GetVideo(ctx context.Context, req *VideoRequest) (*VideoMetadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+func (m *PlaylistVideoChange) XXX_Size() int {
+	return xxx_messageInfo_PlaylistVideoChange.Size(m)
 }
-func (*UnimplementedVideoServiceServer) RateVideo(ctx context.Context, req *VideoRating) (*Nothing, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateVideo not implemented")
+func (m *PlaylistVideoChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistVideoChange.DiscardUnknown(m)
 }
-func (*UnimplementedVideoServiceServer) ViewVideo(ctx context.Context, req *VideoViewing) (*Nothing, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ViewVideo not implemented")
+
+var xxx_messageInfo_PlaylistVideoChange proto.InternalMessageInfo
+
+func (m *PlaylistVideoChange) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
-func (*UnimplementedVideoServiceServer) MakeComment(ctx context.Context, req *VideoComment) (*Nothing, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeComment not implemented")
+
+func (m *PlaylistVideoChange) GetPlaylistID() int64 {
+	if m != nil {
+		return m.PlaylistID
+	}
+	return 0
 }
-func (*UnimplementedVideoServiceServer) MakeCommentUpvote(ctx context.Context, req *CommentUpvote) (*Nothing, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeCommentUpvote not implemented")
+
+func (m *PlaylistVideoChange) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
 }
-func (*UnimplementedVideoServiceServer) GetCommentsForVideo(ctx context.Context, req *CommentRequest) (*CommentListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsForVideo not implemented")
+
+type PlaylistVideoMove struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID           int64    `protobuf:"varint,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	VideoID              int64    `protobuf:"varint,3,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Position             int64    `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedVideoServiceServer) ApproveVideo(ctx context.Context, req *VideoApproval) (*Nothing, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveVideo not implemented")
+
+func (m *PlaylistVideoMove) Reset()         { *m = PlaylistVideoMove{} }
+func (m *PlaylistVideoMove) String() string { return proto.CompactTextString(m) }
+func (*PlaylistVideoMove) ProtoMessage()    {}
+func (*PlaylistVideoMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{34}
+}
+
+func (m *PlaylistVideoMove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistVideoMove.Unmarshal(m, b)
+}
+func (m *PlaylistVideoMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistVideoMove.Marshal(b, m, deterministic)
+}
+func (m *PlaylistVideoMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistVideoMove.Merge(m, src)
+}
+func (m *PlaylistVideoMove) XXX_Size() int {
+	return xxx_messageInfo_PlaylistVideoMove.Size(m)
+}
+func (m *PlaylistVideoMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistVideoMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaylistVideoMove proto.InternalMessageInfo
+
+func (m *PlaylistVideoMove) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *PlaylistVideoMove) GetPlaylistID() int64 {
+	if m != nil {
+		return m.PlaylistID
+	}
+	return 0
+}
+
+func (m *PlaylistVideoMove) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *PlaylistVideoMove) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type GetPlaylistRequest struct {
+	PlaylistID           int64    `protobuf:"varint,1,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PageNumber           int64    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlaylistRequest) Reset()         { *m = GetPlaylistRequest{} }
+func (m *GetPlaylistRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlaylistRequest) ProtoMessage()    {}
+func (*GetPlaylistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{35}
+}
+
+func (m *GetPlaylistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlaylistRequest.Unmarshal(m, b)
+}
+func (m *GetPlaylistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlaylistRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPlaylistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlaylistRequest.Merge(m, src)
+}
+func (m *GetPlaylistRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlaylistRequest.Size(m)
+}
+func (m *GetPlaylistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlaylistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlaylistRequest proto.InternalMessageInfo
+
+func (m *GetPlaylistRequest) GetPlaylistID() int64 {
+	if m != nil {
+		return m.PlaylistID
+	}
+	return 0
+}
+
+func (m *GetPlaylistRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *GetPlaylistRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type PlaylistPage struct {
+	Playlist             *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	Videos               []*Video  `protobuf:"bytes,2,rep,name=videos,proto3" json:"videos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PlaylistPage) Reset()         { *m = PlaylistPage{} }
+func (m *PlaylistPage) String() string { return proto.CompactTextString(m) }
+func (*PlaylistPage) ProtoMessage()    {}
+func (*PlaylistPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{36}
+}
+
+func (m *PlaylistPage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistPage.Unmarshal(m, b)
+}
+func (m *PlaylistPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistPage.Marshal(b, m, deterministic)
+}
+func (m *PlaylistPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistPage.Merge(m, src)
+}
+func (m *PlaylistPage) XXX_Size() int {
+	return xxx_messageInfo_PlaylistPage.Size(m)
+}
+func (m *PlaylistPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaylistPage proto.InternalMessageInfo
+
+func (m *PlaylistPage) GetPlaylist() *Playlist {
+	if m != nil {
+		return m.Playlist
+	}
+	return nil
+}
+
+func (m *PlaylistPage) GetVideos() []*Video {
+	if m != nil {
+		return m.Videos
+	}
+	return nil
+}
+
+type UserPlaylistsRequest struct {
+	OwnerID              int64    `protobuf:"varint,1,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	UserID               int64    `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserPlaylistsRequest) Reset()         { *m = UserPlaylistsRequest{} }
+func (m *UserPlaylistsRequest) String() string { return proto.CompactTextString(m) }
+func (*UserPlaylistsRequest) ProtoMessage()    {}
+func (*UserPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{37}
+}
+
+func (m *UserPlaylistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserPlaylistsRequest.Unmarshal(m, b)
+}
+func (m *UserPlaylistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserPlaylistsRequest.Marshal(b, m, deterministic)
+}
+func (m *UserPlaylistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserPlaylistsRequest.Merge(m, src)
+}
+func (m *UserPlaylistsRequest) XXX_Size() int {
+	return xxx_messageInfo_UserPlaylistsRequest.Size(m)
+}
+func (m *UserPlaylistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserPlaylistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserPlaylistsRequest proto.InternalMessageInfo
+
+func (m *UserPlaylistsRequest) GetOwnerID() int64 {
+	if m != nil {
+		return m.OwnerID
+	}
+	return 0
+}
+
+func (m *UserPlaylistsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type PlaylistList struct {
+	Playlists            []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PlaylistList) Reset()         { *m = PlaylistList{} }
+func (m *PlaylistList) String() string { return proto.CompactTextString(m) }
+func (*PlaylistList) ProtoMessage()    {}
+func (*PlaylistList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{38}
+}
+
+func (m *PlaylistList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaylistList.Unmarshal(m, b)
+}
+func (m *PlaylistList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaylistList.Marshal(b, m, deterministic)
+}
+func (m *PlaylistList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaylistList.Merge(m, src)
+}
+func (m *PlaylistList) XXX_Size() int {
+	return xxx_messageInfo_PlaylistList.Size(m)
+}
+func (m *PlaylistList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaylistList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaylistList proto.InternalMessageInfo
+
+func (m *PlaylistList) GetPlaylists() []*Playlist {
+	if m != nil {
+		return m.Playlists
+	}
+	return nil
+}
+
+type MirroredPlaylistSync struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Site                 Website  `protobuf:"varint,2,opt,name=site,proto3,enum=proto.Website" json:"site,omitempty"`
+	PlaylistID           string   `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Title                string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	ForeignVideoIDs      []string `protobuf:"bytes,5,rep,name=foreignVideoIDs,proto3" json:"foreignVideoIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MirroredPlaylistSync) Reset()         { *m = MirroredPlaylistSync{} }
+func (m *MirroredPlaylistSync) String() string { return proto.CompactTextString(m) }
+func (*MirroredPlaylistSync) ProtoMessage()    {}
+func (*MirroredPlaylistSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{39}
+}
+
+func (m *MirroredPlaylistSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirroredPlaylistSync.Unmarshal(m, b)
+}
+func (m *MirroredPlaylistSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirroredPlaylistSync.Marshal(b, m, deterministic)
+}
+func (m *MirroredPlaylistSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirroredPlaylistSync.Merge(m, src)
+}
+func (m *MirroredPlaylistSync) XXX_Size() int {
+	return xxx_messageInfo_MirroredPlaylistSync.Size(m)
+}
+func (m *MirroredPlaylistSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirroredPlaylistSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirroredPlaylistSync proto.InternalMessageInfo
+
+func (m *MirroredPlaylistSync) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *MirroredPlaylistSync) GetSite() Website {
+	if m != nil {
+		return m.Site
+	}
+	return Website_niconico
+}
+
+func (m *MirroredPlaylistSync) GetPlaylistID() string {
+	if m != nil {
+		return m.PlaylistID
+	}
+	return ""
+}
+
+func (m *MirroredPlaylistSync) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MirroredPlaylistSync) GetForeignVideoIDs() []string {
+	if m != nil {
+		return m.ForeignVideoIDs
+	}
+	return nil
+}
+
+type TagFollow struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagFollow) Reset()         { *m = TagFollow{} }
+func (m *TagFollow) String() string { return proto.CompactTextString(m) }
+func (*TagFollow) ProtoMessage()    {}
+func (*TagFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{40}
+}
+
+func (m *TagFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagFollow.Unmarshal(m, b)
+}
+func (m *TagFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagFollow.Marshal(b, m, deterministic)
+}
+func (m *TagFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagFollow.Merge(m, src)
+}
+func (m *TagFollow) XXX_Size() int {
+	return xxx_messageInfo_TagFollow.Size(m)
+}
+func (m *TagFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagFollow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagFollow proto.InternalMessageInfo
+
+func (m *TagFollow) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagFollow) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type FollowedTagsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FollowedTagsRequest) Reset()         { *m = FollowedTagsRequest{} }
+func (m *FollowedTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*FollowedTagsRequest) ProtoMessage()    {}
+func (*FollowedTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{41}
+}
+
+func (m *FollowedTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowedTagsRequest.Unmarshal(m, b)
+}
+func (m *FollowedTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowedTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *FollowedTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowedTagsRequest.Merge(m, src)
+}
+func (m *FollowedTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_FollowedTagsRequest.Size(m)
+}
+func (m *FollowedTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowedTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FollowedTagsRequest proto.InternalMessageInfo
+
+func (m *FollowedTagsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type FollowedTags struct {
+	Tags                 []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FollowedTags) Reset()         { *m = FollowedTags{} }
+func (m *FollowedTags) String() string { return proto.CompactTextString(m) }
+func (*FollowedTags) ProtoMessage()    {}
+func (*FollowedTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{42}
+}
+
+func (m *FollowedTags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowedTags.Unmarshal(m, b)
+}
+func (m *FollowedTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowedTags.Marshal(b, m, deterministic)
+}
+func (m *FollowedTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowedTags.Merge(m, src)
+}
+func (m *FollowedTags) XXX_Size() int {
+	return xxx_messageInfo_FollowedTags.Size(m)
+}
+func (m *FollowedTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowedTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FollowedTags proto.InternalMessageInfo
+
+func (m *FollowedTags) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type TagLookup struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagLookup) Reset()         { *m = TagLookup{} }
+func (m *TagLookup) String() string { return proto.CompactTextString(m) }
+func (*TagLookup) ProtoMessage()    {}
+func (*TagLookup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{43}
+}
+
+func (m *TagLookup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagLookup.Unmarshal(m, b)
+}
+func (m *TagLookup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagLookup.Marshal(b, m, deterministic)
+}
+func (m *TagLookup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagLookup.Merge(m, src)
+}
+func (m *TagLookup) XXX_Size() int {
+	return xxx_messageInfo_TagLookup.Size(m)
+}
+func (m *TagLookup) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagLookup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagLookup proto.InternalMessageInfo
+
+func (m *TagLookup) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type TagInfo struct {
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category             TagCategory `protobuf:"varint,2,opt,name=category,proto3,enum=proto.TagCategory" json:"category,omitempty"`
+	Description          string      `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Aliases              []string    `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Implies              []string    `protobuf:"bytes,5,rep,name=implies,proto3" json:"implies,omitempty"`
+	ImpliedBy            []string    `protobuf:"bytes,6,rep,name=impliedBy,proto3" json:"impliedBy,omitempty"`
+	VideoCount           int64       `protobuf:"varint,7,opt,name=videoCount,proto3" json:"videoCount,omitempty"`
+	MatchedAlias         string      `protobuf:"bytes,8,opt,name=matchedAlias,proto3" json:"matchedAlias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TagInfo) Reset()         { *m = TagInfo{} }
+func (m *TagInfo) String() string { return proto.CompactTextString(m) }
+func (*TagInfo) ProtoMessage()    {}
+func (*TagInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{44}
+}
+
+func (m *TagInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagInfo.Unmarshal(m, b)
+}
+func (m *TagInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagInfo.Marshal(b, m, deterministic)
+}
+func (m *TagInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagInfo.Merge(m, src)
+}
+func (m *TagInfo) XXX_Size() int {
+	return xxx_messageInfo_TagInfo.Size(m)
+}
+func (m *TagInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagInfo proto.InternalMessageInfo
+
+func (m *TagInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TagInfo) GetCategory() TagCategory {
+	if m != nil {
+		return m.Category
+	}
+	return TagCategory_general
+}
+
+func (m *TagInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TagInfo) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *TagInfo) GetImplies() []string {
+	if m != nil {
+		return m.Implies
+	}
+	return nil
+}
+
+func (m *TagInfo) GetImpliedBy() []string {
+	if m != nil {
+		return m.ImpliedBy
+	}
+	return nil
+}
+
+func (m *TagInfo) GetVideoCount() int64 {
+	if m != nil {
+		return m.VideoCount
+	}
+	return 0
+}
+
+func (m *TagInfo) GetMatchedAlias() string {
+	if m != nil {
+		return m.MatchedAlias
+	}
+	return ""
+}
+
+type TagAutocompleteRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagAutocompleteRequest) Reset()         { *m = TagAutocompleteRequest{} }
+func (m *TagAutocompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*TagAutocompleteRequest) ProtoMessage()    {}
+func (*TagAutocompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{45}
+}
+
+func (m *TagAutocompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagAutocompleteRequest.Unmarshal(m, b)
+}
+func (m *TagAutocompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagAutocompleteRequest.Marshal(b, m, deterministic)
+}
+func (m *TagAutocompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagAutocompleteRequest.Merge(m, src)
+}
+func (m *TagAutocompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_TagAutocompleteRequest.Size(m)
+}
+func (m *TagAutocompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagAutocompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagAutocompleteRequest proto.InternalMessageInfo
+
+func (m *TagAutocompleteRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *TagAutocompleteRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TagCountsRequest struct {
+	Tags                 []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagCountsRequest) Reset()         { *m = TagCountsRequest{} }
+func (m *TagCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*TagCountsRequest) ProtoMessage()    {}
+func (*TagCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{46}
+}
+
+func (m *TagCountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagCountsRequest.Unmarshal(m, b)
+}
+func (m *TagCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagCountsRequest.Marshal(b, m, deterministic)
+}
+func (m *TagCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagCountsRequest.Merge(m, src)
+}
+func (m *TagCountsRequest) XXX_Size() int {
+	return xxx_messageInfo_TagCountsRequest.Size(m)
+}
+func (m *TagCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagCountsRequest proto.InternalMessageInfo
+
+func (m *TagCountsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *TagCountsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Only name, category and videoCount are set, except by GetTag
+type TagList struct {
+	Tags                 []*TagInfo `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TagList) Reset()         { *m = TagList{} }
+func (m *TagList) String() string { return proto.CompactTextString(m) }
+func (*TagList) ProtoMessage()    {}
+func (*TagList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{47}
+}
+
+func (m *TagList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagList.Unmarshal(m, b)
+}
+func (m *TagList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagList.Marshal(b, m, deterministic)
+}
+func (m *TagList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagList.Merge(m, src)
+}
+func (m *TagList) XXX_Size() int {
+	return xxx_messageInfo_TagList.Size(m)
+}
+func (m *TagList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagList proto.InternalMessageInfo
+
+func (m *TagList) GetTags() []*TagInfo {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type TagUpdate struct {
+	UserID               int64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tag                  string      `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category             TagCategory `protobuf:"varint,3,opt,name=category,proto3,enum=proto.TagCategory" json:"category,omitempty"`
+	Description          string      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TagUpdate) Reset()         { *m = TagUpdate{} }
+func (m *TagUpdate) String() string { return proto.CompactTextString(m) }
+func (*TagUpdate) ProtoMessage()    {}
+func (*TagUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{48}
+}
+
+func (m *TagUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagUpdate.Unmarshal(m, b)
+}
+func (m *TagUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagUpdate.Marshal(b, m, deterministic)
+}
+func (m *TagUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagUpdate.Merge(m, src)
+}
+func (m *TagUpdate) XXX_Size() int {
+	return xxx_messageInfo_TagUpdate.Size(m)
+}
+func (m *TagUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagUpdate proto.InternalMessageInfo
+
+func (m *TagUpdate) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagUpdate) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagUpdate) GetCategory() TagCategory {
+	if m != nil {
+		return m.Category
+	}
+	return TagCategory_general
+}
+
+func (m *TagUpdate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type TagAliasChange struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Alias                string   `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagAliasChange) Reset()         { *m = TagAliasChange{} }
+func (m *TagAliasChange) String() string { return proto.CompactTextString(m) }
+func (*TagAliasChange) ProtoMessage()    {}
+func (*TagAliasChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{49}
+}
+
+func (m *TagAliasChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagAliasChange.Unmarshal(m, b)
+}
+func (m *TagAliasChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagAliasChange.Marshal(b, m, deterministic)
+}
+func (m *TagAliasChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagAliasChange.Merge(m, src)
+}
+func (m *TagAliasChange) XXX_Size() int {
+	return xxx_messageInfo_TagAliasChange.Size(m)
+}
+func (m *TagAliasChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagAliasChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagAliasChange proto.InternalMessageInfo
+
+func (m *TagAliasChange) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagAliasChange) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagAliasChange) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+type TagImplicationChange struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	ImpliedTag           string   `protobuf:"bytes,3,opt,name=impliedTag,proto3" json:"impliedTag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagImplicationChange) Reset()         { *m = TagImplicationChange{} }
+func (m *TagImplicationChange) String() string { return proto.CompactTextString(m) }
+func (*TagImplicationChange) ProtoMessage()    {}
+func (*TagImplicationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{50}
+}
+
+func (m *TagImplicationChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagImplicationChange.Unmarshal(m, b)
+}
+func (m *TagImplicationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagImplicationChange.Marshal(b, m, deterministic)
+}
+func (m *TagImplicationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagImplicationChange.Merge(m, src)
+}
+func (m *TagImplicationChange) XXX_Size() int {
+	return xxx_messageInfo_TagImplicationChange.Size(m)
+}
+func (m *TagImplicationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagImplicationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagImplicationChange proto.InternalMessageInfo
+
+func (m *TagImplicationChange) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagImplicationChange) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagImplicationChange) GetImpliedTag() string {
+	if m != nil {
+		return m.ImpliedTag
+	}
+	return ""
+}
+
+type TagHistoryRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageNumber           int64    `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagHistoryRequest) Reset()         { *m = TagHistoryRequest{} }
+func (m *TagHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*TagHistoryRequest) ProtoMessage()    {}
+func (*TagHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{51}
+}
+
+func (m *TagHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagHistoryRequest.Unmarshal(m, b)
+}
+func (m *TagHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *TagHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagHistoryRequest.Merge(m, src)
+}
+func (m *TagHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_TagHistoryRequest.Size(m)
+}
+func (m *TagHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagHistoryRequest proto.InternalMessageInfo
+
+func (m *TagHistoryRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagHistoryRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagHistoryRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+type TagEdit struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	UserID               int64    `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Details              string   `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	CreationDate         string   `protobuf:"bytes,6,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagEdit) Reset()         { *m = TagEdit{} }
+func (m *TagEdit) String() string { return proto.CompactTextString(m) }
+func (*TagEdit) ProtoMessage()    {}
+func (*TagEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{52}
+}
+
+func (m *TagEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagEdit.Unmarshal(m, b)
+}
+func (m *TagEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagEdit.Marshal(b, m, deterministic)
+}
+func (m *TagEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagEdit.Merge(m, src)
+}
+func (m *TagEdit) XXX_Size() int {
+	return xxx_messageInfo_TagEdit.Size(m)
+}
+func (m *TagEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagEdit proto.InternalMessageInfo
+
+func (m *TagEdit) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TagEdit) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagEdit) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TagEdit) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *TagEdit) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *TagEdit) GetCreationDate() string {
+	if m != nil {
+		return m.CreationDate
+	}
+	return ""
+}
+
+// Newest first
+type TagHistory struct {
+	Edits                []*TagEdit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+	NumberOfEdits        int64      `protobuf:"varint,2,opt,name=numberOfEdits,proto3" json:"numberOfEdits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TagHistory) Reset()         { *m = TagHistory{} }
+func (m *TagHistory) String() string { return proto.CompactTextString(m) }
+func (*TagHistory) ProtoMessage()    {}
+func (*TagHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{53}
+}
+
+func (m *TagHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagHistory.Unmarshal(m, b)
+}
+func (m *TagHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagHistory.Marshal(b, m, deterministic)
+}
+func (m *TagHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagHistory.Merge(m, src)
+}
+func (m *TagHistory) XXX_Size() int {
+	return xxx_messageInfo_TagHistory.Size(m)
+}
+func (m *TagHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagHistory proto.InternalMessageInfo
+
+func (m *TagHistory) GetEdits() []*TagEdit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+func (m *TagHistory) GetNumberOfEdits() int64 {
+	if m != nil {
+		return m.NumberOfEdits
+	}
+	return 0
+}
+
+type FeedRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeedRequest) Reset()         { *m = FeedRequest{} }
+func (m *FeedRequest) String() string { return proto.CompactTextString(m) }
+func (*FeedRequest) ProtoMessage()    {}
+func (*FeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{54}
+}
+
+func (m *FeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeedRequest.Unmarshal(m, b)
+}
+func (m *FeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeedRequest.Marshal(b, m, deterministic)
+}
+func (m *FeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedRequest.Merge(m, src)
+}
+func (m *FeedRequest) XXX_Size() int {
+	return xxx_messageInfo_FeedRequest.Size(m)
+}
+func (m *FeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedRequest proto.InternalMessageInfo
+
+func (m *FeedRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *FeedRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type Feed struct {
+	Videos               []*Video `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	NextCursor           string   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Feed) Reset()         { *m = Feed{} }
+func (m *Feed) String() string { return proto.CompactTextString(m) }
+func (*Feed) ProtoMessage()    {}
+func (*Feed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{55}
+}
+
+func (m *Feed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feed.Unmarshal(m, b)
+}
+func (m *Feed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feed.Marshal(b, m, deterministic)
+}
+func (m *Feed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feed.Merge(m, src)
+}
+func (m *Feed) XXX_Size() int {
+	return xxx_messageInfo_Feed.Size(m)
+}
+func (m *Feed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feed proto.InternalMessageInfo
+
+func (m *Feed) GetVideos() []*Video {
+	if m != nil {
+		return m.Videos
+	}
+	return nil
+}
+
+func (m *Feed) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type RelatedVideosRequest struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelatedVideosRequest) Reset()         { *m = RelatedVideosRequest{} }
+func (m *RelatedVideosRequest) String() string { return proto.CompactTextString(m) }
+func (*RelatedVideosRequest) ProtoMessage()    {}
+func (*RelatedVideosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{56}
+}
+
+func (m *RelatedVideosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelatedVideosRequest.Unmarshal(m, b)
+}
+func (m *RelatedVideosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelatedVideosRequest.Marshal(b, m, deterministic)
+}
+func (m *RelatedVideosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedVideosRequest.Merge(m, src)
+}
+func (m *RelatedVideosRequest) XXX_Size() int {
+	return xxx_messageInfo_RelatedVideosRequest.Size(m)
+}
+func (m *RelatedVideosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedVideosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelatedVideosRequest proto.InternalMessageInfo
+
+func (m *RelatedVideosRequest) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *RelatedVideosRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Notification struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	VideoID              int64    `protobuf:"varint,4,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	CommentID            int64    `protobuf:"varint,5,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CreationDate         string   `protobuf:"bytes,6,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	IsRead               bool     `protobuf:"varint,7,opt,name=isRead,proto3" json:"isRead,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{57}
+}
+
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Notification) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Notification) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Notification) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *Notification) GetCommentID() int64 {
+	if m != nil {
+		return m.CommentID
+	}
+	return 0
+}
+
+func (m *Notification) GetCreationDate() string {
+	if m != nil {
+		return m.CreationDate
+	}
+	return ""
+}
+
+func (m *Notification) GetIsRead() bool {
+	if m != nil {
+		return m.IsRead
+	}
+	return false
+}
+
+// Newest first by default. If afterID is set, only notifications with a greater ID are returned, oldest first, which
+// lets clients poll for new notifications.
+type ListNotificationsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PageNumber           int64    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	AfterID              int64    `protobuf:"varint,3,opt,name=afterID,proto3" json:"afterID,omitempty"`
+	UnreadOnly           bool     `protobuf:"varint,4,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNotificationsRequest) Reset()         { *m = ListNotificationsRequest{} }
+func (m *ListNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNotificationsRequest) ProtoMessage()    {}
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{58}
+}
+
+func (m *ListNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNotificationsRequest.Unmarshal(m, b)
+}
+func (m *ListNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNotificationsRequest.Merge(m, src)
+}
+func (m *ListNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNotificationsRequest.Size(m)
+}
+func (m *ListNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNotificationsRequest proto.InternalMessageInfo
+
+func (m *ListNotificationsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ListNotificationsRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *ListNotificationsRequest) GetAfterID() int64 {
+	if m != nil {
+		return m.AfterID
+	}
+	return 0
+}
+
+func (m *ListNotificationsRequest) GetUnreadOnly() bool {
+	if m != nil {
+		return m.UnreadOnly
+	}
+	return false
+}
+
+type NotificationList struct {
+	Notifications        []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NumberOfItems        int64           `protobuf:"varint,2,opt,name=numberOfItems,proto3" json:"numberOfItems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NotificationList) Reset()         { *m = NotificationList{} }
+func (m *NotificationList) String() string { return proto.CompactTextString(m) }
+func (*NotificationList) ProtoMessage()    {}
+func (*NotificationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{59}
+}
+
+func (m *NotificationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationList.Unmarshal(m, b)
+}
+func (m *NotificationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationList.Marshal(b, m, deterministic)
+}
+func (m *NotificationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationList.Merge(m, src)
+}
+func (m *NotificationList) XXX_Size() int {
+	return xxx_messageInfo_NotificationList.Size(m)
+}
+func (m *NotificationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationList proto.InternalMessageInfo
+
+func (m *NotificationList) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *NotificationList) GetNumberOfItems() int64 {
+	if m != nil {
+		return m.NumberOfItems
+	}
+	return 0
+}
+
+type NotificationsRead struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	NotificationIDs      []int64  `protobuf:"varint,2,rep,packed,name=notificationIDs,proto3" json:"notificationIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationsRead) Reset()         { *m = NotificationsRead{} }
+func (m *NotificationsRead) String() string { return proto.CompactTextString(m) }
+func (*NotificationsRead) ProtoMessage()    {}
+func (*NotificationsRead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{60}
+}
+
+func (m *NotificationsRead) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationsRead.Unmarshal(m, b)
+}
+func (m *NotificationsRead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationsRead.Marshal(b, m, deterministic)
+}
+func (m *NotificationsRead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationsRead.Merge(m, src)
+}
+func (m *NotificationsRead) XXX_Size() int {
+	return xxx_messageInfo_NotificationsRead.Size(m)
+}
+func (m *NotificationsRead) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationsRead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationsRead proto.InternalMessageInfo
+
+func (m *NotificationsRead) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *NotificationsRead) GetNotificationIDs() []int64 {
+	if m != nil {
+		return m.NotificationIDs
+	}
+	return nil
+}
+
+type UnreadNotificationsRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnreadNotificationsRequest) Reset()         { *m = UnreadNotificationsRequest{} }
+func (m *UnreadNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnreadNotificationsRequest) ProtoMessage()    {}
+func (*UnreadNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{61}
+}
+
+func (m *UnreadNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnreadNotificationsRequest.Unmarshal(m, b)
+}
+func (m *UnreadNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnreadNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnreadNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnreadNotificationsRequest.Merge(m, src)
+}
+func (m *UnreadNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnreadNotificationsRequest.Size(m)
+}
+func (m *UnreadNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnreadNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnreadNotificationsRequest proto.InternalMessageInfo
+
+func (m *UnreadNotificationsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type UnreadNotificationCount struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnreadNotificationCount) Reset()         { *m = UnreadNotificationCount{} }
+func (m *UnreadNotificationCount) String() string { return proto.CompactTextString(m) }
+func (*UnreadNotificationCount) ProtoMessage()    {}
+func (*UnreadNotificationCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{62}
+}
+
+func (m *UnreadNotificationCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnreadNotificationCount.Unmarshal(m, b)
+}
+func (m *UnreadNotificationCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnreadNotificationCount.Marshal(b, m, deterministic)
+}
+func (m *UnreadNotificationCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnreadNotificationCount.Merge(m, src)
+}
+func (m *UnreadNotificationCount) XXX_Size() int {
+	return xxx_messageInfo_UnreadNotificationCount.Size(m)
+}
+func (m *UnreadNotificationCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnreadNotificationCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnreadNotificationCount proto.InternalMessageInfo
+
+func (m *UnreadNotificationCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type NotificationCreation struct {
+	UserIDs              []int64  `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	VideoID              int64    `protobuf:"varint,4,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationCreation) Reset()         { *m = NotificationCreation{} }
+func (m *NotificationCreation) String() string { return proto.CompactTextString(m) }
+func (*NotificationCreation) ProtoMessage()    {}
+func (*NotificationCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{63}
+}
+
+func (m *NotificationCreation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationCreation.Unmarshal(m, b)
+}
+func (m *NotificationCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationCreation.Marshal(b, m, deterministic)
+}
+func (m *NotificationCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationCreation.Merge(m, src)
+}
+func (m *NotificationCreation) XXX_Size() int {
+	return xxx_messageInfo_NotificationCreation.Size(m)
+}
+func (m *NotificationCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationCreation proto.InternalMessageInfo
+
+func (m *NotificationCreation) GetUserIDs() []int64 {
+	if m != nil {
+		return m.UserIDs
+	}
+	return nil
+}
+
+func (m *NotificationCreation) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *NotificationCreation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *NotificationCreation) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+type VideoApproval struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID              int64    `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoApproval) Reset()         { *m = VideoApproval{} }
+func (m *VideoApproval) String() string { return proto.CompactTextString(m) }
+func (*VideoApproval) ProtoMessage()    {}
+func (*VideoApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{64}
+}
+
+func (m *VideoApproval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoApproval.Unmarshal(m, b)
+}
+func (m *VideoApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoApproval.Marshal(b, m, deterministic)
+}
+func (m *VideoApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoApproval.Merge(m, src)
+}
+func (m *VideoApproval) XXX_Size() int {
+	return xxx_messageInfo_VideoApproval.Size(m)
+}
+func (m *VideoApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoApproval proto.InternalMessageInfo
+
+func (m *VideoApproval) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *VideoApproval) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+type VideoRejection struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID              int64    `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoRejection) Reset()         { *m = VideoRejection{} }
+func (m *VideoRejection) String() string { return proto.CompactTextString(m) }
+func (*VideoRejection) ProtoMessage()    {}
+func (*VideoRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{65}
+}
+
+func (m *VideoRejection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoRejection.Unmarshal(m, b)
+}
+func (m *VideoRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoRejection.Marshal(b, m, deterministic)
+}
+func (m *VideoRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRejection.Merge(m, src)
+}
+func (m *VideoRejection) XXX_Size() int {
+	return xxx_messageInfo_VideoRejection.Size(m)
+}
+func (m *VideoRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoRejection proto.InternalMessageInfo
+
+func (m *VideoRejection) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *VideoRejection) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *VideoRejection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PendingVideosRequest struct {
+	UserID               int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PageNumber           int64    `protobuf:"varint,2,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingVideosRequest) Reset()         { *m = PendingVideosRequest{} }
+func (m *PendingVideosRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingVideosRequest) ProtoMessage()    {}
+func (*PendingVideosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{66}
+}
+
+func (m *PendingVideosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingVideosRequest.Unmarshal(m, b)
+}
+func (m *PendingVideosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingVideosRequest.Marshal(b, m, deterministic)
+}
+func (m *PendingVideosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVideosRequest.Merge(m, src)
+}
+func (m *PendingVideosRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingVideosRequest.Size(m)
+}
+func (m *PendingVideosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVideosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVideosRequest proto.InternalMessageInfo
+
+func (m *PendingVideosRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *PendingVideosRequest) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+// Transcoded videos which have been neither approved nor rejected, oldest first
+type PendingVideoList struct {
+	Videos               []*PendingVideo `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	NumberOfVideos       int64           `protobuf:"varint,2,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PendingVideoList) Reset()         { *m = PendingVideoList{} }
+func (m *PendingVideoList) String() string { return proto.CompactTextString(m) }
+func (*PendingVideoList) ProtoMessage()    {}
+func (*PendingVideoList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{67}
+}
+
+func (m *PendingVideoList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingVideoList.Unmarshal(m, b)
+}
+func (m *PendingVideoList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingVideoList.Marshal(b, m, deterministic)
+}
+func (m *PendingVideoList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVideoList.Merge(m, src)
+}
+func (m *PendingVideoList) XXX_Size() int {
+	return xxx_messageInfo_PendingVideoList.Size(m)
+}
+func (m *PendingVideoList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVideoList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVideoList proto.InternalMessageInfo
+
+func (m *PendingVideoList) GetVideos() []*PendingVideo {
+	if m != nil {
+		return m.Videos
+	}
+	return nil
+}
+
+func (m *PendingVideoList) GetNumberOfVideos() int64 {
+	if m != nil {
+		return m.NumberOfVideos
+	}
+	return 0
+}
+
+type PendingVideo struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorID             int64    `protobuf:"varint,3,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	AuthorName           string   `protobuf:"bytes,4,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	UploadDate           string   `protobuf:"bytes,5,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
+	Approvals            int64    `protobuf:"varint,6,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	Rejections           int64    `protobuf:"varint,7,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	RejectionReasons     []string `protobuf:"bytes,8,rep,name=rejectionReasons,proto3" json:"rejectionReasons,omitempty"`
+	CurrentUserVoted     bool     `protobuf:"varint,9,opt,name=currentUserVoted,proto3" json:"currentUserVoted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingVideo) Reset()         { *m = PendingVideo{} }
+func (m *PendingVideo) String() string { return proto.CompactTextString(m) }
+func (*PendingVideo) ProtoMessage()    {}
+func (*PendingVideo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{68}
+}
+
+func (m *PendingVideo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingVideo.Unmarshal(m, b)
+}
+func (m *PendingVideo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingVideo.Marshal(b, m, deterministic)
+}
+func (m *PendingVideo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVideo.Merge(m, src)
+}
+func (m *PendingVideo) XXX_Size() int {
+	return xxx_messageInfo_PendingVideo.Size(m)
+}
+func (m *PendingVideo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVideo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVideo proto.InternalMessageInfo
+
+func (m *PendingVideo) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func (m *PendingVideo) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PendingVideo) GetAuthorID() int64 {
+	if m != nil {
+		return m.AuthorID
+	}
+	return 0
+}
+
+func (m *PendingVideo) GetAuthorName() string {
+	if m != nil {
+		return m.AuthorName
+	}
+	return ""
+}
+
+func (m *PendingVideo) GetUploadDate() string {
+	if m != nil {
+		return m.UploadDate
+	}
+	return ""
+}
+
+func (m *PendingVideo) GetApprovals() int64 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
+}
+
+func (m *PendingVideo) GetRejections() int64 {
+	if m != nil {
+		return m.Rejections
+	}
+	return 0
+}
+
+func (m *PendingVideo) GetRejectionReasons() []string {
+	if m != nil {
+		return m.RejectionReasons
+	}
+	return nil
+}
+
+func (m *PendingVideo) GetCurrentUserVoted() bool {
+	if m != nil {
+		return m.CurrentUserVoted
+	}
+	return false
+}
+
+type VideoQueryConfig struct {
+	OrderBy              OrderCategory `protobuf:"varint,1,opt,name=orderBy,proto3,enum=proto.OrderCategory" json:"orderBy,omitempty"`
+	Direction            SortDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.SortDirection" json:"direction,omitempty"`
+	PageNumber           int64         `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	ContainsTag          string        `protobuf:"bytes,4,opt,name=containsTag,proto3" json:"containsTag,omitempty"`
+	FromUserID           int64         `protobuf:"varint,5,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	ShowUnapproved       bool          `protobuf:"varint,6,opt,name=showUnapproved,proto3" json:"showUnapproved,omitempty"`
+	ViewerID             int64         `protobuf:"varint,7,opt,name=viewerID,proto3" json:"viewerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VideoQueryConfig) Reset()         { *m = VideoQueryConfig{} }
+func (m *VideoQueryConfig) String() string { return proto.CompactTextString(m) }
+func (*VideoQueryConfig) ProtoMessage()    {}
+func (*VideoQueryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{69}
+}
+
+func (m *VideoQueryConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoQueryConfig.Unmarshal(m, b)
+}
+func (m *VideoQueryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoQueryConfig.Marshal(b, m, deterministic)
+}
+func (m *VideoQueryConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoQueryConfig.Merge(m, src)
+}
+func (m *VideoQueryConfig) XXX_Size() int {
+	return xxx_messageInfo_VideoQueryConfig.Size(m)
+}
+func (m *VideoQueryConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoQueryConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoQueryConfig proto.InternalMessageInfo
+
+func (m *VideoQueryConfig) GetOrderBy() OrderCategory {
+	if m != nil {
+		return m.OrderBy
+	}
+	return OrderCategory_views
+}
+
+func (m *VideoQueryConfig) GetDirection() SortDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return SortDirection_asc
+}
+
+func (m *VideoQueryConfig) GetPageNumber() int64 {
+	if m != nil {
+		return m.PageNumber
+	}
+	return 0
+}
+
+func (m *VideoQueryConfig) GetContainsTag() string {
+	if m != nil {
+		return m.ContainsTag
+	}
+	return ""
+}
+
+func (m *VideoQueryConfig) GetFromUserID() int64 {
+	if m != nil {
+		return m.FromUserID
+	}
+	return 0
+}
+
+func (m *VideoQueryConfig) GetShowUnapproved() bool {
+	if m != nil {
+		return m.ShowUnapproved
+	}
+	return false
+}
+
+func (m *VideoQueryConfig) GetViewerID() int64 {
+	if m != nil {
+		return m.ViewerID
+	}
+	return 0
+}
+
+type VideoExistenceResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoExistenceResponse) Reset()         { *m = VideoExistenceResponse{} }
+func (m *VideoExistenceResponse) String() string { return proto.CompactTextString(m) }
+func (*VideoExistenceResponse) ProtoMessage()    {}
+func (*VideoExistenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{70}
+}
+
+func (m *VideoExistenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoExistenceResponse.Unmarshal(m, b)
+}
+func (m *VideoExistenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoExistenceResponse.Marshal(b, m, deterministic)
+}
+func (m *VideoExistenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoExistenceResponse.Merge(m, src)
+}
+func (m *VideoExistenceResponse) XXX_Size() int {
+	return xxx_messageInfo_VideoExistenceResponse.Size(m)
+}
+func (m *VideoExistenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoExistenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoExistenceResponse proto.InternalMessageInfo
+
+func (m *VideoExistenceResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type ForeignVideoCheck struct {
+	ForeignVideoID       string   `protobuf:"bytes,1,opt,name=ForeignVideoID,proto3" json:"ForeignVideoID,omitempty"`
+	ForeignWebsite       Website  `protobuf:"varint,2,opt,name=ForeignWebsite,proto3,enum=proto.Website" json:"ForeignWebsite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForeignVideoCheck) Reset()         { *m = ForeignVideoCheck{} }
+func (m *ForeignVideoCheck) String() string { return proto.CompactTextString(m) }
+func (*ForeignVideoCheck) ProtoMessage()    {}
+func (*ForeignVideoCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{71}
+}
+
+func (m *ForeignVideoCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForeignVideoCheck.Unmarshal(m, b)
+}
+func (m *ForeignVideoCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForeignVideoCheck.Marshal(b, m, deterministic)
+}
+func (m *ForeignVideoCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeignVideoCheck.Merge(m, src)
+}
+func (m *ForeignVideoCheck) XXX_Size() int {
+	return xxx_messageInfo_ForeignVideoCheck.Size(m)
+}
+func (m *ForeignVideoCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeignVideoCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForeignVideoCheck proto.InternalMessageInfo
+
+func (m *ForeignVideoCheck) GetForeignVideoID() string {
+	if m != nil {
+		return m.ForeignVideoID
+	}
+	return ""
+}
+
+func (m *ForeignVideoCheck) GetForeignWebsite() Website {
+	if m != nil {
+		return m.ForeignWebsite
+	}
+	return Website_niconico
+}
+
+type VideoRequest struct {
+	VideoID              string   `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VideoRequest) Reset()         { *m = VideoRequest{} }
+func (m *VideoRequest) String() string { return proto.CompactTextString(m) }
+func (*VideoRequest) ProtoMessage()    {}
+func (*VideoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{72}
+}
+
+func (m *VideoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoRequest.Unmarshal(m, b)
+}
+func (m *VideoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoRequest.Marshal(b, m, deterministic)
+}
+func (m *VideoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRequest.Merge(m, src)
+}
+func (m *VideoRequest) XXX_Size() int {
+	return xxx_messageInfo_VideoRequest.Size(m)
+}
+func (m *VideoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoRequest proto.InternalMessageInfo
+
+func (m *VideoRequest) GetVideoID() string {
+	if m != nil {
+		return m.VideoID
+	}
+	return ""
+}
+
+type InputVideoChunk struct {
+	// Types that are valid to be assigned to Payload:
+	//	*InputVideoChunk_Content
+	//	*InputVideoChunk_Meta
+	//	*InputVideoChunk_Rawmeta
+	Payload              isInputVideoChunk_Payload `protobuf_oneof:"Payload"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *InputVideoChunk) Reset()         { *m = InputVideoChunk{} }
+func (m *InputVideoChunk) String() string { return proto.CompactTextString(m) }
+func (*InputVideoChunk) ProtoMessage()    {}
+func (*InputVideoChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{73}
+}
+
+func (m *InputVideoChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InputVideoChunk.Unmarshal(m, b)
+}
+func (m *InputVideoChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InputVideoChunk.Marshal(b, m, deterministic)
+}
+func (m *InputVideoChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputVideoChunk.Merge(m, src)
+}
+func (m *InputVideoChunk) XXX_Size() int {
+	return xxx_messageInfo_InputVideoChunk.Size(m)
+}
+func (m *InputVideoChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputVideoChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputVideoChunk proto.InternalMessageInfo
+
+type isInputVideoChunk_Payload interface {
+	isInputVideoChunk_Payload()
+}
+
+type InputVideoChunk_Content struct {
+	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+}
+
+type InputVideoChunk_Meta struct {
+	Meta *InputFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+}
+
+type InputVideoChunk_Rawmeta struct {
+	Rawmeta *RawMetadata `protobuf:"bytes,3,opt,name=rawmeta,proto3,oneof"`
+}
+
+func (*InputVideoChunk_Content) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Meta) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Rawmeta) isInputVideoChunk_Payload() {}
+
+func (m *InputVideoChunk) GetPayload() isInputVideoChunk_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *InputVideoChunk) GetContent() *FileContent {
+	if x, ok := m.GetPayload().(*InputVideoChunk_Content); ok {
+		return x.Content
+	}
+	return nil
+}
+
+func (m *InputVideoChunk) GetMeta() *InputFileMetadata {
+	if x, ok := m.GetPayload().(*InputVideoChunk_Meta); ok {
+		return x.Meta
+	}
+	return nil
+}
+
+func (m *InputVideoChunk) GetRawmeta() *RawMetadata {
+	if x, ok := m.GetPayload().(*InputVideoChunk_Rawmeta); ok {
+		return x.Rawmeta
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InputVideoChunk) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InputVideoChunk_Content)(nil),
+		(*InputVideoChunk_Meta)(nil),
+		(*InputVideoChunk_Rawmeta)(nil),
+	}
+}
+
+type ResponseVideoChunk struct {
+	// Types that are valid to be assigned to Payload:
+	//	*ResponseVideoChunk_Content
+	//	*ResponseVideoChunk_Meta
+	Payload              isResponseVideoChunk_Payload `protobuf_oneof:"Payload"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ResponseVideoChunk) Reset()         { *m = ResponseVideoChunk{} }
+func (m *ResponseVideoChunk) String() string { return proto.CompactTextString(m) }
+func (*ResponseVideoChunk) ProtoMessage()    {}
+func (*ResponseVideoChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{74}
+}
+
+func (m *ResponseVideoChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResponseVideoChunk.Unmarshal(m, b)
+}
+func (m *ResponseVideoChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResponseVideoChunk.Marshal(b, m, deterministic)
+}
+func (m *ResponseVideoChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseVideoChunk.Merge(m, src)
+}
+func (m *ResponseVideoChunk) XXX_Size() int {
+	return xxx_messageInfo_ResponseVideoChunk.Size(m)
+}
+func (m *ResponseVideoChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseVideoChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseVideoChunk proto.InternalMessageInfo
+
+type isResponseVideoChunk_Payload interface {
+	isResponseVideoChunk_Payload()
+}
+
+type ResponseVideoChunk_Content struct {
+	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+}
+
+type ResponseVideoChunk_Meta struct {
+	Meta *ResponseFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+}
+
+func (*ResponseVideoChunk_Content) isResponseVideoChunk_Payload() {}
+
+func (*ResponseVideoChunk_Meta) isResponseVideoChunk_Payload() {}
+
+func (m *ResponseVideoChunk) GetPayload() isResponseVideoChunk_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ResponseVideoChunk) GetContent() *FileContent {
+	if x, ok := m.GetPayload().(*ResponseVideoChunk_Content); ok {
+		return x.Content
+	}
+	return nil
+}
+
+func (m *ResponseVideoChunk) GetMeta() *ResponseFileMetadata {
+	if x, ok := m.GetPayload().(*ResponseVideoChunk_Meta); ok {
+		return x.Meta
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResponseVideoChunk) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResponseVideoChunk_Content)(nil),
+		(*ResponseVideoChunk_Meta)(nil),
+	}
+}
+
+type FileContent struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileContent) Reset()         { *m = FileContent{} }
+func (m *FileContent) String() string { return proto.CompactTextString(m) }
+func (*FileContent) ProtoMessage()    {}
+func (*FileContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{75}
+}
+
+func (m *FileContent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileContent.Unmarshal(m, b)
+}
+func (m *FileContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileContent.Marshal(b, m, deterministic)
+}
+func (m *FileContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileContent.Merge(m, src)
+}
+func (m *FileContent) XXX_Size() int {
+	return xxx_messageInfo_FileContent.Size(m)
+}
+func (m *FileContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileContent proto.InternalMessageInfo
+
+func (m *FileContent) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type RawMetadata struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawMetadata) Reset()         { *m = RawMetadata{} }
+func (m *RawMetadata) String() string { return proto.CompactTextString(m) }
+func (*RawMetadata) ProtoMessage()    {}
+func (*RawMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{76}
+}
+
+func (m *RawMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawMetadata.Unmarshal(m, b)
+}
+func (m *RawMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawMetadata.Marshal(b, m, deterministic)
+}
+func (m *RawMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawMetadata.Merge(m, src)
+}
+func (m *RawMetadata) XXX_Size() int {
+	return xxx_messageInfo_RawMetadata.Size(m)
+}
+func (m *RawMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawMetadata proto.InternalMessageInfo
+
+func (m *RawMetadata) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Could make the reupload fields optional
+type InputFileMetadata struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorUID            string   `protobuf:"bytes,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`
+	OriginalVideoLink    string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"`
+	AuthorUsername       string   `protobuf:"bytes,5,opt,name=authorUsername,proto3" json:"authorUsername,omitempty"`
+	OriginalSite         Website  `protobuf:"varint,6,opt,name=originalSite,proto3,enum=proto.Website" json:"originalSite,omitempty"`
+	OriginalID           string   `protobuf:"bytes,7,opt,name=originalID,proto3" json:"originalID,omitempty"`
+	DomesticAuthorID     int64    `protobuf:"varint,8,opt,name=domesticAuthorID,proto3" json:"domesticAuthorID,omitempty"`
+	Tags                 []string `protobuf:"bytes,9,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Thumbnail            []byte   `protobuf:"bytes,10,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InputFileMetadata) Reset()         { *m = InputFileMetadata{} }
+func (m *InputFileMetadata) String() string { return proto.CompactTextString(m) }
+func (*InputFileMetadata) ProtoMessage()    {}
+func (*InputFileMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{77}
+}
+
+func (m *InputFileMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InputFileMetadata.Unmarshal(m, b)
+}
+func (m *InputFileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InputFileMetadata.Marshal(b, m, deterministic)
+}
+func (m *InputFileMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputFileMetadata.Merge(m, src)
+}
+func (m *InputFileMetadata) XXX_Size() int {
+	return xxx_messageInfo_InputFileMetadata.Size(m)
+}
+func (m *InputFileMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputFileMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputFileMetadata proto.InternalMessageInfo
+
+func (m *InputFileMetadata) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetAuthorUID() string {
+	if m != nil {
+		return m.AuthorUID
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetOriginalVideoLink() string {
+	if m != nil {
+		return m.OriginalVideoLink
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetAuthorUsername() string {
+	if m != nil {
+		return m.AuthorUsername
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetOriginalSite() Website {
+	if m != nil {
+		return m.OriginalSite
+	}
+	return Website_niconico
+}
+
+func (m *InputFileMetadata) GetOriginalID() string {
+	if m != nil {
+		return m.OriginalID
+	}
+	return ""
+}
+
+func (m *InputFileMetadata) GetDomesticAuthorID() int64 {
+	if m != nil {
+		return m.DomesticAuthorID
+	}
+	return 0
+}
+
+func (m *InputFileMetadata) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *InputFileMetadata) GetThumbnail() []byte {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+// For now, these two are the same, but may deviate in future
+type ResponseFileMetadata struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorUID            int64    `protobuf:"varint,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`
+	OriginalVideoLink    string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResponseFileMetadata) Reset()         { *m = ResponseFileMetadata{} }
+func (m *ResponseFileMetadata) String() string { return proto.CompactTextString(m) }
+func (*ResponseFileMetadata) ProtoMessage()    {}
+func (*ResponseFileMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{78}
+}
+
+func (m *ResponseFileMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResponseFileMetadata.Unmarshal(m, b)
+}
+func (m *ResponseFileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResponseFileMetadata.Marshal(b, m, deterministic)
+}
+func (m *ResponseFileMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseFileMetadata.Merge(m, src)
+}
+func (m *ResponseFileMetadata) XXX_Size() int {
+	return xxx_messageInfo_ResponseFileMetadata.Size(m)
+}
+func (m *ResponseFileMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseFileMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseFileMetadata proto.InternalMessageInfo
+
+func (m *ResponseFileMetadata) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ResponseFileMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ResponseFileMetadata) GetAuthorUID() int64 {
+	if m != nil {
+		return m.AuthorUID
+	}
+	return 0
+}
+
+func (m *ResponseFileMetadata) GetOriginalVideoLink() string {
+	if m != nil {
+		return m.OriginalVideoLink
+	}
+	return ""
+}
+
+type UploadResponse struct {
+	VideoID              int64    `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadResponse) Reset()         { *m = UploadResponse{} }
+func (m *UploadResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadResponse) ProtoMessage()    {}
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673ac1e0917b87c1, []int{79}
+}
+
+func (m *UploadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadResponse.Unmarshal(m, b)
+}
+func (m *UploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadResponse.Merge(m, src)
+}
+func (m *UploadResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadResponse.Size(m)
+}
+func (m *UploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadResponse proto.InternalMessageInfo
+
+func (m *UploadResponse) GetVideoID() int64 {
+	if m != nil {
+		return m.VideoID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.CommentSort", CommentSort_name, CommentSort_value)
+	proto.RegisterEnum("proto.DanmakuPosition", DanmakuPosition_name, DanmakuPosition_value)
+	proto.RegisterEnum("proto.ContentType", ContentType_name, ContentType_value)
+	proto.RegisterEnum("proto.ReportReason", ReportReason_name, ReportReason_value)
+	proto.RegisterEnum("proto.ModerationAction", ModerationAction_name, ModerationAction_value)
+	proto.RegisterEnum("proto.PlaylistVisibility", PlaylistVisibility_name, PlaylistVisibility_value)
+	proto.RegisterEnum("proto.TagCategory", TagCategory_name, TagCategory_value)
+	proto.RegisterEnum("proto.Website", Website_name, Website_value)
+	proto.RegisterEnum("proto.OrderCategory", OrderCategory_name, OrderCategory_value)
+	proto.RegisterEnum("proto.SortDirection", SortDirection_name, SortDirection_value)
+	proto.RegisterType((*Nothing)(nil), "proto.Nothing")
+	proto.RegisterType((*VideoTransfer)(nil), "proto.VideoTransfer")
+	proto.RegisterType((*VideoTransferResult)(nil), "proto.VideoTransferResult")
+	proto.RegisterType((*VideoComment)(nil), "proto.videoComment")
+	proto.RegisterType((*CommentRequest)(nil), "proto.commentRequest")
+	proto.RegisterType((*CommentUpvote)(nil), "proto.commentUpvote")
+	proto.RegisterType((*CommentEdit)(nil), "proto.commentEdit")
+	proto.RegisterType((*CommentDeletion)(nil), "proto.commentDeletion")
+	proto.RegisterType((*CommentListResponse)(nil), "proto.CommentListResponse")
+	proto.RegisterType((*Comment)(nil), "proto.Comment")
+	proto.RegisterType((*VideoMetadata)(nil), "proto.videoMetadata")
+	proto.RegisterType((*VideoMirror)(nil), "proto.VideoMirror")
+	proto.RegisterType((*VideoList)(nil), "proto.VideoList")
+	proto.RegisterType((*Video)(nil), "proto.Video")
+	proto.RegisterType((*VideoRating)(nil), "proto.videoRating")
+	proto.RegisterType((*RatingRetraction)(nil), "proto.RatingRetraction")
+	proto.RegisterType((*VideoViewing)(nil), "proto.videoViewing")
+	proto.RegisterType((*VideoViewStatsRequest)(nil), "proto.VideoViewStatsRequest")
+	proto.RegisterType((*VideoViewStats)(nil), "proto.VideoViewStats")
+	proto.RegisterType((*DailyViewCount)(nil), "proto.DailyViewCount")
+	proto.RegisterType((*DanmakuRequest)(nil), "proto.DanmakuRequest")
+	proto.RegisterType((*DanmakuList)(nil), "proto.DanmakuList")
+	proto.RegisterType((*Danmaku)(nil), "proto.Danmaku")
+	proto.RegisterType((*DanmakuPost)(nil), "proto.DanmakuPost")
+	proto.RegisterType((*ContentReport)(nil), "proto.ContentReport")
+	proto.RegisterType((*ListReportsRequest)(nil), "proto.ListReportsRequest")
+	proto.RegisterType((*ReportList)(nil), "proto.ReportList")
+	proto.RegisterType((*ReportedContent)(nil), "proto.ReportedContent")
+	proto.RegisterType((*ReportResolution)(nil), "proto.ReportResolution")
+	proto.RegisterType((*Playlist)(nil), "proto.Playlist")
+	proto.RegisterType((*PlaylistCreation)(nil), "proto.PlaylistCreation")
+	proto.RegisterType((*PlaylistUpdate)(nil), "proto.PlaylistUpdate")
+	proto.RegisterType((*PlaylistDeletion)(nil), "proto.PlaylistDeletion")
+	proto.RegisterType((*PlaylistVideoChange)(nil), "proto.PlaylistVideoChange")
+	proto.RegisterType((*PlaylistVideoMove)(nil), "proto.PlaylistVideoMove")
+	proto.RegisterType((*GetPlaylistRequest)(nil), "proto.GetPlaylistRequest")
+	proto.RegisterType((*PlaylistPage)(nil), "proto.PlaylistPage")
+	proto.RegisterType((*UserPlaylistsRequest)(nil), "proto.UserPlaylistsRequest")
+	proto.RegisterType((*PlaylistList)(nil), "proto.PlaylistList")
+	proto.RegisterType((*MirroredPlaylistSync)(nil), "proto.MirroredPlaylistSync")
+	proto.RegisterType((*TagFollow)(nil), "proto.TagFollow")
+	proto.RegisterType((*FollowedTagsRequest)(nil), "proto.FollowedTagsRequest")
+	proto.RegisterType((*FollowedTags)(nil), "proto.FollowedTags")
+	proto.RegisterType((*TagLookup)(nil), "proto.TagLookup")
+	proto.RegisterType((*TagInfo)(nil), "proto.TagInfo")
+	proto.RegisterType((*TagAutocompleteRequest)(nil), "proto.TagAutocompleteRequest")
+	proto.RegisterType((*TagCountsRequest)(nil), "proto.TagCountsRequest")
+	proto.RegisterType((*TagList)(nil), "proto.TagList")
+	proto.RegisterType((*TagUpdate)(nil), "proto.TagUpdate")
+	proto.RegisterType((*TagAliasChange)(nil), "proto.TagAliasChange")
+	proto.RegisterType((*TagImplicationChange)(nil), "proto.TagImplicationChange")
+	proto.RegisterType((*TagHistoryRequest)(nil), "proto.TagHistoryRequest")
+	proto.RegisterType((*TagEdit)(nil), "proto.TagEdit")
+	proto.RegisterType((*TagHistory)(nil), "proto.TagHistory")
+	proto.RegisterType((*FeedRequest)(nil), "proto.FeedRequest")
+	proto.RegisterType((*Feed)(nil), "proto.Feed")
+	proto.RegisterType((*RelatedVideosRequest)(nil), "proto.RelatedVideosRequest")
+	proto.RegisterType((*Notification)(nil), "proto.Notification")
+	proto.RegisterType((*ListNotificationsRequest)(nil), "proto.ListNotificationsRequest")
+	proto.RegisterType((*NotificationList)(nil), "proto.NotificationList")
+	proto.RegisterType((*NotificationsRead)(nil), "proto.NotificationsRead")
+	proto.RegisterType((*UnreadNotificationsRequest)(nil), "proto.UnreadNotificationsRequest")
+	proto.RegisterType((*UnreadNotificationCount)(nil), "proto.UnreadNotificationCount")
+	proto.RegisterType((*NotificationCreation)(nil), "proto.NotificationCreation")
+	proto.RegisterType((*VideoApproval)(nil), "proto.videoApproval")
+	proto.RegisterType((*VideoRejection)(nil), "proto.videoRejection")
+	proto.RegisterType((*PendingVideosRequest)(nil), "proto.PendingVideosRequest")
+	proto.RegisterType((*PendingVideoList)(nil), "proto.PendingVideoList")
+	proto.RegisterType((*PendingVideo)(nil), "proto.PendingVideo")
+	proto.RegisterType((*VideoQueryConfig)(nil), "proto.VideoQueryConfig")
+	proto.RegisterType((*VideoExistenceResponse)(nil), "proto.VideoExistenceResponse")
+	proto.RegisterType((*ForeignVideoCheck)(nil), "proto.ForeignVideoCheck")
+	proto.RegisterType((*VideoRequest)(nil), "proto.VideoRequest")
+	proto.RegisterType((*InputVideoChunk)(nil), "proto.InputVideoChunk")
+	proto.RegisterType((*ResponseVideoChunk)(nil), "proto.ResponseVideoChunk")
+	proto.RegisterType((*FileContent)(nil), "proto.FileContent")
+	proto.RegisterType((*RawMetadata)(nil), "proto.RawMetadata")
+	proto.RegisterType((*InputFileMetadata)(nil), "proto.InputFileMetadata")
+	proto.RegisterType((*ResponseFileMetadata)(nil), "proto.ResponseFileMetadata")
+	proto.RegisterType((*UploadResponse)(nil), "proto.uploadResponse")
+}
+
+func init() { proto.RegisterFile("videoservice.proto", fileDescriptor_673ac1e0917b87c1) }
+
+var fileDescriptor_673ac1e0917b87c1 = []byte{
+	// 4239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0xcd, 0x73, 0x24, 0xc7,
+	0x52, 0xf8, 0xf4, 0xcc, 0x68, 0x3e, 0x72, 0x3e, 0xd4, 0x2a, 0x7d, 0xec, 0x78, 0x6c, 0xaf, 0xf7,
+	0xd5, 0xcf, 0x3f, 0x10, 0xb2, 0xbd, 0x7e, 0x96, 0xdf, 0xb3, 0x63, 0xbd, 0xcf, 0x60, 0xad, 0x64,
+	0xed, 0x6a, 0xdf, 0x6a, 0x2d, 0x7a, 0xa5, 0x7d, 0x40, 0x40, 0xe8, 0x95, 0xa6, 0x4b, 0xa3, 0x46,
+	0x3d, 0xdd, 0x43, 0x77, 0x8f, 0xb4, 0x3a, 0x11, 0x40, 0x10, 0x5c, 0xf8, 0x07, 0x20, 0xe0, 0xcc,
+	0x85, 0x03, 0xc1, 0x95, 0x0b, 0xc1, 0xbb, 0x73, 0xe1, 0xc8, 0x19, 0x2e, 0x44, 0x10, 0xc1, 0x5f,
+	0x00, 0x51, 0x9f, 0x5d, 0xfd, 0x31, 0xd2, 0x4a, 0x7e, 0xef, 0xa0, 0x50, 0x57, 0x56, 0x56, 0x56,
+	0x56, 0x56, 0x56, 0x66, 0x56, 0x66, 0x0d, 0xa0, 0x0b, 0xcf, 0xa5, 0x61, 0x4c, 0xa3, 0x0b, 0x6f,
+	0x44, 0x1f, 0x4e, 0xa3, 0x30, 0x09, 0xd1, 0x02, 0xff, 0x87, 0xdb, 0xd0, 0x7c, 0x19, 0x26, 0x67,
+	0x5e, 0x30, 0xc6, 0xfb, 0xd0, 0x7b, 0xcd, 0xf0, 0x0e, 0x23, 0x12, 0xc4, 0xa7, 0x34, 0x42, 0x03,
+	0x68, 0x8e, 0x7c, 0xe2, 0x4d, 0xf6, 0x76, 0x06, 0xb5, 0x07, 0xd6, 0x7a, 0xcd, 0x51, 0x4d, 0xb4,
+	0x06, 0x8d, 0x59, 0x4c, 0xa3, 0xbd, 0x9d, 0x41, 0x9d, 0x77, 0xc8, 0xd6, 0xf3, 0x7a, 0xcb, 0xb2,
+	0xab, 0xcf, 0xeb, 0xad, 0xaa, 0x5d, 0xc3, 0x5f, 0xc3, 0x72, 0x86, 0x9c, 0x43, 0xe3, 0x99, 0x9f,
+	0xa0, 0x5f, 0x83, 0x7e, 0x30, 0x9b, 0x9c, 0xd0, 0xe8, 0xbb, 0x53, 0xde, 0x1d, 0x0f, 0x2c, 0x4e,
+	0x22, 0x07, 0xc5, 0x7f, 0x66, 0x41, 0x97, 0xb3, 0xbd, 0x1d, 0x4e, 0x26, 0x34, 0x48, 0xd0, 0x3d,
+	0x68, 0xb2, 0x59, 0x8e, 0x3d, 0x77, 0x60, 0x19, 0x93, 0xba, 0xe8, 0x1d, 0x68, 0x71, 0x44, 0xd6,
+	0x53, 0x15, 0x7c, 0xf2, 0xf6, 0x9e, 0xcb, 0x57, 0x20, 0x86, 0xf3, 0x15, 0xb4, 0x1d, 0xd5, 0x44,
+	0xff, 0x1f, 0xfa, 0x53, 0x12, 0xd1, 0x20, 0x39, 0x56, 0x08, 0x62, 0x25, 0x3d, 0x01, 0x95, 0x93,
+	0xe2, 0x7f, 0xb5, 0xa0, 0x2f, 0x11, 0x1c, 0xfa, 0x47, 0x33, 0x1a, 0x27, 0x8c, 0xa6, 0x20, 0xbf,
+	0x23, 0xf9, 0x50, 0x4d, 0x74, 0x1f, 0x60, 0x34, 0x8b, 0xa2, 0x23, 0x21, 0x19, 0xc1, 0x8a, 0x01,
+	0x41, 0x1b, 0xd0, 0x88, 0xc3, 0x28, 0x79, 0x72, 0xc5, 0x99, 0xe9, 0x6f, 0x22, 0xb1, 0x15, 0x0f,
+	0xe5, 0x04, 0xaf, 0xc2, 0x28, 0x71, 0x24, 0x06, 0x1a, 0x42, 0x4b, 0x70, 0xa2, 0x65, 0xac, 0xdb,
+	0x6c, 0x9e, 0x29, 0x19, 0xd3, 0x97, 0x5c, 0x60, 0x83, 0x05, 0x31, 0x4f, 0x0a, 0x61, 0x63, 0x49,
+	0x34, 0x3a, 0xf3, 0x2e, 0xa8, 0x3b, 0x68, 0x3c, 0xb0, 0xd6, 0x5b, 0x8e, 0x6e, 0x63, 0x02, 0x3d,
+	0x39, 0xdd, 0xd1, 0xf4, 0x22, 0x4c, 0xe8, 0x7c, 0xb1, 0xbe, 0x0f, 0x20, 0x31, 0x53, 0xc1, 0xb6,
+	0x25, 0x64, 0xcf, 0x45, 0x08, 0xea, 0x6c, 0x3c, 0x67, 0x6e, 0xc1, 0xe1, 0xdf, 0xcf, 0xeb, 0xad,
+	0x9a, 0x5d, 0xc7, 0xc7, 0xd0, 0x91, 0x68, 0xdf, 0xba, 0x5e, 0x72, 0xe7, 0x09, 0xe6, 0xee, 0x1d,
+	0xde, 0x83, 0x45, 0xf9, 0xb9, 0x43, 0x7d, 0x9a, 0x78, 0x61, 0x70, 0xd7, 0x49, 0xf0, 0x7f, 0x58,
+	0xb0, 0x2c, 0xf7, 0xfa, 0x85, 0x17, 0x27, 0x0e, 0x8d, 0xa7, 0x61, 0x10, 0x53, 0xb4, 0x01, 0x2d,
+	0x89, 0xc4, 0xf4, 0xb3, 0xb6, 0xde, 0xd9, 0xec, 0xcb, 0xcd, 0x92, 0xd8, 0x8e, 0xee, 0x47, 0x1b,
+	0x60, 0x2b, 0xdd, 0xdd, 0x56, 0x63, 0xc4, 0x44, 0x05, 0x38, 0xfa, 0x0a, 0x6c, 0xb5, 0x15, 0x1a,
+	0xb7, 0x56, 0x4a, 0xbf, 0x80, 0x87, 0xbe, 0x82, 0x81, 0xa2, 0xb7, 0x95, 0xa7, 0x21, 0x54, 0x64,
+	0x6e, 0x3f, 0xfe, 0xdf, 0x1a, 0x34, 0x65, 0x23, 0x27, 0x12, 0x2b, 0x2f, 0xf7, 0xff, 0x07, 0xbd,
+	0x51, 0x44, 0x09, 0x13, 0xeb, 0xb1, 0x4b, 0x12, 0xca, 0xd7, 0xd2, 0x76, 0xba, 0x0a, 0xb8, 0x43,
+	0x12, 0x2a, 0x36, 0x27, 0x48, 0x32, 0x9b, 0xc3, 0x9b, 0xe8, 0xd7, 0x61, 0x91, 0xcc, 0x92, 0xb3,
+	0x30, 0x3a, 0x66, 0x3b, 0x10, 0x90, 0x89, 0x50, 0x91, 0xb6, 0xd3, 0x17, 0xe0, 0x23, 0x09, 0x45,
+	0x5f, 0xc2, 0x40, 0x22, 0x4e, 0xa3, 0xf0, 0xd4, 0xf3, 0xe9, 0xb1, 0x37, 0x21, 0x63, 0x7a, 0x3c,
+	0x8b, 0x7c, 0xae, 0xd3, 0x6d, 0x67, 0x55, 0xf4, 0x1f, 0x88, 0xee, 0x3d, 0xd6, 0x7b, 0x14, 0xf9,
+	0x8c, 0x7f, 0xa6, 0x6d, 0xc7, 0xf1, 0x28, 0x8c, 0x28, 0x57, 0xf0, 0x9a, 0xd3, 0x66, 0x90, 0x57,
+	0x0c, 0xc0, 0xe8, 0xb2, 0x33, 0xc7, 0x96, 0xc7, 0x55, 0xe2, 0x8c, 0xc4, 0xc7, 0x33, 0xae, 0xeb,
+	0xee, 0xa0, 0xc9, 0x4f, 0xc3, 0xaa, 0xec, 0x67, 0xac, 0x3c, 0x23, 0xb1, 0x38, 0x08, 0x2e, 0x7a,
+	0x17, 0xda, 0x92, 0x21, 0xcf, 0x1d, 0xb4, 0xc4, 0x99, 0x13, 0x80, 0x3d, 0xde, 0x29, 0xed, 0x85,
+	0xe7, 0x0e, 0xda, 0x99, 0x03, 0xe9, 0xa2, 0x0d, 0x58, 0xca, 0x4c, 0xc9, 0x0f, 0x06, 0xf0, 0x83,
+	0xb1, 0x68, 0xcc, 0xf5, 0x3a, 0x14, 0x92, 0x73, 0x99, 0xd6, 0x52, 0x77, 0xd0, 0xe1, 0xdc, 0xa8,
+	0x26, 0xfa, 0x00, 0x3a, 0xd4, 0xf5, 0x12, 0xea, 0x0a, 0xb1, 0x77, 0xb9, 0x0c, 0x40, 0x80, 0xb8,
+	0xd0, 0x3f, 0x80, 0x4e, 0x44, 0xa7, 0xfe, 0xd5, 0xf1, 0x28, 0x9c, 0x05, 0xc9, 0xa0, 0x27, 0x0e,
+	0x3e, 0x07, 0x6d, 0x33, 0x48, 0xe6, 0xe0, 0xf7, 0x73, 0x07, 0xff, 0xdf, 0x6b, 0xd0, 0xe3, 0x86,
+	0x6a, 0x9f, 0x26, 0xc4, 0x25, 0x09, 0x61, 0xd8, 0x1c, 0xf0, 0x22, 0x1c, 0x71, 0x2d, 0x68, 0x3b,
+	0xba, 0xcd, 0x4c, 0x0c, 0xff, 0x3e, 0xf4, 0x12, 0x5f, 0x69, 0x80, 0x01, 0x61, 0x0e, 0x20, 0x22,
+	0x89, 0x17, 0x8c, 0xf9, 0xf6, 0x5b, 0x8e, 0x6c, 0xb1, 0x71, 0x42, 0x64, 0x2f, 0xd3, 0x8d, 0x37,
+	0x20, 0x68, 0x05, 0x16, 0x2e, 0x3c, 0x7a, 0x19, 0xf3, 0x1d, 0xae, 0x3b, 0xa2, 0x61, 0x9a, 0xd4,
+	0x46, 0xc1, 0xa4, 0xce, 0xa6, 0x7e, 0x48, 0xb8, 0x00, 0xf8, 0xf6, 0xb5, 0x1d, 0x03, 0x82, 0x1e,
+	0x40, 0xc7, 0xa5, 0xf1, 0x28, 0xf2, 0xa6, 0x4c, 0x35, 0xf9, 0xae, 0xb5, 0x1d, 0x13, 0xc4, 0x65,
+	0x22, 0x36, 0x71, 0x47, 0xed, 0x9b, 0x6a, 0x33, 0x1b, 0x96, 0x90, 0x71, 0x3c, 0x80, 0x07, 0xb5,
+	0xf5, 0xb6, 0xc3, 0xbf, 0xd1, 0x37, 0xb0, 0x44, 0xfc, 0x38, 0xdc, 0xba, 0x20, 0x9e, 0x4f, 0x4e,
+	0x7c, 0xba, 0x1b, 0x85, 0x93, 0x41, 0x87, 0x1f, 0x51, 0x65, 0xaf, 0xb9, 0x87, 0xda, 0xf7, 0xa2,
+	0x28, 0x8c, 0x9c, 0x22, 0x32, 0xe3, 0x49, 0x48, 0x83, 0x6f, 0x0a, 0xdf, 0xc7, 0x9a, 0x63, 0x82,
+	0xd0, 0x3a, 0x2c, 0x8a, 0xe6, 0x33, 0x2f, 0x4e, 0xc2, 0x71, 0x44, 0x26, 0x83, 0xde, 0x83, 0xda,
+	0x7a, 0xcd, 0xc9, 0x83, 0x99, 0xb7, 0x3c, 0x21, 0x57, 0x34, 0xf6, 0x48, 0xe0, 0x08, 0x79, 0xf7,
+	0xb9, 0xbc, 0x73, 0x50, 0xfc, 0xc7, 0xd0, 0x31, 0xb8, 0xba, 0xc6, 0x47, 0x6d, 0x42, 0x37, 0x8c,
+	0xbc, 0xb1, 0x17, 0x10, 0xff, 0x95, 0x27, 0x0f, 0x77, 0x5f, 0x1b, 0x9f, 0x4b, 0x7a, 0x12, 0x7b,
+	0x09, 0x75, 0x32, 0x38, 0x08, 0xa7, 0x63, 0x5e, 0x78, 0xc1, 0xb9, 0x3c, 0xf1, 0x19, 0x18, 0xfe,
+	0x5d, 0x68, 0x73, 0x06, 0x98, 0x15, 0x45, 0x1f, 0x42, 0xe3, 0x42, 0xf9, 0x76, 0x26, 0xb8, 0xae,
+	0x29, 0x38, 0x47, 0xf6, 0x95, 0x44, 0x02, 0xd5, 0xd2, 0x48, 0xe0, 0xdf, 0x2c, 0x58, 0xe0, 0x9f,
+	0x39, 0xad, 0xb4, 0x0a, 0x5a, 0xa9, 0xb5, 0xab, 0x6a, 0x6a, 0xd7, 0x3c, 0x5d, 0xc5, 0xd0, 0x4d,
+	0xce, 0x66, 0x93, 0x93, 0x80, 0x78, 0x3e, 0x3b, 0x03, 0x42, 0x5b, 0x33, 0x30, 0x53, 0x90, 0x0b,
+	0x05, 0xcd, 0x34, 0x34, 0xbd, 0x51, 0xd0, 0xf4, 0x1b, 0x34, 0x17, 0xff, 0x0c, 0x3a, 0x9c, 0x94,
+	0xd8, 0x40, 0x23, 0xa2, 0x32, 0xfd, 0xd7, 0x8e, 0xc9, 0x40, 0x35, 0xcb, 0x40, 0x76, 0x59, 0x55,
+	0xb5, 0x2c, 0xbc, 0x03, 0xb6, 0xa0, 0xe9, 0xd0, 0x24, 0x22, 0x23, 0x7e, 0x08, 0x6e, 0x4d, 0x1d,
+	0xff, 0xbe, 0x8c, 0xbe, 0x5e, 0x7b, 0xf4, 0x92, 0xf1, 0x37, 0x5f, 0xa3, 0x52, 0xda, 0xd5, 0x0c,
+	0xed, 0x21, 0xb4, 0x46, 0xbe, 0xc7, 0x0c, 0xe4, 0x81, 0xd4, 0x18, 0xdd, 0xc6, 0x3f, 0x85, 0xd5,
+	0xd7, 0x8a, 0xfa, 0xab, 0x84, 0x24, 0xf1, 0xcd, 0xc1, 0xd5, 0x00, 0x9a, 0xc1, 0x6c, 0xb2, 0x43,
+	0xae, 0x94, 0x9a, 0xa8, 0x26, 0x7e, 0x0c, 0xfd, 0x2c, 0x31, 0xf4, 0x1b, 0x50, 0x77, 0xc9, 0x95,
+	0xd2, 0xbe, 0x55, 0xa9, 0x7d, 0x3b, 0xc4, 0xf3, 0xaf, 0x18, 0x12, 0x3f, 0x84, 0x0e, 0x47, 0xc1,
+	0x5f, 0x41, 0x3f, 0x0b, 0x67, 0x46, 0x81, 0xdb, 0x5f, 0xa1, 0x5e, 0xfc, 0xbb, 0x5c, 0xb1, 0xf0,
+	0x6b, 0x36, 0x36, 0x98, 0x90, 0xf3, 0xd9, 0xcd, 0xec, 0xaf, 0x41, 0xe3, 0x34, 0x0a, 0x27, 0xfb,
+	0x8a, 0x7b, 0xd9, 0x62, 0xb3, 0x25, 0xe1, 0x7e, 0x2c, 0x03, 0x6c, 0xfe, 0x8d, 0xbf, 0x84, 0x8e,
+	0xa4, 0xcb, 0x4f, 0xd3, 0x3a, 0x34, 0x5d, 0xd1, 0xcc, 0x85, 0x22, 0x6a, 0x72, 0xd5, 0x8d, 0xff,
+	0xcb, 0x82, 0xa6, 0x04, 0xa2, 0xf7, 0xa0, 0x2d, 0xc1, 0x9a, 0x99, 0x14, 0xc0, 0x43, 0xc8, 0x30,
+	0xf6, 0x98, 0x72, 0x68, 0x96, 0x0c, 0xc8, 0x35, 0xfe, 0x7d, 0x13, 0x5a, 0x0a, 0x8f, 0x9f, 0x98,
+	0xfe, 0xe6, 0x9a, 0x64, 0x47, 0x52, 0x3f, 0x90, 0xbd, 0x8e, 0xc6, 0x63, 0xe2, 0x1b, 0x85, 0x7e,
+	0x28, 0x62, 0xd5, 0x05, 0x47, 0x34, 0x32, 0x96, 0xb9, 0x91, 0xb3, 0xcc, 0x18, 0x32, 0xf1, 0x86,
+	0x3c, 0x3f, 0x19, 0x18, 0xfe, 0x85, 0xa5, 0xe5, 0x74, 0x10, 0xc6, 0xc9, 0x1d, 0x8e, 0x50, 0x56,
+	0x0a, 0xb5, 0xeb, 0xa4, 0x50, 0x9f, 0x2f, 0x85, 0x85, 0xdb, 0x4a, 0xa1, 0x61, 0x48, 0x01, 0xff,
+	0x8b, 0x05, 0xbd, 0x6d, 0x41, 0xd5, 0xa1, 0xd3, 0x30, 0x9a, 0xbf, 0x8e, 0x1f, 0xb1, 0xb8, 0x9a,
+	0x23, 0x1e, 0x5e, 0x4d, 0x95, 0xe5, 0x4e, 0xef, 0x10, 0xba, 0xc7, 0x31, 0xd1, 0x98, 0x1e, 0xc8,
+	0xa6, 0xbe, 0xc6, 0xa5, 0x00, 0xf4, 0x11, 0x34, 0x22, 0x4a, 0x62, 0xbd, 0x97, 0xcb, 0x92, 0x5c,
+	0xc4, 0x59, 0x71, 0x78, 0x97, 0x23, 0x51, 0x44, 0xe8, 0x92, 0x10, 0xcf, 0x8f, 0x65, 0x80, 0xa6,
+	0x9a, 0xf8, 0x05, 0x20, 0x11, 0x3e, 0xb3, 0x51, 0xfa, 0x30, 0xcf, 0x5b, 0x48, 0xf6, 0xfe, 0x52,
+	0xcd, 0xdf, 0x5f, 0xf0, 0xcf, 0x01, 0x04, 0x25, 0xae, 0xfe, 0x1f, 0xc3, 0x82, 0x97, 0xd0, 0x89,
+	0x3a, 0xcd, 0x4a, 0xce, 0x02, 0x83, 0x85, 0xb8, 0x42, 0x76, 0x02, 0x09, 0x7d, 0x08, 0x3d, 0xe5,
+	0x3e, 0xf6, 0xf8, 0x28, 0x41, 0x3e, 0x0b, 0xc4, 0xff, 0x59, 0x85, 0xc5, 0x1c, 0x81, 0xbc, 0x78,
+	0xad, 0x3b, 0x88, 0xb7, 0x9a, 0x17, 0xaf, 0xa1, 0x7a, 0xb5, 0x82, 0x39, 0x9b, 0x46, 0x94, 0xd9,
+	0x11, 0xa5, 0x5a, 0xb2, 0x99, 0x39, 0x16, 0x0b, 0xb9, 0x63, 0x71, 0x93, 0xd3, 0x79, 0xc0, 0x23,
+	0xc4, 0x30, 0x4a, 0x44, 0xe8, 0xd1, 0x94, 0xa1, 0x47, 0x0a, 0x62, 0xf2, 0x39, 0xf5, 0x22, 0xb5,
+	0x55, 0xd4, 0x95, 0x21, 0x53, 0x16, 0x88, 0x3e, 0x81, 0xa6, 0xd8, 0xf3, 0x78, 0xd0, 0x7e, 0x50,
+	0x9b, 0xa7, 0x17, 0x0a, 0xc7, 0x54, 0x0c, 0x11, 0x4a, 0x69, 0xc5, 0xf8, 0x85, 0x05, 0xb6, 0x23,
+	0xc7, 0xc4, 0xa1, 0x3f, 0xbb, 0xd6, 0x1b, 0xfd, 0x2a, 0x14, 0xfc, 0x53, 0x68, 0x08, 0x1f, 0x28,
+	0x15, 0xfc, 0x9e, 0x24, 0x37, 0x09, 0x5d, 0x1a, 0x71, 0x5b, 0xb2, 0xc5, 0xbb, 0x1d, 0x89, 0xc6,
+	0x0c, 0x72, 0x10, 0x26, 0x54, 0x6a, 0x38, 0xff, 0xc6, 0x7f, 0x57, 0x83, 0xd6, 0x81, 0x4f, 0xae,
+	0x7c, 0xa6, 0x8f, 0x4c, 0x7b, 0xe5, 0xb7, 0x5e, 0x81, 0x01, 0xb9, 0xce, 0x1f, 0xb2, 0x2f, 0xbe,
+	0x73, 0xd2, 0x1f, 0xaa, 0x36, 0x33, 0x0d, 0x09, 0x8f, 0x69, 0x84, 0x2e, 0x88, 0x46, 0x3e, 0xb8,
+	0x5d, 0x28, 0x06, 0xb7, 0x8f, 0x58, 0x40, 0x14, 0x7b, 0x27, 0x9e, 0xef, 0x25, 0x57, 0x5c, 0x1f,
+	0xfa, 0x9b, 0xef, 0xc8, 0x15, 0x2a, 0x96, 0x5e, 0x6b, 0x04, 0xc7, 0x40, 0x2e, 0x89, 0xbe, 0x9a,
+	0x65, 0xd1, 0x57, 0xc1, 0x12, 0xb7, 0x4a, 0x6e, 0x83, 0x0f, 0xa0, 0xe3, 0x93, 0x38, 0x39, 0x9a,
+	0x32, 0x67, 0x29, 0xae, 0x47, 0x6d, 0xc7, 0x04, 0xb1, 0xc5, 0x4f, 0x78, 0x68, 0x4a, 0x5d, 0x7e,
+	0x31, 0x6a, 0x39, 0xba, 0x8d, 0x1e, 0x02, 0x88, 0x6f, 0x1e, 0x90, 0x76, 0x4a, 0x03, 0x52, 0x03,
+	0x23, 0xa5, 0xb5, 0xb7, 0x23, 0x2f, 0x49, 0xba, 0x8d, 0xff, 0xd6, 0x02, 0x5b, 0xed, 0xd4, 0xb6,
+	0x64, 0x71, 0xae, 0xbe, 0x69, 0xa9, 0x57, 0xaf, 0x91, 0x7a, 0xed, 0x26, 0xa9, 0xd7, 0x6f, 0x21,
+	0x75, 0xfc, 0x4f, 0x16, 0xf4, 0x15, 0x7f, 0x42, 0x36, 0xd7, 0x5a, 0xc9, 0x54, 0xcf, 0xaa, 0x05,
+	0x3d, 0xd3, 0xdc, 0xd7, 0xae, 0xe1, 0xbe, 0x7e, 0x13, 0xf7, 0x0b, 0xb7, 0xe1, 0xfe, 0x79, 0x2a,
+	0x5c, 0x9d, 0x79, 0xb9, 0x23, 0xfb, 0x78, 0x0c, 0xcb, 0x07, 0x7a, 0x36, 0x96, 0xe6, 0x3b, 0x23,
+	0xc1, 0xf8, 0xee, 0xd2, 0x98, 0x6b, 0x69, 0xf1, 0x9f, 0x58, 0xb0, 0x94, 0x99, 0x69, 0x3f, 0xbc,
+	0xf8, 0x15, 0xcc, 0xc3, 0x33, 0x76, 0x66, 0x60, 0x54, 0x4b, 0x5d, 0x3f, 0xf6, 0x01, 0x3d, 0xa5,
+	0x89, 0xe2, 0x42, 0xf9, 0xc7, 0xbb, 0x5a, 0x92, 0xac, 0xff, 0xac, 0x15, 0xfc, 0x27, 0x81, 0xae,
+	0x9a, 0xea, 0x80, 0x8c, 0x29, 0xfa, 0x08, 0x5a, 0x8a, 0x2a, 0x9f, 0xa5, 0xb3, 0xb9, 0x28, 0xf7,
+	0x5b, 0x73, 0xa4, 0x11, 0x8c, 0xbb, 0x5b, 0x75, 0xfe, 0xdd, 0x0d, 0x3f, 0x83, 0x15, 0x96, 0xd1,
+	0x50, 0xe3, 0xcd, 0xf8, 0x3d, 0xbc, 0x0c, 0x0c, 0xb9, 0xaa, 0xe6, 0xbc, 0xc5, 0xe0, 0xaf, 0x53,
+	0x66, 0xb9, 0xbb, 0xff, 0x04, 0xda, 0x8a, 0x17, 0xe5, 0xf2, 0x0b, 0xdc, 0xa6, 0x18, 0xf8, 0x1f,
+	0x2d, 0x58, 0xd9, 0x97, 0x96, 0x44, 0xf5, 0xbf, 0xba, 0x0a, 0x46, 0x73, 0x37, 0x18, 0x43, 0x3d,
+	0x9e, 0x7f, 0xf1, 0xe5, 0x7d, 0xb9, 0x8d, 0x11, 0xe7, 0xab, 0xf4, 0xe8, 0x65, 0xcc, 0xf5, 0x3a,
+	0x2c, 0x9e, 0x86, 0x11, 0xf5, 0xc6, 0xc1, 0x6b, 0xa1, 0x12, 0x2c, 0x4c, 0x62, 0xde, 0x30, 0x0f,
+	0xc6, 0x3f, 0x86, 0xf6, 0x21, 0x19, 0xef, 0x86, 0xbe, 0x1f, 0x5e, 0xce, 0x65, 0xd4, 0x86, 0x5a,
+	0x42, 0xc6, 0xd2, 0x36, 0xb1, 0x4f, 0xfc, 0x09, 0x2c, 0x8b, 0x31, 0xd4, 0x3d, 0x24, 0xe3, 0x9b,
+	0xc2, 0x2c, 0x8c, 0xa1, 0x6b, 0xa2, 0xeb, 0x6c, 0x87, 0x95, 0x66, 0x3b, 0xf0, 0xfb, 0x9c, 0x93,
+	0x17, 0x61, 0x78, 0x3e, 0x9b, 0xaa, 0x19, 0xad, 0x74, 0xc6, 0x3f, 0xaf, 0x42, 0xf3, 0x90, 0x8c,
+	0xf7, 0x82, 0xd3, 0x90, 0x0d, 0xe7, 0xd9, 0x3c, 0x4b, 0x3a, 0x46, 0xe6, 0xb7, 0x1e, 0x42, 0x6b,
+	0x44, 0x12, 0x3a, 0x0e, 0xa3, 0xab, 0x9c, 0xbb, 0x3e, 0x24, 0xe3, 0x6d, 0xd9, 0xe3, 0x68, 0x9c,
+	0xb7, 0xb0, 0xad, 0x03, 0x68, 0x12, 0xdf, 0x23, 0x31, 0x65, 0x39, 0x4d, 0x1e, 0x4a, 0xc8, 0x26,
+	0xeb, 0xf1, 0x26, 0x53, 0xdf, 0xa3, 0x4a, 0xac, 0xaa, 0xc9, 0x22, 0x00, 0xf1, 0xe9, 0x3e, 0x61,
+	0x4e, 0x90, 0xf5, 0xa5, 0x00, 0x9d, 0x34, 0x30, 0x43, 0x22, 0x03, 0xc2, 0x1c, 0xdc, 0x84, 0x24,
+	0xa3, 0x33, 0xea, 0x6e, 0xb1, 0x99, 0x94, 0x83, 0x33, 0x61, 0x78, 0x17, 0xd6, 0x0e, 0xc9, 0x78,
+	0x6b, 0x96, 0x84, 0xa3, 0x70, 0x32, 0xf5, 0x69, 0x42, 0x0d, 0xe1, 0x4f, 0x23, 0x7a, 0xea, 0xbd,
+	0x91, 0x72, 0x91, 0x2d, 0xa6, 0x22, 0xbe, 0x37, 0xf1, 0x12, 0xa9, 0xed, 0xa2, 0x81, 0x7f, 0x02,
+	0x36, 0x13, 0x0c, 0x9b, 0x57, 0x6f, 0x5f, 0xc9, 0xb6, 0xcc, 0x19, 0xfd, 0x09, 0xdf, 0x0c, 0x7e,
+	0x4a, 0xb0, 0x31, 0x28, 0xbd, 0x10, 0xca, 0xad, 0x92, 0x7b, 0xfb, 0x17, 0x16, 0xdf, 0xdc, 0x1b,
+	0xdc, 0x4c, 0x41, 0xcd, 0x32, 0x9b, 0x5a, 0xbb, 0xfd, 0xa6, 0x16, 0x5d, 0x0e, 0x3e, 0x80, 0x3e,
+	0x13, 0x1f, 0x13, 0xe5, 0x0d, 0x66, 0xbe, 0xc8, 0xcd, 0x0a, 0x2c, 0x70, 0x0d, 0x50, 0x6e, 0x8e,
+	0x37, 0xf0, 0xcf, 0x61, 0x85, 0x2d, 0x96, 0x6d, 0xf2, 0x88, 0x3b, 0xf9, 0x5b, 0xd3, 0xbd, 0x0f,
+	0x20, 0x75, 0xe4, 0x90, 0x8c, 0xd5, 0x19, 0x4f, 0x21, 0xf8, 0x0f, 0x60, 0xe9, 0x90, 0x88, 0x4c,
+	0x5c, 0x74, 0x75, 0xd3, 0x8d, 0xa6, 0x94, 0xfc, 0xb5, 0x36, 0xfa, 0xaf, 0x2c, 0xbe, 0x99, 0xbc,
+	0x42, 0xd2, 0x87, 0xaa, 0x4e, 0xc4, 0x57, 0x3d, 0xb7, 0x84, 0x5a, 0x3a, 0x6f, 0x2d, 0x33, 0xef,
+	0x5a, 0x26, 0xba, 0x6d, 0xeb, 0x20, 0x76, 0xee, 0x4d, 0xad, 0x10, 0xce, 0x35, 0x4a, 0x2e, 0xd6,
+	0xbf, 0x03, 0x90, 0x2e, 0x1d, 0x7d, 0x08, 0x0b, 0xd4, 0xf5, 0x92, 0x12, 0x5d, 0x63, 0xcc, 0x3b,
+	0xa2, 0xd3, 0xbc, 0x77, 0x7d, 0xcb, 0xb1, 0x73, 0xf7, 0x2e, 0x0e, 0xc4, 0x5f, 0x43, 0x67, 0x97,
+	0x52, 0xf7, 0x26, 0x71, 0xae, 0x41, 0x63, 0x34, 0x8b, 0xe2, 0x30, 0x92, 0x32, 0x90, 0x2d, 0xfc,
+	0x02, 0xea, 0x6c, 0xf8, 0x5b, 0xe6, 0x17, 0xef, 0x03, 0x04, 0xf4, 0x4d, 0xb2, 0x6d, 0x52, 0x32,
+	0x20, 0x78, 0x17, 0x56, 0x1c, 0xea, 0xb3, 0xf0, 0x94, 0x8f, 0x7b, 0x8b, 0x1c, 0x54, 0xf9, 0xb1,
+	0xfc, 0x67, 0x0b, 0xba, 0x2f, 0xc3, 0xc4, 0x3b, 0x95, 0xaa, 0x58, 0xd8, 0x4f, 0x04, 0xf5, 0x73,
+	0x2f, 0x70, 0x25, 0x0b, 0xfc, 0x9b, 0x4d, 0x32, 0xa1, 0x71, 0x4c, 0xc6, 0x2a, 0x7e, 0x53, 0x4d,
+	0x73, 0xfa, 0x7a, 0x76, 0xfa, 0xf7, 0x40, 0x97, 0x69, 0xd4, 0xd5, 0x30, 0x05, 0xbc, 0xcd, 0xce,
+	0x32, 0xc1, 0x7a, 0xb1, 0x43, 0x89, 0xaa, 0x84, 0xc8, 0x16, 0xfe, 0x4b, 0x0b, 0x06, 0xcc, 0xae,
+	0x98, 0xcb, 0xf8, 0xbe, 0xd7, 0x78, 0x6e, 0xca, 0x4f, 0x13, 0x43, 0x6b, 0x55, 0x93, 0x8d, 0x9c,
+	0x05, 0x11, 0x25, 0xee, 0x77, 0x81, 0x2f, 0x02, 0xe8, 0x96, 0x63, 0x40, 0x70, 0x0c, 0xb6, 0xc9,
+	0x09, 0xb7, 0x78, 0x8f, 0xa0, 0x17, 0x98, 0xdc, 0xc9, 0xad, 0x57, 0x17, 0x53, 0x13, 0xdf, 0xc9,
+	0x62, 0xbe, 0x65, 0x4e, 0xe0, 0x08, 0x96, 0x72, 0xcb, 0x27, 0xee, 0xdc, 0xb5, 0xaf, 0xc3, 0xa2,
+	0x39, 0xc7, 0xde, 0x8e, 0x08, 0x97, 0x6a, 0x4e, 0x1e, 0x8c, 0x7f, 0x04, 0xc3, 0x23, 0xbe, 0xb2,
+	0xdb, 0xc8, 0x16, 0x7f, 0x0a, 0xf7, 0x8a, 0xa3, 0x84, 0xbf, 0xe2, 0x69, 0x24, 0xe6, 0xca, 0xc4,
+	0x08, 0xd1, 0xc0, 0x6f, 0x60, 0x25, 0x83, 0xaa, 0xee, 0x3e, 0x03, 0x51, 0x18, 0xdd, 0xdb, 0x11,
+	0x02, 0xab, 0x39, 0xaa, 0xf9, 0xcb, 0xd2, 0x4a, 0xbc, 0x25, 0xeb, 0x4a, 0x5b, 0xd3, 0x69, 0x14,
+	0x5e, 0x10, 0xff, 0x0e, 0xc9, 0xe6, 0xdf, 0x83, 0x3e, 0xff, 0x74, 0xe8, 0x1f, 0xd2, 0x3b, 0x26,
+	0xac, 0xd9, 0x08, 0x99, 0xc9, 0x12, 0x9c, 0xcb, 0x16, 0x7e, 0x09, 0x2b, 0x07, 0x34, 0x70, 0xbd,
+	0x60, 0x9c, 0x3d, 0xe5, 0x77, 0x4d, 0x4e, 0x8d, 0xc1, 0x36, 0xe9, 0x71, 0xdd, 0xfc, 0x28, 0x67,
+	0x8f, 0x94, 0x52, 0x9a, 0x88, 0xb7, 0x2e, 0x7b, 0xfc, 0x43, 0x15, 0xba, 0x26, 0x81, 0xeb, 0xed,
+	0x52, 0xc9, 0x45, 0xd6, 0x4c, 0x24, 0xd5, 0xae, 0x4d, 0x24, 0xd5, 0x6f, 0xa8, 0x5e, 0x2c, 0x14,
+	0xea, 0x6e, 0xef, 0x41, 0x9b, 0xc8, 0xfd, 0x8e, 0x55, 0x09, 0x56, 0x03, 0xd8, 0xe8, 0x48, 0x6d,
+	0xa5, 0xca, 0x2b, 0x18, 0x10, 0x56, 0x31, 0xd7, 0x2d, 0x47, 0xe6, 0x99, 0x5a, 0x3c, 0xfc, 0x29,
+	0xc0, 0x19, 0x6e, 0xae, 0x84, 0x2a, 0x12, 0x0c, 0x2d, 0xa7, 0x00, 0xc7, 0x7f, 0x53, 0x05, 0x9b,
+	0xcb, 0xea, 0xb7, 0x67, 0x34, 0xba, 0xda, 0x0e, 0x83, 0x53, 0x8f, 0x85, 0x33, 0xcd, 0x30, 0x72,
+	0x69, 0xf4, 0xe4, 0x4a, 0xe6, 0xf4, 0x56, 0xe4, 0xee, 0x70, 0xa8, 0x8e, 0x67, 0x14, 0x12, 0xda,
+	0x84, 0xb6, 0xeb, 0x45, 0x82, 0x89, 0x41, 0x35, 0x33, 0x22, 0x0e, 0xa3, 0x64, 0x47, 0xf5, 0x39,
+	0x29, 0xda, 0x4d, 0xde, 0x9e, 0x85, 0x48, 0x2c, 0x25, 0x45, 0xbc, 0x20, 0x66, 0xd1, 0x86, 0x0c,
+	0x91, 0x0c, 0x10, 0xa3, 0xc0, 0x2a, 0x02, 0xf2, 0xed, 0x88, 0x7c, 0xd3, 0x91, 0x42, 0x98, 0xd6,
+	0xc4, 0x67, 0xe1, 0xe5, 0x51, 0x20, 0xa4, 0xac, 0x5f, 0x76, 0xe4, 0xa0, 0xa2, 0xa8, 0x4b, 0x2f,
+	0x39, 0x15, 0x21, 0x78, 0xdd, 0xc6, 0x3f, 0x84, 0x35, 0x2e, 0x9d, 0x6f, 0xdf, 0x78, 0x71, 0x42,
+	0x83, 0x11, 0xd5, 0xcf, 0x1d, 0xd6, 0xa0, 0xc1, 0x81, 0xe2, 0x31, 0x4e, 0xcb, 0x91, 0x2d, 0x1c,
+	0xc3, 0xd2, 0xae, 0x71, 0x77, 0xd9, 0x3e, 0xa3, 0xa3, 0x73, 0xc6, 0xca, 0x6e, 0xe6, 0x42, 0x23,
+	0x43, 0xdf, 0x1c, 0x14, 0x7d, 0xa1, 0xf1, 0x7e, 0x26, 0x6e, 0x57, 0x73, 0xee, 0x5c, 0x39, 0x2c,
+	0xbc, 0x0e, 0x5d, 0x71, 0x62, 0xca, 0xfd, 0x71, 0x3b, 0xb5, 0x1b, 0x7f, 0x6f, 0xc1, 0xe2, 0x5e,
+	0x30, 0x9d, 0xa9, 0x0c, 0xc2, 0x2c, 0x38, 0x67, 0xdb, 0xad, 0x72, 0xf6, 0xe2, 0xae, 0xab, 0x82,
+	0xd7, 0x5d, 0xcf, 0xa7, 0x32, 0xd7, 0xfb, 0xac, 0x92, 0x66, 0xf2, 0x1f, 0x42, 0x7d, 0x42, 0x13,
+	0xc2, 0x79, 0xeb, 0x6c, 0x0e, 0x24, 0x32, 0xa7, 0xca, 0x46, 0xa8, 0x6a, 0xf9, 0xb3, 0x8a, 0xc3,
+	0xf1, 0x18, 0xfd, 0x88, 0x5c, 0xf2, 0x21, 0xb5, 0x0c, 0x7d, 0x87, 0x5c, 0x1a, 0xc8, 0x0a, 0xe9,
+	0x49, 0x1b, 0x9a, 0x07, 0xe4, 0x8a, 0x1d, 0x1c, 0xfc, 0xa7, 0x16, 0x20, 0x25, 0xf2, 0xef, 0xc1,
+	0xf1, 0x67, 0x19, 0x8e, 0xdf, 0x55, 0xd3, 0x4b, 0xc2, 0x65, 0x4c, 0x9b, 0x4c, 0xfc, 0x00, 0x3a,
+	0x06, 0x5d, 0xe6, 0x05, 0x76, 0x48, 0x42, 0xf8, 0xcc, 0x5d, 0x87, 0x7f, 0x33, 0x14, 0x63, 0x31,
+	0xa5, 0x28, 0xff, 0x53, 0x85, 0xa5, 0x82, 0x8c, 0x52, 0x3b, 0x64, 0x5d, 0x93, 0x92, 0xaa, 0x16,
+	0x2f, 0x7d, 0xef, 0xa9, 0x97, 0x17, 0x47, 0xfa, 0xba, 0x9d, 0x02, 0xd0, 0xc7, 0xb0, 0xa4, 0x4a,
+	0xcd, 0xd2, 0xe4, 0x06, 0xe7, 0xf2, 0x08, 0x15, 0x3b, 0x98, 0x76, 0x66, 0x1f, 0x9a, 0x48, 0xeb,
+	0x95, 0x83, 0x16, 0x0a, 0xe1, 0x8d, 0xb7, 0x28, 0x84, 0xdf, 0x07, 0x50, 0x6d, 0x79, 0xbc, 0xda,
+	0x8e, 0x01, 0x61, 0xb6, 0xca, 0x0d, 0x27, 0x34, 0x4e, 0xbc, 0xd1, 0x96, 0xb2, 0xbc, 0xe2, 0x21,
+	0x49, 0x01, 0xce, 0xa4, 0xca, 0x6e, 0xe5, 0x3c, 0xbf, 0xde, 0x76, 0xf8, 0x37, 0x93, 0x83, 0xae,
+	0x3e, 0xf3, 0x34, 0x69, 0xd7, 0x49, 0x01, 0xf8, 0xaf, 0x2d, 0x58, 0x29, 0xdb, 0xe5, 0x5f, 0x9e,
+	0xd8, 0x6b, 0x77, 0x16, 0x3b, 0xde, 0x80, 0xbe, 0x70, 0x0f, 0xda, 0xa6, 0xcc, 0x75, 0x57, 0x1b,
+	0x18, 0x3a, 0xc6, 0x93, 0x37, 0xd4, 0x84, 0x5a, 0x12, 0x4e, 0xed, 0x0a, 0x02, 0x68, 0x04, 0xf4,
+	0x92, 0xc6, 0x89, 0x6d, 0x6d, 0x6c, 0xc1, 0x62, 0xae, 0x92, 0x86, 0x7a, 0xd0, 0x8e, 0x47, 0x51,
+	0xe8, 0xfb, 0x5e, 0x30, 0xb6, 0x2b, 0xac, 0x79, 0xea, 0xbd, 0xa1, 0xee, 0x31, 0x1b, 0x6c, 0x21,
+	0x1b, 0xba, 0xa2, 0x79, 0x12, 0x26, 0x49, 0x38, 0xb1, 0xab, 0x1b, 0x3f, 0xce, 0x94, 0x13, 0xd0,
+	0x92, 0x8c, 0x53, 0x8e, 0x25, 0xd0, 0xae, 0xa0, 0x65, 0xfd, 0x90, 0x4c, 0x03, 0xad, 0x8d, 0xc7,
+	0xd0, 0x35, 0xab, 0x1c, 0xa8, 0x05, 0xf5, 0x78, 0x4a, 0x26, 0x76, 0x05, 0x21, 0xe8, 0x47, 0x33,
+	0x9f, 0x1e, 0x5f, 0x78, 0xa1, 0xcf, 0xa3, 0x2b, 0xdb, 0x62, 0x5c, 0xb8, 0x33, 0x71, 0x0b, 0xa5,
+	0x76, 0x75, 0xe3, 0x4b, 0xb0, 0xf3, 0x95, 0x05, 0xd4, 0x81, 0xa6, 0xeb, 0xc5, 0x13, 0x2f, 0x8e,
+	0xed, 0x0a, 0xa3, 0x76, 0xe6, 0xb9, 0xd4, 0xb6, 0x50, 0x17, 0x5a, 0x27, 0x24, 0xe0, 0x0f, 0x86,
+	0xec, 0xea, 0xc6, 0x6b, 0x40, 0xc5, 0xe4, 0x2b, 0x63, 0x70, 0x3a, 0x3b, 0xf1, 0xbd, 0xd1, 0xb1,
+	0xea, 0xb4, 0x2b, 0x68, 0x15, 0x96, 0x66, 0x01, 0xfb, 0xa6, 0x6e, 0x0a, 0xb6, 0xd0, 0x0a, 0xd8,
+	0xd3, 0xc8, 0xbb, 0x20, 0x09, 0x4d, 0xa1, 0xd5, 0x8d, 0x9f, 0x42, 0xc7, 0xb8, 0xb5, 0x33, 0x5e,
+	0xc6, 0x34, 0xa0, 0x11, 0xf1, 0x85, 0xbc, 0x49, 0x94, 0x88, 0xd1, 0x00, 0x8d, 0x98, 0x46, 0x1e,
+	0x8d, 0xed, 0x2a, 0x5b, 0xd3, 0xe8, 0x8c, 0xb0, 0xb7, 0x03, 0x34, 0xb2, 0x6b, 0x8c, 0x65, 0x66,
+	0x44, 0xec, 0xfa, 0xc6, 0x26, 0x34, 0xe5, 0xc1, 0x60, 0xdc, 0x07, 0xde, 0x28, 0x64, 0x7f, 0x76,
+	0x85, 0xaf, 0xc5, 0xf3, 0x39, 0xd7, 0xb6, 0xc5, 0x26, 0xb9, 0x0a, 0x67, 0xc9, 0xec, 0x84, 0x49,
+	0x84, 0x42, 0x2f, 0xe3, 0x68, 0x51, 0x5b, 0xd6, 0xd2, 0x05, 0x03, 0xe2, 0xd1, 0x82, 0x6d, 0xa1,
+	0x45, 0xe8, 0x08, 0x05, 0xe2, 0xaf, 0x9f, 0xec, 0x2a, 0xdb, 0xd0, 0x24, 0x12, 0xf1, 0xcf, 0xb1,
+	0x4b, 0xae, 0xec, 0x1a, 0xdb, 0x41, 0x0d, 0xb9, 0xa4, 0xf4, 0xdc, 0xae, 0x33, 0xdd, 0x39, 0x0b,
+	0x13, 0x7b, 0x61, 0x03, 0x43, 0x2f, 0xe3, 0x9d, 0x59, 0x0f, 0x89, 0x47, 0x42, 0xe2, 0x4c, 0xe9,
+	0xed, 0xea, 0xe6, 0x7f, 0x0f, 0xa4, 0x67, 0x79, 0x25, 0x5e, 0xc2, 0xa2, 0x6f, 0xd4, 0x9c, 0x1c,
+	0x8a, 0xd6, 0x4c, 0xe3, 0x9f, 0x1a, 0xe8, 0xa1, 0x7a, 0x40, 0x90, 0x55, 0x70, 0x5c, 0x59, 0xb7,
+	0xd0, 0x36, 0xf4, 0xdc, 0xf0, 0x32, 0x48, 0x69, 0x2c, 0x67, 0xae, 0xa2, 0xc2, 0x83, 0x0d, 0xdf,
+	0xc9, 0xd9, 0xe8, 0x94, 0x36, 0xae, 0xfc, 0xd0, 0x42, 0xdf, 0x01, 0x32, 0x33, 0x84, 0xc2, 0xf7,
+	0x22, 0xe5, 0x8a, 0x0a, 0x0e, 0x78, 0xf8, 0xbe, 0x39, 0x47, 0xc1, 0x99, 0xe3, 0x0a, 0x7a, 0x0c,
+	0xdd, 0x31, 0x4d, 0xd2, 0xf8, 0xf4, 0x9e, 0x39, 0xc0, 0x88, 0x8d, 0x86, 0xb6, 0xd9, 0xc1, 0x50,
+	0x71, 0x05, 0x7d, 0x09, 0x2d, 0x35, 0xb8, 0x7c, 0x35, 0x2a, 0x1a, 0xca, 0xbc, 0x26, 0xc3, 0x15,
+	0xf4, 0x19, 0xb4, 0x23, 0x92, 0x88, 0xc5, 0x21, 0x64, 0x22, 0x89, 0xf7, 0x28, 0xc3, 0x7e, 0x7a,
+	0x57, 0xe3, 0x0f, 0x8e, 0x2b, 0xe8, 0x2b, 0xe8, 0xc9, 0x57, 0x2a, 0x02, 0x45, 0x73, 0x9a, 0x7f,
+	0xc1, 0x52, 0x32, 0x76, 0x13, 0xda, 0x4c, 0x8f, 0xb2, 0x8c, 0x9a, 0x6f, 0x56, 0x4a, 0xc6, 0x3c,
+	0x87, 0xa5, 0xa7, 0x72, 0x6d, 0xe9, 0x6b, 0x91, 0xf7, 0xcc, 0x45, 0xe6, 0x5f, 0xa4, 0x0c, 0x57,
+	0x4b, 0x7b, 0x71, 0x85, 0x55, 0x2b, 0xf7, 0xc9, 0x39, 0x55, 0xaf, 0x2a, 0x33, 0x1c, 0x48, 0x60,
+	0x09, 0x07, 0x8f, 0x61, 0xc9, 0x18, 0x25, 0xdf, 0xe0, 0xae, 0x64, 0x1f, 0x02, 0x0b, 0x68, 0x29,
+	0xfb, 0xcb, 0x4f, 0xa9, 0x7a, 0x9b, 0x1c, 0xef, 0x86, 0x91, 0x58, 0xfc, 0x6a, 0x76, 0xb8, 0xe2,
+	0x7c, 0x98, 0x7d, 0x51, 0x6a, 0xbe, 0x6f, 0xc5, 0x15, 0xf4, 0x39, 0x74, 0x58, 0x4e, 0x46, 0xb1,
+	0x9f, 0x7b, 0x8b, 0xcc, 0xba, 0x4a, 0x18, 0x78, 0x04, 0x3d, 0x5e, 0xf8, 0xd1, 0xab, 0x5e, 0xcb,
+	0x0e, 0x53, 0x55, 0xa1, 0x92, 0xa1, 0x5f, 0x40, 0x57, 0xdc, 0x10, 0xa5, 0x82, 0x64, 0xb4, 0x48,
+	0xdd, 0x1d, 0x4b, 0xc7, 0x75, 0xc4, 0xb5, 0x30, 0xbb, 0xd6, 0xec, 0x7d, 0xb1, 0x54, 0x56, 0x36,
+	0x2b, 0xb9, 0x98, 0x57, 0x3f, 0xf4, 0x6e, 0xc9, 0xbd, 0x4c, 0x6f, 0xf4, 0xbd, 0x92, 0x4e, 0x7d,
+	0x24, 0x7a, 0x8e, 0xac, 0xa1, 0x8b, 0xa8, 0x69, 0x45, 0x8b, 0xd6, 0x78, 0xb8, 0x51, 0xc2, 0xc4,
+	0xd7, 0xd0, 0x31, 0xde, 0x45, 0x20, 0x65, 0x07, 0x8a, 0x6f, 0x25, 0x86, 0x4b, 0x99, 0x67, 0x0d,
+	0x72, 0xde, 0xc7, 0xd0, 0xe7, 0x65, 0xf3, 0x0b, 0xaa, 0x28, 0xdc, 0xcb, 0xa0, 0xa5, 0x35, 0xf5,
+	0xd2, 0xbd, 0x82, 0xa7, 0x34, 0x51, 0xcf, 0x81, 0x56, 0x73, 0x6f, 0x86, 0xe4, 0xb4, 0x28, 0x0b,
+	0x96, 0xf3, 0x7e, 0x0e, 0x1d, 0xf6, 0xa2, 0x46, 0x8d, 0xcd, 0x21, 0xb1, 0xae, 0x92, 0xf9, 0x7e,
+	0x02, 0x7d, 0x9e, 0x75, 0xa0, 0xba, 0x52, 0x7e, 0x2f, 0x57, 0xb7, 0x51, 0x49, 0x89, 0x61, 0xbe,
+	0xa0, 0xc3, 0x2d, 0x41, 0x5f, 0x24, 0xaa, 0xf5, 0xe8, 0xd5, 0x1c, 0x92, 0xe8, 0x2e, 0x1b, 0xfb,
+	0x18, 0xfa, 0x42, 0x2b, 0xe7, 0xce, 0x7c, 0x8d, 0x5e, 0x3e, 0x01, 0xb4, 0xe5, 0x0a, 0xe3, 0x7d,
+	0x18, 0x6a, 0x02, 0xc3, 0x1c, 0x01, 0xa3, 0x44, 0x59, 0x42, 0xe3, 0x29, 0xdc, 0x73, 0xe8, 0x44,
+	0xa9, 0x36, 0x7b, 0x04, 0x7a, 0x47, 0x42, 0x5b, 0xb0, 0xbc, 0xaf, 0xc8, 0xec, 0x05, 0x9a, 0xc8,
+	0xa0, 0x8c, 0x08, 0x43, 0x2c, 0x21, 0xf1, 0x5b, 0xd0, 0x31, 0x4a, 0x8d, 0x5a, 0xe5, 0x8a, 0xe5,
+	0xc7, 0xe1, 0x72, 0x8e, 0x2a, 0xab, 0x15, 0xe2, 0x0a, 0xda, 0xe5, 0x07, 0x27, 0x53, 0xdd, 0xd3,
+	0x07, 0xa7, 0xac, 0xe6, 0x57, 0xa0, 0x23, 0x95, 0x68, 0x17, 0x56, 0x58, 0x21, 0x2e, 0x5f, 0x9c,
+	0xd3, 0xb4, 0xca, 0xaa, 0x76, 0x65, 0xbb, 0xfb, 0x29, 0xb4, 0x45, 0x19, 0x8b, 0x5d, 0x93, 0xed,
+	0x34, 0xfb, 0x2c, 0x80, 0x25, 0x12, 0xf8, 0x0c, 0x3a, 0x47, 0xc1, 0xe9, 0xad, 0x86, 0xec, 0xc0,
+	0xe2, 0x53, 0x9a, 0x64, 0xaa, 0x65, 0x43, 0xed, 0x7e, 0x0b, 0x15, 0xb7, 0xe1, 0x72, 0x49, 0x1f,
+	0xae, 0xa0, 0x8f, 0xa1, 0xc9, 0xa8, 0x50, 0xea, 0xea, 0x23, 0x63, 0x64, 0xbb, 0x87, 0x1d, 0x03,
+	0x86, 0x2b, 0x68, 0x9b, 0xcb, 0x39, 0x93, 0x81, 0x46, 0xe9, 0x65, 0xae, 0x98, 0x97, 0x2e, 0x75,
+	0xd6, 0x1f, 0x43, 0xe3, 0x29, 0x4d, 0x72, 0xcb, 0x14, 0xe5, 0xbc, 0x61, 0xae, 0x2a, 0x24, 0xa6,
+	0x34, 0x6b, 0x58, 0x7c, 0x9d, 0xef, 0xa7, 0x58, 0x25, 0xf5, 0x2d, 0x93, 0x88, 0x9c, 0xf2, 0x11,
+	0x74, 0xc5, 0x94, 0xa2, 0x8c, 0xa5, 0xcf, 0x5a, 0xbe, 0xb0, 0x55, 0x32, 0xf4, 0x53, 0x68, 0x8b,
+	0x53, 0x9c, 0x63, 0x58, 0x1e, 0xed, 0x22, 0xc3, 0x5f, 0x40, 0x67, 0xcb, 0x75, 0x55, 0xed, 0x48,
+	0x9b, 0x84, 0x6c, 0x31, 0xa9, 0x64, 0xdc, 0x23, 0xe8, 0x8b, 0x03, 0x79, 0xfb, 0xa1, 0x4f, 0x60,
+	0x49, 0x4c, 0x69, 0x14, 0x97, 0xf4, 0xbe, 0x94, 0xd5, 0x9c, 0x4a, 0x68, 0x7c, 0x0b, 0x2b, 0x7a,
+	0xfa, 0xef, 0x41, 0xe6, 0x37, 0xa1, 0x27, 0x24, 0xad, 0x4a, 0x31, 0x83, 0x14, 0x25, 0x5b, 0x98,
+	0x1a, 0x2e, 0x15, 0x7a, 0x70, 0x05, 0x7d, 0x07, 0x4b, 0x85, 0xa4, 0x3e, 0xfa, 0xc0, 0xf0, 0x41,
+	0x65, 0x29, 0x69, 0xed, 0x07, 0xf3, 0x19, 0x78, 0xae, 0x3f, 0xab, 0xfb, 0x24, 0x3a, 0x2f, 0xa6,
+	0xc9, 0x07, 0x25, 0x63, 0x78, 0x4f, 0xc9, 0x59, 0x23, 0x30, 0x64, 0xf6, 0x65, 0x4e, 0x76, 0xfb,
+	0x07, 0xca, 0xd2, 0xcc, 0xcd, 0x99, 0x0f, 0xef, 0xcf, 0x45, 0xe1, 0x24, 0x70, 0x05, 0x7d, 0x03,
+	0x8b, 0x5b, 0x6e, 0xa6, 0x47, 0x8b, 0xbe, 0x2c, 0x49, 0x5e, 0x6a, 0x10, 0xfa, 0xea, 0x77, 0x6b,
+	0xf2, 0x68, 0xae, 0x98, 0xa7, 0x4f, 0xf5, 0x0d, 0x87, 0x65, 0x50, 0xf1, 0x4b, 0x37, 0x5c, 0x39,
+	0x69, 0xf0, 0xce, 0xcf, 0xff, 0x6f, 0x00, 0x2b, 0x77, 0x4d, 0xa8, 0x80, 0x37, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VideoServiceClient is the client API for VideoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VideoServiceClient interface {
+	UploadVideo(ctx context.Context, opts ...grpc.CallOption) (VideoService_UploadVideoClient, error)
+	DownloadVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (VideoService_DownloadVideoClient, error)
+	ForeignVideoExists(ctx context.Context, in *ForeignVideoCheck, opts ...grpc.CallOption) (*VideoExistenceResponse, error)
+	GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error)
+	GetVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*VideoMetadata, error)
+	RateVideo(ctx context.Context, in *VideoRating, opts ...grpc.CallOption) (*Nothing, error)
+	RetractRating(ctx context.Context, in *RatingRetraction, opts ...grpc.CallOption) (*Nothing, error)
+	ViewVideo(ctx context.Context, in *VideoViewing, opts ...grpc.CallOption) (*Nothing, error)
+	GetVideoViewStats(ctx context.Context, in *VideoViewStatsRequest, opts ...grpc.CallOption) (*VideoViewStats, error)
+	MakeComment(ctx context.Context, in *VideoComment, opts ...grpc.CallOption) (*Nothing, error)
+	MakeCommentUpvote(ctx context.Context, in *CommentUpvote, opts ...grpc.CallOption) (*Nothing, error)
+	GetCommentsForVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentListResponse, error)
+	EditComment(ctx context.Context, in *CommentEdit, opts ...grpc.CallOption) (*Nothing, error)
+	DeleteComment(ctx context.Context, in *CommentDeletion, opts ...grpc.CallOption) (*Nothing, error)
+	// Require the video.approve permission. A video is approved or rejected once ApprovalThreshold users agree
+	ApproveVideo(ctx context.Context, in *VideoApproval, opts ...grpc.CallOption) (*Nothing, error)
+	RejectVideo(ctx context.Context, in *VideoRejection, opts ...grpc.CallOption) (*Nothing, error)
+	GetPendingVideos(ctx context.Context, in *PendingVideosRequest, opts ...grpc.CallOption) (*PendingVideoList, error)
+	ReportContent(ctx context.Context, in *ContentReport, opts ...grpc.CallOption) (*Nothing, error)
+	// Listing and resolving reports requires the report.resolve permission, and hiding a video also video.delete
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ReportList, error)
+	ResolveReports(ctx context.Context, in *ReportResolution, opts ...grpc.CallOption) (*Nothing, error)
+	GetDanmaku(ctx context.Context, in *DanmakuRequest, opts ...grpc.CallOption) (*DanmakuList, error)
+	PostDanmaku(ctx context.Context, in *DanmakuPost, opts ...grpc.CallOption) (*Nothing, error)
+	// Playlists can only be modified by their owner. Private playlists are only visible to their owner, and unlisted
+	// playlists are only listed for their owner.
+	CreatePlaylist(ctx context.Context, in *PlaylistCreation, opts ...grpc.CallOption) (*Playlist, error)
+	UpdatePlaylist(ctx context.Context, in *PlaylistUpdate, opts ...grpc.CallOption) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, in *PlaylistDeletion, opts ...grpc.CallOption) (*Nothing, error)
+	AddVideoToPlaylist(ctx context.Context, in *PlaylistVideoChange, opts ...grpc.CallOption) (*Nothing, error)
+	RemoveVideoFromPlaylist(ctx context.Context, in *PlaylistVideoChange, opts ...grpc.CallOption) (*Nothing, error)
+	MoveVideoInPlaylist(ctx context.Context, in *PlaylistVideoMove, opts ...grpc.CallOption) (*Nothing, error)
+	GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*PlaylistPage, error)
+	GetUserPlaylists(ctx context.Context, in *UserPlaylistsRequest, opts ...grpc.CallOption) (*PlaylistList, error)
+	// Used by the scheduler to create or update a playlist mirrored from another site, in the original order
+	SyncMirroredPlaylist(ctx context.Context, in *MirroredPlaylistSync, opts ...grpc.CallOption) (*Playlist, error)
+	FollowTag(ctx context.Context, in *TagFollow, opts ...grpc.CallOption) (*Nothing, error)
+	UnfollowTag(ctx context.Context, in *TagFollow, opts ...grpc.CallOption) (*Nothing, error)
+	GetFollowedTags(ctx context.Context, in *FollowedTagsRequest, opts ...grpc.CallOption) (*FollowedTags, error)
+	// Recent approved videos from followed users and tags, newest first
+	GetFeed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*Feed, error)
+	// Related videos are precomputed in the background from shared tags, the uploader and co-ratings
+	GetRelatedVideos(ctx context.Context, in *RelatedVideosRequest, opts ...grpc.CallOption) (*VideoList, error)
+	// Tags are stored under their canonical name, and aliases resolve to the tag they belong to. A video tagged with a
+	// tag is also tagged with everything it implies. Editing tags and viewing their history requires tag.edit.
+	GetTag(ctx context.Context, in *TagLookup, opts ...grpc.CallOption) (*TagInfo, error)
+	AutocompleteTags(ctx context.Context, in *TagAutocompleteRequest, opts ...grpc.CallOption) (*TagList, error)
+	GetTagCounts(ctx context.Context, in *TagCountsRequest, opts ...grpc.CallOption) (*TagList, error)
+	UpdateTag(ctx context.Context, in *TagUpdate, opts ...grpc.CallOption) (*TagInfo, error)
+	// Adding an alias which is currently a tag merges that tag into this one
+	AddTagAlias(ctx context.Context, in *TagAliasChange, opts ...grpc.CallOption) (*TagInfo, error)
+	RemoveTagAlias(ctx context.Context, in *TagAliasChange, opts ...grpc.CallOption) (*TagInfo, error)
+	AddTagImplication(ctx context.Context, in *TagImplicationChange, opts ...grpc.CallOption) (*TagInfo, error)
+	// Videos which were tagged through the implication keep the implied tag
+	RemoveTagImplication(ctx context.Context, in *TagImplicationChange, opts ...grpc.CallOption) (*TagInfo, error)
+	GetTagHistory(ctx context.Context, in *TagHistoryRequest, opts ...grpc.CallOption) (*TagHistory, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	MarkNotificationsRead(ctx context.Context, in *NotificationsRead, opts ...grpc.CallOption) (*Nothing, error)
+	GetUnreadNotificationCount(ctx context.Context, in *UnreadNotificationsRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error)
+	// Used by other services, e.g. the scheduler when an archive request completes or fails
+	AddNotification(ctx context.Context, in *NotificationCreation, opts ...grpc.CallOption) (*Nothing, error)
+	// Moves the videos of a foreign user to the user it was merged into after being claimed. The claim is looked up
+	// with the user service, so only its claimant can move the videos.
+	TransferVideos(ctx context.Context, in *VideoTransfer, opts ...grpc.CallOption) (*VideoTransferResult, error)
+}
+
+type videoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVideoServiceClient(cc grpc.ClientConnInterface) VideoServiceClient {
+	return &videoServiceClient{cc}
+}
+
+func (c *videoServiceClient) UploadVideo(ctx context.Context, opts ...grpc.CallOption) (VideoService_UploadVideoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VideoService_serviceDesc.Streams[0], "/proto.VideoService/uploadVideo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &videoServiceUploadVideoClient{stream}
+	return x, nil
+}
+
+type VideoService_UploadVideoClient interface {
+	Send(*InputVideoChunk) error
+	CloseAndRecv() (*UploadResponse, error)
+	grpc.ClientStream
+}
+
+type videoServiceUploadVideoClient struct {
+	grpc.ClientStream
+}
+
+func (x *videoServiceUploadVideoClient) Send(m *InputVideoChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *videoServiceUploadVideoClient) CloseAndRecv() (*UploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *videoServiceClient) DownloadVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (VideoService_DownloadVideoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VideoService_serviceDesc.Streams[1], "/proto.VideoService/downloadVideo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &videoServiceDownloadVideoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VideoService_DownloadVideoClient interface {
+	Recv() (*ResponseVideoChunk, error)
+	grpc.ClientStream
+}
+
+type videoServiceDownloadVideoClient struct {
+	grpc.ClientStream
+}
+
+func (x *videoServiceDownloadVideoClient) Recv() (*ResponseVideoChunk, error) {
+	m := new(ResponseVideoChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *videoServiceClient) ForeignVideoExists(ctx context.Context, in *ForeignVideoCheck, opts ...grpc.CallOption) (*VideoExistenceResponse, error) {
+	out := new(VideoExistenceResponse)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/foreignVideoExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error) {
+	out := new(VideoList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getVideoList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*VideoMetadata, error) {
+	out := new(VideoMetadata)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RateVideo(ctx context.Context, in *VideoRating, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/rateVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RetractRating(ctx context.Context, in *RatingRetraction, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/RetractRating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ViewVideo(ctx context.Context, in *VideoViewing, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/viewVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetVideoViewStats(ctx context.Context, in *VideoViewStatsRequest, opts ...grpc.CallOption) (*VideoViewStats, error) {
+	out := new(VideoViewStats)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetVideoViewStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) MakeComment(ctx context.Context, in *VideoComment, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/MakeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) MakeCommentUpvote(ctx context.Context, in *CommentUpvote, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/MakeCommentUpvote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetCommentsForVideo(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentListResponse, error) {
+	out := new(CommentListResponse)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetCommentsForVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) EditComment(ctx context.Context, in *CommentEdit, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/EditComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeleteComment(ctx context.Context, in *CommentDeletion, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ApproveVideo(ctx context.Context, in *VideoApproval, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/ApproveVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RejectVideo(ctx context.Context, in *VideoRejection, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/RejectVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetPendingVideos(ctx context.Context, in *PendingVideosRequest, opts ...grpc.CallOption) (*PendingVideoList, error) {
+	out := new(PendingVideoList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetPendingVideos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReportContent(ctx context.Context, in *ContentReport, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/ReportContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ReportList, error) {
+	out := new(ReportList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ResolveReports(ctx context.Context, in *ReportResolution, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/ResolveReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetDanmaku(ctx context.Context, in *DanmakuRequest, opts ...grpc.CallOption) (*DanmakuList, error) {
+	out := new(DanmakuList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetDanmaku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) PostDanmaku(ctx context.Context, in *DanmakuPost, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/PostDanmaku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreatePlaylist(ctx context.Context, in *PlaylistCreation, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/CreatePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdatePlaylist(ctx context.Context, in *PlaylistUpdate, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/UpdatePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeletePlaylist(ctx context.Context, in *PlaylistDeletion, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/DeletePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddVideoToPlaylist(ctx context.Context, in *PlaylistVideoChange, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/AddVideoToPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveVideoFromPlaylist(ctx context.Context, in *PlaylistVideoChange, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/RemoveVideoFromPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) MoveVideoInPlaylist(ctx context.Context, in *PlaylistVideoMove, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/MoveVideoInPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*PlaylistPage, error) {
+	out := new(PlaylistPage)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetUserPlaylists(ctx context.Context, in *UserPlaylistsRequest, opts ...grpc.CallOption) (*PlaylistList, error) {
+	out := new(PlaylistList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetUserPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SyncMirroredPlaylist(ctx context.Context, in *MirroredPlaylistSync, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/SyncMirroredPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) FollowTag(ctx context.Context, in *TagFollow, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/FollowTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UnfollowTag(ctx context.Context, in *TagFollow, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/UnfollowTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetFollowedTags(ctx context.Context, in *FollowedTagsRequest, opts ...grpc.CallOption) (*FollowedTags, error) {
+	out := new(FollowedTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetFollowedTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetFeed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*Feed, error) {
+	out := new(Feed)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetRelatedVideos(ctx context.Context, in *RelatedVideosRequest, opts ...grpc.CallOption) (*VideoList, error) {
+	out := new(VideoList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetRelatedVideos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTag(ctx context.Context, in *TagLookup, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AutocompleteTags(ctx context.Context, in *TagAutocompleteRequest, opts ...grpc.CallOption) (*TagList, error) {
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/AutocompleteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTagCounts(ctx context.Context, in *TagCountsRequest, opts ...grpc.CallOption) (*TagList, error) {
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetTagCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdateTag(ctx context.Context, in *TagUpdate, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/UpdateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddTagAlias(ctx context.Context, in *TagAliasChange, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/AddTagAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveTagAlias(ctx context.Context, in *TagAliasChange, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/RemoveTagAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddTagImplication(ctx context.Context, in *TagImplicationChange, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/AddTagImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveTagImplication(ctx context.Context, in *TagImplicationChange, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/RemoveTagImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTagHistory(ctx context.Context, in *TagHistoryRequest, opts ...grpc.CallOption) (*TagHistory, error) {
+	out := new(TagHistory)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetTagHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error) {
+	out := new(NotificationList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/ListNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) MarkNotificationsRead(ctx context.Context, in *NotificationsRead, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/MarkNotificationsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetUnreadNotificationCount(ctx context.Context, in *UnreadNotificationsRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error) {
+	out := new(UnreadNotificationCount)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/GetUnreadNotificationCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddNotification(ctx context.Context, in *NotificationCreation, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/AddNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) TransferVideos(ctx context.Context, in *VideoTransfer, opts ...grpc.CallOption) (*VideoTransferResult, error) {
+	out := new(VideoTransferResult)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/TransferVideos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VideoServiceServer is the server API for VideoService service.
+type VideoServiceServer interface {
+	UploadVideo(VideoService_UploadVideoServer) error
+	DownloadVideo(*VideoRequest, VideoService_DownloadVideoServer) error
+	ForeignVideoExists(context.Context, *ForeignVideoCheck) (*VideoExistenceResponse, error)
+	GetVideoList(context.Context, *VideoQueryConfig) (*VideoList, error)
+	GetVideo(context.Context, *VideoRequest) (*VideoMetadata, error)
+	RateVideo(context.Context, *VideoRating) (*Nothing, error)
+	RetractRating(context.Context, *RatingRetraction) (*Nothing, error)
+	ViewVideo(context.Context, *VideoViewing) (*Nothing, error)
+	GetVideoViewStats(context.Context, *VideoViewStatsRequest) (*VideoViewStats, error)
+	MakeComment(context.Context, *VideoComment) (*Nothing, error)
+	MakeCommentUpvote(context.Context, *CommentUpvote) (*Nothing, error)
+	GetCommentsForVideo(context.Context, *CommentRequest) (*CommentListResponse, error)
+	EditComment(context.Context, *CommentEdit) (*Nothing, error)
+	DeleteComment(context.Context, *CommentDeletion) (*Nothing, error)
+	// Require the video.approve permission. A video is approved or rejected once ApprovalThreshold users agree
+	ApproveVideo(context.Context, *VideoApproval) (*Nothing, error)
+	RejectVideo(context.Context, *VideoRejection) (*Nothing, error)
+	GetPendingVideos(context.Context, *PendingVideosRequest) (*PendingVideoList, error)
+	ReportContent(context.Context, *ContentReport) (*Nothing, error)
+	// Listing and resolving reports requires the report.resolve permission, and hiding a video also video.delete
+	ListReports(context.Context, *ListReportsRequest) (*ReportList, error)
+	ResolveReports(context.Context, *ReportResolution) (*Nothing, error)
+	GetDanmaku(context.Context, *DanmakuRequest) (*DanmakuList, error)
+	PostDanmaku(context.Context, *DanmakuPost) (*Nothing, error)
+	// Playlists can only be modified by their owner. Private playlists are only visible to their owner, and unlisted
+	// playlists are only listed for their owner.
+	CreatePlaylist(context.Context, *PlaylistCreation) (*Playlist, error)
+	UpdatePlaylist(context.Context, *PlaylistUpdate) (*Playlist, error)
+	DeletePlaylist(context.Context, *PlaylistDeletion) (*Nothing, error)
+	AddVideoToPlaylist(context.Context, *PlaylistVideoChange) (*Nothing, error)
+	RemoveVideoFromPlaylist(context.Context, *PlaylistVideoChange) (*Nothing, error)
+	MoveVideoInPlaylist(context.Context, *PlaylistVideoMove) (*Nothing, error)
+	GetPlaylist(context.Context, *GetPlaylistRequest) (*PlaylistPage, error)
+	GetUserPlaylists(context.Context, *UserPlaylistsRequest) (*PlaylistList, error)
+	// Used by the scheduler to create or update a playlist mirrored from another site, in the original order
+	SyncMirroredPlaylist(context.Context, *MirroredPlaylistSync) (*Playlist, error)
+	FollowTag(context.Context, *TagFollow) (*Nothing, error)
+	UnfollowTag(context.Context, *TagFollow) (*Nothing, error)
+	GetFollowedTags(context.Context, *FollowedTagsRequest) (*FollowedTags, error)
+	// Recent approved videos from followed users and tags, newest first
+	GetFeed(context.Context, *FeedRequest) (*Feed, error)
+	// Related videos are precomputed in the background from shared tags, the uploader and co-ratings
+	GetRelatedVideos(context.Context, *RelatedVideosRequest) (*VideoList, error)
+	// Tags are stored under their canonical name, and aliases resolve to the tag they belong to. A video tagged with a
+	// tag is also tagged with everything it implies. Editing tags and viewing their history requires tag.edit.
+	GetTag(context.Context, *TagLookup) (*TagInfo, error)
+	AutocompleteTags(context.Context, *TagAutocompleteRequest) (*TagList, error)
+	GetTagCounts(context.Context, *TagCountsRequest) (*TagList, error)
+	UpdateTag(context.Context, *TagUpdate) (*TagInfo, error)
+	// Adding an alias which is currently a tag merges that tag into this one
+	AddTagAlias(context.Context, *TagAliasChange) (*TagInfo, error)
+	RemoveTagAlias(context.Context, *TagAliasChange) (*TagInfo, error)
+	AddTagImplication(context.Context, *TagImplicationChange) (*TagInfo, error)
+	// Videos which were tagged through the implication keep the implied tag
+	RemoveTagImplication(context.Context, *TagImplicationChange) (*TagInfo, error)
+	GetTagHistory(context.Context, *TagHistoryRequest) (*TagHistory, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error)
+	MarkNotificationsRead(context.Context, *NotificationsRead) (*Nothing, error)
+	GetUnreadNotificationCount(context.Context, *UnreadNotificationsRequest) (*UnreadNotificationCount, error)
+	// Used by other services, e.g. the scheduler when an archive request completes or fails
+	AddNotification(context.Context, *NotificationCreation) (*Nothing, error)
+	// Moves the videos of a foreign user to the user it was merged into after being claimed. The claim is looked up
+	// with the user service, so only its claimant can move the videos.
+	TransferVideos(context.Context, *VideoTransfer) (*VideoTransferResult, error)
+}
+
+// UnimplementedVideoServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedVideoServiceServer struct {
+}
+
+func (*UnimplementedVideoServiceServer) UploadVideo(srv VideoService_UploadVideoServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) DownloadVideo(req *VideoRequest, srv VideoService_DownloadVideoServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) ForeignVideoExists(ctx context.Context, req *ForeignVideoCheck) (*VideoExistenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForeignVideoExists not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetVideoList(ctx context.Context, req *VideoQueryConfig) (*VideoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoList not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetVideo(ctx context.Context, req *VideoRequest) (*VideoMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) RateVideo(ctx context.Context, req *VideoRating) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) RetractRating(ctx context.Context, req *RatingRetraction) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractRating not implemented")
+}
+func (*UnimplementedVideoServiceServer) ViewVideo(ctx context.Context, req *VideoViewing) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetVideoViewStats(ctx context.Context, req *VideoViewStatsRequest) (*VideoViewStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoViewStats not implemented")
+}
+func (*UnimplementedVideoServiceServer) MakeComment(ctx context.Context, req *VideoComment) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeComment not implemented")
+}
+func (*UnimplementedVideoServiceServer) MakeCommentUpvote(ctx context.Context, req *CommentUpvote) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeCommentUpvote not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetCommentsForVideo(ctx context.Context, req *CommentRequest) (*CommentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsForVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) EditComment(ctx context.Context, req *CommentEdit) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (*UnimplementedVideoServiceServer) DeleteComment(ctx context.Context, req *CommentDeletion) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (*UnimplementedVideoServiceServer) ApproveVideo(ctx context.Context, req *VideoApproval) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) RejectVideo(ctx context.Context, req *VideoRejection) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVideo not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetPendingVideos(ctx context.Context, req *PendingVideosRequest) (*PendingVideoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingVideos not implemented")
+}
+func (*UnimplementedVideoServiceServer) ReportContent(ctx context.Context, req *ContentReport) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (*UnimplementedVideoServiceServer) ListReports(ctx context.Context, req *ListReportsRequest) (*ReportList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (*UnimplementedVideoServiceServer) ResolveReports(ctx context.Context, req *ReportResolution) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetDanmaku(ctx context.Context, req *DanmakuRequest) (*DanmakuList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDanmaku not implemented")
+}
+func (*UnimplementedVideoServiceServer) PostDanmaku(ctx context.Context, req *DanmakuPost) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostDanmaku not implemented")
+}
+func (*UnimplementedVideoServiceServer) CreatePlaylist(ctx context.Context, req *PlaylistCreation) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) UpdatePlaylist(ctx context.Context, req *PlaylistUpdate) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) DeletePlaylist(ctx context.Context, req *PlaylistDeletion) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) AddVideoToPlaylist(ctx context.Context, req *PlaylistVideoChange) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVideoToPlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) RemoveVideoFromPlaylist(ctx context.Context, req *PlaylistVideoChange) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVideoFromPlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) MoveVideoInPlaylist(ctx context.Context, req *PlaylistVideoMove) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveVideoInPlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetPlaylist(ctx context.Context, req *GetPlaylistRequest) (*PlaylistPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetUserPlaylists(ctx context.Context, req *UserPlaylistsRequest) (*PlaylistList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPlaylists not implemented")
+}
+func (*UnimplementedVideoServiceServer) SyncMirroredPlaylist(ctx context.Context, req *MirroredPlaylistSync) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncMirroredPlaylist not implemented")
+}
+func (*UnimplementedVideoServiceServer) FollowTag(ctx context.Context, req *TagFollow) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowTag not implemented")
+}
+func (*UnimplementedVideoServiceServer) UnfollowTag(ctx context.Context, req *TagFollow) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowTag not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetFollowedTags(ctx context.Context, req *FollowedTagsRequest) (*FollowedTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowedTags not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetFeed(ctx context.Context, req *FeedRequest) (*Feed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetRelatedVideos(ctx context.Context, req *RelatedVideosRequest) (*VideoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedVideos not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetTag(ctx context.Context, req *TagLookup) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (*UnimplementedVideoServiceServer) AutocompleteTags(ctx context.Context, req *TagAutocompleteRequest) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetTagCounts(ctx context.Context, req *TagCountsRequest) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagCounts not implemented")
+}
+func (*UnimplementedVideoServiceServer) UpdateTag(ctx context.Context, req *TagUpdate) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (*UnimplementedVideoServiceServer) AddTagAlias(ctx context.Context, req *TagAliasChange) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagAlias not implemented")
+}
+func (*UnimplementedVideoServiceServer) RemoveTagAlias(ctx context.Context, req *TagAliasChange) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagAlias not implemented")
+}
+func (*UnimplementedVideoServiceServer) AddTagImplication(ctx context.Context, req *TagImplicationChange) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagImplication not implemented")
+}
+func (*UnimplementedVideoServiceServer) RemoveTagImplication(ctx context.Context, req *TagImplicationChange) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagImplication not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetTagHistory(ctx context.Context, req *TagHistoryRequest) (*TagHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagHistory not implemented")
+}
+func (*UnimplementedVideoServiceServer) ListNotifications(ctx context.Context, req *ListNotificationsRequest) (*NotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (*UnimplementedVideoServiceServer) MarkNotificationsRead(ctx context.Context, req *NotificationsRead) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (*UnimplementedVideoServiceServer) GetUnreadNotificationCount(ctx context.Context, req *UnreadNotificationsRequest) (*UnreadNotificationCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (*UnimplementedVideoServiceServer) AddNotification(ctx context.Context, req *NotificationCreation) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNotification not implemented")
+}
+func (*UnimplementedVideoServiceServer) TransferVideos(ctx context.Context, req *VideoTransfer) (*VideoTransferResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVideos not implemented")
 }
 
 func RegisterVideoServiceServer(s *grpc.Server, srv VideoServiceServer) {
 	s.RegisterService(&_VideoService_serviceDesc, srv)
 }
 
-func _VideoService_UploadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(VideoServiceServer).UploadVideo(&videoServiceUploadVideoServer{stream})
+func _VideoService_UploadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VideoServiceServer).UploadVideo(&videoServiceUploadVideoServer{stream})
+}
+
+type VideoService_UploadVideoServer interface {
+	SendAndClose(*UploadResponse) error
+	Recv() (*InputVideoChunk, error)
+	grpc.ServerStream
+}
+
+type videoServiceUploadVideoServer struct {
+	grpc.ServerStream
+}
+
+func (x *videoServiceUploadVideoServer) SendAndClose(m *UploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *videoServiceUploadVideoServer) Recv() (*InputVideoChunk, error) {
+	m := new(InputVideoChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VideoService_DownloadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VideoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VideoServiceServer).DownloadVideo(m, &videoServiceDownloadVideoServer{stream})
+}
+
+type VideoService_DownloadVideoServer interface {
+	Send(*ResponseVideoChunk) error
+	grpc.ServerStream
+}
+
+type videoServiceDownloadVideoServer struct {
+	grpc.ServerStream
+}
+
+func (x *videoServiceDownloadVideoServer) Send(m *ResponseVideoChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VideoService_ForeignVideoExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForeignVideoCheck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ForeignVideoExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ForeignVideoExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ForeignVideoExists(ctx, req.(*ForeignVideoCheck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoQueryConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetVideoList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoList(ctx, req.(*VideoQueryConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideo(ctx, req.(*VideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoRating)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/RateVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RateVideo(ctx, req.(*VideoRating))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RetractRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatingRetraction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RetractRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/RetractRating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RetractRating(ctx, req.(*RatingRetraction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ViewVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoViewing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ViewVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ViewVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ViewVideo(ctx, req.(*VideoViewing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideoViewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoViewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoViewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetVideoViewStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoViewStats(ctx, req.(*VideoViewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_MakeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).MakeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/MakeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).MakeComment(ctx, req.(*VideoComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_MakeCommentUpvote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentUpvote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).MakeCommentUpvote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/MakeCommentUpvote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).MakeCommentUpvote(ctx, req.(*CommentUpvote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetCommentsForVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetCommentsForVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetCommentsForVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetCommentsForVideo(ctx, req.(*CommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentEdit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/EditComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).EditComment(ctx, req.(*CommentEdit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeleteComment(ctx, req.(*CommentDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ApproveVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ApproveVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ApproveVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ApproveVideo(ctx, req.(*VideoApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RejectVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoRejection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RejectVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/RejectVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RejectVideo(ctx, req.(*VideoRejection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetPendingVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetPendingVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetPendingVideos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetPendingVideos(ctx, req.(*PendingVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ReportContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReportContent(ctx, req.(*ContentReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ListReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportResolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/ResolveReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ResolveReports(ctx, req.(*ReportResolution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetDanmaku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DanmakuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetDanmaku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetDanmaku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetDanmaku(ctx, req.(*DanmakuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_PostDanmaku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DanmakuPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).PostDanmaku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/PostDanmaku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).PostDanmaku(ctx, req.(*DanmakuPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistCreation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/CreatePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, req.(*PlaylistCreation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/UpdatePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, req.(*PlaylistUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/DeletePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, req.(*PlaylistDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AddVideoToPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistVideoChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddVideoToPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/AddVideoToPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddVideoToPlaylist(ctx, req.(*PlaylistVideoChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemoveVideoFromPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistVideoChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemoveVideoFromPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/RemoveVideoFromPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemoveVideoFromPlaylist(ctx, req.(*PlaylistVideoChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_MoveVideoInPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistVideoMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).MoveVideoInPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/MoveVideoInPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).MoveVideoInPlaylist(ctx, req.(*PlaylistVideoMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetPlaylist(ctx, req.(*GetPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetUserPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetUserPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetUserPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetUserPlaylists(ctx, req.(*UserPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SyncMirroredPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MirroredPlaylistSync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SyncMirroredPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/SyncMirroredPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SyncMirroredPlaylist(ctx, req.(*MirroredPlaylistSync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_FollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagFollow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).FollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/FollowTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).FollowTag(ctx, req.(*TagFollow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UnfollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagFollow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UnfollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/UnfollowTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UnfollowTag(ctx, req.(*TagFollow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type VideoService_UploadVideoServer interface {
-	SendAndClose(*UploadResponse) error
-	Recv() (*InputVideoChunk, error)
-	grpc.ServerStream
+func _VideoService_GetFollowedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowedTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetFollowedTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetFollowedTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetFollowedTags(ctx, req.(*FollowedTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type videoServiceUploadVideoServer struct {
-	grpc.ServerStream
+func _VideoService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetFeed(ctx, req.(*FeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *videoServiceUploadVideoServer) SendAndClose(m *UploadResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _VideoService_GetRelatedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelatedVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetRelatedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetRelatedVideos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetRelatedVideos(ctx, req.(*RelatedVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *videoServiceUploadVideoServer) Recv() (*InputVideoChunk, error) {
-	m := new(InputVideoChunk)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _VideoService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagLookup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTag(ctx, req.(*TagLookup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_DownloadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(VideoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _VideoService_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagAutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(VideoServiceServer).DownloadVideo(m, &videoServiceDownloadVideoServer{stream})
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/AutocompleteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AutocompleteTags(ctx, req.(*TagAutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type VideoService_DownloadVideoServer interface {
-	Send(*ResponseVideoChunk) error
-	grpc.ServerStream
+func _VideoService_GetTagCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTagCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/GetTagCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTagCounts(ctx, req.(*TagCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type videoServiceDownloadVideoServer struct {
-	grpc.ServerStream
+func _VideoService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/UpdateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdateTag(ctx, req.(*TagUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *videoServiceDownloadVideoServer) Send(m *ResponseVideoChunk) error {
-	return x.ServerStream.SendMsg(m)
+func _VideoService_AddTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagAliasChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddTagAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/AddTagAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddTagAlias(ctx, req.(*TagAliasChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_ForeignVideoExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForeignVideoCheck)
+func _VideoService_RemoveTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagAliasChange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).ForeignVideoExists(ctx, in)
+		return srv.(VideoServiceServer).RemoveTagAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/ForeignVideoExists",
+		FullMethod: "/proto.VideoService/RemoveTagAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).ForeignVideoExists(ctx, req.(*ForeignVideoCheck))
+		return srv.(VideoServiceServer).RemoveTagAlias(ctx, req.(*TagAliasChange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoQueryConfig)
+func _VideoService_AddTagImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagImplicationChange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideoList(ctx, in)
+		return srv.(VideoServiceServer).AddTagImplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/GetVideoList",
+		FullMethod: "/proto.VideoService/AddTagImplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideoList(ctx, req.(*VideoQueryConfig))
+		return srv.(VideoServiceServer).AddTagImplication(ctx, req.(*TagImplicationChange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoRequest)
+func _VideoService_RemoveTagImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagImplicationChange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideo(ctx, in)
+		return srv.(VideoServiceServer).RemoveTagImplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/GetVideo",
+		FullMethod: "/proto.VideoService/RemoveTagImplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideo(ctx, req.(*VideoRequest))
+		return srv.(VideoServiceServer).RemoveTagImplication(ctx, req.(*TagImplicationChange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_RateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoRating)
+func _VideoService_GetTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).RateVideo(ctx, in)
+		return srv.(VideoServiceServer).GetTagHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/RateVideo",
+		FullMethod: "/proto.VideoService/GetTagHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).RateVideo(ctx, req.(*VideoRating))
+		return srv.(VideoServiceServer).GetTagHistory(ctx, req.(*TagHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_ViewVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoViewing)
+func _VideoService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).ViewVideo(ctx, in)
+		return srv.(VideoServiceServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/ViewVideo",
+		FullMethod: "/proto.VideoService/ListNotifications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).ViewVideo(ctx, req.(*VideoViewing))
+		return srv.(VideoServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_MakeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoComment)
+func _VideoService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationsRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).MakeComment(ctx, in)
+		return srv.(VideoServiceServer).MarkNotificationsRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/MakeComment",
+		FullMethod: "/proto.VideoService/MarkNotificationsRead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).MakeComment(ctx, req.(*VideoComment))
+		return srv.(VideoServiceServer).MarkNotificationsRead(ctx, req.(*NotificationsRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_MakeCommentUpvote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentUpvote)
+func _VideoService_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreadNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).MakeCommentUpvote(ctx, in)
+		return srv.(VideoServiceServer).GetUnreadNotificationCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/MakeCommentUpvote",
+		FullMethod: "/proto.VideoService/GetUnreadNotificationCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).MakeCommentUpvote(ctx, req.(*CommentUpvote))
+		return srv.(VideoServiceServer).GetUnreadNotificationCount(ctx, req.(*UnreadNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetCommentsForVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentRequest)
+func _VideoService_AddNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationCreation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetCommentsForVideo(ctx, in)
+		return srv.(VideoServiceServer).AddNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/GetCommentsForVideo",
+		FullMethod: "/proto.VideoService/AddNotification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetCommentsForVideo(ctx, req.(*CommentRequest))
+		return srv.(VideoServiceServer).AddNotification(ctx, req.(*NotificationCreation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_ApproveVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VideoApproval)
+func _VideoService_TransferVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoTransfer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).ApproveVideo(ctx, in)
+		return srv.(VideoServiceServer).TransferVideos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.VideoService/ApproveVideo",
+		FullMethod: "/proto.VideoService/TransferVideos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).ApproveVideo(ctx, req.(*VideoApproval))
+		return srv.(VideoServiceServer).TransferVideos(ctx, req.(*VideoTransfer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2094,10 +7220,18 @@ var _VideoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "rateVideo",
 			Handler:    _VideoService_RateVideo_Handler,
 		},
+		{
+			MethodName: "RetractRating",
+			Handler:    _VideoService_RetractRating_Handler,
+		},
 		{
 			MethodName: "viewVideo",
 			Handler:    _VideoService_ViewVideo_Handler,
 		},
+		{
+			MethodName: "GetVideoViewStats",
+			Handler:    _VideoService_GetVideoViewStats_Handler,
+		},
 		{
 			MethodName: "MakeComment",
 			Handler:    _VideoService_MakeComment_Handler,
@@ -2110,10 +7244,158 @@ var _VideoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentsForVideo",
 			Handler:    _VideoService_GetCommentsForVideo_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _VideoService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _VideoService_DeleteComment_Handler,
+		},
 		{
 			MethodName: "ApproveVideo",
 			Handler:    _VideoService_ApproveVideo_Handler,
 		},
+		{
+			MethodName: "RejectVideo",
+			Handler:    _VideoService_RejectVideo_Handler,
+		},
+		{
+			MethodName: "GetPendingVideos",
+			Handler:    _VideoService_GetPendingVideos_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _VideoService_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _VideoService_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _VideoService_ResolveReports_Handler,
+		},
+		{
+			MethodName: "GetDanmaku",
+			Handler:    _VideoService_GetDanmaku_Handler,
+		},
+		{
+			MethodName: "PostDanmaku",
+			Handler:    _VideoService_PostDanmaku_Handler,
+		},
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _VideoService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "UpdatePlaylist",
+			Handler:    _VideoService_UpdatePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _VideoService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "AddVideoToPlaylist",
+			Handler:    _VideoService_AddVideoToPlaylist_Handler,
+		},
+		{
+			MethodName: "RemoveVideoFromPlaylist",
+			Handler:    _VideoService_RemoveVideoFromPlaylist_Handler,
+		},
+		{
+			MethodName: "MoveVideoInPlaylist",
+			Handler:    _VideoService_MoveVideoInPlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _VideoService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "GetUserPlaylists",
+			Handler:    _VideoService_GetUserPlaylists_Handler,
+		},
+		{
+			MethodName: "SyncMirroredPlaylist",
+			Handler:    _VideoService_SyncMirroredPlaylist_Handler,
+		},
+		{
+			MethodName: "FollowTag",
+			Handler:    _VideoService_FollowTag_Handler,
+		},
+		{
+			MethodName: "UnfollowTag",
+			Handler:    _VideoService_UnfollowTag_Handler,
+		},
+		{
+			MethodName: "GetFollowedTags",
+			Handler:    _VideoService_GetFollowedTags_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _VideoService_GetFeed_Handler,
+		},
+		{
+			MethodName: "GetRelatedVideos",
+			Handler:    _VideoService_GetRelatedVideos_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _VideoService_GetTag_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _VideoService_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "GetTagCounts",
+			Handler:    _VideoService_GetTagCounts_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _VideoService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "AddTagAlias",
+			Handler:    _VideoService_AddTagAlias_Handler,
+		},
+		{
+			MethodName: "RemoveTagAlias",
+			Handler:    _VideoService_RemoveTagAlias_Handler,
+		},
+		{
+			MethodName: "AddTagImplication",
+			Handler:    _VideoService_AddTagImplication_Handler,
+		},
+		{
+			MethodName: "RemoveTagImplication",
+			Handler:    _VideoService_RemoveTagImplication_Handler,
+		},
+		{
+			MethodName: "GetTagHistory",
+			Handler:    _VideoService_GetTagHistory_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _VideoService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _VideoService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _VideoService_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "AddNotification",
+			Handler:    _VideoService_AddNotification_Handler,
+		},
+		{
+			MethodName: "TransferVideos",
+			Handler:    _VideoService_TransferVideos_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
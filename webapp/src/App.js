@@ -3,6 +3,9 @@ import { Route, Switch } from "react-router";
 import HomePage from "./HomePage";
 import LoginPage from "./LoginPage";
 import LogoutPage from "./LogoutPage";
+import ModerationPage from "./ModerationPage";
+import ResetPasswordPage from "./ResetPasswordPage";
+import VerifyEmailPage from "./VerifyEmailPage";
 import VideoPage from "./VideoPage";
 
 function App() {
@@ -18,6 +21,15 @@ function App() {
         <Route exact path="/logout">
           <LogoutPage />
         </Route>
+        <Route exact path="/moderation">
+          <ModerationPage />
+        </Route>
+        <Route exact path="/verify-email">
+          <VerifyEmailPage />
+        </Route>
+        <Route exact path="/reset-password">
+          <ResetPasswordPage />
+        </Route>
         <Route exact path="/videos/:id">
           <VideoPage />
         </Route>
@@ -0,0 +1,206 @@
+import { useCallback, useEffect, useRef, useState } from "react";
+import { Button, Input } from "antd";
+
+import * as API from "./api";
+
+// danmaku are fetched in chunks of this many ms of playback
+const CHUNK_MS = 30 * 1000;
+const SCROLL_DURATION_MS = 6000;
+const FIXED_DURATION_MS = 3000;
+const NUM_LANES = 12;
+const LANE_HEIGHT_PERCENT = 100 / NUM_LANES;
+
+function colorToCSS(color) {
+  return "#" + color.toString(16).padStart(6, "0");
+}
+
+// Finds the lane which has been free the longest, so that comments overlap as little as possible
+function pickLane(laneFreeAt, now) {
+  let best = 0;
+  for (let i = 0; i < laneFreeAt.length; i++) {
+    if (laneFreeAt[i] <= now) return i;
+    if (laneFreeAt[i] < laneFreeAt[best]) best = i;
+  }
+  return best;
+}
+
+function DanmakuItem(props) {
+  let { item } = props;
+  let [started, setStarted] = useState(false);
+
+  // Start the scroll on the next frame so the transition runs
+  useEffect(() => {
+    let frame = requestAnimationFrame(() => setStarted(true));
+    return () => cancelAnimationFrame(frame);
+  }, []);
+
+  let style = {
+    top: `${item.lane * LANE_HEIGHT_PERCENT}%`,
+    color: colorToCSS(item.color),
+    textShadow: "1px 1px 2px black",
+  };
+
+  if (item.position === "scrolling") {
+    // Moves from just off the right edge to just off the left edge
+    style.left = started ? "0%" : "100%";
+    style.transform = started ? "translateX(-100%)" : "none";
+    style.transition = `left ${SCROLL_DURATION_MS}ms linear, transform ${SCROLL_DURATION_MS}ms linear`;
+  } else {
+    style.left = "50%";
+    style.transform = "translateX(-50%)";
+    if (item.position === "fixed_bottom") {
+      delete style.top;
+      style.bottom = `${(item.lane % 3) * LANE_HEIGHT_PERCENT}%`;
+    }
+  }
+
+  return (
+    <span className="absolute whitespace-nowrap font-bold" style={style}>
+      {item.content}
+    </span>
+  );
+}
+
+// Overlays the danmaku for videoId on top of the video element in videoRef, synced to its playback position
+export function DanmakuOverlay(props) {
+  let { videoId, videoRef, posted } = props;
+  let [active, setActive] = useState([]);
+  let chunks = useRef(new Map());
+  let lastTime = useRef(0);
+  let laneFreeAt = useRef(new Array(NUM_LANES).fill(0));
+
+  let loadChunk = useCallback(
+    (chunkIdx) => {
+      if (chunks.current.has(chunkIdx)) return;
+      chunks.current.set(chunkIdx, []);
+      API.getDanmaku(videoId, chunkIdx * CHUNK_MS, (chunkIdx + 1) * CHUNK_MS)
+        .then((data) => chunks.current.set(chunkIdx, data))
+        .catch(() => chunks.current.delete(chunkIdx));
+    },
+    [videoId]
+  );
+
+  useEffect(() => {
+    chunks.current = new Map();
+    lastTime.current = 0;
+    setActive([]);
+  }, [videoId]);
+
+  // Show our own danmaku immediately
+  useEffect(() => {
+    if (posted == null) return;
+    let chunk = chunks.current.get(Math.floor(posted.position_ms / CHUNK_MS));
+    if (chunk != null) chunk.push(posted);
+  }, [posted]);
+
+  useEffect(() => {
+    let video = videoRef.current;
+    if (video == null) return;
+
+    let onTimeUpdate = () => {
+      let nowMs = video.currentTime * 1000;
+      let prevMs = lastTime.current;
+      lastTime.current = nowMs;
+
+      let chunkIdx = Math.floor(nowMs / CHUNK_MS);
+      loadChunk(chunkIdx);
+      loadChunk(chunkIdx + 1);
+
+      // Only show danmaku during normal playback, not when seeking
+      if (nowMs < prevMs || nowMs - prevMs > 1000) return;
+
+      let wallNow = performance.now();
+      let newItems = [];
+      for (let idx of [chunkIdx - 1, chunkIdx]) {
+        for (let d of chunks.current.get(idx) || []) {
+          if (d.position_ms <= prevMs || d.position_ms > nowMs) continue;
+          let lane = pickLane(laneFreeAt.current, wallNow);
+          // Lanes free up once the comment has scrolled far enough in
+          laneFreeAt.current[lane] =
+            wallNow +
+            (d.position === "scrolling"
+              ? SCROLL_DURATION_MS / 3
+              : FIXED_DURATION_MS);
+          newItems.push({ ...d, lane, key: `${d.id}-${wallNow}` });
+        }
+      }
+
+      if (newItems.length === 0) return;
+      setActive((items) => [...items, ...newItems]);
+      for (let item of newItems) {
+        let duration =
+          item.position === "scrolling"
+            ? SCROLL_DURATION_MS
+            : FIXED_DURATION_MS;
+        setTimeout(() => {
+          setActive((items) => items.filter((i) => i.key !== item.key));
+        }, duration);
+      }
+    };
+
+    let onSeeked = () => {
+      lastTime.current = video.currentTime * 1000;
+      setActive([]);
+    };
+
+    video.addEventListener("timeupdate", onTimeUpdate);
+    video.addEventListener("seeked", onSeeked);
+    return () => {
+      video.removeEventListener("timeupdate", onTimeUpdate);
+      video.removeEventListener("seeked", onSeeked);
+    };
+  }, [videoRef, loadChunk]);
+
+  return (
+    <div className="absolute inset-0 overflow-hidden pointer-events-none">
+      {active.map((item) => (
+        <DanmakuItem key={item.key} item={item} />
+      ))}
+    </div>
+  );
+}
+
+// Posts a danmaku at the video's current playback position
+export function DanmakuInput(props) {
+  let { videoId, videoRef, onPosted } = props;
+  let [content, setContent] = useState("");
+  let posting = useRef(false);
+
+  let post = useCallback(() => {
+    let video = videoRef.current;
+    if (video == null || content === "" || posting.current) return;
+
+    let positionMs = Math.floor(video.currentTime * 1000);
+    posting.current = true;
+    API.postDanmaku(videoId, { positionMs, content })
+      .then(() => {
+        setContent("");
+        if (onPosted != null)
+          onPosted({
+            id: -Date.now(),
+            position_ms: positionMs + 1,
+            content,
+            position: "scrolling",
+            color: 0xffffff,
+          });
+      })
+      .finally(() => {
+        posting.current = false;
+      });
+  }, [videoId, videoRef, content, onPosted]);
+
+  return (
+    <div className="flex my-2">
+      <Input
+        value={content}
+        maxLength={100}
+        placeholder="Comment at the current position"
+        onChange={(e) => setContent(e.target.value)}
+        onPressEnter={post}
+      />
+      <Button className="ml-2" type="primary" onClick={post}>
+        Post
+      </Button>
+    </div>
+  );
+}
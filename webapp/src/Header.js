@@ -1,14 +1,19 @@
-import { useCallback } from "react";
+import { useCallback, useEffect, useState } from "react";
 import { Link } from "react-router-dom";
 import { FontAwesomeIcon } from "@fortawesome/react-fontawesome";
 import {
   faArchive,
   faBars,
+  faBell,
+  faFlag,
   faSearch,
   faSignOutAlt,
   faUser,
 } from "@fortawesome/free-solid-svg-icons";
-import { Button, Dropdown, Input, Menu } from "antd";
+import { Badge, Button, Dropdown, Input, Menu } from "antd";
+
+import * as API from "./api";
+import { Permission, hasPermission } from "./api/types";
 
 function Search() {
   let onSubmit = useCallback((e) => {
@@ -32,6 +37,41 @@ function Search() {
   );
 }
 
+function NotificationBell() {
+  let [unread, setUnread] = useState(0);
+
+  useEffect(() => {
+    let ignore = false;
+    API.getUnreadNotificationCount().then((data) => {
+      if (!ignore) setUnread(data.count);
+    });
+
+    let stream = API.openNotificationStream();
+    stream.addEventListener("notification", () => {
+      setUnread((count) => count + 1);
+    });
+
+    return () => {
+      ignore = true;
+      stream.close();
+    };
+  }, []);
+
+  let markRead = useCallback(() => {
+    API.markNotificationsRead([]).then(() => setUnread(0));
+  }, []);
+
+  return (
+    <Badge count={unread} size="small" className="mr-4">
+      <Button
+        shape="circle"
+        icon={<FontAwesomeIcon icon={faBell} />}
+        onClick={markRead}
+      />
+    </Badge>
+  );
+}
+
 function LoggedInUserNav(props) {
   const { userData } = props;
 
@@ -46,6 +86,12 @@ function LoggedInUserNav(props) {
       >
         <Link to="/archive-requests">Archive Requests</Link>
       </Menu.Item>
+      {(hasPermission(userData, Permission.VIDEO_APPROVE) ||
+        hasPermission(userData, Permission.REPORT_RESOLVE)) && (
+        <Menu.Item key="moderation" icon={<FontAwesomeIcon icon={faFlag} />}>
+          <Link to="/moderation">Moderation</Link>
+        </Menu.Item>
+      )}
       <Menu.Divider />
       <Menu.Item
         key="logout"
@@ -58,6 +104,7 @@ function LoggedInUserNav(props) {
 
   return (
     <>
+      <NotificationBell />
       <Dropdown overlay={menu} placement="bottomRight" trigger={["click"]}>
         <Button>
           {userData.Username}
@@ -0,0 +1,133 @@
+import { useCallback, useEffect, useState } from "react";
+import { Button, Input, Tag } from "antd";
+import { Link } from "react-router-dom";
+
+import * as API from "./api";
+import Header from "./Header";
+
+function ReportItem(props) {
+  let { report, onResolved } = props;
+  let [note, setNote] = useState("");
+  let [resolving, setResolving] = useState(false);
+
+  let resolve = useCallback(
+    (action) => {
+      if (resolving) return;
+      setResolving(true);
+      API.resolveReports(report.content_type, report.content_id, action, note)
+        .then(() => onResolved(report))
+        .finally(() => setResolving(false));
+    },
+    [report, note, resolving, onResolved]
+  );
+
+  return (
+    <div className="bg-white border p-4 my-2">
+      <div>
+        <span className="font-bold mr-2">{report.report_count} reports</span>
+        <span className="text-gray-600 text-xs">
+          first reported {report.first_reported}
+        </span>
+      </div>
+      <div className="my-2">
+        <Tag>{report.content_type}</Tag>
+        <Link to={`/videos/${report.video_id}`}>{report.preview}</Link>
+        <span className="ml-2 text-gray-600">
+          by{" "}
+          <Link to={`/users/${report.author_id}`}>{report.author_name}</Link>
+        </span>
+      </div>
+      <div className="my-2">
+        {report.reasons.map((reason, idx) => (
+          <Tag key={idx} color="orange">
+            {reason}
+          </Tag>
+        ))}
+      </div>
+      {report.details.map((detail, idx) => (
+        <div key={idx} className="text-sm text-gray-700">
+          {detail}
+        </div>
+      ))}
+      <div className="flex mt-2">
+        <Input
+          value={note}
+          placeholder="Note for the moderation log"
+          onChange={(e) => setNote(e.target.value)}
+        />
+        <Button
+          className="ml-2"
+          disabled={resolving}
+          onClick={() => resolve("dismiss")}
+        >
+          Dismiss
+        </Button>
+        <Button
+          className="ml-2"
+          disabled={resolving}
+          onClick={() => resolve("hide")}
+        >
+          Hide
+        </Button>
+        <Button
+          className="ml-2"
+          danger
+          disabled={resolving}
+          onClick={() => resolve("ban_user")}
+        >
+          Ban user
+        </Button>
+      </div>
+    </div>
+  );
+}
+
+function ModerationPage() {
+  const [pageData, setPageData] = useState(null);
+
+  useEffect(() => {
+    let ignore = false;
+
+    let fetchData = async () => {
+      let data = await API.getReports(1);
+      if (!ignore) setPageData(data);
+    };
+
+    fetchData();
+    return () => {
+      ignore = true;
+    };
+  }, []);
+
+  let onResolved = useCallback((resolved) => {
+    setPageData((data) => ({
+      ...data,
+      reports: data.reports.filter((r) => r !== resolved),
+      number_of_reports: data.number_of_reports - 1,
+    }));
+  }, []);
+
+  if (pageData == null) return null;
+
+  return (
+    <>
+      <Header userData={pageData.L} />
+      <div className="flex justify-center mx-4">
+        <div className="max-w-screen-lg w-screen my-6">
+          <span className="text-lg font-bold">
+            {pageData.number_of_reports} reported items
+          </span>
+          {pageData.reports.map((report) => (
+            <ReportItem
+              key={`${report.content_type}-${report.content_id}`}
+              report={report}
+              onResolved={onResolved}
+            />
+          ))}
+        </div>
+      </div>
+    </>
+  );
+}
+
+export default ModerationPage;
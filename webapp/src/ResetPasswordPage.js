@@ -0,0 +1,85 @@
+import { useFormik } from "formik";
+import { Button, Input } from "antd";
+import { FontAwesomeIcon } from "@fortawesome/react-fontawesome";
+import { faKey } from "@fortawesome/free-solid-svg-icons";
+import { useState } from "react";
+import { useHistory, useLocation } from "react-router-dom";
+
+import Header from "./Header";
+import * as API from "./api";
+
+// landing page for the link in password reset emails, which carries the token
+function ResetPasswordForm() {
+  let history = useHistory();
+  let location = useLocation();
+  let [failed, setFailed] = useState(false);
+
+  let formik = useFormik({
+    initialValues: {
+      password: "",
+    },
+    onSubmit: async (values) => {
+      let token = new URLSearchParams(location.search).get("token") || "";
+      try {
+        await API.resetPassword(token, values.password);
+      } catch (err) {
+        setFailed(true);
+        return;
+      }
+      // the reset logs the user out everywhere
+      history.push("/login");
+    },
+  });
+
+  return (
+    <div className="max-w-xs w-full border rounded shadow bg-white p-4">
+      <h2 className="text-xl mb-4">Choose a new password</h2>
+      {failed && (
+        <div className="mb-4 text-red-600">
+          This link is invalid or has expired.
+        </div>
+      )}
+      <form onSubmit={formik.handleSubmit}>
+        <Input.Group>
+          <Input.Password
+            name="password"
+            value={formik.values.password}
+            onChange={formik.handleChange}
+            size="large"
+            placeholder="New password"
+            prefix={
+              <FontAwesomeIcon className="mr-1 text-gray-400" icon={faKey} />
+            }
+          />
+        </Input.Group>
+        <br />
+        <Input.Group>
+          <Button
+            block
+            type="primary"
+            htmlType="submit"
+            size="large"
+            disabled={formik.isSubmitting}
+          >
+            Submit
+          </Button>
+        </Input.Group>
+      </form>
+    </div>
+  );
+}
+
+function ResetPasswordPage() {
+  return (
+    <>
+      <Header dataless />
+      <div className="flex justify-center mx-4">
+        <div className="max-w-screen-lg w-screen my-6 flex justify-center items-center pt-32">
+          <ResetPasswordForm />
+        </div>
+      </div>
+    </>
+  );
+}
+
+export default ResetPasswordPage;
@@ -0,0 +1,45 @@
+import { useEffect, useState } from "react";
+import { Link, useLocation } from "react-router-dom";
+
+import Header from "./Header";
+import * as API from "./api";
+
+// landing page for the link in verification emails, which carries the token
+function VerifyEmailPage() {
+  let location = useLocation();
+  let [result, setResult] = useState(null);
+
+  useEffect(() => {
+    let ignore = false;
+    let token = new URLSearchParams(location.search).get("token") || "";
+
+    API.verifyEmail(token)
+      .then(() => !ignore && setResult("verified"))
+      .catch(() => !ignore && setResult("failed"));
+
+    return () => {
+      ignore = true;
+    };
+  }, [location]);
+
+  return (
+    <>
+      <Header dataless />
+      <div className="flex justify-center mx-4">
+        <div className="max-w-xs w-full border rounded shadow bg-white p-4 mt-32">
+          {result == null && <span>Verifying your email address...</span>}
+          {result === "verified" && (
+            <span>
+              Your email address is verified. <Link to="/">Continue</Link>
+            </span>
+          )}
+          {result === "failed" && (
+            <span>This link is invalid or has expired.</span>
+          )}
+        </div>
+      </div>
+    </>
+  );
+}
+
+export default VerifyEmailPage;
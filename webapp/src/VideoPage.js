@@ -1,5 +1,5 @@
 import { useCallback, useEffect, useRef, useState } from "react";
-import { Tag, Avatar, Button } from "antd";
+import { Tag, Avatar, Button, Input, Select } from "antd";
 import { Link, useParams } from "react-router-dom";
 import dashjs from "dashjs";
 import { FontAwesomeIcon } from "@fortawesome/react-fontawesome";
@@ -7,14 +7,15 @@ import { faUserCircle } from "@fortawesome/free-solid-svg-icons";
 
 import * as API from "./api";
 import Header from "./Header";
-import { UserRank } from "./api/types";
+import VideoList from "./VideoList";
+import { DanmakuInput, DanmakuOverlay } from "./Danmaku";
+import { Permission, hasPermission } from "./api/types";
 
 const VIDEO_WIDTH = 44;
 const VIDEO_HEIGHT = (9 / 16) * VIDEO_WIDTH;
 
 function VideoPlayer(props) {
-  let { url } = props;
-  let videoRef = useRef();
+  let { url, videoRef, children } = props;
   useEffect(() => {
     let video = videoRef.current;
     if (video == null) return;
@@ -26,14 +27,15 @@ function VideoPlayer(props) {
   }, [url, videoRef]);
 
   return (
-    <>
+    <div className="relative">
       <video
         ref={videoRef}
         className="bg-black w-full h-80 object-contain object-center"
         style={{ height: `${VIDEO_HEIGHT}rem` }}
         controls
       ></video>
-    </>
+      {children}
+    </div>
   );
 }
 
@@ -53,26 +55,139 @@ function VideoAdminControls(props) {
     });
   }, [data, approvingVideo, setApprovedVideo]);
 
+  let [rejectedVideo, setRejectedVideo] = useState(false);
+  let [rejectionReason, setRejectionReason] = useState("");
+  let rejectVideo = useCallback(() => {
+    if (approvingVideo.current || rejectionReason === "") return;
+    let run = async () => {
+      await API.rejectVideo(data.VideoID, rejectionReason);
+      setRejectedVideo(true);
+    };
+    approvingVideo.current = true;
+    run().finally(() => {
+      approvingVideo.current = false;
+    });
+  }, [data, approvingVideo, rejectionReason, setRejectedVideo]);
+
+  let voted = approvedVideo || rejectedVideo;
+
   return (
     <>
       <hr />
-      <div className="my-4">
-        <Button type="primary" disabled={approvedVideo} onClick={approveVideo}>
+      <div className="my-4 flex">
+        <Button type="primary" disabled={voted} onClick={approveVideo}>
           {approvedVideo ? "Approved" : "Approve"}
         </Button>
+        <Input
+          className="mx-2"
+          value={rejectionReason}
+          disabled={voted}
+          placeholder="Reason for rejection"
+          onChange={(e) => setRejectionReason(e.target.value)}
+        />
+        <Button
+          danger
+          disabled={voted || rejectionReason === ""}
+          onClick={rejectVideo}
+        >
+          {rejectedVideo ? "Rejected" : "Reject"}
+        </Button>
       </div>
     </>
   );
 }
 
+function ReportVideo(props) {
+  let { data } = props;
+  let [reason, setReason] = useState("spam");
+  let [reported, setReported] = useState(false);
+
+  let report = useCallback(() => {
+    if (reported) return;
+    API.reportContent("video", data.VideoID, reason, "").then(() =>
+      setReported(true)
+    );
+  }, [data, reason, reported]);
+
+  return (
+    <div className="my-4 flex justify-end items-center">
+      <Select
+        size="small"
+        value={reason}
+        disabled={reported}
+        onChange={setReason}
+        options={[
+          { value: "spam", label: "Spam" },
+          { value: "rule_violation", label: "Breaks the rules" },
+          { value: "duplicate", label: "Duplicate" },
+        ]}
+      />
+      <Button
+        className="ml-2"
+        size="small"
+        disabled={reported}
+        onClick={report}
+      >
+        {reported ? "Reported" : "Report"}
+      </Button>
+    </div>
+  );
+}
+
+function FollowButton(props) {
+  let { data } = props;
+  let [subscribed, setSubscribed] = useState(data.Subscribed);
+  let [subscribers, setSubscribers] = useState(data.UserSubscribers);
+
+  let toggle = useCallback(() => {
+    let request = subscribed ? API.unfollowUser : API.followUser;
+    request(data.AuthorID).then((counts) => {
+      setSubscribed(counts.Subscribed);
+      setSubscribers(counts.UserSubscribers);
+    });
+  }, [data, subscribed]);
+
+  return (
+    <div className="ml-auto flex items-center">
+      <span className="mr-2 text-gray-600 text-xs">
+        {subscribers} followers
+      </span>
+      {data.L.UserID !== 0 && data.L.UserID !== data.AuthorID && (
+        <Button
+          size="small"
+          type={subscribed ? "default" : "primary"}
+          onClick={toggle}
+        >
+          {subscribed ? "Unfollow" : "Follow"}
+        </Button>
+      )}
+    </div>
+  );
+}
+
 function VideoView(props) {
   let { data } = props;
+  let videoRef = useRef();
+  let [postedDanmaku, setPostedDanmaku] = useState(null);
 
   // TODO(ivan): responsive video page UI
   return (
     <div className="bg-white border" style={{ width: `${VIDEO_WIDTH}rem` }}>
-      <VideoPlayer url={data.MPDLoc} />
+      <VideoPlayer url={data.MPDLoc} videoRef={videoRef}>
+        <DanmakuOverlay
+          videoId={data.VideoID}
+          videoRef={videoRef}
+          posted={postedDanmaku}
+        />
+      </VideoPlayer>
       <div className="p-4">
+        {data.L.UserID !== 0 && (
+          <DanmakuInput
+            videoId={data.VideoID}
+            videoRef={videoRef}
+            onPosted={setPostedDanmaku}
+          />
+        )}
         <div>
           <span className="text-lg font-bold">{data.Title}</span>
           <span className="float-right">
@@ -95,13 +210,31 @@ function VideoView(props) {
             })}
           </div>
         </div>
-        {data.L.Rank === UserRank.ADMIN && <VideoAdminControls data={data} />}
+        {data.AlsoAvailableFrom && data.AlsoAvailableFrom.length > 0 && (
+          <div className="my-4">
+            <span className="text-xs font-bold mb-2">Also available from</span>
+            <div className="border px-2 py-1">
+              {data.AlsoAvailableFrom.map((mirror) => (
+                <div key={mirror.VideoID} className="my-1 inline-block">
+                  <Link to={`/videos/${mirror.VideoID}`}>
+                    <Tag>{mirror.Website}</Tag>
+                  </Link>
+                </div>
+              ))}
+            </div>
+          </div>
+        )}
+        {data.L.UserID !== 0 && <ReportVideo data={data} />}
+        {hasPermission(data.L, Permission.VIDEO_APPROVE) && (
+          <VideoAdminControls data={data} />
+        )}
         <hr />
         <div className="my-4">
           <div className="flex justify-start items-center">
             <Link to={`/users/${data.AuthorID}`}>
               <Avatar
                 size="large"
+                src={data.ProfilePicture}
                 icon={<FontAwesomeIcon icon={faUserCircle} />}
               />
             </Link>
@@ -109,7 +242,11 @@ function VideoView(props) {
               {" "}
               <Link to={`/users/${data.AuthorID}`}>{data.Username}</Link>
             </div>
+            <FollowButton data={data} />
           </div>
+          {data.UserDescription && (
+            <p className="mt-2 whitespace-pre-line">{data.UserDescription}</p>
+          )}
           {/* TODO(ivan): THIS IS VERY OBVIOUSLY A SECURITY ISSUE! Remove this once you get a proper video description format going */}
           <div className="mt-4">
             <span dangerouslySetInnerHTML={{ __html: data.VideoDescription }} />
@@ -148,6 +285,12 @@ function VideoPage() {
       <div className="flex justify-center mx-4">
         <div className="max-w-screen-lg w-screen my-6">
           <VideoView data={pageData} />
+          {pageData.RelatedVideos.length > 0 && (
+            <>
+              <div className="mt-6 text-lg font-bold">Related videos</div>
+              <VideoList videos={pageData.RelatedVideos} />
+            </>
+          )}
         </div>
       </div>
     </>
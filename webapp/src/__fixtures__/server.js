@@ -10,6 +10,7 @@ const handlers = [
           Username: "foo",
           ProfilePictureURL: "",
           Rank: 1,
+          Permissions: ["video.approve", "report.resolve"],
         },
         PaginationData: {},
         Videos: [],
@@ -32,6 +32,48 @@ export async function postLogout() {
   return res.data;
 }
 
+export async function sendVerificationEmail() {
+  const res = await axios.post(e("verify-email/send"));
+  return res.data;
+}
+
+export async function verifyEmail(token) {
+  let form = new FormData();
+  form.append("token", token);
+
+  const res = await axios.post(e("verify-email"), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
+export async function requestPasswordReset(email) {
+  let form = new FormData();
+  form.append("email", email);
+
+  const res = await axios.post(e("reset-password/request"), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
+export async function resetPassword(token, password) {
+  let form = new FormData();
+  form.append("token", token);
+  form.append("password", password);
+
+  const res = await axios.post(e("reset-password"), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
 export async function getVideo(videoId) {
   const res = await axios.get(e(`videos/${videoId}`));
   return res.data;
@@ -41,3 +83,255 @@ export async function approveVideo(videoId) {
   const res = await axios.post(e(`approve/${videoId}`));
   return res.data;
 }
+
+export async function rejectVideo(videoId, reason) {
+  let form = new FormData();
+  form.append("reason", reason);
+
+  const res = await axios.post(e(`reject/${videoId}`), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
+export async function getPendingVideos(page) {
+  const res = await axios.get(e("pendingvideos"), { params: { page } });
+  return res.data;
+}
+
+function formData(data) {
+  let form = new FormData();
+  for (let [key, value] of Object.entries(data)) form.append(key, value);
+  return form;
+}
+
+const multipartHeaders = {
+  headers: {
+    "content-type": "multipart/form-data",
+  },
+};
+
+// contentType is video or comment, reason is spam, rule_violation or duplicate
+export async function reportContent(contentType, contentId, reason, details) {
+  const res = await axios.post(
+    e("reports"),
+    formData({
+      content_type: contentType,
+      content_id: contentId,
+      reason,
+      details,
+    }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+export async function getReports(page) {
+  const res = await axios.get(e("reports"), { params: { page } });
+  return res.data;
+}
+
+// action is dismiss, hide or ban_user
+export async function resolveReports(contentType, contentId, action, note) {
+  const res = await axios.post(
+    e("reports/resolve"),
+    formData({
+      content_type: contentType,
+      content_id: contentId,
+      action,
+      note,
+    }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+// Danmaku with playback positions in [fromMs, toMs)
+export async function getDanmaku(videoId, fromMs, toMs) {
+  const res = await axios.get(e(`danmaku/${videoId}`), {
+    params: { from: fromMs, to: toMs },
+  });
+  return res.data;
+}
+
+export async function postDanmaku(videoId, data) {
+  let form = new FormData();
+  form.append("position_ms", data.positionMs);
+  form.append("content", data.content);
+
+  const res = await axios.post(e(`danmaku/${videoId}`), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
+export async function followUser(userId) {
+  const res = await axios.post(e(`users/${userId}/follow`));
+  return res.data;
+}
+
+export async function unfollowUser(userId) {
+  const res = await axios.delete(e(`users/${userId}/follow`));
+  return res.data;
+}
+
+export async function getFeed(cursor) {
+  const res = await axios.get(e("feed"), { params: { cursor } });
+  return res.data;
+}
+
+export async function getNotifications(page, unreadOnly) {
+  const res = await axios.get(e("notifications"), {
+    params: { page, unread: unreadOnly ? 1 : 0 },
+  });
+  return res.data;
+}
+
+export async function getUnreadNotificationCount() {
+  const res = await axios.get(e("notifications/unread"));
+  return res.data;
+}
+
+// Marks every notification read if ids is empty
+export async function markNotificationsRead(ids) {
+  let form = new FormData();
+  form.append("ids", ids.join(","));
+
+  const res = await axios.post(e("notifications/read"), form, {
+    headers: {
+      "content-type": "multipart/form-data",
+    },
+  });
+  return res.data;
+}
+
+// Server-sent events, one "notification" event per new notification
+export function openNotificationStream() {
+  return new EventSource(e("notifications/stream"), { withCredentials: true });
+}
+
+export async function autocompleteTags(prefix) {
+  const res = await axios.get(e("tags/autocomplete"), { params: { q: prefix } });
+  return res.data;
+}
+
+export async function getTagInfo(tag) {
+  const res = await axios.get(e(`tags/${encodeURIComponent(tag)}`));
+  return res.data;
+}
+
+// ranks is a list of regular, trusted or admin, and matches any rank if empty
+export async function getAdminUsers(query, { ranks = [], bannedOnly, localOnly, page } = {}) {
+  const params = new URLSearchParams({ q: query, page: page || 1 });
+  ranks.forEach((rank) => params.append("rank", rank));
+  if (bannedOnly) params.append("banned", "true");
+  if (localOnly) params.append("local", "true");
+
+  const res = await axios.get(e("admin/users"), { params });
+  return res.data;
+}
+
+export async function setUserRank(userId, rank) {
+  const res = await axios.post(
+    e(`admin/users/${userId}/rank`),
+    formData({ rank }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+// A duration of 0 seconds bans permanently
+export async function banUser(userId, durationSeconds, reason) {
+  const res = await axios.post(
+    e(`admin/users/${userId}/ban`),
+    formData({ duration: durationSeconds, reason }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+export async function unbanUser(userId, reason) {
+  const res = await axios.post(
+    e(`admin/users/${userId}/unban`),
+    formData({ reason }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+// links is a list of http or https URLs
+export async function updateProfile(displayName, bio, links) {
+  const res = await axios.put(
+    e("profile"),
+    formData({ display_name: displayName, bio, links: JSON.stringify(links) }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+// image is avatar or banner
+export async function uploadProfileImage(image, file) {
+  let form = new FormData();
+  form.append("image", file);
+
+  const res = await axios.post(e(`profile/${image}`), form, multipartHeaders);
+  return res.data;
+}
+
+export async function removeProfileImage(image) {
+  const res = await axios.delete(e(`profile/${image}`));
+  return res.data;
+}
+
+// userId is a foreign user, created for archived videos. The claim's code goes in their channel description.
+export async function startClaim(userId) {
+  const res = await axios.post(
+    e("claims"),
+    formData({ user_id: userId }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+export async function getClaim(claimId) {
+  const res = await axios.get(e(`claims/${claimId}`));
+  return res.data;
+}
+
+// mode is convert, which needs a password and optionally an email, or merge
+export async function completeClaim(claimId, mode, { email, password } = {}) {
+  const res = await axios.post(
+    e(`claims/${claimId}/complete`),
+    formData({ mode, email: email || "", password: password || "" }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+export async function getAPITokens() {
+  const res = await axios.get(e("tokens"));
+  return res.data;
+}
+
+// scopes is a list of read, upload, archive and moderate. The token is only in the response to this.
+export async function createAPIToken(name, scopes, expiresInSeconds) {
+  const res = await axios.post(
+    e("tokens"),
+    formData({
+      name,
+      scopes: scopes.join(","),
+      expires_in: expiresInSeconds || "",
+    }),
+    multipartHeaders
+  );
+  return res.data;
+}
+
+export async function revokeAPIToken(tokenId) {
+  const res = await axios.delete(e(`tokens/${tokenId}`));
+  return res.data;
+}
@@ -3,3 +3,19 @@ export const UserRank = {
   TRUSTED: 1,
   ADMIN: 2,
 };
+
+// Named permissions granted by the user service, listed in L.Permissions
+export const Permission = {
+  VIDEO_APPROVE: "video.approve",
+  VIDEO_DELETE: "video.delete",
+  COMMENT_DELETE: "comment.delete",
+  REPORT_RESOLVE: "report.resolve",
+  TAG_EDIT: "tag.edit",
+  USER_BAN: "user.ban",
+  USER_MANAGE: "user.manage",
+  ARCHIVE_UNLIMITED: "archive.unlimited",
+};
+
+export function hasPermission(userData, permission) {
+  return (userData.Permissions || []).includes(permission);
+}